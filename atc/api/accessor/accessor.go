@@ -15,6 +15,7 @@ type Access interface {
 	IsSystem() bool
 	TeamNames() []string
 	CSRFToken() string
+	UserName() string
 }
 
 type access struct {
@@ -115,9 +116,22 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
+func (a *access) UserName() string {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if userNameClaim, ok := claims["user_name"]; ok {
+			if userName, ok := userNameClaim.(string); ok {
+				return userName
+			}
+		}
+	}
+	return ""
+}
+
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
 	atc.GetConfig:                     "viewer",
+	atc.ListConfigVersions:            "viewer",
+	atc.GetConfigVersion:              "viewer",
 	atc.GetCC:                         "viewer",
 	atc.GetBuild:                      "viewer",
 	atc.GetBuildPlan:                  "viewer",
@@ -129,11 +143,15 @@ var requiredRoles = map[string]string{
 	atc.GetBuildPreparation:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "member",
+	atc.RerunJobBuild:                 "member",
+	atc.GetJobBuildStepPlan:           "member",
 	atc.ListAllJobs:                   "viewer",
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
 	atc.ListJobInputs:                 "viewer",
 	atc.GetJobBuild:                   "viewer",
+	atc.DiffJobBuildInputs:            "viewer",
+	atc.GetJobBuildProvenance:         "viewer",
 	atc.PauseJob:                      "member",
 	atc.UnpauseJob:                    "member",
 	atc.GetVersionsDB:                 "viewer",
@@ -146,11 +164,17 @@ var requiredRoles = map[string]string{
 	atc.GetResource:                   "viewer",
 	atc.PauseResource:                 "member",
 	atc.UnpauseResource:               "member",
+	atc.PinResource:                   "member",
 	atc.UnpinResource:                 "member",
 	atc.SetPinCommentOnResource:       "member",
 	atc.CheckResource:                 "member",
 	atc.CheckResourceWebHook:          "member",
 	atc.CheckResourceType:             "member",
+	atc.ListChecks:                    "viewer",
+	atc.ListResourceChecks:            "viewer",
+	atc.CreateResourceCheck:           "member",
+	atc.CreateResourceTypeCheck:       "member",
+	atc.GetCheck:                      "viewer",
 	atc.ListResourceVersions:          "viewer",
 	atc.GetResourceVersion:            "viewer",
 	atc.EnableResourceVersion:         "member",
@@ -168,10 +192,16 @@ var requiredRoles = map[string]string{
 	atc.UnpausePipeline:               "member",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
+	atc.PausePipelines:                "member",
+	atc.UnpausePipelines:              "member",
+	atc.ExposePipelines:               "member",
+	atc.HidePipelines:                 "member",
+	atc.DeletePipelines:               "member",
 	atc.RenamePipeline:                "member",
 	atc.ListPipelineBuilds:            "viewer",
 	atc.CreatePipelineBuild:           "member",
 	atc.PipelineBadge:                 "viewer",
+	atc.ListSecretLookups:             "member",
 	atc.RegisterWorker:                "member",
 	atc.LandWorker:                    "member",
 	atc.RetireWorker:                  "member",
@@ -197,6 +227,10 @@ var requiredRoles = map[string]string{
 	atc.RenameTeam:                    "owner",
 	atc.DestroyTeam:                   "owner",
 	atc.ListTeamBuilds:                "viewer",
+	atc.GetTeamResourceTypes:          "viewer",
+	atc.SetTeamResourceTypes:          "member",
+	atc.ReceiveWebhook:                "member",
+	atc.ListWebhookDeliveries:         "member",
 	atc.CreateArtifact:                "member",
 	atc.GetArtifact:                   "member",
 	atc.ListBuildArtifacts:            "viewer",
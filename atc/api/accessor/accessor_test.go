@@ -269,6 +269,34 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Get User Name", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": "some-user"}
+			})
+			It("returns the user name", func() {
+				Expect(access.UserName()).To(Equal("some-user"))
+			})
+		})
+
+		Context("when request does not have user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Get Team Names", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -346,6 +374,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.GetConfig, atc.GetConfig, "member", true),
 		Entry("viewer :: "+atc.GetConfig, atc.GetConfig, "viewer", true),
 
+		Entry("owner :: "+atc.ListConfigVersions, atc.ListConfigVersions, "owner", true),
+		Entry("member :: "+atc.ListConfigVersions, atc.ListConfigVersions, "member", true),
+		Entry("viewer :: "+atc.ListConfigVersions, atc.ListConfigVersions, "viewer", true),
+
+		Entry("owner :: "+atc.GetConfigVersion, atc.GetConfigVersion, "owner", true),
+		Entry("member :: "+atc.GetConfigVersion, atc.GetConfigVersion, "member", true),
+		Entry("viewer :: "+atc.GetConfigVersion, atc.GetConfigVersion, "viewer", true),
+
 		Entry("owner :: "+atc.GetCC, atc.GetCC, "owner", true),
 		Entry("member :: "+atc.GetCC, atc.GetCC, "member", true),
 		Entry("viewer :: "+atc.GetCC, atc.GetCC, "viewer", true),
@@ -390,6 +426,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
+		Entry("owner :: "+atc.RerunJobBuild, atc.RerunJobBuild, "owner", true),
+		Entry("member :: "+atc.RerunJobBuild, atc.RerunJobBuild, "member", true),
+		Entry("viewer :: "+atc.RerunJobBuild, atc.RerunJobBuild, "viewer", false),
+
+		Entry("owner :: "+atc.GetJobBuildStepPlan, atc.GetJobBuildStepPlan, "owner", true),
+		Entry("member :: "+atc.GetJobBuildStepPlan, atc.GetJobBuildStepPlan, "member", true),
+		Entry("viewer :: "+atc.GetJobBuildStepPlan, atc.GetJobBuildStepPlan, "viewer", false),
+
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
@@ -410,6 +454,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
 		Entry("viewer :: "+atc.GetJobBuild, atc.GetJobBuild, "viewer", true),
 
+		Entry("owner :: "+atc.DiffJobBuildInputs, atc.DiffJobBuildInputs, "owner", true),
+		Entry("member :: "+atc.DiffJobBuildInputs, atc.DiffJobBuildInputs, "member", true),
+		Entry("viewer :: "+atc.DiffJobBuildInputs, atc.DiffJobBuildInputs, "viewer", true),
+
+		Entry("owner :: "+atc.GetJobBuildProvenance, atc.GetJobBuildProvenance, "owner", true),
+		Entry("member :: "+atc.GetJobBuildProvenance, atc.GetJobBuildProvenance, "member", true),
+		Entry("viewer :: "+atc.GetJobBuildProvenance, atc.GetJobBuildProvenance, "viewer", true),
+
 		Entry("owner :: "+atc.PauseJob, atc.PauseJob, "owner", true),
 		Entry("member :: "+atc.PauseJob, atc.PauseJob, "member", true),
 		Entry("viewer :: "+atc.PauseJob, atc.PauseJob, "viewer", false),
@@ -458,6 +510,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.UnpauseResource, atc.UnpauseResource, "member", true),
 		Entry("viewer :: "+atc.UnpauseResource, atc.UnpauseResource, "viewer", false),
 
+		Entry("owner :: "+atc.PinResource, atc.PinResource, "owner", true),
+		Entry("member :: "+atc.PinResource, atc.PinResource, "member", true),
+		Entry("viewer :: "+atc.PinResource, atc.PinResource, "viewer", false),
+
 		Entry("owner :: "+atc.CheckResource, atc.CheckResource, "owner", true),
 		Entry("member :: "+atc.CheckResource, atc.CheckResource, "member", true),
 		Entry("viewer :: "+atc.CheckResource, atc.CheckResource, "viewer", false),
@@ -470,6 +526,26 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CheckResourceType, atc.CheckResourceType, "member", true),
 		Entry("viewer :: "+atc.CheckResourceType, atc.CheckResourceType, "viewer", false),
 
+		Entry("owner :: "+atc.ListChecks, atc.ListChecks, "owner", true),
+		Entry("member :: "+atc.ListChecks, atc.ListChecks, "member", true),
+		Entry("viewer :: "+atc.ListChecks, atc.ListChecks, "viewer", true),
+
+		Entry("owner :: "+atc.ListResourceChecks, atc.ListResourceChecks, "owner", true),
+		Entry("member :: "+atc.ListResourceChecks, atc.ListResourceChecks, "member", true),
+		Entry("viewer :: "+atc.ListResourceChecks, atc.ListResourceChecks, "viewer", true),
+
+		Entry("owner :: "+atc.CreateResourceCheck, atc.CreateResourceCheck, "owner", true),
+		Entry("member :: "+atc.CreateResourceCheck, atc.CreateResourceCheck, "member", true),
+		Entry("viewer :: "+atc.CreateResourceCheck, atc.CreateResourceCheck, "viewer", false),
+
+		Entry("owner :: "+atc.CreateResourceTypeCheck, atc.CreateResourceTypeCheck, "owner", true),
+		Entry("member :: "+atc.CreateResourceTypeCheck, atc.CreateResourceTypeCheck, "member", true),
+		Entry("viewer :: "+atc.CreateResourceTypeCheck, atc.CreateResourceTypeCheck, "viewer", false),
+
+		Entry("owner :: "+atc.GetCheck, atc.GetCheck, "owner", true),
+		Entry("member :: "+atc.GetCheck, atc.GetCheck, "member", true),
+		Entry("viewer :: "+atc.GetCheck, atc.GetCheck, "viewer", true),
+
 		Entry("owner :: "+atc.ListResourceVersions, atc.ListResourceVersions, "owner", true),
 		Entry("member :: "+atc.ListResourceVersions, atc.ListResourceVersions, "member", true),
 		Entry("viewer :: "+atc.ListResourceVersions, atc.ListResourceVersions, "viewer", true),
@@ -534,6 +610,26 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.HidePipeline, atc.HidePipeline, "member", true),
 		Entry("viewer :: "+atc.HidePipeline, atc.HidePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.PausePipelines, atc.PausePipelines, "owner", true),
+		Entry("member :: "+atc.PausePipelines, atc.PausePipelines, "member", true),
+		Entry("viewer :: "+atc.PausePipelines, atc.PausePipelines, "viewer", false),
+
+		Entry("owner :: "+atc.UnpausePipelines, atc.UnpausePipelines, "owner", true),
+		Entry("member :: "+atc.UnpausePipelines, atc.UnpausePipelines, "member", true),
+		Entry("viewer :: "+atc.UnpausePipelines, atc.UnpausePipelines, "viewer", false),
+
+		Entry("owner :: "+atc.ExposePipelines, atc.ExposePipelines, "owner", true),
+		Entry("member :: "+atc.ExposePipelines, atc.ExposePipelines, "member", true),
+		Entry("viewer :: "+atc.ExposePipelines, atc.ExposePipelines, "viewer", false),
+
+		Entry("owner :: "+atc.HidePipelines, atc.HidePipelines, "owner", true),
+		Entry("member :: "+atc.HidePipelines, atc.HidePipelines, "member", true),
+		Entry("viewer :: "+atc.HidePipelines, atc.HidePipelines, "viewer", false),
+
+		Entry("owner :: "+atc.DeletePipelines, atc.DeletePipelines, "owner", true),
+		Entry("member :: "+atc.DeletePipelines, atc.DeletePipelines, "member", true),
+		Entry("viewer :: "+atc.DeletePipelines, atc.DeletePipelines, "viewer", false),
+
 		Entry("owner :: "+atc.RenamePipeline, atc.RenamePipeline, "owner", true),
 		Entry("member :: "+atc.RenamePipeline, atc.RenamePipeline, "member", true),
 		Entry("viewer :: "+atc.RenamePipeline, atc.RenamePipeline, "viewer", false),
@@ -550,6 +646,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.PipelineBadge, atc.PipelineBadge, "member", true),
 		Entry("viewer :: "+atc.PipelineBadge, atc.PipelineBadge, "viewer", true),
 
+		Entry("owner :: "+atc.ListSecretLookups, atc.ListSecretLookups, "owner", true),
+		Entry("member :: "+atc.ListSecretLookups, atc.ListSecretLookups, "member", true),
+		Entry("viewer :: "+atc.ListSecretLookups, atc.ListSecretLookups, "viewer", false),
+
 		Entry("owner :: "+atc.RegisterWorker, atc.RegisterWorker, "owner", true),
 		Entry("member :: "+atc.RegisterWorker, atc.RegisterWorker, "member", true),
 		Entry("viewer :: "+atc.RegisterWorker, atc.RegisterWorker, "viewer", false),
@@ -650,6 +750,22 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "member", true),
 		Entry("viewer :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.GetTeamResourceTypes, atc.GetTeamResourceTypes, "owner", true),
+		Entry("member :: "+atc.GetTeamResourceTypes, atc.GetTeamResourceTypes, "member", true),
+		Entry("viewer :: "+atc.GetTeamResourceTypes, atc.GetTeamResourceTypes, "viewer", true),
+
+		Entry("owner :: "+atc.SetTeamResourceTypes, atc.SetTeamResourceTypes, "owner", true),
+		Entry("member :: "+atc.SetTeamResourceTypes, atc.SetTeamResourceTypes, "member", true),
+		Entry("viewer :: "+atc.SetTeamResourceTypes, atc.SetTeamResourceTypes, "viewer", false),
+
+		Entry("owner :: "+atc.ReceiveWebhook, atc.ReceiveWebhook, "owner", true),
+		Entry("member :: "+atc.ReceiveWebhook, atc.ReceiveWebhook, "member", true),
+		Entry("viewer :: "+atc.ReceiveWebhook, atc.ReceiveWebhook, "viewer", false),
+
+		Entry("owner :: "+atc.ListWebhookDeliveries, atc.ListWebhookDeliveries, "owner", true),
+		Entry("member :: "+atc.ListWebhookDeliveries, atc.ListWebhookDeliveries, "member", true),
+		Entry("viewer :: "+atc.ListWebhookDeliveries, atc.ListWebhookDeliveries, "viewer", false),
+
 		Entry("owner :: "+atc.CreateArtifact, atc.CreateArtifact, "owner", true),
 		Entry("member :: "+atc.CreateArtifact, atc.CreateArtifact, "member", true),
 		Entry("viewer :: "+atc.CreateArtifact, atc.CreateArtifact, "viewer", false),
@@ -15,7 +15,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
-	"github.com/concourse/concourse/atc/api/resourceserver/resourceserverfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
@@ -41,6 +40,7 @@ var (
 	dbJobFactory            *dbfakes.FakeJobFactory
 	dbResourceFactory       *dbfakes.FakeResourceFactory
 	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	dbCheckFactory          *dbfakes.FakeCheckFactory
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccessor            *accessorfakes.FakeAccessFactory
 	dbWorkerFactory         *dbfakes.FakeWorkerFactory
@@ -48,7 +48,6 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbTeam                  *dbfakes.FakeTeam
-	fakeScannerFactory      *resourceserverfakes.FakeScannerFactory
 	fakeVariablesFactory    *credsfakes.FakeVariablesFactory
 	credsManagers           creds.Managers
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
@@ -91,6 +90,7 @@ var _ = BeforeEach(func() {
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
@@ -104,7 +104,7 @@ var _ = BeforeEach(func() {
 
 	fakeAccessor = new(accessorfakes.FakeAccessFactory)
 	fakePipeline = new(dbfakes.FakePipeline)
-	dbTeam.PipelineReturns(fakePipeline, true, nil)
+	dbTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 
 	dbWorkerFactory = new(dbfakes.FakeWorkerFactory)
 	dbWorkerLifecycle = new(dbfakes.FakeWorkerLifecycle)
@@ -113,8 +113,6 @@ var _ = BeforeEach(func() {
 
 	fakeWorkerClient = new(workerfakes.FakeClient)
 
-	fakeScannerFactory = new(resourceserverfakes.FakeScannerFactory)
-
 	fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
 	fakeContainerRepository = new(dbfakes.FakeContainerRepository)
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
@@ -168,14 +166,13 @@ var _ = BeforeEach(func() {
 		fakeDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
 
 		constructedEventHandler.Construct,
 		drain,
 
 		fakeWorkerClient,
 
-		fakeScannerFactory,
-
 		sink,
 
 		isTLSEnabled,
@@ -186,6 +183,7 @@ var _ = BeforeEach(func() {
 		fakeVariablesFactory,
 		credsManagers,
 		interceptTimeoutFactory,
+		"some-webhook-secret",
 	)
 
 	Expect(err).NotTo(HaveOccurred())
@@ -83,7 +83,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 	Context("when pipeline exists", func() {
 		BeforeEach(func() {
 			pipeline.NameReturns("some-pipeline")
-			team.PipelineReturns(pipeline, true, nil)
+			team.PipelineInstanceReturns(pipeline, true, nil)
 		})
 
 		Context("when pipeline is public", func() {
@@ -152,7 +152,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 
 	Context("when pipeline does not exist", func() {
 		BeforeEach(func() {
-			team.PipelineReturns(nil, false, nil)
+			team.PipelineInstanceReturns(nil, false, nil)
 		})
 
 		It("returns 404", func() {
@@ -166,7 +166,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 
 	Context("when getting pipeline fails", func() {
 		BeforeEach(func() {
-			team.PipelineReturns(nil, false, errors.New("disaster"))
+			team.PipelineInstanceReturns(nil, false, errors.New("disaster"))
 		})
 
 		It("returns 500", func() {
@@ -0,0 +1,389 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checks API", func() {
+	var (
+		fakeaccess *accessorfakes.FakeAccess
+		response   *http.Response
+
+		fakeResourceCheck     *dbfakes.FakeCheck
+		fakeResourceTypeCheck *dbfakes.FakeCheck
+	)
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+		fakeAccessor.CreateReturns(fakeaccess)
+
+		fakeResourceCheck = new(dbfakes.FakeCheck)
+		fakeResourceCheck.IDReturns(2)
+		fakeResourceCheck.TeamIDReturns(734)
+		fakeResourceCheck.TeamNameReturns("a-team")
+		fakeResourceCheck.PipelineNameReturns("a-pipeline")
+		fakeResourceCheck.ResourceIDReturns(12)
+		fakeResourceCheck.StatusReturns(atc.CheckStatusErrored)
+		fakeResourceCheck.PlanReturns(atc.CheckPlan{Name: "some-resource", Type: "git"})
+		fakeResourceCheck.CreateTimeReturns(time.Unix(100, 0))
+		fakeResourceCheck.StartTimeReturns(time.Unix(101, 0))
+		fakeResourceCheck.EndTimeReturns(time.Unix(102, 0))
+		fakeResourceCheck.CheckErrorReturns(errors.New("exit status 1"))
+
+		fakeResourceTypeCheck = new(dbfakes.FakeCheck)
+		fakeResourceTypeCheck.IDReturns(1)
+		fakeResourceTypeCheck.TeamIDReturns(734)
+		fakeResourceTypeCheck.TeamNameReturns("a-team")
+		fakeResourceTypeCheck.PipelineNameReturns("a-pipeline")
+		fakeResourceTypeCheck.ResourceTypeIDReturns(3)
+		fakeResourceTypeCheck.StatusReturns(atc.CheckStatusPending)
+		fakeResourceTypeCheck.ManuallyTriggeredReturns(true)
+		fakeResourceTypeCheck.PlanReturns(atc.CheckPlan{Name: "some-type", Type: "registry-image"})
+		fakeResourceTypeCheck.CreateTimeReturns(time.Unix(90, 0))
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/checks", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/checks"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakePipeline.ChecksReturns([]db.Check{fakeResourceCheck, fakeResourceTypeCheck}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("looks up the pipeline", func() {
+				Expect(dbTeam.PipelineInstanceArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+			})
+
+			It("defaults the limit", func() {
+				Expect(fakePipeline.ChecksArgsForCall(0)).To(Equal(50))
+			})
+
+			Context("when a limit is given", func() {
+				BeforeEach(func() {
+					query = "?limit=10"
+				})
+
+				It("passes it along", func() {
+					Expect(fakePipeline.ChecksArgsForCall(0)).To(Equal(10))
+				})
+			})
+
+			It("returns the checks", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 2,
+						"team_name": "a-team",
+						"pipeline_name": "a-pipeline",
+						"resource_name": "some-resource",
+						"status": "errored",
+						"manually_triggered": false,
+						"plan": {"name": "some-resource", "type": "git"},
+						"create_time": 100,
+						"start_time": 101,
+						"end_time": 102,
+						"check_error": "exit status 1"
+					},
+					{
+						"id": 1,
+						"team_name": "a-team",
+						"pipeline_name": "a-pipeline",
+						"resource_type_name": "some-type",
+						"status": "pending",
+						"manually_triggered": true,
+						"plan": {"name": "some-type", "type": "registry-image"},
+						"create_time": 90
+					}
+				]`))
+			})
+
+			Context("when finding the checks fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ChecksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", func() {
+		var (
+			query        string
+			fakeResource *dbfakes.FakeResource
+		)
+
+		BeforeEach(func() {
+			query = ""
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResourceCheck.OutputReturns("some-output\n")
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/checks"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource exists", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+					fakeResource.ChecksReturns([]db.Check{fakeResourceCheck}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("looks up the resource", func() {
+					Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+				})
+
+				It("defaults the limit", func() {
+					Expect(fakeResource.ChecksArgsForCall(0)).To(Equal(50))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("passes it along", func() {
+						Expect(fakeResource.ChecksArgsForCall(0)).To(Equal(5))
+					})
+				})
+
+				It("returns the checks with their output", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"team_name": "a-team",
+							"pipeline_name": "a-pipeline",
+							"resource_name": "some-resource",
+							"status": "errored",
+							"manually_triggered": false,
+							"plan": {"name": "some-resource", "type": "git"},
+							"create_time": 100,
+							"start_time": 101,
+							"end_time": 102,
+							"check_error": "exit status 1",
+							"output": "some-output\n"
+						}
+					]`))
+				})
+
+				Context("when finding the checks fails", func() {
+					BeforeEach(func() {
+						fakeResource.ChecksReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource does not exist", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when finding the resource fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/checks/:check_id", func() {
+		var checkID string
+
+		BeforeEach(func() {
+			checkID = "2"
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/checks/"+checkID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the check exists", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CheckReturns(fakeResourceCheck, true, nil)
+				})
+
+				It("looks it up by id", func() {
+					Expect(dbCheckFactory.CheckArgsForCall(0)).To(Equal(2))
+				})
+
+				It("returns the check", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"id": 2,
+						"team_name": "a-team",
+						"pipeline_name": "a-pipeline",
+						"resource_name": "some-resource",
+						"status": "errored",
+						"manually_triggered": false,
+						"plan": {"name": "some-resource", "type": "git"},
+						"create_time": 100,
+						"start_time": 101,
+						"end_time": 102,
+						"check_error": "exit status 1"
+					}`))
+				})
+
+				Context("when it belongs to another team", func() {
+					BeforeEach(func() {
+						fakeResourceCheck.TeamIDReturns(735)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+			})
+
+			Context("when the check does not exist", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CheckReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the check id is not a number", func() {
+				BeforeEach(func() {
+					checkID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when finding the check fails", func() {
+				BeforeEach(func() {
+					dbCheckFactory.CheckReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
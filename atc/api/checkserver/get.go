@@ -0,0 +1,45 @@
+package checkserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) GetCheck(team db.Team) http.Handler {
+	logger := s.logger.Session("get-check")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkID, err := strconv.Atoi(rata.Param(r, "check_id"))
+		if err != nil {
+			logger.Info("malformed-check-id", lager.Data{"check-id": rata.Param(r, "check_id")})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		check, found, err := s.checkFactory.Check(checkID)
+		if err != nil {
+			logger.Error("failed-to-get-check", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found || check.TeamID() != team.ID() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.Check(check))
+		if err != nil {
+			logger.Error("failed-to-encode-check", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
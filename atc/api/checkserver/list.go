@@ -0,0 +1,81 @@
+package checkserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+const defaultLimit = 50
+
+func (s *Server) ListChecks(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-checks")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultLimit
+		}
+
+		checks, err := pipeline.Checks(limit)
+		if err != nil {
+			logger.Error("failed-to-get-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.Checks(checks))
+		if err != nil {
+			logger.Error("failed-to-encode-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) ListResourceChecks(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-resource-checks")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := rata.Param(r, "resource_name")
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultLimit
+		}
+
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		checks, err := resource.Checks(limit)
+		if err != nil {
+			logger.Error("failed-to-get-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.Checks(checks))
+		if err != nil {
+			logger.Error("failed-to-encode-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
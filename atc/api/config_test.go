@@ -11,6 +11,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
@@ -171,7 +172,7 @@ var _ = Describe("Config API", func() {
 								Resources: []string{"some-resource"},
 							},
 						})
-						fakeTeam.PipelineReturns(fakePipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 					})
 
 					Context("when the jobs are found", func() {
@@ -321,7 +322,7 @@ var _ = Describe("Config API", func() {
 
 				Context("when the pipeline is not found", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(nil, false, nil)
+						fakeTeam.PipelineInstanceReturns(nil, false, nil)
 					})
 
 					It("returns 404", func() {
@@ -331,7 +332,7 @@ var _ = Describe("Config API", func() {
 
 				Context("when finding the pipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(nil, false, errors.New("failed"))
+						fakeTeam.PipelineInstanceReturns(nil, false, errors.New("failed"))
 					})
 
 					It("returns 500", func() {
@@ -429,7 +430,7 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("does not save anything", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 						})
 					})
 
@@ -457,7 +458,7 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("does not save anything", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -482,18 +483,77 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("saves it", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(pipelineState).To(Equal(db.PipelineNoChange))
 						})
 
+						Context("when the user is known", func() {
+							BeforeEach(func() {
+								fakeaccess.UserNameReturns("some-user")
+							})
+
+							It("records them as the author of the config", func() {
+								_, _, _, _, author := dbTeam.SavePipelineInstanceArgsForCall(0)
+								Expect(author).To(Equal("some-user"))
+							})
+						})
+
+						Context("when instance vars are given", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = atc.InstanceVars{"branch": "1.2"}.QueryParams().Encode()
+							})
+
+							It("saves the instance with the vars", func() {
+								pipelineRef, _, _, _, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{
+									Name:         "a-pipeline",
+									InstanceVars: atc.InstanceVars{"branch": "1.2"},
+								}))
+							})
+						})
+
+						Context("when the instance vars are malformed", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = "vars=branch"
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("does not save anything", func() {
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the dry_run param is set", func() {
+							BeforeEach(func() {
+								query := request.URL.Query()
+								query.Add(atc.SaveConfigDryRun, "")
+								request.URL.RawQuery = query.Encode()
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("returns Content-Type 'application/json'", func() {
+								Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
-								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+								dbTeam.SavePipelineInstanceReturns(nil, false, errors.New("oh no!"))
 							})
 
 							It("returns 500", func() {
@@ -508,7 +568,7 @@ var _ = Describe("Config API", func() {
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
-								dbTeam.SavePipelineReturns(returnedPipeline, true, nil)
+								dbTeam.SavePipelineInstanceReturns(returnedPipeline, true, nil)
 							})
 
 							It("returns 201", func() {
@@ -516,6 +576,52 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when the team has resource types", func() {
+							BeforeEach(func() {
+								dbTeam.ResourceTypesReturns(atc.ResourceTypes{
+									{
+										Name:     "some-type",
+										Type:     "registry-image",
+										Source:   atc.Source{"repository": "some-repository"},
+										Defaults: atc.Source{"source-config": "default-value", "default": "value"},
+									},
+								}, nil)
+							})
+
+							It("saves only the config it was given", func() {
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
+
+								_, savedConfig, _, _, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+								Expect(savedConfig.ResourceTypes).To(Equal(pipelineConfig.ResourceTypes))
+								Expect(savedConfig.Resources[0].Source).ToNot(HaveKey("default"))
+							})
+
+							It("warns about where the resource type resolves from", func() {
+								var payload atc.SaveConfigResponse
+								err := json.NewDecoder(response.Body).Decode(&payload)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(payload.Warnings).To(ContainElement(atc.ConfigWarning{
+									Type:    "resource_type",
+									Message: "resource type 'some-type' resolves from the team's resource types",
+								}))
+							})
+						})
+
+						Context("when getting the team's resource types fails", func() {
+							BeforeEach(func() {
+								dbTeam.ResourceTypesReturns(nil, errors.New("oh no!"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
+							})
+						})
+
 						Context("when the config is invalid", func() {
 							BeforeEach(func() {
 								pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
@@ -542,7 +648,7 @@ var _ = Describe("Config API", func() {
 							})
 
 							It("does not save it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 							})
 						})
 					})
@@ -566,19 +672,19 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("saves it", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(pipelineState).To(Equal(db.PipelineNoChange))
 						})
 
 						It("does not give the DB a map of empty interfaces to empty interfaces", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-							_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+							_, savedConfig, _, _, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
 							Expect(savedConfig).To(Equal(pipelineConfig))
 
 							_, err := json.Marshal(pipelineConfig)
@@ -620,10 +726,10 @@ jobs:
 							})
 
 							It("saves it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -743,10 +849,10 @@ jobs:
 									})
 
 									It("passes validation and saves it un-interpolated", func() {
-										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+										Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-										name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										pipelineRef, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+										Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 
 										Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -756,6 +862,34 @@ jobs:
 									It("returns 200", func() {
 										Expect(response.StatusCode).To(Equal(http.StatusOK))
 									})
+
+									It("returns the result of checking each credential", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"credential_checks":[{"name":"BAR","status":"found"}]
+										}`))
+									})
+
+									Context("when the dry_run param is set", func() {
+										BeforeEach(func() {
+											query := request.URL.Query()
+											query.Add(atc.SaveConfigDryRun, "")
+											request.URL.RawQuery = query.Encode()
+										})
+
+										It("returns 200", func() {
+											Expect(response.StatusCode).To(Equal(http.StatusOK))
+										})
+
+										It("returns the result of checking each credential", func() {
+											Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+												"credential_checks":[{"name":"BAR","status":"found"}]
+											}`))
+										})
+
+										It("does not save it", func() {
+											Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
+										})
+									})
 								})
 
 								Context("when the credential does not exist in the credential manager", func() {
@@ -769,8 +903,74 @@ jobs:
 										Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
 									})
 
+									It("does not save it", func() {
+										Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
+									})
+
 									It("returns the credential name that was missing", func() {
-										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"errors":["1 error occurred:\n\t* failed to interpolate task config: Expected to find variables: BAR\n\n"]}`))
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"errors":["1 error occurred:\n\t* failed to interpolate task config: Expected to find variables: BAR\n\n"],
+											"credential_checks":[{"name":"BAR","status":"not_found"}]
+										}`))
+									})
+								})
+
+								Context("when the credential manager can describe where it looked", func() {
+									BeforeEach(func() {
+										fakeVariables := new(credsfakes.FakeLookupVariables)
+										fakeVariablesFactory.NewVariablesReturns(fakeVariables)
+										fakeVariables.LookupReturns(nil, creds.SecretLookup{
+											Name:    "BAR",
+											Backend: "vault",
+											Searched: []string{
+												"/concourse/a-team/a-pipeline/BAR",
+												"/concourse/a-team/BAR",
+											},
+										}, nil)
+									})
+
+									It("returns the paths that were searched", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"errors":["1 error occurred:\n\t* failed to interpolate task config: Expected to find variables: BAR\n\n"],
+											"credential_checks":[{
+												"name":"BAR",
+												"backend":"vault",
+												"status":"not_found",
+												"searched":["/concourse/a-team/a-pipeline/BAR","/concourse/a-team/BAR"]
+											}]
+										}`))
+									})
+								})
+
+								Context("when looking up the credential fails", func() {
+									BeforeEach(func() {
+										fakeVariables := new(credsfakes.FakeLookupVariables)
+										fakeVariablesFactory.NewVariablesReturns(fakeVariables)
+										fakeVariables.LookupReturns(nil, creds.SecretLookup{
+											Name:     "BAR",
+											Backend:  "vault",
+											Searched: []string{"/concourse/a-team/a-pipeline/BAR"},
+										}, errors.New("permission denied"))
+									})
+
+									It("returns 400", func() {
+										Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+									})
+
+									It("returns the error for the credential", func() {
+										var saveConfigResponse atc.SaveConfigResponse
+										err := json.NewDecoder(response.Body).Decode(&saveConfigResponse)
+										Expect(err).NotTo(HaveOccurred())
+
+										Expect(saveConfigResponse.CredentialChecks).To(Equal([]atc.CredentialCheck{
+											{
+												Name:     "BAR",
+												Backend:  "vault",
+												Status:   atc.CredentialCheckErrored,
+												Searched: []string{"/concourse/a-team/a-pipeline/BAR"},
+												Error:    "permission denied",
+											},
+										}))
 									})
 								})
 
@@ -784,8 +984,15 @@ jobs:
 										Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
 									})
 
+									It("does not save it", func() {
+										Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
+									})
+
 									It("returns the credential name that was missing", func() {
-										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"errors":["1 error occurred:\n\t* failed to interpolate task config: Expected to find variables: BAR\n\n"]}`))
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"errors":["1 error occurred:\n\t* failed to interpolate task config: Expected to find variables: BAR\n\n"],
+											"credential_checks":[{"name":"BAR","status":"not_found"}]
+										}`))
 									})
 								})
 							})
@@ -795,7 +1002,7 @@ jobs:
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
-								dbTeam.SavePipelineReturns(returnedPipeline, true, nil)
+								dbTeam.SavePipelineInstanceReturns(returnedPipeline, true, nil)
 							})
 
 							It("returns 201", func() {
@@ -805,7 +1012,7 @@ jobs:
 
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
-								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+								dbTeam.SavePipelineInstanceReturns(nil, false, errors.New("oh no!"))
 							})
 
 							It("returns 500", func() {
@@ -843,7 +1050,7 @@ jobs:
 							})
 
 							It("does not save it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
 							})
 						})
 					})
@@ -893,10 +1100,10 @@ jobs:
 							})
 
 							It("saves it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+								Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(pipelineConfig))
 								Expect(id).To(Equal(db.ConfigVersion(42)))
 								Expect(pipelineState).To(Equal(expectedDBValue))
@@ -905,7 +1112,7 @@ jobs:
 							Context("when it's the first time the pipeline has been created", func() {
 								BeforeEach(func() {
 									returnedPipeline := new(dbfakes.FakePipeline)
-									dbTeam.SavePipelineReturns(returnedPipeline, true, nil)
+									dbTeam.SavePipelineInstanceReturns(returnedPipeline, true, nil)
 								})
 
 								It("returns 201", func() {
@@ -915,7 +1122,7 @@ jobs:
 
 							Context("and saving it fails", func() {
 								BeforeEach(func() {
-									dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+									dbTeam.SavePipelineInstanceReturns(nil, false, errors.New("oh no!"))
 								})
 
 								It("returns 500", func() {
@@ -950,7 +1157,7 @@ jobs:
 								})
 
 								It("does not save it", func() {
-									Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+									Expect(dbTeam.SavePipelineInstanceCallCount()).To(BeZero())
 								})
 							})
 
@@ -1085,7 +1292,7 @@ jobs:
 								})
 
 								It("does not save anything", func() {
-									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+									Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 								})
 							})
 
@@ -1128,7 +1335,7 @@ jobs:
 								})
 
 								It("does not save anything", func() {
-									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+									Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 								})
 							})
 						})
@@ -1150,7 +1357,7 @@ jobs:
 					})
 
 					It("does not save it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+						Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 					})
 				})
 
@@ -1187,10 +1394,10 @@ jobs:
 					})
 
 					It("saves it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+						Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(1))
 
-						name, savedConfig, id, _ := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						pipelineRef, savedConfig, id, _, _ := dbTeam.SavePipelineInstanceArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
@@ -1242,7 +1449,7 @@ jobs:
 					})
 
 					It("does not save it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+						Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 					})
 				})
 			})
@@ -1270,7 +1477,7 @@ jobs:
 				})
 
 				It("does not save it", func() {
-					Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+					Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 				})
 			})
 		})
@@ -1285,7 +1492,7 @@ jobs:
 			})
 
 			It("does not save the config", func() {
-				Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+				Expect(dbTeam.SavePipelineInstanceCallCount()).To(Equal(0))
 			})
 		})
 	})
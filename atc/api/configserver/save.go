@@ -10,7 +10,6 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
-	"strings"
 
 	"github.com/concourse/concourse/atc/exec"
 
@@ -18,10 +17,11 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/hashicorp/go-multierror"
-	"github.com/mitchellh/mapstructure"
 	"github.com/tedsuo/rata"
 	"gopkg.in/yaml.v2"
 )
@@ -60,6 +60,11 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		checkCredentials = true
 	}
 
+	dryRun := false
+	if _, exists := query[atc.SaveConfigDryRun]; exists {
+		dryRun = true
+	}
+
 	var version db.ConfigVersion
 	if configVersionStr := r.Header.Get(atc.ConfigVersionHeader); len(configVersionStr) != 0 {
 		_, err := fmt.Sscanf(configVersionStr, "%d", &version)
@@ -107,42 +112,91 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	warnings, errorMessages := config.Validate()
+	pipelineName := rata.Param(r, "pipeline_name")
+	teamName := rata.Param(r, "team_name")
+
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, []string{err.Error()}, session)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		session.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		session.Debug("team-not-found")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	teamResourceTypes, err := team.ResourceTypes()
+	if err != nil {
+		session.Error("failed-to-get-team-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// the pipeline is validated with the team's resource types it will run
+	// with, but only its own config is saved
+	resolvedConfig := config.WithTeamResourceTypes(teamResourceTypes)
+	resolutionWarnings := config.ValidateTeamResourceTypes(teamResourceTypes)
+
+	warnings, errorMessages := resolvedConfig.Validate()
 	if len(errorMessages) > 0 {
 		session.Error("ignoring-invalid-config", err)
 		s.handleBadRequest(w, errorMessages, session)
 		return
 	}
 
-	pipelineName := rata.Param(r, "pipeline_name")
-	teamName := rata.Param(r, "team_name")
+	warnings = append(warnings, resolutionWarnings...)
 
+	var credentialChecks []atc.CredentialCheck
 	if checkCredentials {
 		variables := s.variablesFactory.NewVariables(teamName, pipelineName)
 
-		errs := validateCredParams(variables, config, session)
+		checks, errs := validateCredParams(variables, resolvedConfig, session)
 		if errs != nil {
-			s.handleBadRequest(w, []string{errs.Error()}, session)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			s.writeSaveConfigResponse(w, atc.SaveConfigResponse{
+				Errors:           []string{errs.Error()},
+				CredentialChecks: checks,
+			}, session)
 			return
 		}
+
+		credentialChecks = checks
 	}
 
-	session.Info("saving")
+	if dryRun {
+		session.Info("dry-run")
 
-	team, found, err := s.teamFactory.FindTeam(teamName)
-	if err != nil {
-		session.Error("failed-to-find-team", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		s.writeSaveConfigResponse(w, atc.SaveConfigResponse{
+			Warnings:         warnings,
+			CredentialChecks: credentialChecks,
+		}, session)
 		return
 	}
 
-	if !found {
-		session.Debug("team-not-found")
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
+	session.Info("saving")
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState)
+	author := accessor.GetAccessor(r).UserName()
+
+	_, created, err := team.SavePipelineInstance(
+		atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars},
+		config,
+		version,
+		pausedState,
+		author,
+	)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -160,13 +214,18 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}
 
-	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings}, session)
+	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{
+		Warnings:         warnings,
+		CredentialChecks: credentialChecks,
+	}, session)
 }
 
 // Simply validate that the credentials exist; don't do anything with the actual secrets
-func validateCredParams(credMgrVars creds.Variables, config atc.Config, session lager.Logger) error {
+func validateCredParams(variables creds.Variables, config atc.Config, session lager.Logger) ([]atc.CredentialCheck, error) {
 	var errs error
 
+	credMgrVars := creds.NewCheckingVariables(variables)
+
 	for _, resourceType := range config.ResourceTypes {
 		_, err := creds.NewSource(credMgrVars, resourceType.Source).Evaluate()
 		if err != nil {
@@ -227,7 +286,7 @@ func validateCredParams(credMgrVars creds.Variables, config atc.Config, session
 		session.Info("config-has-invalid-creds", lager.Data{"errors": errs.Error()})
 	}
 
-	return errs
+	return present.CredentialChecks(credMgrVars.Checks()), errs
 }
 
 func (s *Server) handleBadRequest(w http.ResponseWriter, errorMessages []string, session lager.Logger) {
@@ -329,36 +388,11 @@ func saveConfigRequestUnmarshaler(r *http.Request) (atc.Config, db.PipelinePause
 		return atc.Config{}, db.PipelineNoChange, err
 	}
 
-	var config atc.Config
-	var md mapstructure.Metadata
-	msConfig := &mapstructure.DecoderConfig{
-		Metadata:         &md,
-		Result:           &config,
-		WeaklyTypedInput: true,
-		DecodeHook: mapstructure.ComposeDecodeHookFunc(
-			atc.SanitizeDecodeHook,
-			atc.VersionConfigDecodeHook,
-			atc.InputsConfigDecodeHook,
-			atc.ContainerLimitsDecodeHook,
-		),
-	}
-
-	decoder, err := mapstructure.NewDecoder(msConfig)
+	config, nestedUnused, err := atc.DecodeConfig(configStructure)
 	if err != nil {
-		return atc.Config{}, db.PipelineNoChange, ErrFailedToConstructDecoder
-	}
-
-	if err := decoder.Decode(configStructure); err != nil {
 		return atc.Config{}, db.PipelineNoChange, ErrCouldNotDecode
 	}
 
-	nestedUnused := []string{}
-	for _, unused := range md.Unused {
-		if strings.Contains(unused, ".") {
-			nestedUnused = append(nestedUnused, unused)
-		}
-	}
-
 	if len(nestedUnused) != 0 {
 		return atc.Config{}, db.PipelineNoChange, ExtraKeysError{extraKeys: nestedUnused}
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/api/artifactserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
+	"github.com/concourse/concourse/atc/api/checkserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
@@ -20,11 +21,13 @@ import (
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
+	"github.com/concourse/concourse/atc/api/webhookserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/mainredirect"
+	"github.com/concourse/concourse/atc/webhook"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/tedsuo/rata"
@@ -47,14 +50,13 @@ func NewHandler(
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 	drain <-chan struct{},
 
 	workerClient worker.Client,
 
-	scannerFactory resourceserver.ScannerFactory,
-
 	sink *lager.ReconfigurableSink,
 
 	isTLSEnabled bool,
@@ -65,6 +67,7 @@ func NewHandler(
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
+	webhookSecret string,
 ) (http.Handler, error) {
 
 	absCLIDownloadsDir, err := filepath.Abs(cliDownloadsDir)
@@ -77,8 +80,8 @@ func NewHandler(
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
 	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, drain)
-	jobServer := jobserver.NewServer(logger, externalURL, variablesFactory, dbJobFactory)
-	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory, dbResourceConfigFactory)
+	jobServer := jobserver.NewServer(logger, externalURL, variablesFactory, dbJobFactory, dbPipelineFactory)
+	resourceServer := resourceserver.NewServer(logger, dbCheckFactory, variablesFactory, dbResourceFactory, dbResourceConfigFactory)
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
 	configServer := configserver.NewServer(logger, dbTeamFactory, variablesFactory)
@@ -91,6 +94,8 @@ func NewHandler(
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
+	checkServer := checkserver.NewServer(logger, dbCheckFactory)
+	webhookServer := webhookserver.NewServer(logger, dbTeamFactory, dbCheckFactory, variablesFactory, webhook.GitMatcher{}, webhookSecret)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -108,16 +113,20 @@ func NewHandler(
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
-		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
-		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
-		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
-		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
-		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
-		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
-		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
-		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
-		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
-		atc.JobBadge:       pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
+		atc.ListAllJobs:           http.HandlerFunc(jobServer.ListAllJobs),
+		atc.ListJobs:              pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
+		atc.GetJob:                pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
+		atc.ListJobBuilds:         pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
+		atc.ListJobInputs:         pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.GetJobBuild:           pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
+		atc.DiffJobBuildInputs:    pipelineHandlerFactory.HandlerFor(jobServer.DiffJobBuildInputs),
+		atc.GetJobBuildProvenance: pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuildProvenance),
+		atc.RerunJobBuild:         pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
+		atc.GetJobBuildStepPlan:   pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuildStepPlan),
+		atc.CreateJobBuild:        pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
+		atc.PauseJob:              pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
+		atc.UnpauseJob:            pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
+		atc.JobBadge:              pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
 		atc.MainJobBadge: mainredirect.Handler{
 			Routes: atc.Routes,
 			Route:  atc.JobBadge,
@@ -130,6 +139,11 @@ func NewHandler(
 		atc.GetPipeline:         pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
 		atc.DeletePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.DeletePipeline),
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
+		atc.PausePipelines:      http.HandlerFunc(pipelineServer.PausePipelines),
+		atc.UnpausePipelines:    http.HandlerFunc(pipelineServer.UnpausePipelines),
+		atc.ExposePipelines:     http.HandlerFunc(pipelineServer.ExposePipelines),
+		atc.HidePipelines:       http.HandlerFunc(pipelineServer.HidePipelines),
+		atc.DeletePipelines:     http.HandlerFunc(pipelineServer.DeletePipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
 		atc.UnpausePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
 		atc.ExposePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
@@ -139,17 +153,27 @@ func NewHandler(
 		atc.ListPipelineBuilds:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
+		atc.ListSecretLookups:   pipelineHandlerFactory.HandlerFor(pipelineServer.ListSecretLookups),
+		atc.ListConfigVersions:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListConfigVersions),
+		atc.GetConfigVersion:    pipelineHandlerFactory.HandlerFor(pipelineServer.GetConfigVersion),
 
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
 		atc.ListResourceTypes:       pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
 		atc.GetResource:             pipelineHandlerFactory.HandlerFor(resourceServer.GetResource),
+		atc.PinResource:             pipelineHandlerFactory.HandlerFor(resourceServer.PinResource),
 		atc.UnpinResource:           pipelineHandlerFactory.HandlerFor(resourceServer.UnpinResource),
 		atc.SetPinCommentOnResource: pipelineHandlerFactory.HandlerFor(resourceServer.SetPinCommentOnResource),
 		atc.CheckResource:           pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
+		atc.ListChecks:              pipelineHandlerFactory.HandlerFor(checkServer.ListChecks),
+		atc.ListResourceChecks:      pipelineHandlerFactory.HandlerFor(checkServer.ListResourceChecks),
+		atc.CreateResourceCheck:     pipelineHandlerFactory.HandlerFor(resourceServer.CreateResourceCheck),
+		atc.CreateResourceTypeCheck: pipelineHandlerFactory.HandlerFor(resourceServer.CreateResourceTypeCheck),
+		atc.GetCheck:                teamHandlerFactory.HandlerFor(checkServer.GetCheck),
+
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
@@ -190,6 +214,12 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.GetTeamResourceTypes: http.HandlerFunc(teamServer.GetTeamResourceTypes),
+		atc.SetTeamResourceTypes: http.HandlerFunc(teamServer.SetTeamResourceTypes),
+
+		atc.ReceiveWebhook:        http.HandlerFunc(webhookServer.ReceiveWebhook),
+		atc.ListWebhookDeliveries: teamHandlerFactory.HandlerFor(webhookServer.ListWebhookDeliveries),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -27,7 +28,7 @@ var _ = Describe("Jobs API", func() {
 		fakeaccess = new(accessorfakes.FakeAccess)
 		fakePipeline = new(dbfakes.FakePipeline)
 		dbTeamFactory.FindTeamReturns(dbTeam, true, nil)
-		dbTeam.PipelineReturns(fakePipeline, true, nil)
+		dbTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 
 		versionedResourceTypes = atc.VersionedResourceTypes{
 			atc.VersionedResourceType{
@@ -68,10 +69,13 @@ var _ = Describe("Jobs API", func() {
 	})
 
 	Describe("GET /api/v1/jobs", func() {
-		var response *http.Response
+		var (
+			response *http.Response
+			query    string
+		)
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/jobs", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/jobs"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -81,6 +85,8 @@ var _ = Describe("Jobs API", func() {
 		})
 
 		BeforeEach(func() {
+			query = ""
+
 			build1 := new(dbfakes.FakeBuild)
 			build1.IDReturns(1)
 			build1.NameReturns("1")
@@ -212,6 +218,80 @@ var _ = Describe("Jobs API", func() {
 			]`))
 		})
 
+		Context("when filtering by pipeline labels", func() {
+			var (
+				matchingPipeline *dbfakes.FakePipeline
+				otherPipeline    *dbfakes.FakePipeline
+			)
+
+			BeforeEach(func() {
+				query = "?label=env=prod"
+
+				fakeJob.PipelineIDReturns(1)
+
+				otherJob := new(dbfakes.FakeJob)
+				otherJob.IDReturns(2)
+				otherJob.NameReturns("other-job")
+				otherJob.PipelineIDReturns(2)
+				otherJob.PipelineNameReturns("other-pipeline")
+				otherJob.TeamNameReturns("some-team")
+
+				dbJobFactory.VisibleJobsReturns(db.Dashboard{
+					db.DashboardJob{Job: fakeJob},
+					db.DashboardJob{Job: otherJob},
+				}, nil)
+
+				matchingPipeline = new(dbfakes.FakePipeline)
+				matchingPipeline.IDReturns(1)
+				matchingPipeline.LabelsReturns(map[string]string{"env": "prod"})
+
+				otherPipeline = new(dbfakes.FakePipeline)
+				otherPipeline.IDReturns(2)
+				otherPipeline.LabelsReturns(map[string]string{"env": "staging"})
+
+				dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{matchingPipeline, otherPipeline}, nil)
+			})
+
+			It("returns only the jobs of pipelines matching the selectors", func() {
+				var jobs []atc.Job
+				err := json.NewDecoder(response.Body).Decode(&jobs)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(jobs).To(HaveLen(1))
+				Expect(jobs[0].Name).To(Equal("some-job"))
+			})
+
+			It("looks up the visible pipelines", func() {
+				Expect(dbPipelineFactory.VisiblePipelinesCallCount()).To(Equal(1))
+			})
+
+			Context("when getting the pipelines fails", func() {
+				BeforeEach(func() {
+					dbPipelineFactory.VisiblePipelinesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the selector is malformed", func() {
+				BeforeEach(func() {
+					query = "?label=="
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+
+		Context("when not filtering by labels", func() {
+			It("does not look up the pipelines", func() {
+				Expect(dbPipelineFactory.VisiblePipelinesCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when getting the jobs fails", func() {
 			BeforeEach(func() {
 				dbJobFactory.VisibleJobsReturns(nil, errors.New("nope"))
@@ -1556,6 +1636,153 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/rerun", func() {
+		var request *http.Request
+		var response *http.Response
+
+		BeforeEach(func() {
+			var err error
+
+			request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/1/rerun", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns a 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job succeeds", func() {
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{{Get: "some-input"}},
+					})
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when manual triggering is disabled", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name:                 "some-job",
+							DisableManualTrigger: true,
+						})
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the build is not found", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, nil)
+					})
+
+					It("returns a 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when the build is found", func() {
+					var buildToRerun *dbfakes.FakeBuild
+
+					BeforeEach(func() {
+						buildToRerun = new(dbfakes.FakeBuild)
+						buildToRerun.IDReturns(41)
+						buildToRerun.NameReturns("1")
+
+						fakeJob.BuildReturns(buildToRerun, true, nil)
+					})
+
+					Context("when rerunning the build fails", func() {
+						BeforeEach(func() {
+							fakeJob.RerunBuildReturns(nil, errors.New("nope"))
+						})
+
+						It("returns a 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when rerunning the build succeeds", func() {
+						BeforeEach(func() {
+							build := new(dbfakes.FakeBuild)
+							build.IDReturns(42)
+							build.NameReturns("2")
+							build.JobNameReturns("some-job")
+							build.PipelineNameReturns("some-pipeline")
+							build.TeamNameReturns("some-team")
+							build.StatusReturns(db.BuildStatusPending)
+							build.RerunOfReturns(41)
+							build.RerunOfNameReturns("1")
+
+							fakeJob.RerunBuildReturns(build, nil)
+						})
+
+						It("reruns the requested build", func() {
+							Expect(fakeJob.BuildArgsForCall(0)).To(Equal("1"))
+							Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(buildToRerun))
+						})
+
+						It("returns the new build", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+								"id": 42,
+								"name": "2",
+								"job_name": "some-job",
+								"status": "pending",
+								"api_url": "/api/v1/builds/42",
+								"pipeline_name": "some-pipeline",
+								"team_name": "some-team",
+								"rerun_of": {"id": 41, "name": "1"}
+							}`))
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
 		var response *http.Response
 
@@ -1905,6 +2132,405 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/input_diff", func() {
+		var (
+			response  *http.Response
+			query     string
+			fromBuild *dbfakes.FakeBuild
+			toBuild   *dbfakes.FakeBuild
+			resource  *dbfakes.FakeResource
+		)
+
+		BeforeEach(func() {
+			query = "?from=41"
+
+			fakeaccess.IsAuthorizedReturns(true)
+			fakeaccess.IsAuthenticatedReturns(true)
+
+			fakePipeline.JobReturns(fakeJob, true, nil)
+
+			fromBuild = new(dbfakes.FakeBuild)
+			fromBuild.NameReturns("41")
+			fromBuild.ResourcesReturns([]db.BuildInput{
+				{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "a"}},
+				{Name: "unchanged-input", ResourceID: 1, Version: atc.Version{"ref": "z"}},
+				{Name: "removed-input", ResourceID: 1, Version: atc.Version{"ref": "r"}},
+			}, nil, nil)
+
+			toBuild = new(dbfakes.FakeBuild)
+			toBuild.NameReturns("42")
+			toBuild.ResourcesReturns([]db.BuildInput{
+				{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "b"}},
+				{Name: "unchanged-input", ResourceID: 1, Version: atc.Version{"ref": "z"}},
+			}, nil, nil)
+
+			fakeJob.BuildStub = func(name string) (db.Build, bool, error) {
+				switch name {
+				case "41":
+					return fromBuild, true, nil
+				case "42":
+					return toBuild, true, nil
+				default:
+					return nil, false, nil
+				}
+			}
+
+			resource = new(dbfakes.FakeResource)
+			resource.IDReturns(1)
+			resource.NameReturns("some-resource")
+			resource.ResourceConfigVersionIDStub = func(version atc.Version) (int, bool, error) {
+				switch version["ref"] {
+				case "a":
+					return 10, true, nil
+				case "b":
+					return 11, true, nil
+				default:
+					return 0, false, nil
+				}
+			}
+			fakePipeline.ResourcesReturns(db.Resources{resource}, nil)
+
+			fakePipeline.ResourceVersionStub = func(id int) (atc.ResourceVersion, bool, error) {
+				return atc.ResourceVersion{
+					ID:       id,
+					Version:  atc.Version{"id": strconv.Itoa(id)},
+					Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+					Enabled:  true,
+				}, true, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42/input_diff" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the changed inputs", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`{
+				"from_build": "41",
+				"to_build": "42",
+				"changes": [
+					{
+						"name": "removed-input",
+						"resource": "some-resource",
+						"from": {"id": 0, "version": {"ref": "r"}, "enabled": false}
+					},
+					{
+						"name": "some-input",
+						"resource": "some-resource",
+						"from": {"id": 10, "version": {"id": "10"}, "metadata": [{"name": "some", "value": "metadata"}], "enabled": true},
+						"to": {"id": 11, "version": {"id": "11"}, "metadata": [{"name": "some", "value": "metadata"}], "enabled": true}
+					}
+				]
+			}`))
+		})
+
+		Context("when not authorized and the resource is private", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+			})
+
+			It("hides the version metadata", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).ToNot(ContainSubstring("metadata"))
+			})
+		})
+
+		Context("when the build to compare with is not given", func() {
+			BeforeEach(func() {
+				query = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the build to compare with is not found", func() {
+			BeforeEach(func() {
+				query = "?from=40"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the job is not found", func() {
+			BeforeEach(func() {
+				fakePipeline.JobReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when getting the build inputs fails", func() {
+			BeforeEach(func() {
+				toBuild.ResourcesReturns(nil, nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/step_plan", func() {
+		var (
+			stepName string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			stepName = "unit"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/1/step_plan?step=" + stepName)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			var fakeBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakePipeline.JobReturns(fakeJob, true, nil)
+
+				fakeBuild = new(dbfakes.FakeBuild)
+				fakeBuild.PrivatePlanReturns(`{
+					"id": "1",
+					"do": [
+						{"id": "2", "get": {"name": "repo", "type": "git", "source": {"uri": "some-uri"}, "version": {"ref": "abc"}}},
+						{"id": "3", "task": {"name": "unit", "config_path": "repo/ci/unit.yml"}},
+						{"id": "4", "task": {"name": "integration", "config_path": "repo/ci/integration.yml"}}
+					]
+				}`)
+				fakeJob.BuildReturns(fakeBuild, true, nil)
+			})
+
+			It("looks up the right build", func() {
+				Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				Expect(fakeJob.BuildArgsForCall(0)).To(Equal("1"))
+			})
+
+			It("returns the task and the gets it may use", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"inputs": [
+						{"id": "2", "get": {"name": "repo", "type": "git", "resource": "", "source": {"uri": "some-uri"}, "version": {"ref": "abc"}}}
+					],
+					"step": {"id": "3", "task": {"name": "unit", "privileged": false, "config_path": "repo/ci/unit.yml"}}
+				}`))
+			})
+
+			Context("when the step does not exist", func() {
+				BeforeEach(func() {
+					stepName = "bogus"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when no step is given and the build has several tasks", func() {
+				BeforeEach(func() {
+					stepName = ""
+				})
+
+				It("returns 400 with the names of the tasks", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("integration, unit"))
+				})
+			})
+
+			Context("when the build has not started", func() {
+				BeforeEach(func() {
+					fakeBuild.PrivatePlanReturns("")
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/provenance", func() {
+		var (
+			response      *http.Response
+			upstreamJob   *dbfakes.FakeJob
+			build         *dbfakes.FakeBuild
+			upstreamBuild *dbfakes.FakeBuild
+		)
+
+		BeforeEach(func() {
+			fakeaccess.IsAuthorizedReturns(true)
+			fakeaccess.IsAuthenticatedReturns(true)
+
+			fakeJob.NameReturns("some-job")
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name: "some-job",
+				Plan: atc.PlanSequence{
+					{Get: "some-input", Resource: "some-resource", Passed: []string{"upstream-job"}},
+				},
+			})
+
+			upstreamJob = new(dbfakes.FakeJob)
+			upstreamJob.NameReturns("upstream-job")
+			upstreamJob.ConfigReturns(atc.JobConfig{
+				Name: "upstream-job",
+				Plan: atc.PlanSequence{
+					{Get: "some-input", Resource: "some-resource"},
+					{Get: "other-input", Resource: "other-resource"},
+				},
+			})
+
+			fakePipeline.JobStub = func(name string) (db.Job, bool, error) {
+				switch name {
+				case "some-job":
+					return fakeJob, true, nil
+				case "upstream-job":
+					return upstreamJob, true, nil
+				default:
+					return nil, false, nil
+				}
+			}
+
+			build = new(dbfakes.FakeBuild)
+			build.IDReturns(20)
+			build.NameReturns("42")
+			build.ResourcesReturns([]db.BuildInput{
+				{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "a"}},
+			}, nil, nil)
+			fakeJob.BuildReturns(build, true, nil)
+
+			upstreamBuild = new(dbfakes.FakeBuild)
+			upstreamBuild.IDReturns(10)
+			upstreamBuild.NameReturns("7")
+			upstreamBuild.JobNameReturns("upstream-job")
+			upstreamBuild.StatusReturns(db.BuildStatusSucceeded)
+			upstreamBuild.ResourcesReturns([]db.BuildInput{
+				{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "a"}},
+				{Name: "other-input", ResourceID: 2, Version: atc.Version{"ref": "b"}},
+			}, nil, nil)
+
+			upstreamJob.LatestSucceededBuildWithVersionReturns(upstreamBuild, true, nil)
+
+			someResource := new(dbfakes.FakeResource)
+			someResource.IDReturns(1)
+			someResource.NameReturns("some-resource")
+			someResource.ResourceConfigVersionIDReturns(100, true, nil)
+
+			otherResource := new(dbfakes.FakeResource)
+			otherResource.IDReturns(2)
+			otherResource.NameReturns("other-resource")
+			otherResource.ResourceConfigVersionIDReturns(200, true, nil)
+
+			fakePipeline.ResourcesReturns(db.Resources{someResource, otherResource}, nil)
+
+			fakePipeline.ResourceVersionStub = func(id int) (atc.ResourceVersion, bool, error) {
+				return atc.ResourceVersion{ID: id, Version: atc.Version{"id": strconv.Itoa(id)}, Enabled: true}, true, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42/provenance")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the versions which flowed into the build through its passed constraints", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`[
+				{
+					"resource": "some-resource",
+					"version": {"id": 100, "version": {"id": "100"}, "enabled": true},
+					"job_name": "some-job",
+					"build_name": "42",
+					"depth": 0
+				},
+				{
+					"resource": "other-resource",
+					"version": {"id": 200, "version": {"id": "200"}, "enabled": true},
+					"job_name": "upstream-job",
+					"build_name": "7",
+					"depth": 1
+				}
+			]`))
+		})
+
+		It("looks up the upstream build by the input's resource and version, preceding the build", func() {
+			Expect(upstreamJob.LatestSucceededBuildWithVersionCallCount()).To(Equal(1))
+
+			resourceID, versionID, beforeBuildID := upstreamJob.LatestSucceededBuildWithVersionArgsForCall(0)
+			Expect(resourceID).To(Equal(1))
+			Expect(versionID).To(Equal(100))
+			Expect(beforeBuildID).To(Equal(20))
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				fakeJob.BuildReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when looking up upstream builds fails", func() {
+			BeforeEach(func() {
+				upstreamJob.LatestSucceededBuildWithVersionReturns(nil, false, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", func() {
 		var response *http.Response
 
@@ -0,0 +1,227 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DiffJobBuildInputs(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("diff-job-build-inputs")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		teamName := r.FormValue(":team_name")
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+		fromBuildName := r.FormValue("from")
+
+		if fromBuildName == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fromBuild, found, err := job.Build(fromBuildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		toBuild, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fromInputs, _, err := fromBuild.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-build-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		toInputs, _, err := toBuild.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-build-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		versions, err := newVersionResolver(pipeline, accessor.GetAccessor(r).IsAuthorized(teamName))
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fromByName := map[string]db.BuildInput{}
+		for _, input := range fromInputs {
+			fromByName[input.Name] = input
+		}
+
+		toByName := map[string]db.BuildInput{}
+		for _, input := range toInputs {
+			toByName[input.Name] = input
+		}
+
+		names := []string{}
+		for name := range fromByName {
+			names = append(names, name)
+		}
+
+		for name := range toByName {
+			if _, found := fromByName[name]; !found {
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+
+		diff := atc.BuildInputDiff{
+			FromBuild: fromBuild.Name(),
+			ToBuild:   toBuild.Name(),
+			Changes:   []atc.BuildInputChange{},
+		}
+
+		for _, name := range names {
+			from, hadFrom := fromByName[name]
+			to, hadTo := toByName[name]
+
+			if hadFrom && hadTo && from.ResourceID == to.ResourceID && reflect.DeepEqual(from.Version, to.Version) {
+				continue
+			}
+
+			change := atc.BuildInputChange{Name: name}
+
+			if hadFrom {
+				version, err := versions.Resolve(from)
+				if err != nil {
+					logger.Error("failed-to-get-resource-version", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				change.Resource = versions.ResourceName(from)
+				change.From = &version
+			}
+
+			if hadTo {
+				version, err := versions.Resolve(to)
+				if err != nil {
+					logger.Error("failed-to-get-resource-version", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				change.Resource = versions.ResourceName(to)
+				change.To = &version
+			}
+
+			diff.Changes = append(diff.Changes, change)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(diff)
+		if err != nil {
+			logger.Error("failed-to-encode-build-input-diff", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// versionResolver looks up the resource and metadata of versions used as
+// build inputs, hiding metadata of private resources from unauthorized users.
+type versionResolver struct {
+	pipeline   db.Pipeline
+	resources  map[int]db.Resource
+	authorized bool
+}
+
+func newVersionResolver(pipeline db.Pipeline, authorized bool) (*versionResolver, error) {
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[int]db.Resource{}
+	for _, resource := range resources {
+		byID[resource.ID()] = resource
+	}
+
+	return &versionResolver{
+		pipeline:   pipeline,
+		resources:  byID,
+		authorized: authorized,
+	}, nil
+}
+
+func (v *versionResolver) ResourceName(input db.BuildInput) string {
+	resource, found := v.resources[input.ResourceID]
+	if !found {
+		return ""
+	}
+
+	return resource.Name()
+}
+
+func (v *versionResolver) Resolve(input db.BuildInput) (atc.ResourceVersion, error) {
+	version := atc.ResourceVersion{Version: input.Version}
+
+	resource, found := v.resources[input.ResourceID]
+	if !found {
+		return version, nil
+	}
+
+	id, found, err := resource.ResourceConfigVersionID(input.Version)
+	if err != nil {
+		return atc.ResourceVersion{}, err
+	}
+
+	if !found {
+		return version, nil
+	}
+
+	resolved, found, err := v.pipeline.ResourceVersion(id)
+	if err != nil {
+		return atc.ResourceVersion{}, err
+	}
+
+	if !found {
+		return version, nil
+	}
+
+	if !resource.Public() && !v.authorized {
+		resolved.Metadata = nil
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,163 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobBuildProvenance(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-build-provenance")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		teamName := r.FormValue(":team_name")
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		build, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		versions, err := newVersionResolver(pipeline, accessor.GetAccessor(r).IsAuthorized(teamName))
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		provenance, err := walkProvenance(pipeline, job, build, versions)
+		if err != nil {
+			logger.Error("failed-to-walk-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(provenance)
+		if err != nil {
+			logger.Error("failed-to-encode-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+type provenanceStep struct {
+	job   db.Job
+	build db.Build
+	depth int
+}
+
+// walkProvenance lists the inputs of the build and, for every input with
+// passed constraints, the inputs of the upstream builds which the version
+// went through, breadth first. Each version is only listed once, at the
+// shallowest depth it was found.
+func walkProvenance(pipeline db.Pipeline, job db.Job, build db.Build, versions *versionResolver) ([]atc.VersionProvenance, error) {
+	provenance := []atc.VersionProvenance{}
+
+	seenBuilds := map[int]bool{build.ID(): true}
+	seenVersions := map[int]bool{}
+
+	jobs := map[string]db.Job{job.Name(): job}
+
+	queue := []provenanceStep{{job: job, build: build}}
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+
+		inputs, _, err := step.build.Resources()
+		if err != nil {
+			return nil, err
+		}
+
+		passed := map[string][]string{}
+		for _, input := range step.job.Config().Inputs() {
+			passed[input.Name] = input.Passed
+		}
+
+		for _, input := range inputs {
+			version, err := versions.Resolve(input)
+			if err != nil {
+				return nil, err
+			}
+
+			if version.ID != 0 {
+				if seenVersions[version.ID] {
+					continue
+				}
+
+				seenVersions[version.ID] = true
+			}
+
+			provenance = append(provenance, atc.VersionProvenance{
+				Resource: versions.ResourceName(input),
+				Version:  version,
+				JobName:  step.job.Name(),
+				Build:    step.build.Name(),
+				Depth:    step.depth,
+			})
+
+			if version.ID == 0 {
+				continue
+			}
+
+			for _, passedJobName := range passed[input.Name] {
+				passedJob, found := jobs[passedJobName]
+				if !found {
+					passedJob, found, err = pipeline.Job(passedJobName)
+					if err != nil {
+						return nil, err
+					}
+
+					if !found {
+						continue
+					}
+
+					jobs[passedJobName] = passedJob
+				}
+
+				upstream, found, err := passedJob.LatestSucceededBuildWithVersion(input.ResourceID, version.ID, step.build.ID())
+				if err != nil {
+					return nil, err
+				}
+
+				if !found || seenBuilds[upstream.ID()] {
+					continue
+				}
+
+				seenBuilds[upstream.ID()] = true
+
+				queue = append(queue, provenanceStep{
+					job:   passedJob,
+					build: upstream,
+					depth: step.depth + 1,
+				})
+			}
+		}
+	}
+
+	return provenance, nil
+}
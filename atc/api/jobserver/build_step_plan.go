@@ -0,0 +1,90 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobBuildStepPlan(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-build-step-plan")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+		stepName := r.FormValue("step")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		build, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found || build.PrivatePlan() == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var plan atc.Plan
+		err = json.Unmarshal([]byte(build.PrivatePlan()), &plan)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-build-plan", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_, buildOutputs, err := build.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-build-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		outputs := map[string]atc.Version{}
+		for _, output := range buildOutputs {
+			outputs[output.Name] = output.Version
+		}
+
+		stepPlan, err := atc.ExtractStepPlan(plan, stepName, outputs)
+		if err == atc.ErrStepNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if _, ok := err.(atc.AmbiguousStepError); ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		if err != nil {
+			logger.Error("failed-to-extract-step-plan", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(stepPlan)
+		if err != nil {
+			logger.Error("failed-to-encode-step-plan", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -2,8 +2,10 @@ package jobserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
@@ -12,6 +14,14 @@ import (
 func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("list-all-jobs")
 
+	selectors, err := atc.ParseLabelSelectors(r.URL.Query()["label"])
+	if err != nil {
+		logger.Info("malformed-label-selector", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, err.Error())
+		return
+	}
+
 	acc := accessor.GetAccessor(r)
 
 	dashboard, err := s.jobFactory.VisibleJobs(acc.TeamNames())
@@ -21,9 +31,30 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var matchingPipelineIDs map[int]bool
+	if len(selectors) > 0 {
+		pipelines, err := s.pipelineFactory.VisiblePipelines(acc.TeamNames())
+		if err != nil {
+			logger.Error("failed-to-get-all-visible-pipelines", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		matchingPipelineIDs = map[int]bool{}
+		for _, pipeline := range pipelines {
+			if atc.MatchesLabels(pipeline.Labels(), selectors) {
+				matchingPipelineIDs[pipeline.ID()] = true
+			}
+		}
+	}
+
 	jobs := []atc.Job{}
 
 	for _, job := range dashboard {
+		if matchingPipelineIDs != nil && !matchingPipelineIDs[job.Job.PipelineID()] {
+			continue
+		}
+
 		jobs = append(
 			jobs,
 			present.Job(
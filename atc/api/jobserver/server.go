@@ -14,6 +14,7 @@ type Server struct {
 	rejector         auth.Rejector
 	variablesFactory creds.VariablesFactory
 	jobFactory       db.JobFactory
+	pipelineFactory  db.PipelineFactory
 }
 
 func NewServer(
@@ -21,6 +22,7 @@ func NewServer(
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
 	jobFactory db.JobFactory,
+	pipelineFactory db.PipelineFactory,
 ) *Server {
 	return &Server{
 		logger:           logger,
@@ -28,5 +30,6 @@ func NewServer(
 		rejector:         auth.UnauthorizedRejector{},
 		variablesFactory: variablesFactory,
 		jobFactory:       jobFactory,
+		pipelineFactory:  pipelineFactory,
 	}
 }
@@ -89,10 +89,17 @@ var _ = Describe("Pipelines API", func() {
 	})
 
 	Describe("GET /api/v1/pipelines", func() {
-		var response *http.Response
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/pipelines", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/pipelines"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -202,6 +209,74 @@ var _ = Describe("Pipelines API", func() {
 				}]`))
 			})
 
+			Context("when filtering by labels", func() {
+				BeforeEach(func() {
+					privatePipeline.LabelsReturns(map[string]string{"env": "prod", "tier": "web"})
+					publicPipeline.LabelsReturns(map[string]string{"env": "staging", "tier": "web"})
+				})
+
+				Context("with a key and value", func() {
+					BeforeEach(func() {
+						query = "?label=env=prod"
+					})
+
+					It("returns only the pipelines with the label set to the value", func() {
+						var pipelines []atc.Pipeline
+						err := json.NewDecoder(response.Body).Decode(&pipelines)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(pipelines).To(HaveLen(1))
+						Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+						Expect(pipelines[0].Labels).To(Equal(map[string]string{"env": "prod", "tier": "web"}))
+					})
+				})
+
+				Context("with multiple selectors", func() {
+					BeforeEach(func() {
+						query = "?label=tier&label=env=staging"
+					})
+
+					It("returns only the pipelines matching all of them", func() {
+						var pipelines []atc.Pipeline
+						err := json.NewDecoder(response.Body).Decode(&pipelines)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(pipelines).To(HaveLen(1))
+						Expect(pipelines[0].Name).To(Equal("public-pipeline"))
+					})
+				})
+
+				Context("when no pipelines match", func() {
+					BeforeEach(func() {
+						query = "?label=env=dev"
+					})
+
+					It("returns an empty list", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[]`))
+					})
+				})
+
+				Context("when the selector is malformed", func() {
+					BeforeEach(func() {
+						query = "?label==prod"
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("returns the error", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(string(body)).To(Equal("label key cannot be empty"))
+					})
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					dbPipelineFactory.VisiblePipelinesReturns(nil, errors.New("disaster"))
@@ -215,10 +290,17 @@ var _ = Describe("Pipelines API", func() {
 	})
 
 	Describe("GET /api/v1/teams/:team_name/pipelines", func() {
-		var response *http.Response
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/main/pipelines", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/main/pipelines"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -281,6 +363,48 @@ var _ = Describe("Pipelines API", func() {
 					}]`))
 			})
 
+			Context("when filtering by labels", func() {
+				BeforeEach(func() {
+					privatePipeline.LabelsReturns(map[string]string{"env": "prod"})
+					query = "?label=env=prod"
+				})
+
+				It("returns only the team's pipelines matching the selectors", func() {
+					var pipelines []atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines).To(HaveLen(1))
+					Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+				})
+			})
+
+			Context("when a pipeline is an instance", func() {
+				BeforeEach(func() {
+					privatePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "1.2"})
+				})
+
+				It("includes its instance vars", func() {
+					var pipelines []atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+					Expect(pipelines[0].InstanceVars).To(Equal(atc.InstanceVars{"branch": "1.2"}))
+					Expect(pipelines[1].InstanceVars).To(BeEmpty())
+				})
+			})
+
+			Context("when the label selector is malformed", func() {
+				BeforeEach(func() {
+					query = "?label=bad,key"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelinesReturns(nil, errors.New("disaster"))
@@ -398,7 +522,7 @@ var _ = Describe("Pipelines API", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
-				fakeTeam.PipelineReturns(fakePipeline, true, nil)
+				fakeTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 			})
 
 			It("returns 200 ok", func() {
@@ -441,7 +565,7 @@ var _ = Describe("Pipelines API", func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(false)
 
-				fakeTeam.PipelineReturns(fakePipeline, true, nil)
+				fakeTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
 			})
 
@@ -457,7 +581,7 @@ var _ = Describe("Pipelines API", func() {
 
 			Context("and the pipeline is public", func() {
 				BeforeEach(func() {
-					fakeTeam.PipelineReturns(fakePipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 					fakePipeline.PublicReturns(true)
 				})
 
@@ -470,7 +594,7 @@ var _ = Describe("Pipelines API", func() {
 		Context("when not authenticated at all", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
-				dbTeam.PipelineReturns(fakePipeline, true, nil)
+				dbTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 			})
 
 			Context("and the pipeline is private", func() {
@@ -502,7 +626,7 @@ var _ = Describe("Pipelines API", func() {
 		BeforeEach(func() {
 			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
 			dbPipeline.NameReturns("some-pipeline")
-			fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 
 			jobWithNoBuilds = new(dbfakes.FakeJob)
 			jobWithSucceededBuild = new(dbfakes.FakeJob)
@@ -783,7 +907,7 @@ var _ = Describe("Pipelines API", func() {
 
 					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
 					dbPipeline.NameReturns("a-pipeline-name")
-					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 				})
 
 				It("returns 204 No Content", func() {
@@ -796,8 +920,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -806,7 +930,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when an error occurs destroying the pipeline", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						err := errors.New("disaster!")
 						dbPipeline.DestroyReturns(err)
 					})
@@ -869,13 +993,13 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.PauseReturns(nil)
 					})
 
@@ -886,7 +1010,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when pausing the pipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.PauseReturns(errors.New("welp"))
 					})
 
@@ -940,7 +1064,7 @@ var _ = Describe("Pipelines API", func() {
 					fakeaccess.IsAuthorizedReturns(true)
 
 					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
-					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 				})
 
 				It("constructs team with provided team name", func() {
@@ -949,13 +1073,13 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.UnpauseReturns(nil)
 					})
 
@@ -966,7 +1090,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when unpausing the pipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.UnpauseReturns(errors.New("welp"))
 					})
 
@@ -1028,14 +1152,14 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(fakeTeam.PipelineInstanceCallCount()).To(Equal(1))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.ExposeReturns(nil)
 					})
 
@@ -1046,7 +1170,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when exposing the pipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.ExposeReturns(errors.New("welp"))
 					})
 
@@ -1107,13 +1231,13 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.HideReturns(nil)
 					})
 
@@ -1124,7 +1248,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when hiding the pipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.HideReturns(errors.New("welp"))
 					})
 
@@ -1267,6 +1391,183 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/pause", func() {
+		var response *http.Response
+		var body io.Reader
+
+		BeforeEach(func() {
+			body = bytes.NewBufferString(`[1, 2]`)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/pause", body)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				Context("with invalid json", func() {
+					BeforeEach(func() {
+						body = bytes.NewBufferString(`{}`)
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when pausing the pipelines succeeds", func() {
+					BeforeEach(func() {
+						fakeTeam.PausePipelinesReturns([]int{2}, nil)
+					})
+
+					It("pauses the given pipelines of the team", func() {
+						Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+
+						Expect(fakeTeam.PausePipelinesCallCount()).To(Equal(1))
+						Expect(fakeTeam.PausePipelinesArgsForCall(0)).To(Equal([]int{1, 2}))
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns the result for each pipeline", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{"id": 1, "found": true},
+							{"id": 2, "found": false}
+						]`))
+					})
+				})
+
+				Context("when pausing the pipelines fails", func() {
+					BeforeEach(func() {
+						fakeTeam.PausePipelinesReturns(nil, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("bulk updates of pipelines", func() {
+		var (
+			method   string
+			path     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(method, server.URL+path, bytes.NewBufferString(`[1]`))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		Context("PUT /api/v1/teams/:team_name/pipelines/unpause", func() {
+			BeforeEach(func() {
+				method, path = "PUT", "/api/v1/teams/a-team/pipelines/unpause"
+			})
+
+			It("unpauses the given pipelines", func() {
+				Expect(fakeTeam.UnpausePipelinesCallCount()).To(Equal(1))
+				Expect(fakeTeam.UnpausePipelinesArgsForCall(0)).To(Equal([]int{1}))
+			})
+		})
+
+		Context("PUT /api/v1/teams/:team_name/pipelines/expose", func() {
+			BeforeEach(func() {
+				method, path = "PUT", "/api/v1/teams/a-team/pipelines/expose"
+			})
+
+			It("exposes the given pipelines", func() {
+				Expect(fakeTeam.ExposePipelinesCallCount()).To(Equal(1))
+				Expect(fakeTeam.ExposePipelinesArgsForCall(0)).To(Equal([]int{1}))
+			})
+		})
+
+		Context("PUT /api/v1/teams/:team_name/pipelines/hide", func() {
+			BeforeEach(func() {
+				method, path = "PUT", "/api/v1/teams/a-team/pipelines/hide"
+			})
+
+			It("hides the given pipelines", func() {
+				Expect(fakeTeam.HidePipelinesCallCount()).To(Equal(1))
+				Expect(fakeTeam.HidePipelinesArgsForCall(0)).To(Equal([]int{1}))
+			})
+		})
+
+		Context("DELETE /api/v1/teams/:team_name/pipelines", func() {
+			BeforeEach(func() {
+				method, path = "DELETE", "/api/v1/teams/a-team/pipelines"
+			})
+
+			It("destroys the given pipelines", func() {
+				Expect(fakeTeam.DestroyPipelinesCallCount()).To(Equal(1))
+				Expect(fakeTeam.DestroyPipelinesArgsForCall(0)).To(Equal([]int{1}))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", func() {
 		var response *http.Response
 
@@ -1285,7 +1586,7 @@ var _ = Describe("Pipelines API", func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(true)
 				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
-				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+				fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 				//construct Version db
 
 				dbPipeline.LoadVersionsDBReturns(
@@ -1396,6 +1697,311 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/secret-lookups", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/secret-lookups", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
+			})
+
+			Context("when the lookups can be found", func() {
+				BeforeEach(func() {
+					dbPipeline.SecretLookupsReturns([]db.SecretLookup{
+						{
+							UsedByType:   db.SecretLookupUsedByJob,
+							UsedByName:   "some-job",
+							Name:         "some-var",
+							Backend:      "vault",
+							Path:         "/concourse/a-team/some-var",
+							Found:        true,
+							LastLookedUp: time.Unix(1, 0),
+						},
+						{
+							UsedByType:   db.SecretLookupUsedByResource,
+							UsedByName:   "some-resource",
+							Name:         "other-var",
+							Backend:      "vault",
+							LastLookedUp: time.Unix(2, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns application/json", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the lookups without any values", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"used_by_type": "job",
+							"used_by_name": "some-job",
+							"name": "some-var",
+							"backend": "vault",
+							"path": "/concourse/a-team/some-var",
+							"found": true,
+							"last_looked_up": 1
+						},
+						{
+							"used_by_type": "resource",
+							"used_by_name": "some-resource",
+							"name": "other-var",
+							"backend": "vault",
+							"found": false,
+							"last_looked_up": 2
+						}
+					]`))
+				})
+			})
+
+			Context("when finding the lookups fails", func() {
+				BeforeEach(func() {
+					dbPipeline.SecretLookupsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/config/versions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
+			})
+
+			Context("when the versions can be found", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigVersionsReturns([]db.PipelineConfigVersion{
+						{
+							Version:   db.ConfigVersion(2),
+							Author:    "some-user",
+							CreatedAt: time.Unix(2, 0),
+						},
+						{
+							Version: db.ConfigVersion(1),
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns application/json", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the versions", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"version": 2,
+							"author": "some-user",
+							"created_at": 2
+						},
+						{
+							"version": 1
+						}
+					]`))
+				})
+			})
+
+			Context("when finding the versions fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigVersionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", func() {
+		var (
+			version  string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			version = "2"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/config/versions/"+version, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
+			})
+
+			Context("when the version can be found", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigAtVersionReturns(atc.Config{
+						Jobs: atc.JobConfigs{
+							{Name: "some-job"},
+						},
+					}, true, nil)
+				})
+
+				It("looks up the requested version", func() {
+					Expect(dbPipeline.ConfigAtVersionCallCount()).To(Equal(1))
+					Expect(dbPipeline.ConfigAtVersionArgsForCall(0)).To(Equal(db.ConfigVersion(2)))
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns the version in the config version header", func() {
+					Expect(response.Header.Get(atc.ConfigVersionHeader)).To(Equal("2"))
+				})
+
+				It("returns the config", func() {
+					var configResponse atc.ConfigResponse
+					err := json.NewDecoder(response.Body).Decode(&configResponse)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(configResponse.Config.Jobs).To(HaveLen(1))
+					Expect(configResponse.Config.Jobs[0].Name).To(Equal("some-job"))
+				})
+			})
+
+			Context("when the version cannot be found", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigAtVersionReturns(atc.Config{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when finding the version fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigAtVersionReturns(atc.Config{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the version is malformed", func() {
+				BeforeEach(func() {
+					version = "latest"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/rename", func() {
 		var response *http.Response
 
@@ -1418,7 +2024,7 @@ var _ = Describe("Pipelines API", func() {
 					fakeaccess.IsAuthorizedReturns(true)
 
 					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
-					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 				})
 
 				It("constructs teamDB with provided team name", func() {
@@ -1427,8 +2033,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
@@ -1442,7 +2048,7 @@ var _ = Describe("Pipelines API", func() {
 
 				Context("when an error occurs on update", func() {
 					BeforeEach(func() {
-						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 						dbPipeline.RenameReturns(errors.New("whoops"))
 					})
 
@@ -1707,7 +2313,7 @@ var _ = Describe("Pipelines API", func() {
 				BeforeEach(func() {
 					fakeaccess.IsAuthorizedReturns(true)
 					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
-					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(dbPipeline, true, nil)
 				})
 
 				Context("when creating a started build fails", func() {
@@ -0,0 +1,90 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) PausePipelines(w http.ResponseWriter, r *http.Request) {
+	s.updatePipelines(w, r, "pause-pipelines", db.Team.PausePipelines)
+}
+
+func (s *Server) UnpausePipelines(w http.ResponseWriter, r *http.Request) {
+	s.updatePipelines(w, r, "unpause-pipelines", db.Team.UnpausePipelines)
+}
+
+func (s *Server) ExposePipelines(w http.ResponseWriter, r *http.Request) {
+	s.updatePipelines(w, r, "expose-pipelines", db.Team.ExposePipelines)
+}
+
+func (s *Server) HidePipelines(w http.ResponseWriter, r *http.Request) {
+	s.updatePipelines(w, r, "hide-pipelines", db.Team.HidePipelines)
+}
+
+func (s *Server) DeletePipelines(w http.ResponseWriter, r *http.Request) {
+	s.updatePipelines(w, r, "delete-pipelines", db.Team.DestroyPipelines)
+}
+
+func (s *Server) updatePipelines(
+	w http.ResponseWriter,
+	r *http.Request,
+	action string,
+	update func(db.Team, []int) ([]int, error),
+) {
+	logger := s.logger.Session(action)
+
+	var pipelineIDs []int
+	if err := json.NewDecoder(r.Body).Decode(&pipelineIDs); err != nil {
+		logger.Error("invalid-json", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	teamName := r.FormValue(":team_name")
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	notFound, err := update(team, pipelineIDs)
+	if err != nil {
+		logger.Error("failed-to-update-pipelines", err, lager.Data{
+			"pipeline-ids": pipelineIDs,
+		})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	missing := map[int]bool{}
+	for _, id := range notFound {
+		missing[id] = true
+	}
+
+	results := []atc.PipelineBatchResult{}
+	for _, id := range pipelineIDs {
+		results = append(results, atc.PipelineBatchResult{
+			ID:    id,
+			Found: !missing[id],
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(results)
+	if err != nil {
+		logger.Error("failed-to-encode-results", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,49 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) GetConfigVersion(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-config-version")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, err := strconv.Atoi(rata.Param(r, "config_version"))
+		if err != nil {
+			logger.Info("malformed-config-version", lager.Data{"version": rata.Param(r, "config_version")})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		config, found, err := pipeline.ConfigAtVersion(db.ConfigVersion(version))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set(atc.ConfigVersionHeader, fmt.Sprintf("%d", version))
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(atc.ConfigResponse{
+			Config: config,
+		})
+		if err != nil {
+			logger.Error("failed-to-encode-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package pipelineserver
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func filterPipelines(pipelines []db.Pipeline, selectors []atc.LabelSelector) []db.Pipeline {
+	if len(selectors) == 0 {
+		return pipelines
+	}
+
+	filtered := []db.Pipeline{}
+	for _, pipeline := range pipelines {
+		if atc.MatchesLabels(pipeline.Labels(), selectors) {
+			filtered = append(filtered, pipeline)
+		}
+	}
+
+	return filtered
+}
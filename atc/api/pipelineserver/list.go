@@ -2,8 +2,11 @@ package pipelineserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
@@ -12,6 +15,15 @@ import (
 func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("list-pipelines")
 	requestTeamName := r.FormValue(":team_name")
+
+	selectors, err := atc.ParseLabelSelectors(r.URL.Query()["label"])
+	if err != nil {
+		logger.Info("malformed-label-selector", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, err.Error())
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(requestTeamName)
 	if err != nil {
 		logger.Error("failed-to-get-team", err)
@@ -42,7 +54,7 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 
-	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
+	err = json.NewEncoder(w).Encode(present.Pipelines(filterPipelines(pipelines, selectors)))
 	if err != nil {
 		logger.Error("failed-to-encode-pipelines", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -2,8 +2,11 @@ package pipelineserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 )
@@ -12,6 +15,14 @@ import (
 func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("list-all-pipelines")
 
+	selectors, err := atc.ParseLabelSelectors(r.URL.Query()["label"])
+	if err != nil {
+		logger.Info("malformed-label-selector", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, err.Error())
+		return
+	}
+
 	acc := accessor.GetAccessor(r)
 
 	pipelines, err := s.pipelineFactory.VisiblePipelines(acc.TeamNames())
@@ -22,7 +33,7 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
+	err = json.NewEncoder(w).Encode(present.Pipelines(filterPipelines(pipelines, selectors)))
 	if err != nil {
 		logger.Error("failed-to-encode-pipelines", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,30 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListConfigVersions(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-config-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versions, err := pipeline.ConfigVersions()
+		if err != nil {
+			logger.Error("failed-to-get-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.PipelineConfigVersions(versions))
+		if err != nil {
+			logger.Error("failed-to-encode-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
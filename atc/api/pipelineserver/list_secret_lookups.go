@@ -0,0 +1,30 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListSecretLookups(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-secret-lookups")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups, err := pipeline.SecretLookups()
+		if err != nil {
+			logger.Error("failed-to-get-secret-lookups", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.SecretLookups(lookups))
+		if err != nil {
+			logger.Error("failed-to-encode-secret-lookups", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/db"
@@ -26,10 +28,12 @@ var _ = Describe("Handler", func() {
 		fakePipeline  *dbfakes.FakePipeline
 
 		handler http.Handler
+		query   string
 	)
 
 	BeforeEach(func() {
 		delegate = &delegateHandler{}
+		query = ""
 
 		dbTeamFactory = new(dbfakes.FakeTeamFactory)
 		fakeTeam = new(dbfakes.FakeTeam)
@@ -42,7 +46,7 @@ var _ = Describe("Handler", func() {
 	JustBeforeEach(func() {
 		server = httptest.NewServer(handler)
 
-		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline", nil)
+		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline"+query, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		response, err = new(http.Client).Do(request)
@@ -110,12 +114,12 @@ var _ = Describe("Handler", func() {
 			Context("when the pipeline exists", func() {
 				BeforeEach(func() {
 					fakePipeline.NameReturns("some-pipeline")
-					fakeTeam.PipelineReturns(fakePipeline, true, nil)
+					fakeTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 				})
 
 				It("looks up the pipeline by the right name", func() {
-					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal("some-pipeline"))
+					Expect(fakeTeam.PipelineInstanceCallCount()).To(Equal(1))
+					Expect(fakeTeam.PipelineInstanceArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 				})
 
 				It("returns 200", func() {
@@ -125,11 +129,38 @@ var _ = Describe("Handler", func() {
 				It("calls the scoped handler", func() {
 					Expect(delegate.IsCalled).To(BeTrue())
 				})
+
+				Context("when instance vars are given", func() {
+					BeforeEach(func() {
+						query = "&vars=" + url.QueryEscape(`{"branch":"1.2"}`)
+					})
+
+					It("looks up the instance with the vars", func() {
+						Expect(fakeTeam.PipelineInstanceArgsForCall(0)).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "1.2"},
+						}))
+					})
+				})
+
+				Context("when the instance vars are malformed", func() {
+					BeforeEach(func() {
+						query = "&vars=branch"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("does not call the scoped handler", func() {
+						Expect(delegate.IsCalled).To(BeFalse())
+					})
+				})
 			})
 
 			Context("when the pipeline does not exist", func() {
 				BeforeEach(func() {
-					fakeTeam.PipelineReturns(nil, false, nil)
+					fakeTeam.PipelineInstanceReturns(nil, false, nil)
 				})
 
 				It("returns 404", func() {
@@ -143,7 +174,7 @@ var _ = Describe("Handler", func() {
 
 			Context("when finding the pipeline fails", func() {
 				BeforeEach(func() {
-					fakeTeam.PipelineReturns(nil, false, errors.New("error"))
+					fakeTeam.PipelineInstanceReturns(nil, false, errors.New("error"))
 				})
 
 				It("returns 500", func() {
@@ -28,6 +28,13 @@ func Build(build db.Build) atc.Build {
 		APIURL:       apiURL,
 	}
 
+	if build.RerunOf() != 0 {
+		atcBuild.RerunOf = &atc.RerunOfBuild{
+			ID:   build.RerunOf(),
+			Name: build.RerunOfName(),
+		}
+	}
+
 	if !build.StartTime().IsZero() {
 		atcBuild.StartTime = build.StartTime().Unix()
 	}
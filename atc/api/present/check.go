@@ -0,0 +1,51 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Check(check db.Check) atc.Check {
+	plan := check.Plan()
+
+	atcCheck := atc.Check{
+		ID:                check.ID(),
+		TeamName:          check.TeamName(),
+		PipelineName:      check.PipelineName(),
+		Status:            check.Status(),
+		ManuallyTriggered: check.ManuallyTriggered(),
+		Plan:              plan,
+		CreateTime:        check.CreateTime().Unix(),
+		Output:            check.Output(),
+	}
+
+	if check.ResourceID() != 0 {
+		atcCheck.ResourceName = plan.Name
+	} else {
+		atcCheck.ResourceTypeName = plan.Name
+	}
+
+	if !check.StartTime().IsZero() {
+		atcCheck.StartTime = check.StartTime().Unix()
+	}
+
+	if !check.EndTime().IsZero() {
+		atcCheck.EndTime = check.EndTime().Unix()
+	}
+
+	if check.CheckError() != nil {
+		atcCheck.CheckError = check.CheckError().Error()
+	}
+
+	return atcCheck
+}
+
+func Checks(checks []db.Check) []atc.Check {
+	presented := []atc.Check{}
+
+	for _, check := range checks {
+		presented = append(presented, Check(check))
+	}
+
+	return presented
+}
@@ -0,0 +1,34 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+func CredentialChecks(checks []creds.VarCheck) []atc.CredentialCheck {
+	presented := []atc.CredentialCheck{}
+
+	for _, check := range checks {
+		status := atc.CredentialCheckNotFound
+		if check.Found {
+			status = atc.CredentialCheckFound
+		}
+
+		var errorMessage string
+		if check.Err != nil {
+			status = atc.CredentialCheckErrored
+			errorMessage = check.Err.Error()
+		}
+
+		presented = append(presented, atc.CredentialCheck{
+			Name:     check.Name,
+			Backend:  check.Backend,
+			Status:   status,
+			Path:     check.Path,
+			Searched: check.Searched,
+			Error:    errorMessage,
+		})
+	}
+
+	return presented
+}
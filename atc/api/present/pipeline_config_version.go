@@ -0,0 +1,25 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func PipelineConfigVersions(versions []db.PipelineConfigVersion) []atc.PipelineConfigVersion {
+	presented := []atc.PipelineConfigVersion{}
+
+	for _, version := range versions {
+		presentedVersion := atc.PipelineConfigVersion{
+			Version: int(version.Version),
+			Author:  version.Author,
+		}
+
+		if !version.CreatedAt.IsZero() {
+			presentedVersion.CreatedAt = version.CreatedAt.Unix()
+		}
+
+		presented = append(presented, presentedVersion)
+	}
+
+	return presented
+}
@@ -43,6 +43,13 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 	} else if resource.APIPinnedVersion() != nil {
 		atcResource.PinnedVersion = resource.APIPinnedVersion()
 		atcResource.PinnedInConfig = false
+		atcResource.PinnedBy = resource.PinnedBy()
+
+		if !resource.PinExpiresAt().IsZero() {
+			atcResource.PinExpiresAt = resource.PinExpiresAt().Unix()
+		}
+	} else {
+		atcResource.UnpinnedBy = resource.UnpinnedBy()
 	}
 
 	return atcResource
@@ -0,0 +1,24 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func SecretLookups(lookups []db.SecretLookup) []atc.SecretLookup {
+	presented := []atc.SecretLookup{}
+
+	for _, lookup := range lookups {
+		presented = append(presented, atc.SecretLookup{
+			UsedByType:   lookup.UsedByType,
+			UsedByName:   lookup.UsedByName,
+			Name:         lookup.Name,
+			Backend:      lookup.Backend,
+			Path:         lookup.Path,
+			Found:        lookup.Found,
+			LastLookedUp: lookup.LastLookedUp.Unix(),
+		})
+	}
+
+	return presented
+}
@@ -0,0 +1,31 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func WebhookDelivery(delivery db.WebhookDelivery) atc.WebhookDelivery {
+	return atc.WebhookDelivery{
+		ID:         delivery.ID,
+		Provider:   delivery.Provider,
+		Event:      delivery.Event,
+		DeliveryID: delivery.DeliveryID,
+		Matched:    WebhookMatches(delivery.Matched),
+		Error:      delivery.Error,
+		ReceivedAt: delivery.ReceivedAt.Unix(),
+	}
+}
+
+func WebhookMatches(matches []db.WebhookMatch) []atc.WebhookMatch {
+	presented := []atc.WebhookMatch{}
+
+	for _, match := range matches {
+		presented = append(presented, atc.WebhookMatch{
+			PipelineName: match.PipelineName,
+			ResourceName: match.ResourceName,
+		})
+	}
+
+	return presented
+}
@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/google/jsonapi"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -19,8 +18,6 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
-	"github.com/concourse/concourse/atc/radar/radarfakes"
-	"github.com/concourse/concourse/atc/resource"
 )
 
 var _ = Describe("Resources API", func() {
@@ -34,7 +31,7 @@ var _ = Describe("Resources API", func() {
 	BeforeEach(func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		dbTeamFactory.FindTeamReturns(dbTeam, true, nil)
-		dbTeam.PipelineReturns(fakePipeline, true, nil)
+		dbTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 	})
 
 	JustBeforeEach(func() {
@@ -340,6 +337,167 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin", func() {
+		var response *http.Response
+		var pinRequestBody atc.PinResourceRequestBody
+		var fakeResource *dbfakes.FakeResource
+
+		BeforeEach(func() {
+			pinRequestBody = atc.PinResourceRequestBody{
+				Version: atc.Version{"ref": "abc123"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			reqPayload, err := json.Marshal(pinRequestBody)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/pin", bytes.NewBuffer(reqPayload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.UserNameReturns("some-user")
+				})
+
+				Context("when finding the resource succeeds", func() {
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakeResource.IDReturns(1)
+						fakePipeline.ResourceReturns(fakeResource, true, nil)
+					})
+
+					It("looks up the latest version matching the given fields", func() {
+						Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("resource-name"))
+						Expect(fakeResource.LatestMatchingVersionIDArgsForCall(0)).To(Equal(atc.Version{"ref": "abc123"}))
+					})
+
+					Context("when a matching version is found", func() {
+						BeforeEach(func() {
+							fakeResource.LatestMatchingVersionIDReturns(42, true, nil)
+						})
+
+						It("pins it, recording who pinned it", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+							Expect(fakeResource.PinVersionCallCount()).To(Equal(1))
+							rcvID, pinnedBy, expiresAt := fakeResource.PinVersionArgsForCall(0)
+							Expect(rcvID).To(Equal(42))
+							Expect(pinnedBy).To(Equal("some-user"))
+							Expect(expiresAt).To(BeZero())
+						})
+
+						It("does not set a pin comment", func() {
+							Expect(fakeResource.SetPinCommentCallCount()).To(BeZero())
+						})
+
+						Context("when an expiry is given", func() {
+							BeforeEach(func() {
+								pinRequestBody.ExpiresIn = "24h"
+							})
+
+							It("pins it until then", func() {
+								_, _, expiresAt := fakeResource.PinVersionArgsForCall(0)
+								Expect(expiresAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+							})
+						})
+
+						Context("when a pin comment is given", func() {
+							BeforeEach(func() {
+								pinRequestBody.PinComment = "broken upstream"
+							})
+
+							It("sets it", func() {
+								Expect(fakeResource.SetPinCommentArgsForCall(0)).To(Equal("broken upstream"))
+							})
+						})
+
+						Context("when pinning fails", func() {
+							BeforeEach(func() {
+								fakeResource.PinVersionReturns(errors.New("welp"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+
+					Context("when no matching version is found", func() {
+						BeforeEach(func() {
+							fakeResource.LatestMatchingVersionIDReturns(0, false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+							Expect(fakeResource.PinVersionCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the resource is not found", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns not found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when no version is given", func() {
+					BeforeEach(func() {
+						pinRequestBody.Version = nil
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when the expiry is invalid", func() {
+					BeforeEach(func() {
+						pinRequestBody.ExpiresIn = "tomorrow"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", func() {
 		var response *http.Response
 		var fakeResource *dbfakes.FakeResource
@@ -362,6 +520,7 @@ var _ = Describe("Resources API", func() {
 			Context("when authorized", func() {
 				BeforeEach(func() {
 					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.UserNameReturns("some-user")
 				})
 
 				It("tries to find the resource", func() {
@@ -384,6 +543,11 @@ var _ = Describe("Resources API", func() {
 						It("returns 200", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusOK))
 						})
+
+						It("records who unpinned it", func() {
+							Expect(fakeResource.UnpinVersionCallCount()).To(Equal(1))
+							Expect(fakeResource.UnpinVersionArgsForCall(0)).To(Equal("some-user"))
+						})
 					})
 
 					Context("when unpinning the resource fails", func() {
@@ -551,14 +715,10 @@ var _ = Describe("Resources API", func() {
 	})
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", func() {
-		var fakeScanner *radarfakes.FakeScanner
 		var checkRequestBody atc.CheckRequestBody
 		var response *http.Response
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
-
 			checkRequestBody = atc.CheckRequestBody{}
 		})
 
@@ -599,27 +759,27 @@ var _ = Describe("Resources API", func() {
 			})
 
 			Context("when it finds the resource", func() {
+				var fakeResource *dbfakes.FakeResource
+
 				BeforeEach(func() {
-					fakeResource := new(dbfakes.FakeResource)
+					fakeResource = new(dbfakes.FakeResource)
 					fakeResource.IDReturns(1)
 					fakePipeline.ResourceReturns(fakeResource, true, nil)
-				})
 
-				It("injects the proper pipelineDB", func() {
-					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					dbCheckFactory.CreateCheckReturns(new(dbfakes.FakeCheck), nil)
 				})
 
-				It("tries to scan with no version specified", func() {
-					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-					_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-					Expect(actualResourceID).To(Equal(1))
-					Expect(actualFromVersion).To(BeNil())
+				It("injects the proper pipelineDB", func() {
+					Expect(dbTeam.PipelineInstanceCallCount()).To(Equal(1))
+					pipelineRef := dbTeam.PipelineInstanceArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
-				It("returns 200", func() {
-					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				It("queues a check with no version specified", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+					checkable, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+					Expect(checkable).To(Equal(fakeResource))
+					Expect(fromVersion).To(BeNil())
 				})
 
 				Context("when checking with a version specified", func() {
@@ -631,76 +791,179 @@ var _ = Describe("Resources API", func() {
 						}
 					})
 
-					It("tries to scan with the version specified", func() {
-						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-						_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-						Expect(actualResourceID).To(Equal(1))
-						Expect(actualFromVersion).To(Equal(checkRequestBody.From))
+					It("queues a check from the version specified", func() {
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+						_, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+						Expect(fromVersion).To(Equal(checkRequestBody.From))
 					})
 				})
 
-				Context("when checking fails with ResourceNotFoundError", func() {
+				Context("when the check succeeds", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceNotFoundError{})
+						fakeCheck := new(dbfakes.FakeCheck)
+						fakeCheck.StatusReturns(atc.CheckStatusSucceeded)
+
+						dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
 					})
 
-					It("returns 404", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
 					})
 				})
 
-				Context("when checking the resource fails with ResourceTypeNotFoundError", func() {
+				Context("when the check has not finished yet", func() {
+					var fakeCheck *dbfakes.FakeCheck
+
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceTypeNotFoundError{ID: 13})
+						fakeCheck = new(dbfakes.FakeCheck)
+						fakeCheck.StatusReturns(atc.CheckStatusStarted)
+						fakeCheck.ReloadStub = func() (bool, error) {
+							fakeCheck.StatusReturns(atc.CheckStatusSucceeded)
+							return true, nil
+						}
+
+						dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
 					})
 
-					It("returns jsonapi 400", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
-						Expect(response.Header.Get("Content-Type")).To(Equal(jsonapi.MediaType))
+					It("waits for it to finish", func() {
+						Expect(fakeCheck.ReloadCallCount()).To(Equal(1))
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when the check errors", func() {
+					BeforeEach(func() {
+						fakeCheck := new(dbfakes.FakeCheck)
+						fakeCheck.StatusReturns(atc.CheckStatusErrored)
+						fakeCheck.CheckErrorReturns(errors.New("some-check-error"))
+
+						dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
+					})
+
+					It("returns 500 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("some-check-error"))
 					})
 				})
 
-				Context("when checking the resource fails internally", func() {
+				Context("when queueing the check fails", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(errors.New("welp"))
+						dbCheckFactory.CreateCheckReturns(nil, errors.New("welp"))
 					})
 
 					It("returns 500", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
-						buf := new(bytes.Buffer)
-						_, err := buf.ReadFrom(response.Body)
-						Expect(err).ToNot(HaveOccurred())
-						body := buf.String()
-						Expect(body).To(Equal("welp"))
 					})
 				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", func() {
+		var checkRequestBody atc.CheckRequestBody
+		var response *http.Response
+
+		BeforeEach(func() {
+			checkRequestBody = atc.CheckRequestBody{}
+		})
+
+		JustBeforeEach(func() {
+			reqPayload, err := json.Marshal(checkRequestBody)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/checks", bytes.NewBuffer(reqPayload))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when it finds the resource", func() {
+				var fakeResource *dbfakes.FakeResource
 
-				Context("when checking the resource fails with ErrResourceScriptFailed", func() {
+				BeforeEach(func() {
+					fakeResource = new(dbfakes.FakeResource)
+					fakeResource.IDReturns(1)
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+				})
+
+				Context("when the check is queued", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(
-							resource.ErrResourceScriptFailed{
-								ExitStatus: 42,
-								Stderr:     "my tooth",
-							},
-						)
+						fakeCheck := new(dbfakes.FakeCheck)
+						fakeCheck.IDReturns(10)
+						fakeCheck.TeamNameReturns("a-team")
+						fakeCheck.PipelineNameReturns("a-pipeline")
+						fakeCheck.ResourceIDReturns(1)
+						fakeCheck.StatusReturns(atc.CheckStatusPending)
+						fakeCheck.ManuallyTriggeredReturns(true)
+						fakeCheck.PlanReturns(atc.CheckPlan{Name: "resource-name", Type: "git"})
+						fakeCheck.CreateTimeReturns(time.Unix(100, 0))
+
+						dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
 					})
 
-					It("returns 400", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					It("returns 201", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
 					})
 
-					It("returns the script's exit status and stderr", func() {
+					It("returns application/json", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the check", func() {
 						body, err := ioutil.ReadAll(response.Body)
 						Expect(err).NotTo(HaveOccurred())
 
 						Expect(body).To(MatchJSON(`{
-						"exit_status": 42,
-						"stderr": "my tooth"
-					}`))
+							"id": 10,
+							"team_name": "a-team",
+							"pipeline_name": "a-pipeline",
+							"resource_name": "resource-name",
+							"status": "pending",
+							"manually_triggered": true,
+							"plan": {"name": "resource-name", "type": "git"},
+							"create_time": 100
+						}`))
 					})
+				})
 
-					It("returns application/json", func() {
-						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				Context("when queueing the check fails", func() {
+					BeforeEach(func() {
+						dbCheckFactory.CreateCheckReturns(nil, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
 			})
@@ -1119,6 +1382,33 @@ var _ = Describe("Resources API", func() {
 							}`))
 					})
 				})
+
+				Context("when the resource has been unpinned", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+						resource1.UnpinnedByReturns("pin-expiry")
+						fakePipeline.ResourceReturns(resource1, true, nil)
+					})
+
+					It("returns who unpinned it in the response json", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"last_checked": 1513364881,
+								"unpinned_by": "pin-expiry"
+							}`))
+					})
+				})
 			})
 		})
 
@@ -1221,14 +1511,9 @@ var _ = Describe("Resources API", func() {
 		})
 
 		Context("when authenticated and authorized", func() {
-			var fakeScanner *radarfakes.FakeScanner
-
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(true)
-
-				fakeScanner = new(radarfakes.FakeScanner)
-				fakeScannerFactory.NewResourceTypeScannerReturns(fakeScanner)
 			})
 
 			Context("when looking up the resource type fails", func() {
@@ -1250,18 +1535,47 @@ var _ = Describe("Resources API", func() {
 			})
 
 			Context("when it finds the resource type", func() {
+				var fakeResourceType *dbfakes.FakeResourceType
+
 				BeforeEach(func() {
-					fakeResourceType := new(dbfakes.FakeResourceType)
+					fakeResourceType = new(dbfakes.FakeResourceType)
 					fakeResourceType.IDReturns(1)
 					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+
+					fakeCheck := new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(10)
+					fakeCheck.ResourceTypeIDReturns(1)
+					fakeCheck.StatusReturns(atc.CheckStatusSucceeded)
+					fakeCheck.PlanReturns(atc.CheckPlan{Name: "resource-type-name", Type: "registry-image"})
+					dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
 				})
 
-				It("returns 200", func() {
+				It("returns 200 once the check has finished", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
 
-				It("calls Scan", func() {
-					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+				Context("when the check errors", func() {
+					BeforeEach(func() {
+						fakeCheck := new(dbfakes.FakeCheck)
+						fakeCheck.StatusReturns(atc.CheckStatusErrored)
+						fakeCheck.CheckErrorReturns(errors.New("some-check-error"))
+						dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
+					})
+
+					It("returns 500 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("some-check-error"))
+					})
+				})
+
+				It("queues a check of the resource type", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+					checkable, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+					Expect(checkable).To(Equal(fakeResourceType))
+					Expect(fromVersion).To(BeNil())
 				})
 
 				Context("when checking with a version specified", func() {
@@ -1273,28 +1587,144 @@ var _ = Describe("Resources API", func() {
 						}
 					})
 
-					It("tries to scan with the version specified", func() {
-						Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-						_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-						Expect(actualResourceID).To(Equal(1))
-						Expect(actualFromVersion).To(Equal(checkRequestBody.From))
+					It("queues a check from the version specified", func() {
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+						_, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+						Expect(fromVersion).To(Equal(checkRequestBody.From))
 					})
 				})
 
-				Context("when resource type checking fails with ResourceNotFoundError", func() {
+				Context("when queueing the check fails", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanFromVersionReturns(db.ResourceTypeNotFoundError{})
+						dbCheckFactory.CreateCheckReturns(nil, errors.New("some-error"))
 					})
 
-					It("returns 404", func() {
-						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/checks", func() {
+		var checkRequestBody atc.CheckRequestBody
+		var response *http.Response
+
+		BeforeEach(func() {
+			checkRequestBody = atc.CheckRequestBody{}
+		})
+
+		JustBeforeEach(func() {
+			reqPayload, err := json.Marshal(checkRequestBody)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resource-types/resource-type-name/checks", bytes.NewBuffer(reqPayload))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when looking up the resource type fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, errors.New("nope"))
+				})
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the resource type is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, nil)
+				})
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when it finds the resource type", func() {
+				var fakeResourceType *dbfakes.FakeResourceType
+
+				BeforeEach(func() {
+					fakeResourceType = new(dbfakes.FakeResourceType)
+					fakeResourceType.IDReturns(1)
+					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+
+					fakeCheck := new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(10)
+					fakeCheck.ResourceTypeIDReturns(1)
+					fakeCheck.StatusReturns(atc.CheckStatusPending)
+					fakeCheck.PlanReturns(atc.CheckPlan{Name: "resource-type-name", Type: "registry-image"})
+					dbCheckFactory.CreateCheckReturns(fakeCheck, nil)
+				})
+
+				It("returns 201 with the check", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					var check atc.Check
+					err := json.NewDecoder(response.Body).Decode(&check)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(check.ID).To(Equal(10))
+					Expect(check.ResourceTypeName).To(Equal("resource-type-name"))
+					Expect(check.ResourceName).To(BeEmpty())
+				})
+
+				It("queues a check of the resource type", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+					checkable, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+					Expect(checkable).To(Equal(fakeResourceType))
+					Expect(fromVersion).To(BeNil())
+				})
+
+				Context("when checking with a version specified", func() {
+					BeforeEach(func() {
+						checkRequestBody = atc.CheckRequestBody{
+							From: atc.Version{
+								"some-version-key": "some-version-value",
+							},
+						}
+					})
+
+					It("queues a check from the version specified", func() {
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+						_, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+						Expect(fromVersion).To(Equal(checkRequestBody.From))
 					})
 				})
 
-				Context("when resource type fails with unexpected error", func() {
+				Context("when queueing the check fails", func() {
 					BeforeEach(func() {
-						err := errors.New("some-error")
-						fakeScanner.ScanFromVersionReturns(err)
+						dbCheckFactory.CreateCheckReturns(nil, errors.New("some-error"))
 					})
 
 					It("returns 500", func() {
@@ -1307,26 +1737,17 @@ var _ = Describe("Resources API", func() {
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
-			fakeScanner               *radarfakes.FakeScanner
-			checkRequestBody          atc.CheckRequestBody
-			response                  *http.Response
-			fakeResource              *dbfakes.FakeResource
-			fakeResourceConfig        *dbfakes.FakeResourceConfig
-			fakeResourceConfigVersion *dbfakes.FakeResourceConfigVersion
-			fakeResourceConfigScope   *dbfakes.FakeResourceConfigScope
+			checkRequestBody atc.CheckRequestBody
+			response         *http.Response
+			fakeResource     *dbfakes.FakeResource
 		)
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
 			checkRequestBody = atc.CheckRequestBody{}
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
 			fakeResource.IDReturns(10)
-			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
-			fakeResourceConfigVersion = new(dbfakes.FakeResourceConfigVersion)
-			fakeResourceConfigScope = new(dbfakes.FakeResourceConfigScope)
 		})
 
 		JustBeforeEach(func() {
@@ -1350,12 +1771,10 @@ var _ = Describe("Resources API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				fakeResource.WebhookTokenReturns(token)
 				fakePipeline.ResourceReturns(fakeResource, true, nil)
-				fakeResource.ResourceConfigIDReturns(1)
-				fakeResource.ResourceConfigScopeIDReturns(2)
 			})
 
 			It("injects the proper pipelineDB", func() {
-				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
+				Expect(dbTeam.PipelineInstanceCallCount()).To(Equal(1))
 				resourceName := fakePipeline.ResourceArgsForCall(0)
 				Expect(resourceName).To(Equal("resource-name"))
 			})
@@ -1370,101 +1789,65 @@ var _ = Describe("Resources API", func() {
 					fakePipeline.ResourceReturns(fakeResource, true, nil)
 				})
 
-				It("tries to find the resource config using the resource config id", func() {
-					Eventually(dbResourceConfigFactory.FindResourceConfigByIDCallCount).Should(Equal(1))
-					Expect(dbResourceConfigFactory.FindResourceConfigByIDArgsForCall(0)).To(Equal(1))
+				It("queues a check of the resource", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+					checkable, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+					Expect(checkable).To(Equal(fakeResource))
+					Expect(fromVersion).To(BeNil())
 				})
 
-				Context("when finding the resource config succeeds", func() {
-					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(fakeResourceConfig, true, nil)
-					})
-
-					It("tries to find the resource config scope using the resource config scope id", func() {
-						Eventually(fakeResourceConfig.FindResourceConfigScopeByIDCallCount).Should(Equal(1))
-						resourceConfigScopeID, resource := fakeResourceConfig.FindResourceConfigScopeByIDArgsForCall(0)
-						Expect(resourceConfigScopeID).To(Equal(2))
-						Expect(resource).To(Equal(fakeResource))
-					})
-
-					Context("when finding the resource config scope succeeds", func() {
+				It("resets the resource's check interval", func() {
+					Expect(fakeResource.ResetCheckIntervalCallCount()).To(Equal(1))
+				})
 
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(fakeResourceConfigScope, true, nil)
-						})
+				Context("when the resource has a latest version", func() {
+					BeforeEach(func() {
+						fakeResource.ResourceConfigIDReturns(1)
+						fakeResource.ResourceConfigScopeIDReturns(2)
 
-						Context("when the latest version is found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigVersion.IDReturns(4)
-								fakeResourceConfigVersion.VersionReturns(db.Version{"some": "version"})
-								fakeResourceConfigVersion.MetadataReturns([]db.ResourceConfigMetadataField{
-									{
-										Name:  "some",
-										Value: "metadata",
-									},
-								})
-
-								fakeResourceConfigScope.LatestVersionReturns(fakeResourceConfigVersion, true, nil)
-							})
+						fakeVersion := new(dbfakes.FakeResourceConfigVersion)
+						fakeVersion.VersionReturns(db.Version{"ref": "some-ref"})
 
-							It("tries to scan with the latest version", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceID).To(Equal(10))
-								Expect(actualFromVersion).To(Equal(atc.Version{"some": "version"}))
-							})
+						fakeScope := new(dbfakes.FakeResourceConfigScope)
+						fakeScope.LatestVersionReturns(fakeVersion, true, nil)
 
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
+						fakeResourceConfig := new(dbfakes.FakeResourceConfig)
+						fakeResourceConfig.FindResourceConfigScopeByIDReturns(fakeScope, true, nil)
 
-						Context("when the latest version is not found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, nil)
-							})
-
-							It("tries to scan with no version specified", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceID, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceID).To(Equal(10))
-								Expect(actualFromVersion).To(BeNil())
-							})
-
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
+						dbResourceConfigFactory.FindResourceConfigByIDReturns(fakeResourceConfig, true, nil)
+					})
 
-						Context("when failing to get latest version for resource", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, errors.New("disaster"))
-							})
+					It("queues a check from the latest version", func() {
+						Expect(dbResourceConfigFactory.FindResourceConfigByIDArgsForCall(0)).To(Equal(1))
 
-							It("does not scan from version", func() {
-								Consistently(fakeScanner.ScanFromVersionCallCount).Should(Equal(0))
-							})
-						})
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+						_, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+						Expect(fromVersion).To(Equal(atc.Version{"ref": "some-ref"}))
 					})
+				})
 
-					Context("when the resource config scope is not found", func() {
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(nil, false, nil)
-						})
+				Context("when looking up the resource config fails", func() {
+					BeforeEach(func() {
+						dbResourceConfigFactory.FindResourceConfigByIDReturns(nil, false, errors.New("disaster"))
+					})
 
-						It("tries to scan", func() {
-							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-						})
+					It("returns 500 without queueing a check", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
 					})
 				})
 
-				Context("when the resource config is not found", func() {
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				Context("when queueing the check fails", func() {
 					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(nil, false, nil)
+						dbCheckFactory.CreateCheckReturns(nil, errors.New("disaster"))
 					})
 
-					It("tries to scan", func() {
-						Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
 			})
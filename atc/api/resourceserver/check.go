@@ -2,76 +2,123 @@ package resourceserver
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/resource"
-	"github.com/google/jsonapi"
 	"github.com/tedsuo/rata"
 )
 
+const checkPollInterval = time.Second
+
+// CheckResource queues a check of the resource and waits for it to finish,
+// responding with 200 once it succeeded, or with 500 and the check's error
+// once it failed.
 func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resourceName := rata.Param(r, "resource_name")
+		check, created := s.createResourceCheck(logger, w, r, dbPipeline)
+		if !created {
+			return
+		}
 
-		var reqBody atc.CheckRequestBody
-		err := json.NewDecoder(r.Body).Decode(&reqBody)
-		if err != nil {
-			logger.Info("malformed-request", lager.Data{"error": err.Error()})
-			w.WriteHeader(http.StatusBadRequest)
+		s.waitForCheck(logger, w, r, check)
+	})
+}
+
+// CreateResourceCheck queues a check of the resource, responding with the
+// check without waiting for it to run.
+func (s *Server) CreateResourceCheck(dbPipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("create-resource-check")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		check, created := s.createResourceCheck(logger, w, r, dbPipeline)
+		if !created {
+			return
+		}
+
+		s.respondWithCheck(logger, w, check)
+	})
+}
+
+func (s *Server) createResourceCheck(logger lager.Logger, w http.ResponseWriter, r *http.Request, dbPipeline db.Pipeline) (db.Check, bool) {
+	resourceName := rata.Param(r, "resource_name")
+
+	var reqBody atc.CheckRequestBody
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	dbResource, found, err := dbPipeline.Resource(resourceName)
+	if err != nil {
+		logger.Error("failed-to-get-resource", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	check, err := s.checkFactory.CreateCheck(dbResource, reqBody.From)
+	if err != nil {
+		logger.Error("failed-to-create-check", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return check, true
+}
+
+func (s *Server) respondWithCheck(logger lager.Logger, w http.ResponseWriter, check db.Check) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err := json.NewEncoder(w).Encode(present.Check(check))
+	if err != nil {
+		logger.Error("failed-to-encode-check", err)
+	}
+}
+
+// waitForCheck polls the check until it has finished, for clients which
+// expect the check to have run once they get a response.
+func (s *Server) waitForCheck(logger lager.Logger, w http.ResponseWriter, r *http.Request, check db.Check) {
+	for check.Status() == atc.CheckStatusPending || check.Status() == atc.CheckStatusStarted {
+		select {
+		case <-r.Context().Done():
 			return
+		case <-time.After(checkPollInterval):
 		}
 
-		dbResource, found, err := dbPipeline.Resource(resourceName)
+		found, err := check.Reload()
 		if err != nil {
-			logger.Error("failed-to-get-resource", err)
+			logger.Error("failed-to-reload-check", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 
 		if !found {
-			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
-			w.WriteHeader(http.StatusNotFound)
+			logger.Error("check-disappeared", errors.New("check disappeared"), lager.Data{"check": check.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+	}
 
-		scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-
-		err = scanner.ScanFromVersion(logger, dbResource.ID(), reqBody.From)
-		switch scanErr := err.(type) {
-		case resource.ErrResourceScriptFailed:
-			checkResponseBody := atc.CheckResponseBody{
-				ExitStatus: scanErr.ExitStatus,
-				Stderr:     scanErr.Stderr,
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusBadRequest)
-			err = json.NewEncoder(w).Encode(checkResponseBody)
-			if err != nil {
-				logger.Error("failed-to-encode-check-response-body", err)
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte(err.Error()))
-			}
-		case db.ResourceNotFoundError:
-			w.WriteHeader(http.StatusNotFound)
-		case db.ResourceTypeNotFoundError:
-			w.Header().Set("Content-Type", jsonapi.MediaType)
-			w.WriteHeader(http.StatusBadRequest)
-			_ = jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{{
-				Title:  "Resource Type Not Found Error",
-				Detail: err.Error(),
-				Status: "400",
-			}})
-		case error:
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(err.Error()))
-		default:
-			w.WriteHeader(http.StatusOK)
-		}
-	})
+	if check.Status() == atc.CheckStatusErrored {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(check.CheckError().Error()))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
 }
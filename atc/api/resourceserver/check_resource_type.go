@@ -10,44 +10,66 @@ import (
 	"github.com/tedsuo/rata"
 )
 
+// CheckResourceType queues a check of the resource type and waits for it to
+// finish, like CheckResource.
 func (s *Server) CheckResourceType(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-type")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resourceName := rata.Param(r, "resource_type_name")
-
-		var reqBody atc.CheckRequestBody
-		err := json.NewDecoder(r.Body).Decode(&reqBody)
-		if err != nil {
-			logger.Info("malformed-request", lager.Data{"error": err.Error()})
-			w.WriteHeader(http.StatusBadRequest)
+		check, created := s.createResourceTypeCheck(logger, w, r, dbPipeline)
+		if !created {
 			return
 		}
 
-		dbResourceType, found, err := dbPipeline.ResourceType(resourceName)
-		if err != nil {
-			logger.Error("failed-to-get-resource-type", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
+		s.waitForCheck(logger, w, r, check)
+	})
+}
+
+// CreateResourceTypeCheck queues a check of the resource type, responding
+// with the check without waiting for it to run.
+func (s *Server) CreateResourceTypeCheck(dbPipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("create-resource-type-check")
 
-		if !found {
-			logger.Debug("resource-type-not-found", lager.Data{"resource": resourceName})
-			w.WriteHeader(http.StatusNotFound)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		check, created := s.createResourceTypeCheck(logger, w, r, dbPipeline)
+		if !created {
 			return
 		}
 
-		scanner := s.scannerFactory.NewResourceTypeScanner(dbPipeline)
-
-		err = scanner.ScanFromVersion(logger, dbResourceType.ID(), reqBody.From)
-		switch err.(type) {
-		case db.ResourceTypeNotFoundError:
-			w.WriteHeader(http.StatusNotFound)
-		case error:
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(err.Error()))
-		default:
-			w.WriteHeader(http.StatusOK)
-		}
+		s.respondWithCheck(logger, w, check)
 	})
 }
+
+func (s *Server) createResourceTypeCheck(logger lager.Logger, w http.ResponseWriter, r *http.Request, dbPipeline db.Pipeline) (db.Check, bool) {
+	resourceTypeName := rata.Param(r, "resource_type_name")
+
+	var reqBody atc.CheckRequestBody
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	dbResourceType, found, err := dbPipeline.ResourceType(resourceTypeName)
+	if err != nil {
+		logger.Error("failed-to-get-resource-type", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("resource-type-not-found", lager.Data{"resource-type": resourceTypeName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	check, err := s.checkFactory.CreateCheck(dbResourceType, reqBody.From)
+	if err != nil {
+		logger.Error("failed-to-create-check", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return check, true
+}
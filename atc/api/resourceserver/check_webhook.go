@@ -46,38 +46,61 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		go func() {
-			var fromVersion atc.Version
-			resourceConfigID := pipelineResource.ResourceConfigID()
-			resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigID)
-			if err != nil {
-				logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigID})
-				return
-			}
-
-			if found {
-				resourceConfigScope, found, err := resourceConfig.FindResourceConfigScopeByID(pipelineResource.ResourceConfigScopeID(), pipelineResource)
-				if err != nil {
-					logger.Error("failed-to-get-resource-config-scope", err, lager.Data{"resource-config-scope-id": pipelineResource.ResourceConfigScopeID()})
-					return
-				}
-
-				if found {
-					latestVersion, found, err := resourceConfigScope.LatestVersion()
-					if err != nil {
-						logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigID})
-						return
-					}
-					if found {
-						fromVersion = atc.Version(latestVersion.Version())
-					}
-				}
-			}
-
-			scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-			scanner.ScanFromVersion(logger, pipelineResource.ID(), fromVersion)
-		}()
+		err = pipelineResource.ResetCheckInterval()
+		if err != nil {
+			logger.Error("failed-to-reset-check-interval", err)
+		}
+
+		fromVersion, err := s.latestVersion(logger, pipelineResource)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_, err = s.checkFactory.CreateCheck(pipelineResource, fromVersion)
+		if err != nil {
+			logger.Error("failed-to-create-check", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+// latestVersion returns the latest version of the resource's config scope,
+// for the webhook's check to run from, if the resource has been checked yet.
+func (s *Server) latestVersion(logger lager.Logger, pipelineResource db.Resource) (atc.Version, error) {
+	resourceConfigID := pipelineResource.ResourceConfigID()
+	resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigID)
+	if err != nil {
+		logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigID})
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	resourceConfigScope, found, err := resourceConfig.FindResourceConfigScopeByID(pipelineResource.ResourceConfigScopeID(), pipelineResource)
+	if err != nil {
+		logger.Error("failed-to-get-resource-config-scope", err, lager.Data{"resource-config-scope-id": pipelineResource.ResourceConfigScopeID()})
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	latestVersion, found, err := resourceConfigScope.LatestVersion()
+	if err != nil {
+		logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigID})
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return atc.Version(latestVersion.Version()), nil
+}
@@ -0,0 +1,86 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) PinResource(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("pin-resource")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody atc.PinResourceRequestBody
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if len(reqBody.Version) == 0 {
+			logger.Info("missing-version")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var expiresAt time.Time
+		if reqBody.ExpiresIn != "" {
+			expiresIn, err := time.ParseDuration(reqBody.ExpiresIn)
+			if err != nil || expiresIn <= 0 {
+				logger.Info("invalid-expiry", lager.Data{"expires-in": reqBody.ExpiresIn})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			expiresAt = time.Now().Add(expiresIn)
+		}
+
+		resourceName := r.FormValue(":resource_name")
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		resourceConfigVersionID, found, err := resource.LatestMatchingVersionID(reqBody.Version)
+		if err != nil {
+			logger.Error("failed-to-find-resource-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-version-not-found", lager.Data{"version": reqBody.Version})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		err = resource.PinVersion(resourceConfigVersionID, accessor.GetAccessor(r).UserName(), expiresAt)
+		if err != nil {
+			logger.Error("failed-to-pin-resource-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if reqBody.PinComment != "" {
+			err = resource.SetPinComment(reqBody.PinComment)
+			if err != nil {
+				logger.Error("failed-to-set-pin-comment-on-resource", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -4,19 +4,11 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/radar"
 )
 
-//go:generate counterfeiter . ScannerFactory
-
-type ScannerFactory interface {
-	NewResourceScanner(pipeline db.Pipeline) radar.Scanner
-	NewResourceTypeScanner(dbPipeline db.Pipeline) radar.Scanner
-}
-
 type Server struct {
 	logger                lager.Logger
-	scannerFactory        ScannerFactory
+	checkFactory          db.CheckFactory
 	variablesFactory      creds.VariablesFactory
 	resourceFactory       db.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
@@ -24,14 +16,14 @@ type Server struct {
 
 func NewServer(
 	logger lager.Logger,
-	scannerFactory ScannerFactory,
+	checkFactory db.CheckFactory,
 	variablesFactory creds.VariablesFactory,
 	resourceFactory db.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 ) *Server {
 	return &Server{
 		logger:                logger,
-		scannerFactory:        scannerFactory,
+		checkFactory:          checkFactory,
 		variablesFactory:      variablesFactory,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
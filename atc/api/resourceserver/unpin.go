@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -23,7 +24,7 @@ func (s *Server) UnpinResource(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		err = resource.UnpinVersion()
+		err = resource.UnpinVersion(accessor.GetAccessor(r).UserName())
 		if err != nil {
 			logger.Error("failed-to-unpin-resource-version", err)
 			w.WriteHeader(http.StatusInternalServerError)
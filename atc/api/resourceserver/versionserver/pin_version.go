@@ -3,8 +3,10 @@ package versionserver
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -30,7 +32,7 @@ func (s *Server) PinResourceVersion(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		err = resource.PinVersion(resourceConfigVersionID)
+		err = resource.PinVersion(resourceConfigVersionID, accessor.GetAccessor(r).UserName(), time.Time{})
 		if err != nil {
 			logger.Error("failed-to-pin-resource-version", err)
 			w.WriteHeader(http.StatusInternalServerError)
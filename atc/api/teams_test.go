@@ -497,6 +497,206 @@ var _ = Describe("Teams API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/resource_types", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/resource_types", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.ResourceTypesReturns(atc.ResourceTypes{
+						{
+							Name:     "some-type",
+							Type:     "registry-image",
+							Source:   atc.Source{"repository": "some-repository"},
+							Defaults: atc.Source{"some": "default"},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the team's resource types", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-type",
+							"type": "registry-image",
+							"source": {"repository": "some-repository"},
+							"privileged": false,
+							"unique_version_history": false,
+							"defaults": {"some": "default"}
+						}
+					]`))
+				})
+
+				Context("when getting the resource types fails", func() {
+					BeforeEach(func() {
+						fakeTeam.ResourceTypesReturns(nil, errors.New("disaster"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the team is not found", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/resource_types", func() {
+		var (
+			response      *http.Response
+			resourceTypes atc.ResourceTypes
+		)
+
+		BeforeEach(func() {
+			resourceTypes = atc.ResourceTypes{
+				{
+					Name:     "some-type",
+					Type:     "registry-image",
+					Source:   atc.Source{"repository": "some-repository"},
+					Defaults: atc.Source{"some": "default"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/resource_types", jsonEncode(resourceTypes))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("returns 204 No Content", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("saves the team's resource types", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+					Expect(fakeTeam.SetResourceTypesCallCount()).To(Equal(1))
+					Expect(fakeTeam.SetResourceTypesArgsForCall(0)).To(Equal(resourceTypes))
+				})
+
+				Context("when the resource types are invalid", func() {
+					BeforeEach(func() {
+						resourceTypes = append(resourceTypes, atc.ResourceType{Name: "some-type", Type: "other-type"})
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("returns the errors", func() {
+						var payload atc.SaveConfigResponse
+						err := json.NewDecoder(response.Body).Decode(&payload)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(payload.Errors).To(HaveLen(1))
+						Expect(payload.Errors[0]).To(ContainSubstring("some-type"))
+					})
+
+					It("does not save them", func() {
+						Expect(fakeTeam.SetResourceTypesCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when saving the resource types fails", func() {
+					BeforeEach(func() {
+						fakeTeam.SetResourceTypesReturns(errors.New("disaster"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.SetResourceTypesCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/builds", func() {
 		var (
 			response    *http.Response
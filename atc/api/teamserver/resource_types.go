@@ -0,0 +1,104 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+// GetTeamResourceTypes returns the resource types shared by every pipeline of
+// the team
+func (s *Server) GetTeamResourceTypes(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-team-resource-types")
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	resourceTypes, err := team.ResourceTypes()
+	if err != nil {
+		logger.Error("failed-to-get-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(resourceTypes)
+	if err != nil {
+		logger.Error("failed-to-encode-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// SetTeamResourceTypes replaces the resource types shared by every pipeline of
+// the team
+func (s *Server) SetTeamResourceTypes(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("set-team-resource-types")
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var resourceTypes atc.ResourceTypes
+	err := json.NewDecoder(r.Body).Decode(&resourceTypes)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if errorMessages := resourceTypes.Validate(); len(errorMessages) > 0 {
+		logger.Info("invalid-resource-types", lager.Data{"errors": errorMessages})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		json.NewEncoder(w).Encode(atc.SaveConfigResponse{Errors: errorMessages})
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.SetResourceTypes(resourceTypes)
+	if err != nil {
+		logger.Error("failed-to-set-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
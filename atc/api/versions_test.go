@@ -23,7 +23,7 @@ var _ = Describe("Versions API", func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		fakeaccess = new(accessorfakes.FakeAccess)
 		dbTeamFactory.FindTeamReturns(dbTeam, true, nil)
-		dbTeam.PipelineReturns(fakePipeline, true, nil)
+		dbTeam.PipelineInstanceReturns(fakePipeline, true, nil)
 	})
 
 	JustBeforeEach(func() {
@@ -625,6 +625,7 @@ var _ = Describe("Versions API", func() {
 			Context("when authorized", func() {
 				BeforeEach(func() {
 					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.UserNameReturns("some-user")
 				})
 
 				It("tries to find the resource", func() {
@@ -640,10 +641,16 @@ var _ = Describe("Versions API", func() {
 					})
 
 					It("tries to pin the right resource config version", func() {
-						resourceConfigVersionID := fakeResource.PinVersionArgsForCall(0)
+						resourceConfigVersionID, _, _ := fakeResource.PinVersionArgsForCall(0)
 						Expect(resourceConfigVersionID).To(Equal(42))
 					})
 
+					It("records who pinned it, without an expiry", func() {
+						_, pinnedBy, expiresAt := fakeResource.PinVersionArgsForCall(0)
+						Expect(pinnedBy).To(Equal("some-user"))
+						Expect(expiresAt).To(BeZero())
+					})
+
 					Context("when pinning the resource succeeds", func() {
 						BeforeEach(func() {
 							fakeResource.PinVersionReturns(nil)
@@ -0,0 +1,348 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks API", func() {
+	var (
+		fakeaccess *accessorfakes.FakeAccess
+		response   *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+		fakeAccessor.CreateReturns(fakeaccess)
+
+		dbTeam.NameReturns("a-team")
+	})
+
+	Describe("POST /api/v1/teams/:team_name/webhooks/:provider", func() {
+		var (
+			provider  string
+			payload   string
+			signature string
+
+			matchingPipe   *dbfakes.FakePipeline
+			pausedPipe     *dbfakes.FakePipeline
+			matchingRes    *dbfakes.FakeResource
+			otherBranchRes *dbfakes.FakeResource
+			otherRepoRes   *dbfakes.FakeResource
+			pausedPipeRes  *dbfakes.FakeResource
+		)
+
+		sign := func(body string, secret string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(body))
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		newResource := func(id int, name string, source atc.Source) *dbfakes.FakeResource {
+			resource := new(dbfakes.FakeResource)
+			resource.IDReturns(id)
+			resource.NameReturns(name)
+			resource.TypeReturns("git")
+			resource.SourceReturns(source)
+			return resource
+		}
+
+		BeforeEach(func() {
+			provider = "github"
+			payload = `{
+				"ref": "refs/heads/master",
+				"repository": {
+					"full_name": "concourse/concourse",
+					"clone_url": "https://github.com/concourse/concourse.git"
+				}
+			}`
+			signature = sign(payload, "some-webhook-secret")
+
+			fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{})
+
+			matchingRes = newResource(1, "some-repo", atc.Source{"uri": "git@github.com:concourse/concourse.git", "branch": "master"})
+			otherBranchRes = newResource(2, "some-release-repo", atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "release"})
+			otherRepoRes = newResource(3, "some-other-repo", atc.Source{"uri": "https://github.com/concourse/fly"})
+
+			matchingPipe = new(dbfakes.FakePipeline)
+			matchingPipe.NameReturns("some-pipeline")
+			matchingPipe.ResourcesReturns(db.Resources{matchingRes, otherBranchRes, otherRepoRes}, nil)
+
+			pausedPipeRes = newResource(4, "some-repo", atc.Source{"uri": "https://github.com/concourse/concourse"})
+
+			pausedPipe = new(dbfakes.FakePipeline)
+			pausedPipe.NameReturns("paused-pipeline")
+			pausedPipe.PausedReturns(true)
+			pausedPipe.ResourcesReturns(db.Resources{pausedPipeRes}, nil)
+
+			dbTeam.PipelinesReturns([]db.Pipeline{matchingPipe, pausedPipe}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhooks/"+provider, bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-GitHub-Event", "push")
+			request.Header.Set("X-GitHub-Delivery", "some-delivery")
+			request.Header.Set("X-Hub-Signature-256", signature)
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the payload is signed with the global secret", func() {
+			It("returns 200 without requiring authentication", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(fakeaccess.IsAuthenticatedCallCount()).To(BeZero())
+			})
+
+			It("returns the matched resources", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{"pipeline_name": "some-pipeline", "resource_name": "some-repo"}
+				]`))
+			})
+
+			It("queues checks of the matched resources", func() {
+				Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+
+				checkable, fromVersion := dbCheckFactory.CreateCheckArgsForCall(0)
+				Expect(checkable).To(Equal(matchingRes))
+				Expect(fromVersion).To(BeNil())
+			})
+
+			It("resets the check intervals of the matched resources", func() {
+				Expect(matchingRes.ResetCheckIntervalCallCount()).To(Equal(1))
+			})
+
+			It("records the delivery", func() {
+				Expect(dbTeam.RecordWebhookDeliveryCallCount()).To(Equal(1))
+				Expect(dbTeam.RecordWebhookDeliveryArgsForCall(0)).To(Equal(db.WebhookDelivery{
+					Provider:   "github",
+					Event:      "push",
+					DeliveryID: "some-delivery",
+					Matched: []db.WebhookMatch{
+						{PipelineName: "some-pipeline", ResourceName: "some-repo"},
+					},
+				}))
+			})
+		})
+
+		Context("when a resource's source uses vars", func() {
+			BeforeEach(func() {
+				fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{
+					"repo-uri": "https://github.com/concourse/concourse.git",
+				})
+
+				matchingRes.SourceReturns(atc.Source{"uri": "((repo-uri))", "branch": "master"})
+				otherRepoRes.SourceReturns(atc.Source{"uri": "((missing-var))"})
+			})
+
+			It("matches the interpolated source", func() {
+				Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+
+				checkable, _ := dbCheckFactory.CreateCheckArgsForCall(0)
+				Expect(checkable).To(Equal(matchingRes))
+			})
+
+			It("looks up vars at the pipeline level", func() {
+				teamName, pipelineName := fakeVariablesFactory.NewVariablesArgsForCall(1)
+				Expect(teamName).To(Equal("a-team"))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+			})
+		})
+
+		Context("when the team configures its own secret", func() {
+			BeforeEach(func() {
+				fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{
+					"webhook_secret": "team-secret",
+				})
+			})
+
+			It("looks up the secret at the team level", func() {
+				teamName, pipelineName := fakeVariablesFactory.NewVariablesArgsForCall(0)
+				Expect(teamName).To(Equal("a-team"))
+				Expect(pipelineName).To(BeEmpty())
+			})
+
+			It("rejects payloads signed with the global secret", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			Context("when the payload is signed with the team's secret", func() {
+				BeforeEach(func() {
+					signature = sign(payload, "team-secret")
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when the signature is invalid", func() {
+			BeforeEach(func() {
+				signature = sign(payload, "wrong-secret")
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not check anything", func() {
+				Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
+			})
+
+			It("does not record the delivery", func() {
+				Expect(dbTeam.RecordWebhookDeliveryCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				payload = "not-json"
+				signature = sign(payload, "some-webhook-secret")
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the provider is unknown", func() {
+			BeforeEach(func() {
+				provider = "svn"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the team is not found", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when listing the pipelines fails", func() {
+			BeforeEach(func() {
+				dbTeam.PipelinesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/webhooks/deliveries", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/webhooks/deliveries?limit=10", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				dbTeam.WebhookDeliveriesReturns([]db.WebhookDelivery{
+					{
+						ID:         2,
+						Provider:   "github",
+						Event:      "push",
+						DeliveryID: "some-delivery",
+						Matched: []db.WebhookMatch{
+							{PipelineName: "some-pipeline", ResourceName: "some-repo"},
+						},
+						ReceivedAt: time.Unix(2, 0),
+					},
+					{
+						ID:         1,
+						Provider:   "github",
+						Error:      "invalid webhook signature",
+						ReceivedAt: time.Unix(1, 0),
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("passes along the limit", func() {
+				Expect(dbTeam.WebhookDeliveriesArgsForCall(0)).To(Equal(10))
+			})
+
+			It("returns the deliveries", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 2,
+						"provider": "github",
+						"event": "push",
+						"delivery_id": "some-delivery",
+						"matched": [{"pipeline_name": "some-pipeline", "resource_name": "some-repo"}],
+						"received_at": 2
+					},
+					{
+						"id": 1,
+						"provider": "github",
+						"event": "",
+						"matched": [],
+						"error": "invalid webhook signature",
+						"received_at": 1
+					}
+				]`))
+			})
+
+			Context("when finding the deliveries fails", func() {
+				BeforeEach(func() {
+					dbTeam.WebhookDeliveriesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWebhookDeliveries(team db.Team) http.Handler {
+	logger := s.logger.Session("list-webhook-deliveries")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		deliveries, err := team.WebhookDeliveries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := []atc.WebhookDelivery{}
+		for _, delivery := range deliveries {
+			presented = append(presented, present.WebhookDelivery(delivery))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhook"
+	"github.com/tedsuo/rata"
+)
+
+// SecretVar is the team-level var from which the secret used to verify
+// payloads is read.
+const SecretVar = "webhook_secret"
+
+const maxPayloadSize = 25 * 1024 * 1024
+
+var errNoSecret = errors.New("no webhook secret configured")
+
+// ReceiveWebhook verifies a payload sent by a code hosting provider and
+// queues a check for every resource in the team which it matches.
+//
+// The endpoint is unauthenticated; the payload's signature is what
+// authorizes the request, and nothing is written until it is verified.
+func (s *Server) ReceiveWebhook(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("receive-webhook")
+
+	teamName := rata.Param(r, "team_name")
+	providerName := rata.Param(r, "provider")
+
+	provider, found := webhook.LookupProvider(providerName)
+	if !found {
+		logger.Info("unknown-provider", lager.Data{"provider": providerName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err, lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger = logger.WithData(lager.Data{"team": teamName, "provider": providerName})
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		logger.Error("failed-to-read-body", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	secret, err := s.secretFor(team)
+	if err != nil {
+		logger.Error("failed-to-get-secret", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if secret == "" {
+		err = errNoSecret
+	} else {
+		err = provider.Verify(r, body, secret)
+	}
+
+	if err != nil {
+		// unverified payloads are not recorded, so that unauthenticated
+		// requests can't write to the database
+		logger.Info("rejected-delivery", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	delivery := db.WebhookDelivery{
+		Provider: providerName,
+	}
+
+	event, err := provider.Parse(r, body)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		delivery.Error = fmt.Sprintf("malformed payload: %s", err)
+		s.record(logger, team, delivery)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	delivery.Event = event.Type
+	delivery.DeliveryID = event.DeliveryID
+
+	matches, err := s.checkMatchingResources(logger, team, event)
+	if err != nil {
+		logger.Error("failed-to-match-resources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	delivery.Matched = matches
+	s.record(logger, team, delivery)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(present.WebhookMatches(matches))
+	if err != nil {
+		logger.Error("failed-to-encode-matches", err)
+	}
+}
+
+func (s *Server) secretFor(team db.Team) (string, error) {
+	variables := s.variablesFactory.NewVariables(team.Name(), "")
+
+	secret, found, err := variables.Get(boshtemplate.VariableDefinition{Name: SecretVar})
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return s.secret, nil
+	}
+
+	return fmt.Sprintf("%v", secret), nil
+}
+
+func (s *Server) checkMatchingResources(logger lager.Logger, team db.Team, event webhook.Event) ([]db.WebhookMatch, error) {
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []db.WebhookMatch{}
+	for _, pipeline := range pipelines {
+		if pipeline.Paused() {
+			continue
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			return nil, err
+		}
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			return nil, err
+		}
+
+		versionedResourceTypes := resourceTypes.Deserialize()
+		variables := s.variablesFactory.NewVariables(team.Name(), pipeline.Name())
+
+		for _, resource := range resources {
+			source, err := creds.NewSource(
+				variables,
+				versionedResourceTypes.SourceWithDefaults(resource.Type(), resource.Source()),
+			).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-source", err, lager.Data{
+					"pipeline": pipeline.Name(),
+					"resource": resource.Name(),
+				})
+				continue
+			}
+
+			if !s.matcher.Match(event, resource.Type(), source) {
+				continue
+			}
+
+			matches = append(matches, db.WebhookMatch{
+				PipelineName: pipeline.Name(),
+				ResourceName: resource.Name(),
+			})
+
+			err = resource.ResetCheckInterval()
+			if err != nil {
+				logger.Error("failed-to-reset-check-interval", err, lager.Data{
+					"pipeline": pipeline.Name(),
+					"resource": resource.Name(),
+				})
+			}
+
+			_, err = s.checkFactory.CreateCheck(resource, nil)
+			if err != nil {
+				logger.Error("failed-to-create-check", err, lager.Data{
+					"pipeline": pipeline.Name(),
+					"resource": resource.Name(),
+				})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func (s *Server) record(logger lager.Logger, team db.Team, delivery db.WebhookDelivery) {
+	err := team.RecordWebhookDelivery(delivery)
+	if err != nil {
+		logger.Error("failed-to-record-delivery", err)
+	}
+}
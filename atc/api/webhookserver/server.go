@@ -0,0 +1,35 @@
+package webhookserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhook"
+)
+
+type Server struct {
+	logger           lager.Logger
+	teamFactory      db.TeamFactory
+	checkFactory     db.CheckFactory
+	variablesFactory creds.VariablesFactory
+	matcher          webhook.Matcher
+	secret           string
+}
+
+func NewServer(
+	logger lager.Logger,
+	teamFactory db.TeamFactory,
+	checkFactory db.CheckFactory,
+	variablesFactory creds.VariablesFactory,
+	matcher webhook.Matcher,
+	secret string,
+) *Server {
+	return &Server{
+		logger:           logger,
+		teamFactory:      teamFactory,
+		checkFactory:     checkFactory,
+		variablesFactory: variablesFactory,
+		matcher:          matcher,
+		secret:           secret,
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lockrunner"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/pipelines"
@@ -40,6 +41,7 @@ import (
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -101,12 +103,23 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	WebhookSecret string `long:"webhook-secret" description:"Secret used to verify payloads sent to team webhook endpoints, for teams which do not configure their own 'webhook_secret' var."`
+
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
 
+	AdaptiveCheckIntervals   bool          `long:"adaptive-check-intervals" description:"Back off checking of failing resources, check inactive resources less often, and add jitter so that checks don't align. Webhooks reset a resource's interval."`
+	CheckIntervalJitter      float64       `long:"check-interval-jitter" default:"0.1" description:"Fraction of a check interval to spread checks out by, when adaptive check intervals are enabled."`
+	MaxCheckInterval         time.Duration `long:"max-check-interval" default:"1h" description:"Longest interval that adaptive check intervals will lengthen to."`
+	CheckInactivityThreshold time.Duration `long:"check-inactivity-threshold" default:"24h" description:"Lengthen the check interval of resources by their configured interval for every period of this length without a new version, when adaptive check intervals are enabled."`
+
+	LidarScannerInterval   time.Duration `long:"lidar-scanner-interval" default:"1m" description:"Interval on which to queue checks for resources and resource types whose check interval has elapsed."`
+	LidarCheckerInterval   time.Duration `long:"lidar-checker-interval" default:"10s" description:"Interval on which to look for queued checks to run, in addition to being notified when they are queued."`
+	LidarMaxChecksInFlight int           `long:"lidar-max-checks-in-flight" default:"32" description:"Maximum number of checks to run at once on each ATC."`
+
 	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" description:"Method by which a worker is selected during container placement."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
@@ -128,6 +141,8 @@ type RunCommand struct {
 		CaptureErrorMetrics bool              `long:"capture-error-metrics" description:"Enable capturing of error log metrics"`
 	} `group:"Metrics & Diagnostics"`
 
+	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" description:"The value to set for X-Frame-Options. If omitted, the header is not set."`
 	} `group:"Web Server"`
@@ -139,6 +154,9 @@ type RunCommand struct {
 
 		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
 		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"6h" description:"Period after which to remove finished checks."`
+		CheckHistoryLimit      int           `long:"check-history-limit" default:"10" description:"Number of finished checks to keep, along with their output, for each resource config scope."`
+		VersionHistoryLimit    int           `long:"version-history-limit" default:"0" description:"Number of versions to keep for resources which don't configure a version_history. Pinned versions and versions used by running builds or by as many of each job's most recent builds are always kept. 0 keeps every version."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -369,6 +387,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := tracing.Initialize(logger.Session("tracing"), cmd.Tracing); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -538,19 +560,6 @@ func (cmd *RunCommand) constructAPIMembers(
 	pool := worker.NewPool(workerProvider)
 	workerClient := worker.NewClient(pool, workerProvider)
 
-	checkContainerStrategy := worker.NewRandomPlacementStrategy()
-
-	radarScannerFactory := radar.NewScannerFactory(
-		pool,
-		resourceFactory,
-		dbResourceConfigFactory,
-		cmd.ResourceTypeCheckingInterval,
-		cmd.ResourceCheckingInterval,
-		cmd.ExternalURL.String(),
-		variablesFactory,
-		checkContainerStrategy,
-	)
-
 	drain := make(chan struct{})
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -559,6 +568,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
 	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
 
 	apiHandler, err := cmd.constructAPIHandler(
@@ -574,9 +584,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
 		workerClient,
 		drain,
-		radarScannerFactory,
 		variablesFactory,
 		credsManagers,
 		accessFactory,
@@ -739,12 +749,17 @@ func (cmd *RunCommand) constructBackendMembers(
 	)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
+		engine,
+	)
+	radarScannerFactory := radar.NewScannerFactory(
 		pool,
 		resourceFactory,
 		dbResourceConfigFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
-		engine,
+		cmd.checkIntervalPolicy(),
+		cmd.ExternalURL.String(),
+		variablesFactory,
 		checkContainerStrategy,
 	)
 	dbWorkerLifecycle := db.NewWorkerLifecycle(dbConn)
@@ -753,6 +768,10 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbArtifactLifecycle := db.NewArtifactLifecycle(dbConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(dbConn)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
+	dbCheckLifecycle := db.NewCheckLifecycle(dbConn)
+	dbResourceConfigVersionLifecycle := db.NewResourceConfigVersionLifecycle(dbConn)
+	dbResourcePinLifecycle := db.NewResourcePinLifecycle(dbConn)
 	bus := dbConn.Bus()
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	members := []grouper.Member{
@@ -771,8 +790,6 @@ func (cmd *RunCommand) constructBackendMembers(
 				logger.Session("pipelines"),
 				dbPipelineFactory,
 				radarSchedulerFactory,
-				variablesFactory,
-				bus,
 			),
 			Interval: 10 * time.Second,
 			Clock:    clock.NewClock(),
@@ -837,6 +854,67 @@ func (cmd *RunCommand) constructBackendMembers(
 			clock.NewClock(),
 			30*time.Second,
 		)},
+		{Name: "check-collector", Runner: lockrunner.NewRunner(
+			logger.Session("check-collector"),
+			gc.NewCheckCollector(
+				dbCheckLifecycle,
+				cmd.GC.CheckRecyclePeriod,
+				cmd.GC.CheckHistoryLimit,
+				cmd.GlobalResourceCheckTimeout,
+			),
+			"check-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+		{Name: "version-collector", Runner: lockrunner.NewRunner(
+			logger.Session("version-collector"),
+			gc.NewResourceConfigVersionCollector(
+				dbPipelineFactory,
+				dbResourceConfigVersionLifecycle,
+				cmd.GC.VersionHistoryLimit,
+			),
+			"version-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
+		{Name: "pin-expirer", Runner: lockrunner.NewRunner(
+			logger.Session("pin-expirer"),
+			gc.NewResourcePinCollector(dbResourcePinLifecycle),
+			"pin-expirer",
+			lockFactory,
+			clock.NewClock(),
+			time.Minute,
+		)},
+	}
+
+	if !cmd.Developer.Noop {
+		members = append(members,
+			grouper.Member{Name: "lidar-scanner", Runner: lockrunner.NewRunner(
+				logger.Session("lidar-scanner"),
+				lidar.NewScanner(
+					dbPipelineFactory,
+					dbCheckFactory,
+					cmd.ResourceCheckingInterval,
+					cmd.ResourceTypeCheckingInterval,
+					cmd.checkIntervalPolicy(),
+				),
+				"lidar-scanner",
+				lockFactory,
+				clock.NewClock(),
+				cmd.LidarScannerInterval,
+			)},
+			grouper.Member{Name: "lidar-checker", Runner: lidar.NewChecker(
+				logger.Session("lidar-checker"),
+				dbCheckFactory,
+				radarScannerFactory,
+				bus,
+				clock.NewClock(),
+				cmd.LidarCheckerInterval,
+				cmd.LidarMaxChecksInFlight,
+			)},
+		)
 	}
 
 	//Syslog Drainer Configuration
@@ -1085,6 +1163,13 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.CheckIntervalJitter < 0 || cmd.CheckIntervalJitter > 1 {
+		errs = multierror.Append(
+			errs,
+			errors.New("--check-interval-jitter must be between 0 and 1"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1153,6 +1238,15 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
+func (cmd *RunCommand) checkIntervalPolicy() radar.CheckIntervalPolicy {
+	return radar.CheckIntervalPolicy{
+		Adaptive:            cmd.AdaptiveCheckIntervals,
+		Jitter:              cmd.CheckIntervalJitter,
+		MaxInterval:         cmd.MaxCheckInterval,
+		InactivityThreshold: cmd.CheckInactivityThreshold,
+	}
+}
+
 func (cmd *RunCommand) chooseBuildContainerStrategy() worker.ContainerPlacementStrategy {
 	var strategy worker.ContainerPlacementStrategy
 	switch cmd.ContainerPlacementStrategy {
@@ -1201,6 +1295,8 @@ func (cmd *RunCommand) constructEngine(
 	strategy worker.ContainerPlacementStrategy,
 	resourceFactory resource.ResourceFactory,
 ) engine.Engine {
+	secretLookups := exec.NewBuildSecretLookups()
+
 	gardenFactory := exec.NewGardenFactory(
 		workerPool,
 		workerClient,
@@ -1211,11 +1307,13 @@ func (cmd *RunCommand) constructEngine(
 		defaultLimits,
 		strategy,
 		resourceFactory,
+		secretLookups,
 	)
 
 	execV2Engine := engine.NewExecEngine(
 		gardenFactory,
 		engine.NewBuildDelegateFactory(),
+		secretLookups,
 		cmd.ExternalURL.String(),
 	)
 
@@ -1268,9 +1366,9 @@ func (cmd *RunCommand) constructAPIHandler(
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 	workerClient worker.Client,
 	drain <-chan struct{},
-	radarScannerFactory radar.ScannerFactory,
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
@@ -1308,12 +1406,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		resourceConfigFactory,
+		dbCheckFactory,
 
 		buildserver.NewEventHandler,
 		drain,
 
 		workerClient,
-		radarScannerFactory,
 
 		reconfigurableSink,
 
@@ -1325,6 +1423,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		variablesFactory,
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
+		cmd.WebhookSecret,
 	)
 }
 
@@ -1353,28 +1452,12 @@ func (cmd *RunCommand) constructPipelineSyncer(
 	logger lager.Logger,
 	pipelineFactory db.PipelineFactory,
 	radarSchedulerFactory pipelines.RadarSchedulerFactory,
-	variablesFactory creds.VariablesFactory,
-	bus db.NotificationsBus,
 ) *pipelines.Syncer {
 	return pipelines.NewSyncer(
 		logger,
 		pipelineFactory,
 		func(pipeline db.Pipeline) ifrit.Runner {
-			variables := variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
-				{
-					Name: fmt.Sprintf("radar:%d", pipeline.ID()),
-					Runner: radar.NewRunner(
-						logger.Session("radar").WithData(lager.Data{
-							"team":     pipeline.TeamName(),
-							"pipeline": pipeline.Name(),
-						}),
-						cmd.Developer.Noop,
-						radarSchedulerFactory.BuildScanRunnerFactory(pipeline, cmd.ExternalURL.String(), variables, bus),
-						pipeline,
-						1*time.Minute,
-					),
-				},
 				{
 					Name: fmt.Sprintf("scheduler:%d", pipeline.ID()),
 					Runner: &scheduler.Runner{
@@ -22,6 +22,15 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+
+	RerunOf *RerunOfBuild `json:"rerun_of,omitempty"`
+}
+
+// RerunOfBuild identifies the build which a build re-ran with the same input
+// versions.
+type RerunOfBuild struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 func (b Build) IsRunning() bool {
@@ -23,3 +23,29 @@ type ResourceVersion struct {
 	Version  Version         `json:"version"`
 	Enabled  bool            `json:"enabled"`
 }
+
+// BuildInputDiff lists the inputs whose versions differ between two builds of
+// the same job. Inputs only present in one of the builds have no From or To.
+type BuildInputDiff struct {
+	FromBuild string             `json:"from_build"`
+	ToBuild   string             `json:"to_build"`
+	Changes   []BuildInputChange `json:"changes"`
+}
+
+type BuildInputChange struct {
+	Name     string           `json:"name"`
+	Resource string           `json:"resource"`
+	From     *ResourceVersion `json:"from,omitempty"`
+	To       *ResourceVersion `json:"to,omitempty"`
+}
+
+// VersionProvenance is a version which flowed into a build, either directly
+// as one of its inputs (depth 0) or as an input to an upstream build that
+// satisfied one of its passed constraints.
+type VersionProvenance struct {
+	Resource string          `json:"resource"`
+	Version  ResourceVersion `json:"version"`
+	JobName  string          `json:"job_name"`
+	Build    string          `json:"build_name"`
+	Depth    int             `json:"depth"`
+}
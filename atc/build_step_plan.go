@@ -0,0 +1,126 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BuildStepPlan is a task extracted from a build's plan along with the gets
+// for the artifacts it may use, pinned to the versions the build fetched, so
+// that the task can be re-run as a one-off build.
+type BuildStepPlan struct {
+	Inputs []Plan `json:"inputs"`
+	Step   Plan   `json:"step"`
+}
+
+var ErrStepNotFound = errors.New("step not found")
+
+type AmbiguousStepError struct {
+	Names []string
+}
+
+func (err AmbiguousStepError) Error() string {
+	return fmt.Sprintf("build has more than one task, specify one of: %s", strings.Join(err.Names, ", "))
+}
+
+// ExtractStepPlan finds the named task in the plan, or the only task if no
+// name is given. Gets whose version came from a put are pinned to the version
+// recorded for the put's output, and are left out if there isn't one.
+func ExtractStepPlan(plan Plan, stepName string, outputs map[string]Version) (BuildStepPlan, error) {
+	var (
+		task      *Plan
+		taskNames []string
+		gets      = map[string]Plan{}
+		getOrder  []string
+	)
+
+	plan.Each(func(step *Plan) {
+		if task != nil && stepName != "" {
+			return
+		}
+
+		switch {
+		case step.Task != nil:
+			taskNames = append(taskNames, step.Task.Name)
+
+			if task == nil && (stepName == "" || step.Task.Name == stepName) {
+				found := *step
+				task = &found
+			}
+
+		case step.Get != nil:
+			if task != nil {
+				return
+			}
+
+			get := *step
+			if get.Get.VersionFrom != nil {
+				version, found := outputs[get.Get.Name]
+				if !found {
+					return
+				}
+
+				getPlan := *get.Get
+				getPlan.Version = &version
+				getPlan.VersionFrom = nil
+				get.Get = &getPlan
+			}
+
+			if _, found := gets[get.Get.Name]; !found {
+				getOrder = append(getOrder, get.Get.Name)
+			}
+
+			gets[get.Get.Name] = get
+		}
+	})
+
+	if task == nil {
+		return BuildStepPlan{}, ErrStepNotFound
+	}
+
+	if stepName == "" && len(taskNames) > 1 {
+		sort.Strings(taskNames)
+		return BuildStepPlan{}, AmbiguousStepError{Names: taskNames}
+	}
+
+	used := taskArtifacts(*task.Task)
+
+	stepPlan := BuildStepPlan{
+		Inputs: []Plan{},
+		Step:   *task,
+	}
+
+	for _, name := range getOrder {
+		if used == nil || used[name] {
+			stepPlan.Inputs = append(stepPlan.Inputs, gets[name])
+		}
+	}
+
+	return stepPlan, nil
+}
+
+// taskArtifacts returns the names of the artifacts the task uses, or nil if
+// they can't be known without loading the task's config from a file.
+func taskArtifacts(task TaskPlan) map[string]bool {
+	if task.Config == nil || task.ConfigPath != "" {
+		return nil
+	}
+
+	artifacts := map[string]bool{}
+	for _, input := range task.Config.Inputs {
+		name := input.Name
+		if mapped, found := task.InputMapping[name]; found {
+			name = mapped
+		}
+
+		artifacts[name] = true
+	}
+
+	if task.ImageArtifactName != "" {
+		artifacts[task.ImageArtifactName] = true
+	}
+
+	return artifacts
+}
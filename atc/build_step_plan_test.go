@@ -0,0 +1,145 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExtractStepPlan", func() {
+	var (
+		plan    atc.Plan
+		outputs map[string]atc.Version
+
+		putID atc.PlanID
+	)
+
+	BeforeEach(func() {
+		putID = "3"
+
+		plan = atc.Plan{
+			ID: "0",
+			Do: &atc.DoPlan{
+				{
+					ID: "1",
+					Aggregate: &atc.AggregatePlan{
+						{
+							ID: "2",
+							Get: &atc.GetPlan{
+								Name:     "repo",
+								Resource: "some-repo",
+								Version:  &atc.Version{"ref": "abc"},
+							},
+						},
+					},
+				},
+				{
+					ID: "4",
+					OnSuccess: &atc.OnSuccessPlan{
+						Step: atc.Plan{
+							ID:  putID,
+							Put: &atc.PutPlan{Name: "image", Resource: "some-image"},
+						},
+						Next: atc.Plan{
+							ID: "5",
+							Get: &atc.GetPlan{
+								Name:        "image",
+								Resource:    "some-image",
+								VersionFrom: &putID,
+							},
+						},
+					},
+				},
+				{
+					ID: "6",
+					Task: &atc.TaskPlan{
+						Name: "unit",
+						Config: &atc.TaskConfig{
+							Inputs: []atc.TaskInputConfig{{Name: "source"}},
+						},
+						InputMapping:      map[string]string{"source": "repo"},
+						ImageArtifactName: "image",
+					},
+				},
+				{
+					ID: "7",
+					Task: &atc.TaskPlan{
+						Name:       "integration",
+						ConfigPath: "repo/ci/integration.yml",
+					},
+				},
+			},
+		}
+
+		outputs = map[string]atc.Version{
+			"image": {"digest": "sha256:def"},
+		}
+	})
+
+	It("returns the named task with the gets for the artifacts it uses", func() {
+		stepPlan, err := atc.ExtractStepPlan(plan, "unit", outputs)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stepPlan.Step.ID).To(Equal(atc.PlanID("6")))
+		Expect(stepPlan.Step.Task.Name).To(Equal("unit"))
+
+		Expect(stepPlan.Inputs).To(HaveLen(2))
+		Expect(stepPlan.Inputs[0].Get.Name).To(Equal("repo"))
+		Expect(stepPlan.Inputs[0].Get.Version).To(Equal(&atc.Version{"ref": "abc"}))
+	})
+
+	It("pins gets of put versions to the version the put produced", func() {
+		stepPlan, err := atc.ExtractStepPlan(plan, "unit", outputs)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stepPlan.Inputs[1].Get.Name).To(Equal("image"))
+		Expect(stepPlan.Inputs[1].Get.Version).To(Equal(&atc.Version{"digest": "sha256:def"}))
+		Expect(stepPlan.Inputs[1].Get.VersionFrom).To(BeNil())
+	})
+
+	Context("when the put's version was not recorded", func() {
+		BeforeEach(func() {
+			outputs = nil
+		})
+
+		It("leaves out the get", func() {
+			stepPlan, err := atc.ExtractStepPlan(plan, "unit", outputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stepPlan.Inputs).To(HaveLen(1))
+			Expect(stepPlan.Inputs[0].Get.Name).To(Equal("repo"))
+		})
+	})
+
+	Context("when the task loads its config from a file", func() {
+		It("returns every get which ran before the task", func() {
+			stepPlan, err := atc.ExtractStepPlan(plan, "integration", outputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stepPlan.Step.Task.ConfigPath).To(Equal("repo/ci/integration.yml"))
+			Expect(stepPlan.Inputs).To(HaveLen(2))
+		})
+	})
+
+	Context("when the task does not exist", func() {
+		It("returns ErrStepNotFound", func() {
+			_, err := atc.ExtractStepPlan(plan, "bogus", outputs)
+			Expect(err).To(Equal(atc.ErrStepNotFound))
+		})
+	})
+
+	Context("when no task is named", func() {
+		It("returns an error listing the tasks if there are several", func() {
+			_, err := atc.ExtractStepPlan(plan, "", outputs)
+			Expect(err).To(Equal(atc.AmbiguousStepError{Names: []string{"integration", "unit"}}))
+		})
+
+		It("returns the task if there is only one", func() {
+			*plan.Do = (*plan.Do)[:3]
+
+			stepPlan, err := atc.ExtractStepPlan(plan, "", outputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stepPlan.Step.Task.Name).To(Equal("unit"))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package atc
+
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusStarted   CheckStatus = "started"
+	CheckStatusSucceeded CheckStatus = "succeeded"
+	CheckStatusErrored   CheckStatus = "errored"
+
+	// CheckStatusSkipped is given to checks which didn't run, as their
+	// resource config was already being checked, or was checked recently
+	// enough through another resource or resource type sharing it.
+	CheckStatusSkipped CheckStatus = "skipped"
+)
+
+// CheckPlan describes what a queued check will run. The source is not
+// included as it is interpolated with credentials when the check starts.
+type CheckPlan struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Tags        Tags    `json:"tags,omitempty"`
+	Timeout     string  `json:"timeout,omitempty"`
+	FromVersion Version `json:"from_version,omitempty"`
+}
+
+type Check struct {
+	ID                int         `json:"id"`
+	TeamName          string      `json:"team_name"`
+	PipelineName      string      `json:"pipeline_name"`
+	ResourceName      string      `json:"resource_name,omitempty"`
+	ResourceTypeName  string      `json:"resource_type_name,omitempty"`
+	Status            CheckStatus `json:"status"`
+	ManuallyTriggered bool        `json:"manually_triggered"`
+	Plan              CheckPlan   `json:"plan"`
+	CreateTime        int64       `json:"create_time,omitempty"`
+	StartTime         int64       `json:"start_time,omitempty"`
+	EndTime           int64       `json:"end_time,omitempty"`
+	CheckError        string      `json:"check_error,omitempty"`
+	Output            string      `json:"output,omitempty"`
+}
+
+func (c Check) IsRunning() bool {
+	switch c.Status {
+	case CheckStatusPending, CheckStatusStarted:
+		return true
+	default:
+		return false
+	}
+}
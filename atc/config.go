@@ -18,6 +18,8 @@ type Config struct {
 	Resources     ResourceConfigs `yaml:"resources" json:"resources" mapstructure:"resources"`
 	ResourceTypes ResourceTypes   `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
 	Jobs          JobConfigs      `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+
+	Labels map[string]string `yaml:"labels,omitempty" json:"labels,omitempty" mapstructure:"labels"`
 }
 
 type GroupConfig struct {
@@ -38,17 +40,22 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 	return GroupConfig{}, -1, false
 }
 
+// CheckEveryNever is the check_every value for resources and resource types
+// which should only be checked when explicitly asked to, e.g. by a webhook.
+const CheckEveryNever = "never"
+
 type ResourceConfig struct {
-	Name         string  `yaml:"name" json:"name" mapstructure:"name"`
-	Public       bool    `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
-	WebhookToken string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
-	Type         string  `yaml:"type" json:"type" mapstructure:"type"`
-	Source       Source  `yaml:"source" json:"source" mapstructure:"source"`
-	CheckEvery   string  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
-	CheckTimeout string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
-	Tags         Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
-	Version      Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
-	Icon         string  `yaml:"icon,omitempty" json:"icon,omitempty" mapstructure:"icon"`
+	Name           string  `yaml:"name" json:"name" mapstructure:"name"`
+	Public         bool    `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
+	WebhookToken   string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
+	Type           string  `yaml:"type" json:"type" mapstructure:"type"`
+	Source         Source  `yaml:"source" json:"source" mapstructure:"source"`
+	CheckEvery     string  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
+	CheckTimeout   string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
+	Tags           Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
+	Version        Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+	Icon           string  `yaml:"icon,omitempty" json:"icon,omitempty" mapstructure:"icon"`
+	VersionHistory int     `yaml:"version_history,omitempty" json:"version_history,omitempty" mapstructure:"version_history"`
 }
 
 type ResourceType struct {
@@ -62,6 +69,7 @@ type ResourceType struct {
 	CheckSetupError      string `yaml:"check_setup_error,omitempty" json:"check_setup_error,omitempty" mapstructure:"check_setup_error"`
 	CheckError           string `yaml:"check_error,omitempty" json:"check_error,omitempty" mapstructure:"check_error"`
 	UniqueVersionHistory bool   `yaml:"unique_version_history,omitempty" json:"unique_version_history" mapstructure:"unique_version_history"`
+	Defaults             Source `yaml:"defaults,omitempty" json:"defaults,omitempty" mapstructure:"defaults"`
 }
 
 type ResourceTypes []ResourceType
@@ -87,6 +95,106 @@ func (types ResourceTypes) Without(name string) ResourceTypes {
 	return newTypes
 }
 
+// WithTeamResourceTypes returns the config with the team's resource types
+// which the pipeline uses added to its own, as the pipeline will be run with
+// them. It is used to validate the config; the config saved for the pipeline
+// is always the one given by the user.
+func (c Config) WithTeamResourceTypes(teamTypes ResourceTypes) Config {
+	teamResourceTypes := c.TeamResourceTypes(teamTypes)
+	if len(teamResourceTypes) == 0 {
+		return c
+	}
+
+	c.ResourceTypes = append(append(ResourceTypes{}, c.ResourceTypes...), teamResourceTypes...)
+
+	return c
+}
+
+// TeamResourceTypes returns the team's resource types which the pipeline
+// uses, directly or through another resource type, without declaring them
+// itself.
+func (c Config) TeamResourceTypes(teamTypes ResourceTypes) ResourceTypes {
+	resourceTypes := ResourceTypes{}
+	for _, name := range c.teamResourceTypeNames(teamTypes) {
+		teamType, _ := teamTypes.Lookup(name)
+		resourceTypes = append(resourceTypes, teamType)
+	}
+
+	return resourceTypes
+}
+
+// teamResourceTypeNames returns the names of the team's resource types which
+// the pipeline uses, directly or through another resource type, without
+// declaring them itself.
+func (c Config) teamResourceTypeNames(teamTypes ResourceTypes) []string {
+	queue := []string{}
+	for _, resource := range c.Resources {
+		queue = append(queue, resource.Type)
+	}
+
+	for _, resourceType := range c.ResourceTypes {
+		queue = append(queue, resourceType.Type)
+	}
+
+	seen := map[string]bool{}
+	names := []string{}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		if _, declared := c.ResourceTypes.Lookup(name); declared {
+			continue
+		}
+
+		teamType, found := teamTypes.Lookup(name)
+		if !found {
+			continue
+		}
+
+		names = append(names, name)
+		queue = append(queue, teamType.Type)
+	}
+
+	return names
+}
+
+// SourceWithDefaults returns the source of a resource of this type with the
+// type's defaults filled in for any keys the source doesn't set.
+func (resourceType ResourceType) SourceWithDefaults(source Source) Source {
+	if len(resourceType.Defaults) == 0 {
+		return source
+	}
+
+	merged := Source{}
+	for k, v := range resourceType.Defaults {
+		merged[k] = v
+	}
+
+	for k, v := range source {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// SourceWithDefaults returns the source of a resource of the named type with
+// the type's defaults filled in.
+func (types ResourceTypes) SourceWithDefaults(typeName string, source Source) Source {
+	resourceType, found := types.Lookup(typeName)
+	if !found {
+		return source
+	}
+
+	return resourceType.SourceWithDefaults(source)
+}
+
 type Hooks struct {
 	Abort   *PlanConfig
 	Error   *PlanConfig
@@ -350,6 +458,9 @@ type PlanConfig struct {
 	// used by Put to specify params for the subsequent Get
 	GetParams Params `yaml:"get_params,omitempty" json:"get_params,omitempty" mapstructure:"get_params"`
 
+	// used by Put to skip the subsequent Get
+	NoGet bool `yaml:"no_get,omitempty" json:"no_get,omitempty" mapstructure:"no_get"`
+
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
@@ -0,0 +1,90 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SourceLocation is the file and line a part of a pipeline config was loaded
+// from.
+type SourceLocation struct {
+	File string
+	Line int
+}
+
+func (location SourceLocation) String() string {
+	if location.Line == 0 {
+		return location.File
+	}
+
+	return fmt.Sprintf("%s:%d", location.File, location.Line)
+}
+
+// ConfigSources maps the paths to the parts of a config, in the form the
+// config's keys are reported in when it is decoded (e.g. 'jobs[0].plan[1]'),
+// to where they were loaded from when a config is assembled from more than
+// one file.
+type ConfigSources map[string]SourceLocation
+
+// Locate returns where the part of the config at the path was loaded from.
+// If the part itself was not located, the location of the closest part
+// enclosing it is returned instead.
+func (sources ConfigSources) Locate(path string) (SourceLocation, bool) {
+	for path != "" {
+		if location, found := sources[path]; found {
+			return location, true
+		}
+
+		path = parentPath(path)
+	}
+
+	return SourceLocation{}, false
+}
+
+// Annotate prefixes the message with where the part of the config at the
+// path was loaded from, if it is known.
+func (sources ConfigSources) Annotate(path string, message string) string {
+	location, found := sources.Locate(path)
+	if !found {
+		return message
+	}
+
+	return location.String() + ": " + message
+}
+
+func (sources ConfigSources) annotateAll(path string, messages []string) []string {
+	annotated := make([]string, len(messages))
+	for i, message := range messages {
+		annotated[i] = sources.Annotate(path, message)
+	}
+
+	return annotated
+}
+
+// UnknownKeys decodes the raw config the same way the ATC does when the config
+// is saved, and reports each key which is not part of a config along with
+// where it was loaded from, as the ATC would refuse them.
+func (sources ConfigSources) UnknownKeys(raw interface{}) ([]string, error) {
+	_, unused, err := DecodeConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []string{}
+	for _, key := range unused {
+		messages = append(messages, sources.Annotate(key, fmt.Sprintf("unknown key '%s'", key)))
+	}
+
+	return messages, nil
+}
+
+// parentPath strips the last key or index from the path, e.g. 'jobs[0].plan'
+// becomes 'jobs[0]' and 'jobs[0]' becomes 'jobs'.
+func parentPath(path string) string {
+	cut := strings.LastIndexAny(path, ".[")
+	if cut == -1 {
+		return ""
+	}
+
+	return path[:cut]
+}
@@ -46,4 +46,99 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("WithTeamResourceTypes", func() {
+		var (
+			config    Config
+			teamTypes ResourceTypes
+		)
+
+		BeforeEach(func() {
+			config = Config{
+				Resources: ResourceConfigs{
+					{Name: "notify", Type: "slack", Source: Source{"channel": "#builds"}},
+					{Name: "version", Type: "semver", Source: Source{"key": "version"}},
+					{Name: "repo", Type: "git"},
+				},
+				ResourceTypes: ResourceTypes{
+					{Name: "semver", Type: "registry-image", Source: Source{"repository": "pipeline/semver"}},
+				},
+			}
+
+			teamTypes = ResourceTypes{
+				{
+					Name:     "slack",
+					Type:     "registry-image",
+					Source:   Source{"repository": "team/slack"},
+					Defaults: Source{"url": "https://hooks.example.com", "channel": "#general"},
+				},
+				{Name: "semver", Type: "registry-image", Source: Source{"repository": "team/semver"}},
+				{Name: "pull-request", Type: "registry-image"},
+			}
+		})
+
+		It("adds the used team types which the pipeline doesn't declare", func() {
+			merged := config.WithTeamResourceTypes(teamTypes)
+			Expect(merged.ResourceTypes).To(Equal(ResourceTypes{
+				{Name: "semver", Type: "registry-image", Source: Source{"repository": "pipeline/semver"}},
+				teamTypes[0],
+			}))
+		})
+
+		It("does not merge defaults into the resources' source", func() {
+			merged := config.WithTeamResourceTypes(teamTypes)
+			Expect(merged.Resources[0].Source).To(Equal(Source{"channel": "#builds"}))
+		})
+
+		It("does not modify the original config", func() {
+			config.WithTeamResourceTypes(teamTypes)
+			Expect(config.ResourceTypes).To(HaveLen(1))
+		})
+
+		It("returns only the used team types which the pipeline doesn't declare from TeamResourceTypes", func() {
+			Expect(config.TeamResourceTypes(teamTypes)).To(Equal(ResourceTypes{teamTypes[0]}))
+		})
+
+		Context("when a team type is based on another team type", func() {
+			BeforeEach(func() {
+				teamTypes[0].Type = "pull-request"
+			})
+
+			It("adds both", func() {
+				merged := config.WithTeamResourceTypes(teamTypes)
+				Expect(merged.ResourceTypes).To(HaveLen(3))
+				Expect(merged.ResourceTypes[2].Name).To(Equal("pull-request"))
+			})
+		})
+	})
+
+	Describe("SourceWithDefaults", func() {
+		var resourceTypes ResourceTypes
+
+		BeforeEach(func() {
+			resourceTypes = ResourceTypes{
+				{
+					Name:     "slack",
+					Type:     "registry-image",
+					Defaults: Source{"url": "https://hooks.example.com", "channel": "#general"},
+				},
+				{Name: "semver", Type: "registry-image"},
+			}
+		})
+
+		It("fills in the type's defaults, preferring the resource's source", func() {
+			Expect(resourceTypes.SourceWithDefaults("slack", Source{"channel": "#builds"})).To(Equal(Source{
+				"url":     "https://hooks.example.com",
+				"channel": "#builds",
+			}))
+		})
+
+		It("returns the source as-is when the type has no defaults", func() {
+			Expect(resourceTypes.SourceWithDefaults("semver", Source{"key": "version"})).To(Equal(Source{"key": "version"}))
+		})
+
+		It("returns the source as-is when the type isn't declared", func() {
+			Expect(resourceTypes.SourceWithDefaults("git", Source{"uri": "some-uri"})).To(Equal(Source{"uri": "some-uri"}))
+		})
+	})
 })
@@ -0,0 +1,18 @@
+package atc
+
+type CredentialCheckStatus string
+
+const (
+	CredentialCheckFound    CredentialCheckStatus = "found"
+	CredentialCheckNotFound CredentialCheckStatus = "not_found"
+	CredentialCheckErrored  CredentialCheckStatus = "errored"
+)
+
+type CredentialCheck struct {
+	Name     string                `json:"name"`
+	Backend  string                `json:"backend,omitempty"`
+	Status   CredentialCheckStatus `json:"status"`
+	Path     string                `json:"path,omitempty"`
+	Searched []string              `json:"searched,omitempty"`
+	Error    string                `json:"error,omitempty"`
+}
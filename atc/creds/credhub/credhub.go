@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/credhub-cli/credhub/credentials"
 	"code.cloudfoundry.org/lager"
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 )
 
 type CredHubAtc struct {
@@ -19,29 +20,39 @@ type CredHubAtc struct {
 }
 
 func (c CredHubAtc) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
-	var cred credentials.Credential
-	var found bool
-	var err error
+	val, lookup, err := c.Lookup(varDef)
+	return val, lookup.Found, err
+}
 
+func (c CredHubAtc) Lookup(varDef template.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+	lookup := creds.SecretLookup{Name: varDef.Name, Backend: "credhub"}
+
+	var paths []string
 	if c.PipelineName != "" {
-		path := c.path(c.TeamName, c.PipelineName, varDef.Name)
-		cred, found, err = c.findCred(path)
-		if err != nil {
-			c.logger.Error("could not find cred", err)
-			return nil, false, err
-		}
+		paths = append(paths, c.path(c.TeamName, c.PipelineName, varDef.Name))
 	}
 
-	if !found {
-		cred, found, err = c.findCred(c.path(c.TeamName, varDef.Name))
+	paths = append(paths, c.path(c.TeamName, varDef.Name))
+
+	var cred credentials.Credential
+	for _, lookupPath := range paths {
+		lookup.Searched = append(lookup.Searched, lookupPath)
+
+		var err error
+		cred, lookup.Found, err = c.findCred(lookupPath)
 		if err != nil {
 			c.logger.Error("could not find cred", err)
-			return nil, false, err
+			return nil, lookup, err
+		}
+
+		if lookup.Found {
+			lookup.Path = lookupPath
+			break
 		}
 	}
 
-	if !found {
-		return nil, false, nil
+	if !lookup.Found {
+		return nil, lookup, nil
 	}
 
 	var result interface{} = cred.Value
@@ -59,7 +70,7 @@ func (c CredHubAtc) Get(varDef template.VariableDefinition) (interface{}, bool,
 		result = evenLessTyped
 	}
 
-	return result, true, nil
+	return result, lookup, nil
 }
 
 func (c CredHubAtc) findCred(path string) (credentials.Credential, bool, error) {
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package credsfakes
+
+import (
+	sync "sync"
+
+	creds "github.com/concourse/concourse/atc/creds"
+)
+
+type FakeSecretLookupRecorder struct {
+	RecordSecretLookupsStub        func([]creds.SecretLookup) error
+	recordSecretLookupsMutex       sync.RWMutex
+	recordSecretLookupsArgsForCall []struct {
+		arg1 []creds.SecretLookup
+	}
+	recordSecretLookupsReturns struct {
+		result1 error
+	}
+	recordSecretLookupsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookups(arg1 []creds.SecretLookup) error {
+	var arg1Copy []creds.SecretLookup
+	if arg1 != nil {
+		arg1Copy = make([]creds.SecretLookup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.recordSecretLookupsMutex.Lock()
+	ret, specificReturn := fake.recordSecretLookupsReturnsOnCall[len(fake.recordSecretLookupsArgsForCall)]
+	fake.recordSecretLookupsArgsForCall = append(fake.recordSecretLookupsArgsForCall, struct {
+		arg1 []creds.SecretLookup
+	}{arg1Copy})
+	fake.recordInvocation("RecordSecretLookups", []interface{}{arg1Copy})
+	fake.recordSecretLookupsMutex.Unlock()
+	if fake.RecordSecretLookupsStub != nil {
+		return fake.RecordSecretLookupsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordSecretLookupsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookupsCallCount() int {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	return len(fake.recordSecretLookupsArgsForCall)
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookupsCalls(stub func([]creds.SecretLookup) error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = stub
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookupsArgsForCall(i int) []creds.SecretLookup {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	argsForCall := fake.recordSecretLookupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookupsReturns(result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	fake.recordSecretLookupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretLookupRecorder) RecordSecretLookupsReturnsOnCall(i int, result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	if fake.recordSecretLookupsReturnsOnCall == nil {
+		fake.recordSecretLookupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordSecretLookupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretLookupRecorder) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSecretLookupRecorder) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ creds.SecretLookupRecorder = new(FakeSecretLookupRecorder)
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 	v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,14 +20,29 @@ type Kubernetes struct {
 }
 
 func (k Kubernetes) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	val, lookup, err := k.Lookup(varDef)
+	return val, lookup.Found, err
+}
+
+func (k Kubernetes) Lookup(varDef template.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+	lookup := creds.SecretLookup{Name: varDef.Name, Backend: "kubernetes"}
+
 	var namespace = k.NamespacePrefix + k.TeamName
 	var pipelineSecretName = k.PipelineName + "." + varDef.Name
 	var secretName = varDef.Name
 
+	lookup.Searched = append(lookup.Searched, namespace+"/"+pipelineSecretName)
 	secret, found, err := k.findSecret(namespace, pipelineSecretName)
+	if found {
+		lookup.Path = namespace + "/" + pipelineSecretName
+	}
 
 	if !found && err == nil {
+		lookup.Searched = append(lookup.Searched, namespace+"/"+secretName)
 		secret, found, err = k.findSecret(namespace, secretName)
+		if found {
+			lookup.Path = namespace + "/" + secretName
+		}
 	}
 
 	if err != nil {
@@ -35,11 +51,12 @@ func (k Kubernetes) Get(varDef template.VariableDefinition) (interface{}, bool,
 			"pipelineSecretName": pipelineSecretName,
 			"secretName":         secretName,
 		})
-		return nil, false, err
+		return nil, lookup, err
 	}
 
 	if found {
-		return k.getValueFromSecret(secret)
+		lookup.Found = true
+		return k.getValueFromSecret(secret), lookup, nil
 	}
 
 	k.logger.Info("k8s-secret-not-found", lager.Data{
@@ -47,13 +64,13 @@ func (k Kubernetes) Get(varDef template.VariableDefinition) (interface{}, bool,
 		"pipelineSecretName": pipelineSecretName,
 		"secretName":         secretName,
 	})
-	return nil, false, nil
+	return nil, lookup, nil
 }
 
-func (k Kubernetes) getValueFromSecret(secret *v1.Secret) (interface{}, bool, error) {
+func (k Kubernetes) getValueFromSecret(secret *v1.Secret) interface{} {
 	val, found := secret.Data["value"]
 	if found {
-		return string(val), true, nil
+		return string(val)
 	}
 
 	evenLessTyped := map[interface{}]interface{}{}
@@ -61,7 +78,7 @@ func (k Kubernetes) getValueFromSecret(secret *v1.Secret) (interface{}, bool, er
 		evenLessTyped[k] = string(v)
 	}
 
-	return evenLessTyped, true, nil
+	return evenLessTyped
 }
 
 func (k Kubernetes) findSecret(namespace, name string) (*v1.Secret, bool, error) {
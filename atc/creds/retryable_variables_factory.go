@@ -31,20 +31,25 @@ func (rvf RetryableVariablesFactory) NewVariables(teamName string, pipelineName
 }
 
 func (rv RetryableVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	result, lookup, err := rv.Lookup(varDef)
+	return result, lookup.Found, err
+}
+
+func (rv RetryableVariables) Lookup(varDef template.VariableDefinition) (interface{}, SecretLookup, error) {
 	r := &retryhttp.DefaultRetryer{}
 	for i := 0; i < rv.retryConfig.Attempts-1; i++ {
-		result, exists, err := rv.variables.Get(varDef)
+		result, lookup, err := Lookup(rv.variables, varDef)
 		if err != nil && r.IsRetryable(err) {
 			time.Sleep(rv.retryConfig.Interval)
 			continue
 		}
-		return result, exists, err
+		return result, lookup, err
 	}
-	result, exists, err := rv.variables.Get(varDef)
+	result, lookup, err := Lookup(rv.variables, varDef)
 	if err != nil {
 		err = fmt.Errorf("%s (after %d retries)", err, rv.retryConfig.Attempts)
 	}
-	return result, exists, err
+	return result, lookup, err
 }
 
 func (rv RetryableVariables) List() ([]template.VariableDefinition, error) {
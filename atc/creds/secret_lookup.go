@@ -0,0 +1,145 @@
+package creds
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// SecretLookup describes where a credential manager looked for a var. It
+// never contains the var's value.
+type SecretLookup struct {
+	Name    string
+	Backend string
+	Path    string
+	Found   bool
+
+	// Searched lists every path tried, in order, including the one the var
+	// was found at.
+	Searched []string
+}
+
+//go:generate counterfeiter . LookupVariables
+
+// LookupVariables are Variables which can report where they found a var.
+type LookupVariables interface {
+	Variables
+
+	Lookup(template.VariableDefinition) (interface{}, SecretLookup, error)
+}
+
+// Lookup fetches the var, describing where it was found if the variables
+// support it.
+func Lookup(variables Variables, varDef template.VariableDefinition) (interface{}, SecretLookup, error) {
+	if lookupVariables, ok := variables.(LookupVariables); ok {
+		return lookupVariables.Lookup(varDef)
+	}
+
+	val, found, err := variables.Get(varDef)
+	return val, SecretLookup{Name: varDef.Name, Found: found}, err
+}
+
+//go:generate counterfeiter . SecretLookupRecorder
+
+type SecretLookupRecorder interface {
+	RecordSecretLookups([]SecretLookup) error
+}
+
+type recordedLookup struct {
+	name    string
+	backend string
+	path    string
+	found   bool
+}
+
+// SecretLookups collects the lookups made through its Variables so that they
+// can be recorded together, rather than writing each one as it's made.
+type SecretLookups struct {
+	lock    sync.Mutex
+	seen    map[recordedLookup]bool
+	unsaved []SecretLookup
+}
+
+func NewSecretLookups() *SecretLookups {
+	return &SecretLookups{
+		seen: map[recordedLookup]bool{},
+	}
+}
+
+// Variables returns Variables which add each successful lookup to the
+// collection. Each distinct lookup is only collected once.
+func (l *SecretLookups) Variables(variables Variables) Variables {
+	return recordingVariables{
+		variables: variables,
+		lookups:   l,
+	}
+}
+
+// Record records every lookup collected since the last call in a single
+// call to the recorder.
+func (l *SecretLookups) Record(logger lager.Logger, recorder SecretLookupRecorder) error {
+	l.lock.Lock()
+	lookups := l.unsaved
+	l.unsaved = nil
+	l.lock.Unlock()
+
+	if len(lookups) == 0 {
+		return nil
+	}
+
+	for _, lookup := range lookups {
+		logger.Debug("secret-lookup", lager.Data{
+			"var":     lookup.Name,
+			"backend": lookup.Backend,
+			"path":    lookup.Path,
+			"found":   lookup.Found,
+		})
+	}
+
+	return recorder.RecordSecretLookups(lookups)
+}
+
+func (l *SecretLookups) add(lookup SecretLookup) {
+	key := recordedLookup{
+		name:    lookup.Name,
+		backend: lookup.Backend,
+		path:    lookup.Path,
+		found:   lookup.Found,
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.seen[key] {
+		return
+	}
+
+	l.seen[key] = true
+	l.unsaved = append(l.unsaved, lookup)
+}
+
+type recordingVariables struct {
+	variables Variables
+	lookups   *SecretLookups
+}
+
+func (v recordingVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	val, lookup, err := v.Lookup(varDef)
+	return val, lookup.Found, err
+}
+
+func (v recordingVariables) Lookup(varDef template.VariableDefinition) (interface{}, SecretLookup, error) {
+	val, lookup, err := Lookup(v.variables, varDef)
+	if err != nil {
+		return nil, lookup, err
+	}
+
+	v.lookups.add(lookup)
+
+	return val, lookup, nil
+}
+
+func (v recordingVariables) List() ([]template.VariableDefinition, error) {
+	return v.variables.List()
+}
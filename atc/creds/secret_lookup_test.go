@@ -0,0 +1,180 @@
+package creds_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Lookups", func() {
+	var (
+		logger        *lagertest.TestLogger
+		fakeVariables *credsfakes.FakeLookupVariables
+		fakeRecorder  *credsfakes.FakeSecretLookupRecorder
+
+		lookups   *creds.SecretLookups
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeVariables = new(credsfakes.FakeLookupVariables)
+		fakeRecorder = new(credsfakes.FakeSecretLookupRecorder)
+
+		lookups = creds.NewSecretLookups()
+		variables = lookups.Variables(fakeVariables)
+	})
+
+	Context("when the var is found", func() {
+		BeforeEach(func() {
+			fakeVariables.LookupReturns("some-value", creds.SecretLookup{
+				Name:    "some-var",
+				Backend: "vault",
+				Path:    "/concourse/team/some-var",
+				Found:   true,
+			}, nil)
+		})
+
+		It("returns the value", func() {
+			value, found, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+
+		It("does not record the lookup until asked to", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(BeZero())
+		})
+
+		It("records the lookup without the value", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(Equal(1))
+			Expect(fakeRecorder.RecordSecretLookupsArgsForCall(0)).To(Equal([]creds.SecretLookup{
+				{
+					Name:    "some-var",
+					Backend: "vault",
+					Path:    "/concourse/team/some-var",
+					Found:   true,
+				},
+			}))
+		})
+
+		It("collects each lookup only once", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = lookups.Variables(fakeVariables).Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(Equal(1))
+			Expect(fakeRecorder.RecordSecretLookupsArgsForCall(0)).To(HaveLen(1))
+		})
+
+		It("does not record the same lookups again", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(Equal(1))
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeRecorder.RecordSecretLookupsReturns(errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = lookups.Record(logger, fakeRecorder)
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+
+	Context("when the var is not found", func() {
+		BeforeEach(func() {
+			fakeVariables.LookupReturns(nil, creds.SecretLookup{
+				Name:    "some-var",
+				Backend: "vault",
+			}, nil)
+		})
+
+		It("records the lookup as not found", func() {
+			_, found, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(Equal(1))
+			Expect(fakeRecorder.RecordSecretLookupsArgsForCall(0)[0].Found).To(BeFalse())
+		})
+	})
+
+	Context("when the lookup fails", func() {
+		BeforeEach(func() {
+			fakeVariables.LookupReturns(nil, creds.SecretLookup{Name: "some-var"}, errors.New("nope"))
+		})
+
+		It("returns the error and records nothing", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).To(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the underlying variables cannot describe lookups", func() {
+		BeforeEach(func() {
+			plainVariables := new(credsfakes.FakeVariables)
+			plainVariables.GetReturns("some-value", true, nil)
+
+			variables = lookups.Variables(plainVariables)
+		})
+
+		It("records the var name only", func() {
+			_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = lookups.Record(logger, fakeRecorder)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeRecorder.RecordSecretLookupsArgsForCall(0)).To(Equal([]creds.SecretLookup{
+				{
+					Name:  "some-var",
+					Found: true,
+				},
+			}))
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 
 	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 )
 
 type SecretsManager struct {
@@ -43,29 +44,39 @@ func (s *SecretsManager) buildSecretId(nameTemplate *template.Template, secret s
 }
 
 func (s *SecretsManager) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, error) {
+	value, lookup, err := s.Lookup(varDef)
+	return value, lookup.Found, err
+}
+
+func (s *SecretsManager) Lookup(varDef varTemplate.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+	lookup := creds.SecretLookup{Name: varDef.Name, Backend: "secretsmanager"}
 	for _, st := range s.SecretTemplates {
 		secretId, err := s.buildSecretId(st, varDef.Name)
 		if err != nil {
 			s.log.Error("build-secret-id", err, lager.Data{"template": st.Name(), "secret": varDef.Name})
-			return nil, false, err
+			return nil, lookup, err
 		}
 
 		if strings.Contains(secretId, "//") {
 			continue
 		}
 
+		lookup.Searched = append(lookup.Searched, secretId)
+
 		value, found, err := s.getSecretById(secretId)
 		if err != nil {
 			s.log.Error("get-secret", err, lager.Data{
 				"template": st.Name(), "secret": varDef.Name, "secretId": secretId,
 			})
-			return nil, false, err
+			return nil, lookup, err
 		}
 		if found {
-			return value, true, nil
+			lookup.Path = secretId
+			lookup.Found = true
+			return value, lookup, nil
 		}
 	}
-	return nil, false, nil
+	return nil, lookup, nil
 }
 
 /*
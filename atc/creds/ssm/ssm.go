@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 )
 
 type Ssm struct {
@@ -42,6 +43,12 @@ func (s *Ssm) transformSecret(nameTemplate *template.Template, secret string) (s
 }
 
 func (s *Ssm) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, error) {
+	value, lookup, err := s.Lookup(varDef)
+	return value, lookup.Found, err
+}
+
+func (s *Ssm) Lookup(varDef varTemplate.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+	lookup := creds.SecretLookup{Name: varDef.Name, Backend: "ssm"}
 	for _, st := range s.SecretTemplates {
 		// Try to get the parameter as string value
 		parameter, err := s.transformSecret(st, varDef.Name)
@@ -50,12 +57,13 @@ func (s *Ssm) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, err
 				"template": st.Name(),
 				"secret":   varDef.Name,
 			})
-			return nil, false, err
+			return nil, lookup, err
 		}
 		// If pipeline name is empty, double slashes may be present in the parameter name
 		if strings.Contains(parameter, "//") {
 			continue
 		}
+		lookup.Searched = append(lookup.Searched, parameter)
 		value, found, err := s.getParameterByName(parameter)
 		if err != nil {
 			s.log.Error("failed-to-get-ssm-parameter-by-name", err, lager.Data{
@@ -63,10 +71,12 @@ func (s *Ssm) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, err
 				"secret":    varDef.Name,
 				"parameter": parameter,
 			})
-			return nil, false, err
+			return nil, lookup, err
 		}
 		if found {
-			return value, true, nil
+			lookup.Path = parameter
+			lookup.Found = true
+			return value, lookup, nil
 		}
 		// // Paramter may exist as a complex value so try again using paramter name as root path
 		value, found, err = s.getParameterByPath(parameter)
@@ -76,13 +86,15 @@ func (s *Ssm) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, err
 				"secret":    varDef.Name,
 				"parameter": parameter,
 			})
-			return nil, false, err
+			return nil, lookup, err
 		}
 		if found {
-			return value, true, nil
+			lookup.Path = parameter
+			lookup.Found = true
+			return value, lookup, nil
 		}
 	}
-	return nil, false, nil
+	return nil, lookup, nil
 }
 
 func (s *Ssm) getParameterByName(name string) (interface{}, bool, error) {
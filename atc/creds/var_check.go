@@ -0,0 +1,66 @@
+package creds
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// VarCheck is the outcome of looking up a single var while checking a
+// config's credentials.
+type VarCheck struct {
+	SecretLookup
+
+	Err error
+}
+
+// CheckingVariables are Variables which remember the outcome of every var
+// looked up through them, so that a config's credentials can be reported on
+// as a whole rather than failing on the first missing var.
+type CheckingVariables struct {
+	variables Variables
+
+	checksL *sync.Mutex
+	checks  map[string]VarCheck
+}
+
+func NewCheckingVariables(variables Variables) *CheckingVariables {
+	return &CheckingVariables{
+		variables: variables,
+
+		checksL: &sync.Mutex{},
+		checks:  map[string]VarCheck{},
+	}
+}
+
+func (v *CheckingVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	val, lookup, err := Lookup(v.variables, varDef)
+
+	v.checksL.Lock()
+	v.checks[varDef.Name] = VarCheck{SecretLookup: lookup, Err: err}
+	v.checksL.Unlock()
+
+	return val, lookup.Found, err
+}
+
+func (v *CheckingVariables) List() ([]template.VariableDefinition, error) {
+	return v.variables.List()
+}
+
+// Checks returns the outcome of each var looked up so far, sorted by name.
+func (v *CheckingVariables) Checks() []VarCheck {
+	v.checksL.Lock()
+	defer v.checksL.Unlock()
+
+	checks := make([]VarCheck, 0, len(v.checks))
+	for _, check := range v.checks {
+		checks = append(checks, check)
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Name < checks[j].Name
+	})
+
+	return checks
+}
@@ -0,0 +1,93 @@
+package creds_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checking Variables", func() {
+	var (
+		fakeVariables *credsfakes.FakeLookupVariables
+
+		variables *creds.CheckingVariables
+	)
+
+	BeforeEach(func() {
+		fakeVariables = new(credsfakes.FakeLookupVariables)
+		fakeVariables.LookupStub = func(varDef template.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+			switch varDef.Name {
+			case "found-var":
+				return "some-value", creds.SecretLookup{
+					Name:     "found-var",
+					Backend:  "vault",
+					Path:     "/concourse/team/found-var",
+					Found:    true,
+					Searched: []string{"/concourse/team/pipeline/found-var", "/concourse/team/found-var"},
+				}, nil
+			case "errored-var":
+				return nil, creds.SecretLookup{
+					Name:     "errored-var",
+					Backend:  "vault",
+					Searched: []string{"/concourse/team/pipeline/errored-var"},
+				}, errors.New("permission denied")
+			default:
+				return nil, creds.SecretLookup{
+					Name:     varDef.Name,
+					Backend:  "vault",
+					Searched: []string{"/concourse/team/pipeline/" + varDef.Name, "/concourse/team/" + varDef.Name},
+				}, nil
+			}
+		}
+
+		variables = creds.NewCheckingVariables(fakeVariables)
+	})
+
+	It("passes through the underlying result", func() {
+		value, found, err := variables.Get(template.VariableDefinition{Name: "found-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("some-value"))
+
+		_, _, err = variables.Get(template.VariableDefinition{Name: "errored-var"})
+		Expect(err).To(MatchError("permission denied"))
+	})
+
+	It("reports the outcome of every var looked up, sorted by name", func() {
+		variables.Get(template.VariableDefinition{Name: "missing-var"})
+		variables.Get(template.VariableDefinition{Name: "found-var"})
+		variables.Get(template.VariableDefinition{Name: "errored-var"})
+		variables.Get(template.VariableDefinition{Name: "found-var"})
+
+		Expect(variables.Checks()).To(Equal([]creds.VarCheck{
+			{
+				SecretLookup: creds.SecretLookup{
+					Name:     "errored-var",
+					Backend:  "vault",
+					Searched: []string{"/concourse/team/pipeline/errored-var"},
+				},
+				Err: errors.New("permission denied"),
+			},
+			{
+				SecretLookup: creds.SecretLookup{
+					Name:     "found-var",
+					Backend:  "vault",
+					Path:     "/concourse/team/found-var",
+					Found:    true,
+					Searched: []string{"/concourse/team/pipeline/found-var", "/concourse/team/found-var"},
+				},
+			},
+			{
+				SecretLookup: creds.SecretLookup{
+					Name:     "missing-var",
+					Backend:  "vault",
+					Searched: []string{"/concourse/team/pipeline/missing-var", "/concourse/team/missing-var"},
+				},
+			},
+		}))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"path"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 	vaultapi "github.com/hashicorp/vault/api"
 )
 
@@ -25,38 +26,47 @@ type Vault struct {
 }
 
 func (v Vault) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
-	var secret *vaultapi.Secret
-	var found bool
-	var err error
+	val, lookup, err := v.Lookup(varDef)
+	return val, lookup.Found, err
+}
+
+func (v Vault) Lookup(varDef template.VariableDefinition) (interface{}, creds.SecretLookup, error) {
+	lookup := creds.SecretLookup{Name: varDef.Name, Backend: "vault"}
 
+	var paths []string
 	if v.PipelineName != "" {
-		secret, found, err = v.findSecret(v.path(v.TeamName, v.PipelineName, varDef.Name))
-		if err != nil {
-			return nil, false, err
-		}
+		paths = append(paths, v.path(v.TeamName, v.PipelineName, varDef.Name))
 	}
 
-	if !found {
-		secret, found, err = v.findSecret(v.path(v.TeamName, varDef.Name))
-		if err != nil {
-			return nil, false, err
-		}
+	paths = append(paths, v.path(v.TeamName, varDef.Name))
+
+	if v.SharedPath != "" {
+		paths = append(paths, v.path(v.SharedPath, varDef.Name))
 	}
 
-	if !found && v.SharedPath != "" {
-		secret, found, err = v.findSecret(v.path(v.SharedPath, varDef.Name))
+	var secret *vaultapi.Secret
+	for _, lookupPath := range paths {
+		lookup.Searched = append(lookup.Searched, lookupPath)
+
+		var err error
+		secret, lookup.Found, err = v.findSecret(lookupPath)
 		if err != nil {
-			return nil, false, err
+			return nil, lookup, err
+		}
+
+		if lookup.Found {
+			lookup.Path = lookupPath
+			break
 		}
 	}
 
-	if !found {
-		return nil, false, nil
+	if !lookup.Found {
+		return nil, lookup, nil
 	}
 
 	val, found := secret.Data["value"]
 	if found {
-		return val, true, nil
+		return val, lookup, nil
 	}
 
 	evenLessTyped := map[interface{}]interface{}{}
@@ -64,7 +74,7 @@ func (v Vault) Get(varDef template.VariableDefinition) (interface{}, bool, error
 		evenLessTyped[k] = v
 	}
 
-	return evenLessTyped, true, nil
+	return evenLessTyped, lookup, nil
 }
 
 func (v Vault) findSecret(path string) (*vaultapi.Secret, bool, error) {
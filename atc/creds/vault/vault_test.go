@@ -2,6 +2,7 @@ package vault_test
 
 import (
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/vault"
 	vaultapi "github.com/hashicorp/vault/api"
 	. "github.com/onsi/ginkgo"
@@ -122,4 +123,46 @@ var _ = Describe("Vault", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	Describe("Lookup()", func() {
+		It("should describe where the secret was found", func() {
+			v.SecretReader = &MockSecretReader{&[]MockSecret{
+				{
+					path: "/concourse/team/foo",
+					secret: &vaultapi.Secret{
+						Data: map[string]interface{}{"value": "bar"},
+					},
+				}},
+			}
+			value, lookup, err := v.Lookup(template.VariableDefinition{Name: "foo"})
+			Expect(value).To(BeEquivalentTo("bar"))
+			Expect(lookup).To(Equal(creds.SecretLookup{
+				Name:    "foo",
+				Backend: "vault",
+				Path:    "/concourse/team/foo",
+				Found:   true,
+				Searched: []string{
+					"/concourse/team/pipeline/foo",
+					"/concourse/team/foo",
+				},
+			}))
+			Expect(err).To(BeNil())
+		})
+
+		It("should list every path searched when the secret is not found", func() {
+			v.SecretReader = &MockSecretReader{&[]MockSecret{}}
+			value, lookup, err := v.Lookup(template.VariableDefinition{Name: "foo"})
+			Expect(value).To(BeNil())
+			Expect(lookup).To(Equal(creds.SecretLookup{
+				Name:    "foo",
+				Backend: "vault",
+				Searched: []string{
+					"/concourse/team/pipeline/foo",
+					"/concourse/team/foo",
+					"/concourse/shared/foo",
+				},
+			}))
+			Expect(err).To(BeNil())
+		})
+	})
 })
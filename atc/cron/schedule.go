@@ -0,0 +1,249 @@
+// Package cron parses standard five-field cron expressions and computes when
+// they next fire.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Schedule is a parsed cron expression.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// when both the day of the month and the day of the week are restricted,
+	// a day matching either fires, as with cron(8)
+	domRestricted bool
+	dowRestricted bool
+}
+
+type bounds struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minutes = bounds{name: "minute", min: 0, max: 59}
+	hours   = bounds{name: "hour", min: 0, max: 23}
+	doms    = bounds{name: "day of month", min: 1, max: 31}
+	months  = bounds{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+
+	// 7 is accepted as sunday and folded into 0 when parsed
+	dows = bounds{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression made of the minute, hour, day of month,
+// month and day of week fields, or one of the @yearly, @monthly, @weekly,
+// @daily and @hourly macros.
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, found := macros[expr]; found {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields, found %d", len(fields))
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+
+	schedule.minute, err = parseField(fields[0], minutes)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule.hour, err = parseField(fields[1], hours)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule.dom, err = parseField(fields[2], doms)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule.month, err = parseField(fields[3], months)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule.dow, err = parseField(fields[4], dows)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = schedule.dow&^(1<<7) | 1
+	}
+
+	schedule.domRestricted = !strings.HasPrefix(fields[2], "*")
+	schedule.dowRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(part, "/", 2)
+
+		var start, end int
+		switch {
+		case rangeAndStep[0] == "*":
+			start, end = b.min, b.max
+		case strings.Contains(rangeAndStep[0], "-"):
+			startAndEnd := strings.SplitN(rangeAndStep[0], "-", 2)
+
+			var err error
+			start, err = parseValue(startAndEnd[0], b)
+			if err != nil {
+				return 0, err
+			}
+
+			end, err = parseValue(startAndEnd[1], b)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			value, err := parseValue(rangeAndStep[0], b)
+			if err != nil {
+				return 0, err
+			}
+
+			start, end = value, value
+			if len(rangeAndStep) == 2 {
+				end = b.max
+			}
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("%s range %s is backwards", b.name, rangeAndStep[0])
+		}
+
+		step := 1
+		if len(rangeAndStep) == 2 {
+			var err error
+			step, err = strconv.Atoi(rangeAndStep[1])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid %s step: %s", b.name, rangeAndStep[1])
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (int, error) {
+	if named, found := b.names[strings.ToLower(value)]; found {
+		return named, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", b.name, value)
+	}
+
+	if parsed < b.min || parsed > b.max {
+		return 0, fmt.Errorf("%s %d is out of range %d-%d", b.name, parsed, b.min, b.max)
+	}
+
+	return parsed, nil
+}
+
+// ErrNeverFires is returned by Next for schedules which can't be satisfied,
+// e.g. the 30th of february.
+var ErrNeverFires = errors.New("schedule never fires")
+
+// Next returns the first time after the given one at which the schedule
+// fires, in the given time's location.
+func (s Schedule) Next(after time.Time) (time.Time, error) {
+	loc := after.Location()
+
+	t := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), 0, 0, loc).Add(time.Minute)
+
+	// every combination of month and day recurs within a leap cycle
+	limit := t.Year() + 5
+
+wrap:
+	for t.Year() <= limit {
+		for !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			if t.Month() == time.January {
+				continue wrap
+			}
+		}
+
+		for !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			if t.Day() == 1 {
+				continue wrap
+			}
+		}
+
+		for !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if t.Hour() == 0 {
+				continue wrap
+			}
+		}
+
+		for !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue wrap
+			}
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, ErrNeverFires
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatches := has(s.dom, t.Day())
+	dowMatches := has(s.dow, int(t.Weekday()))
+
+	if s.domRestricted && s.dowRestricted {
+		return domMatches || dowMatches
+	}
+
+	return domMatches && dowMatches
+}
+
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
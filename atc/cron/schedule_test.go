@@ -0,0 +1,67 @@
+package cron_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// a monday
+	after := time.Date(2019, time.May, 13, 10, 30, 45, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(expr string, expected time.Time) {
+			schedule, err := cron.Parse(expr)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(schedule.Next(after)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2019, time.May, 13, 10, 31, 0, 0, time.UTC)),
+		Entry("a specific minute", "15 * * * *", time.Date(2019, time.May, 13, 11, 15, 0, 0, time.UTC)),
+		Entry("a step", "*/20 * * * *", time.Date(2019, time.May, 13, 10, 40, 0, 0, time.UTC)),
+		Entry("a list", "0 9,17 * * *", time.Date(2019, time.May, 13, 17, 0, 0, 0, time.UTC)),
+		Entry("weekdays", "0 3 * * 1-5", time.Date(2019, time.May, 14, 3, 0, 0, 0, time.UTC)),
+		Entry("named days", "0 3 * * sat,sun", time.Date(2019, time.May, 18, 3, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 3 * * 7", time.Date(2019, time.May, 19, 3, 0, 0, 0, time.UTC)),
+		Entry("named months", "0 0 1 jan *", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("either day field when both are restricted", "0 0 1 * fri", time.Date(2019, time.May, 17, 0, 0, 0, 0, time.UTC)),
+		Entry("leap days", "0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("a macro", "@daily", time.Date(2019, time.May, 14, 0, 0, 0, 0, time.UTC)),
+	)
+
+	It("fires in the location of the given time", func() {
+		loc, err := time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule, err := cron.Parse("0 3 * * *")
+		Expect(err).NotTo(HaveOccurred())
+
+		next, err := schedule.Next(after.In(loc))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next.UTC()).To(Equal(time.Date(2019, time.May, 14, 7, 0, 0, 0, time.UTC)))
+	})
+
+	It("returns an error for schedules which never fire", func() {
+		schedule, err := cron.Parse("0 0 30 2 *")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = schedule.Next(after)
+		Expect(err).To(Equal(cron.ErrNeverFires))
+	})
+
+	DescribeTable("Parse errors",
+		func(expr string, message string) {
+			_, err := cron.Parse(expr)
+			Expect(err).To(MatchError(message))
+		},
+		Entry("too few fields", "* * * *", "expected 5 fields, found 4"),
+		Entry("an invalid value", "x * * * *", "invalid minute: x"),
+		Entry("an out of range value", "0 24 * * *", "hour 24 is out of range 0-23"),
+		Entry("a backwards range", "0 0 * * 5-1", "day of week range 5-1 is backwards"),
+		Entry("an invalid step", "*/0 * * * *", "invalid minute step: 0"),
+	)
+})
@@ -42,11 +42,12 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.drained, b.rerun_of, rb.name").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
-	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id")
+	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id").
+	JoinClause("LEFT OUTER JOIN builds rb ON b.rerun_of = rb.id")
 
 var minMaxIdQuery = psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").
 	From("builds as b")
@@ -71,6 +72,8 @@ type Build interface {
 	EndTime() time.Time
 	ReapTime() time.Time
 	IsManuallyTriggered() bool
+	RerunOf() int
+	RerunOfName() string
 	IsScheduled() bool
 	IsRunning() bool
 
@@ -108,6 +111,8 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	RecordSecretLookups([]creds.SecretLookup) error
 }
 
 type build struct {
@@ -126,6 +131,9 @@ type build struct {
 
 	isManuallyTriggered bool
 
+	rerunOf     int
+	rerunOfName string
+
 	schema      string
 	privatePlan string
 	publicPlan  *json.RawMessage
@@ -162,6 +170,8 @@ func (b *build) PipelineName() string         { return b.pipelineName }
 func (b *build) TeamID() int                  { return b.teamID }
 func (b *build) TeamName() string             { return b.teamName }
 func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
+func (b *build) RerunOf() int                 { return b.rerunOf }
+func (b *build) RerunOfName() string          { return b.rerunOfName }
 func (b *build) Schema() string               { return b.schema }
 func (b *build) PrivatePlan() string          { return b.privatePlan }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
@@ -240,6 +250,16 @@ func (b *build) SetInterceptible(i bool) error {
 	return nil
 }
 
+// RecordSecretLookups records the vars looked up by a job's build. Lookups
+// made by one-off builds are not recorded.
+func (b *build) RecordSecretLookups(lookups []creds.SecretLookup) error {
+	if b.jobID == 0 || len(lookups) == 0 {
+		return nil
+	}
+
+	return recordSecretLookups(b.conn, b.pipelineID, SecretLookupUsedByJob, b.jobName, lookups)
+}
+
 func (b *build) Start(schema string, plan atc.Plan) (bool, error) {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -1023,6 +1043,42 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 		})
 	}
 
+	rows, err = psql.Select("name", "version").
+		From("build_cron_inputs").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var (
+			inputName   string
+			versionBlob string
+			version     atc.Version
+		)
+
+		err = rows.Scan(&inputName, &versionBlob)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBlob), &version)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// every cron trigger is a new version
+		inputs = append(inputs, BuildInput{
+			Name:            inputName,
+			Version:         version,
+			FirstOccurrence: true,
+		})
+	}
+
 	rows, err = psql.Select("outputs.name", "versions.version").
 		From("resource_config_versions versions, build_resource_config_version_outputs outputs, builds, resources").
 		Where(sq.Eq{"builds.id": b.id}).
@@ -1098,12 +1154,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, pipelineID                                      sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan sql.NullString
 		createTime, startTime, endTime, reapTime               pq.NullTime
-		nonce                                                  sql.NullString
+		nonce, rerunOfName                                     sql.NullString
+		rerunOf                                                sql.NullInt64
 		drained                                                bool
 		status                                                 string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &drained, &rerunOf, &rerunOfName)
 	if err != nil {
 		return err
 	}
@@ -1119,6 +1176,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.rerunOf = int(rerunOf.Int64)
+	b.rerunOfName = rerunOfName.String
 
 	var (
 		noncense      *string
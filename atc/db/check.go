@@ -0,0 +1,306 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . Check
+
+// Check is a queued or finished run of a resource's or resource type's check
+// script.
+type Check interface {
+	ID() int
+	TeamID() int
+	TeamName() string
+	PipelineID() int
+	PipelineName() string
+
+	// ResourceID is 0 for checks of resource types.
+	ResourceID() int
+	// ResourceTypeID is 0 for checks of resources.
+	ResourceTypeID() int
+	// ResourceConfigScopeID is 0 until the check has found the scope it is
+	// checking.
+	ResourceConfigScopeID() int
+
+	Status() atc.CheckStatus
+	ManuallyTriggered() bool
+	Plan() atc.CheckPlan
+
+	CreateTime() time.Time
+	StartTime() time.Time
+	EndTime() time.Time
+	CheckError() error
+	Output() string
+
+	Pipeline() (Pipeline, bool, error)
+
+	SetResourceConfigScope(ResourceConfigScope) error
+	AppendOutput(string) error
+	Finish(error) error
+	Skip() error
+	Reload() (bool, error)
+}
+
+var checksQuery = psql.Select(
+	"c.id",
+	"c.team_id",
+	"t.name",
+	"c.pipeline_id",
+	"p.name",
+	"c.resource_id",
+	"c.resource_type_id",
+	"c.resource_config_scope_id",
+	"c.status",
+	"c.manually_triggered",
+	"c.plan",
+	"c.create_time",
+	"c.start_time",
+	"c.end_time",
+	"c.check_error",
+	"c.output",
+).
+	From("checks c").
+	Join("teams t ON t.id = c.team_id").
+	Join("pipelines p ON p.id = c.pipeline_id")
+
+type check struct {
+	id           int
+	teamID       int
+	teamName     string
+	pipelineID   int
+	pipelineName string
+
+	resourceID            int
+	resourceTypeID        int
+	resourceConfigScopeID int
+
+	status            atc.CheckStatus
+	manuallyTriggered bool
+	plan              atc.CheckPlan
+
+	createTime time.Time
+	startTime  time.Time
+	endTime    time.Time
+	checkError error
+	output     string
+
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func (c *check) ID() int                    { return c.id }
+func (c *check) TeamID() int                { return c.teamID }
+func (c *check) TeamName() string           { return c.teamName }
+func (c *check) PipelineID() int            { return c.pipelineID }
+func (c *check) PipelineName() string       { return c.pipelineName }
+func (c *check) ResourceID() int            { return c.resourceID }
+func (c *check) ResourceTypeID() int        { return c.resourceTypeID }
+func (c *check) ResourceConfigScopeID() int { return c.resourceConfigScopeID }
+func (c *check) Status() atc.CheckStatus    { return c.status }
+func (c *check) ManuallyTriggered() bool    { return c.manuallyTriggered }
+func (c *check) Plan() atc.CheckPlan        { return c.plan }
+func (c *check) CreateTime() time.Time      { return c.createTime }
+func (c *check) StartTime() time.Time       { return c.startTime }
+func (c *check) EndTime() time.Time         { return c.endTime }
+func (c *check) CheckError() error          { return c.checkError }
+func (c *check) Output() string             { return c.output }
+
+func (c *check) Pipeline() (Pipeline, bool, error) {
+	pipeline := newPipeline(c.conn, c.lockFactory)
+	pipeline.id = c.pipelineID
+
+	found, err := pipeline.Reload()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return pipeline, true, nil
+}
+
+// SetResourceConfigScope associates the check with the scope it is checking,
+// so that its output is kept in the scope's check history.
+func (c *check) SetResourceConfigScope(scope ResourceConfigScope) error {
+	_, err := psql.Update("checks").
+		Set("resource_config_scope_id", scope.ID()).
+		Where(sq.Eq{"id": c.id}).
+		RunWith(c.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	c.resourceConfigScopeID = scope.ID()
+
+	return nil
+}
+
+// AppendOutput appends output of the check script, so that it can be
+// followed while the check is running.
+func (c *check) AppendOutput(output string) error {
+	_, err := psql.Update("checks").
+		Set("output", sq.Expr("output || ?", output)).
+		Where(sq.Eq{"id": c.id}).
+		RunWith(c.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	c.output += output
+
+	return nil
+}
+
+// Finish ends a started check as succeeded, or as errored if it failed. A
+// check which has already been ended elsewhere, e.g. as interrupted or timed
+// out, is left as it is.
+func (c *check) Finish(checkErr error) error {
+	status := atc.CheckStatusSucceeded
+
+	var errStr sql.NullString
+	if checkErr != nil {
+		status = atc.CheckStatusErrored
+		errStr = sql.NullString{String: checkErr.Error(), Valid: true}
+	}
+
+	ended, err := c.end(status, errStr)
+	if err != nil {
+		return err
+	}
+
+	if ended {
+		c.checkError = checkErr
+	}
+
+	return nil
+}
+
+// Skip finishes a check which didn't run, e.g. because its resource config
+// was already being checked.
+func (c *check) Skip() error {
+	_, err := c.end(atc.CheckStatusSkipped, sql.NullString{})
+	return err
+}
+
+func (c *check) end(status atc.CheckStatus, checkErr sql.NullString) (bool, error) {
+	row := psql.Update("checks").
+		Set("status", status).
+		Set("end_time", sq.Expr("now()")).
+		Set("check_error", checkErr).
+		Where(sq.Eq{
+			"id":     c.id,
+			"status": atc.CheckStatusStarted,
+		}).
+		Suffix("RETURNING end_time").
+		RunWith(c.conn).
+		QueryRow()
+
+	var endTime time.Time
+	err := row.Scan(&endTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	c.status = status
+	c.endTime = endTime
+
+	return true, nil
+}
+
+func (c *check) Reload() (bool, error) {
+	row := checksQuery.
+		Where(sq.Eq{"c.id": c.id}).
+		RunWith(c.conn).
+		QueryRow()
+
+	err := scanCheck(c, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func scanCheck(c *check, row scannable) error {
+	var (
+		resourceID, resourceTypeID sql.NullInt64
+		resourceConfigScopeID      sql.NullInt64
+		planJSON                   []byte
+		startTime, endTime         pq.NullTime
+		checkErr                   sql.NullString
+	)
+
+	err := row.Scan(
+		&c.id,
+		&c.teamID,
+		&c.teamName,
+		&c.pipelineID,
+		&c.pipelineName,
+		&resourceID,
+		&resourceTypeID,
+		&resourceConfigScopeID,
+		&c.status,
+		&c.manuallyTriggered,
+		&planJSON,
+		&c.createTime,
+		&startTime,
+		&endTime,
+		&checkErr,
+		&c.output,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.resourceID = int(resourceID.Int64)
+	c.resourceTypeID = int(resourceTypeID.Int64)
+	c.resourceConfigScopeID = int(resourceConfigScopeID.Int64)
+	c.startTime = startTime.Time
+	c.endTime = endTime.Time
+
+	c.checkError = nil
+	if checkErr.Valid {
+		c.checkError = errors.New(checkErr.String)
+	}
+
+	return json.Unmarshal(planJSON, &c.plan)
+}
+
+func scanChecks(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Check, error) {
+	defer Close(rows)
+
+	var checks []Check
+	for rows.Next() {
+		c := &check{conn: conn, lockFactory: lockFactory}
+
+		err := scanCheck(c, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
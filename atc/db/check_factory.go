@@ -0,0 +1,259 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+// CheckerChannel is notified whenever a check is queued, so that idle
+// checkers can pick it up without waiting for their next tick.
+const CheckerChannel = "checker"
+
+var ErrUnknownCheckable = errors.New("unknown checkable")
+
+// Checkable is a resource or resource type whose versions are found by
+// running checks.
+type Checkable interface {
+	ID() int
+	Name() string
+	Type() string
+	PipelineID() int
+	Tags() atc.Tags
+	CheckEvery() string
+	CheckFailures() int
+	LastActivityTime() time.Time
+}
+
+//go:generate counterfeiter . CheckFactory
+
+type CheckFactory interface {
+	Check(int) (Check, bool, error)
+
+	// TryCreateCheck queues a check unless one is already pending or one was
+	// queued within the given interval.
+	TryCreateCheck(checkable Checkable, interval time.Duration) (bool, error)
+
+	// CreateCheck queues a manually triggered check, taking over any check
+	// which is already pending.
+	CreateCheck(checkable Checkable, fromVersion atc.Version) (Check, error)
+
+	// StartNextCheck claims the oldest pending check, preferring manually
+	// triggered ones. Checks are claimed exactly once across all ATCs.
+	StartNextCheck() (Check, bool, error)
+}
+
+type checkFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewCheckFactory(conn Conn, lockFactory lock.LockFactory) CheckFactory {
+	return &checkFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func (f *checkFactory) Check(id int) (Check, bool, error) {
+	c := &check{
+		id:          id,
+		conn:        f.conn,
+		lockFactory: f.lockFactory,
+	}
+
+	found, err := c.Reload()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return c, true, nil
+}
+
+func (f *checkFactory) TryCreateCheck(checkable Checkable, interval time.Duration) (bool, error) {
+	column, plan, err := checkPlan(checkable, nil)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := f.conn.Exec(`
+		INSERT INTO checks (team_id, pipeline_id, `+column+`, plan)
+		SELECT p.team_id, p.id, $2, $3
+		FROM pipelines p
+		WHERE p.id = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM checks c
+			WHERE c.`+column+` = $2
+			AND (c.status = 'pending' OR c.create_time > now() - ($4 || ' SECONDS')::INTERVAL)
+		)
+		ON CONFLICT DO NOTHING
+	`, checkable.PipelineID(), checkable.ID(), plan, interval.Seconds())
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rows == 0 {
+		return false, nil
+	}
+
+	return true, f.notifyChecker()
+}
+
+func (f *checkFactory) CreateCheck(checkable Checkable, fromVersion atc.Version) (Check, error) {
+	column, plan, err := checkPlan(checkable, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int
+	err = f.conn.QueryRow(`
+		INSERT INTO checks (team_id, pipeline_id, `+column+`, manually_triggered, plan)
+		SELECT p.team_id, p.id, $2, true, $3
+		FROM pipelines p
+		WHERE p.id = $1
+		ON CONFLICT (`+column+`) WHERE status = 'pending' DO UPDATE SET
+			manually_triggered = true,
+			plan = EXCLUDED.plan
+		RETURNING id
+	`, checkable.PipelineID(), checkable.ID(), plan).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.notifyChecker()
+	if err != nil {
+		return nil, err
+	}
+
+	c, found, err := f.Check(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("check %d disappeared", id)
+	}
+
+	return c, nil
+}
+
+func (f *checkFactory) StartNextCheck() (Check, bool, error) {
+	var id int
+	err := f.conn.QueryRow(`
+		UPDATE checks
+		SET status = 'started', start_time = now()
+		WHERE id = (
+			SELECT id FROM checks
+			WHERE status = 'pending'
+			ORDER BY manually_triggered DESC, id ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return f.Check(id)
+}
+
+func (f *checkFactory) notifyChecker() error {
+	return f.conn.Bus().Notify(CheckerChannel)
+}
+
+func checkPlan(checkable Checkable, fromVersion atc.Version) (string, []byte, error) {
+	plan := atc.CheckPlan{
+		Name:        checkable.Name(),
+		Type:        checkable.Type(),
+		Tags:        checkable.Tags(),
+		FromVersion: fromVersion,
+	}
+
+	var column string
+	switch c := checkable.(type) {
+	case Resource:
+		column = "resource_id"
+		plan.Timeout = c.CheckTimeout()
+	case ResourceType:
+		column = "resource_type_id"
+	default:
+		return "", nil, ErrUnknownCheckable
+	}
+
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return column, planJSON, nil
+}
+
+// Checks returns the most recent checks of the pipeline's resources and
+// resource types, newest first.
+func (p *pipeline) Checks(limit int) ([]Check, error) {
+	query := checksQuery.
+		Where(sq.Eq{"c.pipeline_id": p.id}).
+		OrderBy("c.id DESC")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(p.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanChecks(p.conn, p.lockFactory, rows)
+}
+
+// Checks returns the most recent checks of the resource's config scope,
+// newest first, including checks of the resource which have not yet been
+// associated with a scope. Resources sharing a scope share its history.
+func (r *resource) Checks(limit int) ([]Check, error) {
+	var where sq.Sqlizer = sq.Eq{"c.resource_id": r.id}
+	if r.resourceConfigScopeID != 0 {
+		where = sq.Or{
+			sq.Eq{"c.resource_config_scope_id": r.resourceConfigScopeID},
+			sq.And{
+				sq.Eq{"c.resource_id": r.id},
+				sq.Eq{"c.resource_config_scope_id": nil},
+			},
+		}
+	}
+
+	query := checksQuery.
+		Where(where).
+		OrderBy("c.id DESC")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(r.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanChecks(r.conn, r.lockFactory, rows)
+}
@@ -0,0 +1,275 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFactory", func() {
+	var checkFactory db.CheckFactory
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory)
+	})
+
+	Describe("TryCreateCheck", func() {
+		It("queues a check of the resource", func() {
+			created, err := checkFactory.TryCreateCheck(defaultResource, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			checks, err := defaultResource.Checks(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Status()).To(Equal(atc.CheckStatusPending))
+			Expect(checks[0].ManuallyTriggered()).To(BeFalse())
+		})
+
+		Context("when a check of the resource is already pending", func() {
+			BeforeEach(func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("does not queue another one", func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+
+		Context("when a check of the resource was queued within the interval", func() {
+			BeforeEach(func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				check, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.Finish(nil)).To(Succeed())
+			})
+
+			It("does not queue another one", func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+
+			It("queues another one once the interval has passed", func() {
+				_, err := dbConn.Exec(`UPDATE checks SET create_time = now() - '2 minutes'::interval`)
+				Expect(err).ToNot(HaveOccurred())
+
+				created, err := checkFactory.TryCreateCheck(defaultResource, time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("CreateCheck", func() {
+		It("queues a manually triggered check from the given version", func() {
+			check, err := checkFactory.CreateCheck(defaultResource, atc.Version{"ref": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+			Expect(check.Status()).To(Equal(atc.CheckStatusPending))
+			Expect(check.ManuallyTriggered()).To(BeTrue())
+			Expect(check.Plan().FromVersion).To(Equal(atc.Version{"ref": "v1"}))
+		})
+
+		It("queues checks of resource types", func() {
+			check, err := checkFactory.CreateCheck(defaultResourceType, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(check.ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+			Expect(check.ResourceID()).To(BeZero())
+		})
+
+		Context("when a check of the resource is already pending", func() {
+			var pendingCheck db.Check
+
+			BeforeEach(func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				checks, err := defaultResource.Checks(0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+				pendingCheck = checks[0]
+			})
+
+			It("takes over the pending check", func() {
+				check, err := checkFactory.CreateCheck(defaultResource, atc.Version{"ref": "v1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(check.ID()).To(Equal(pendingCheck.ID()))
+				Expect(check.ManuallyTriggered()).To(BeTrue())
+				Expect(check.Plan().FromVersion).To(Equal(atc.Version{"ref": "v1"}))
+
+				checks, err := defaultResource.Checks(0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+			})
+		})
+
+		Context("when the check of the resource has already started", func() {
+			BeforeEach(func() {
+				_, err := checkFactory.CreateCheck(defaultResource, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("queues another one", func() {
+				_, err := checkFactory.CreateCheck(defaultResource, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				checks, err := defaultResource.Checks(0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checks).To(HaveLen(2))
+			})
+		})
+	})
+
+	Describe("Resource.Checks", func() {
+		var scope db.ResourceConfigScope
+
+		BeforeEach(func() {
+			var err error
+			scope, err = defaultResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := defaultResource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		startCheck := func(checkable db.Checkable) db.Check {
+			_, err := checkFactory.CreateCheck(checkable, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			check, found, err := checkFactory.StartNextCheck()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			return check
+		}
+
+		checkIDs := func(checks []db.Check) []int {
+			ids := []int{}
+			for _, check := range checks {
+				ids = append(ids, check.ID())
+			}
+			return ids
+		}
+
+		It("returns the checks of the resource's config scope, newest first", func() {
+			inScope := startCheck(defaultResource)
+			Expect(inScope.SetResourceConfigScope(scope)).To(Succeed())
+
+			sharingScope := startCheck(defaultResourceType)
+			Expect(sharingScope.SetResourceConfigScope(scope)).To(Succeed())
+
+			pending, err := checkFactory.CreateCheck(defaultResource, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherScope, err := defaultResourceType.SetResourceConfig(logger, atc.Source{"some-type": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			elsewhere := startCheck(defaultResourceType)
+			Expect(elsewhere.SetResourceConfigScope(otherScope)).To(Succeed())
+
+			checks, err := defaultResource.Checks(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkIDs(checks)).To(Equal([]int{pending.ID(), sharingScope.ID(), inScope.ID()}))
+		})
+
+		It("returns at most the given number of checks", func() {
+			startCheck(defaultResource)
+			newest := startCheck(defaultResource)
+
+			checks, err := defaultResource.Checks(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkIDs(checks)).To(Equal([]int{newest.ID()}))
+		})
+	})
+
+	Describe("StartNextCheck", func() {
+		Context("when no checks are pending", func() {
+			It("returns false", func() {
+				_, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when checks are pending", func() {
+			var manualCheck db.Check
+
+			BeforeEach(func() {
+				created, err := checkFactory.TryCreateCheck(defaultResource, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				manualCheck, err = checkFactory.CreateCheck(defaultResourceType, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("starts manually triggered checks first", func() {
+				check, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.ID()).To(Equal(manualCheck.ID()))
+				Expect(check.Status()).To(Equal(atc.CheckStatusStarted))
+				Expect(check.StartTime()).ToNot(BeZero())
+			})
+
+			It("starts each check only once", func() {
+				first, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				second, found, err := checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(second.ID()).ToNot(Equal(first.ID()))
+
+				_, found, err = checkFactory.StartNextCheck()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when another ATC is claiming a check", func() {
+				var tx db.Tx
+
+				BeforeEach(func() {
+					var err error
+					tx, err = dbConn.Begin()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = tx.Exec(`SELECT id FROM checks WHERE id = $1 FOR UPDATE`, manualCheck.ID())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					Expect(tx.Rollback()).To(Succeed())
+				})
+
+				It("skips the locked check instead of waiting for it", func() {
+					check, found, err := checkFactory.StartNextCheck()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(check.ID()).ToNot(Equal(manualCheck.ID()))
+					Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . CheckLifecycle
+
+type CheckLifecycle interface {
+	ErrorTimedOutChecks(defaultTimeout time.Duration) (int, error)
+	RemoveExpiredChecks(recyclePeriod time.Duration) (int, error)
+	RemoveExcessChecks(historyLimit int) (int, error)
+}
+
+type checkLifecycle struct {
+	conn Conn
+}
+
+func NewCheckLifecycle(conn Conn) CheckLifecycle {
+	return &checkLifecycle{
+		conn: conn,
+	}
+}
+
+// ErrorTimedOutChecks ends the checks which were started longer than their
+// timeout ago as errored, as the ATC running them must have stopped without
+// finishing them. Checks without a timeout of their own, such as those of
+// resource types, time out after the default timeout.
+func (lifecycle *checkLifecycle) ErrorTimedOutChecks(defaultTimeout time.Duration) (int, error) {
+	rows, err := psql.Select("id", "plan->>'timeout'", "EXTRACT(EPOCH FROM now() - start_time)").
+		From("checks").
+		Where(sq.Eq{"status": atc.CheckStatusStarted}).
+		RunWith(lifecycle.conn).
+		Query()
+	if err != nil {
+		return 0, err
+	}
+
+	timedOut := map[int]time.Duration{}
+	for rows.Next() {
+		var (
+			id      int
+			timeout sql.NullString
+			elapsed float64
+		)
+
+		err := rows.Scan(&id, &timeout, &elapsed)
+		if err != nil {
+			Close(rows)
+			return 0, err
+		}
+
+		checkTimeout := defaultTimeout
+		if timeout.String != "" {
+			checkTimeout, err = time.ParseDuration(timeout.String)
+			if err != nil {
+				checkTimeout = defaultTimeout
+			}
+		}
+
+		if time.Duration(elapsed*float64(time.Second)) > checkTimeout {
+			timedOut[id] = checkTimeout
+		}
+	}
+
+	Close(rows)
+
+	errored := 0
+	for id, timeout := range timedOut {
+		result, err := psql.Update("checks").
+			Set("status", atc.CheckStatusErrored).
+			Set("end_time", sq.Expr("now()")).
+			Set("check_error", fmt.Sprintf("check did not finish within its timeout of %s", timeout)).
+			Where(sq.Eq{
+				"id":     id,
+				"status": atc.CheckStatusStarted,
+			}).
+			RunWith(lifecycle.conn).
+			Exec()
+		if err != nil {
+			return errored, err
+		}
+
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return errored, err
+		}
+
+		errored += int(updated)
+	}
+
+	return errored, nil
+}
+
+// RemoveExpiredChecks removes finished checks which were queued longer than
+// the recycle period ago, unless they are part of the check history of a
+// resource config scope. Checks which are pending or started are kept, as
+// they may still be run or be running; started checks which have timed out
+// are ended by ErrorTimedOutChecks first.
+func (lifecycle *checkLifecycle) RemoveExpiredChecks(recyclePeriod time.Duration) (int, error) {
+	result, err := psql.Delete("checks").
+		Where(sq.NotEq{"status": []atc.CheckStatus{atc.CheckStatusPending, atc.CheckStatusStarted}}).
+		Where("resource_config_scope_id IS NULL").
+		Where("create_time < now() - (? || ' SECONDS')::INTERVAL", recyclePeriod.Seconds()).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rows), nil
+}
+
+// RemoveExcessChecks keeps only the most recent finished checks, along with
+// their output, of each resource config scope.
+func (lifecycle *checkLifecycle) RemoveExcessChecks(historyLimit int) (int, error) {
+	result, err := lifecycle.conn.Exec(`
+		DELETE FROM checks
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (
+					PARTITION BY resource_config_scope_id
+					ORDER BY id DESC
+				) AS n
+				FROM checks
+				WHERE resource_config_scope_id IS NOT NULL
+				AND status NOT IN ('pending', 'started')
+			) history
+			WHERE history.n > $1
+		)
+	`, historyLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rows), nil
+}
@@ -0,0 +1,235 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckLifecycle", func() {
+	var (
+		checkFactory db.CheckFactory
+		lifecycle    db.CheckLifecycle
+	)
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory)
+		lifecycle = db.NewCheckLifecycle(dbConn)
+	})
+
+	startCheck := func(checkable db.Checkable) db.Check {
+		_, err := checkFactory.CreateCheck(checkable, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		check, found, err := checkFactory.StartNextCheck()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		return check
+	}
+
+	expire := func() {
+		_, err := dbConn.Exec(`UPDATE checks SET create_time = now() - '1 hour'::interval`)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	checkExists := func(check db.Check) bool {
+		found, err := check.Reload()
+		Expect(err).ToNot(HaveOccurred())
+		return found
+	}
+
+	Describe("ErrorTimedOutChecks", func() {
+		startedAgo := func(check db.Check, ago string) {
+			_, err := dbConn.Exec(`UPDATE checks SET start_time = now() - $2::interval WHERE id = $1`, check.ID(), ago)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("errors started checks which have run for longer than the default timeout", func() {
+			check := startCheck(defaultResourceType)
+			startedAgo(check, "2 hours")
+
+			errored, err := lifecycle.ErrorTimedOutChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errored).To(Equal(1))
+
+			Expect(checkExists(check)).To(BeTrue())
+			Expect(check.Status()).To(Equal(atc.CheckStatusErrored))
+			Expect(check.EndTime()).ToNot(BeZero())
+			Expect(check.CheckError()).To(MatchError("check did not finish within its timeout of 1h0m0s"))
+		})
+
+		It("uses the timeout of the check's own plan", func() {
+			check := startCheck(defaultResource)
+			startedAgo(check, "20 minutes")
+
+			_, err := dbConn.Exec(`UPDATE checks SET plan = plan || '{"timeout": "10m"}' WHERE id = $1`, check.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			errored, err := lifecycle.ErrorTimedOutChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errored).To(Equal(1))
+
+			Expect(checkExists(check)).To(BeTrue())
+			Expect(check.Status()).To(Equal(atc.CheckStatusErrored))
+		})
+
+		It("leaves checks which are within their timeout, pending or finished", func() {
+			running := startCheck(defaultResource)
+			startedAgo(running, "30 minutes")
+
+			finished := startCheck(defaultResourceType)
+			Expect(finished.Finish(nil)).To(Succeed())
+			startedAgo(finished, "2 hours")
+
+			pending, err := checkFactory.CreateCheck(defaultResource, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			errored, err := lifecycle.ErrorTimedOutChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errored).To(BeZero())
+
+			Expect(checkExists(running)).To(BeTrue())
+			Expect(running.Status()).To(Equal(atc.CheckStatusStarted))
+			Expect(checkExists(finished)).To(BeTrue())
+			Expect(finished.Status()).To(Equal(atc.CheckStatusSucceeded))
+			Expect(checkExists(pending)).To(BeTrue())
+			Expect(pending.Status()).To(Equal(atc.CheckStatusPending))
+		})
+
+		It("keeps the check errored when whatever was running it finishes it later", func() {
+			check := startCheck(defaultResource)
+			startedAgo(check, "2 hours")
+
+			_, err := lifecycle.ErrorTimedOutChecks(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(check.Finish(nil)).To(Succeed())
+
+			Expect(checkExists(check)).To(BeTrue())
+			Expect(check.Status()).To(Equal(atc.CheckStatusErrored))
+			Expect(check.CheckError()).To(HaveOccurred())
+		})
+	})
+
+	Describe("RemoveExpiredChecks", func() {
+		It("removes finished checks queued before the recycle period", func() {
+			succeeded := startCheck(defaultResource)
+			Expect(succeeded.Finish(nil)).To(Succeed())
+
+			skipped := startCheck(defaultResourceType)
+			Expect(skipped.Skip()).To(Succeed())
+
+			expire()
+
+			removed, err := lifecycle.RemoveExpiredChecks(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(2))
+
+			Expect(checkExists(succeeded)).To(BeFalse())
+			Expect(checkExists(skipped)).To(BeFalse())
+		})
+
+		It("keeps finished checks queued within the recycle period", func() {
+			check := startCheck(defaultResource)
+			Expect(check.Finish(nil)).To(Succeed())
+
+			removed, err := lifecycle.RemoveExpiredChecks(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(checkExists(check)).To(BeTrue())
+		})
+
+		It("keeps pending and started checks", func() {
+			started := startCheck(defaultResource)
+
+			pending, err := checkFactory.CreateCheck(defaultResource, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			expire()
+
+			removed, err := lifecycle.RemoveExpiredChecks(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+
+			Expect(checkExists(started)).To(BeTrue())
+			Expect(checkExists(pending)).To(BeTrue())
+			Expect(started.Status()).To(Equal(atc.CheckStatusStarted))
+		})
+
+		It("keeps checks in the check history of a resource config scope", func() {
+			scope, err := defaultResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			check := startCheck(defaultResource)
+			Expect(check.SetResourceConfigScope(scope)).To(Succeed())
+			Expect(check.Finish(nil)).To(Succeed())
+
+			expire()
+
+			removed, err := lifecycle.RemoveExpiredChecks(time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(checkExists(check)).To(BeTrue())
+		})
+	})
+
+	Describe("RemoveExcessChecks", func() {
+		var scope db.ResourceConfigScope
+
+		BeforeEach(func() {
+			var err error
+			scope, err = defaultResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		finishCheckInScope := func() db.Check {
+			check := startCheck(defaultResource)
+			Expect(check.SetResourceConfigScope(scope)).To(Succeed())
+			Expect(check.Finish(nil)).To(Succeed())
+			return check
+		}
+
+		It("keeps only the most recent finished checks of each scope", func() {
+			oldest := finishCheckInScope()
+			older := finishCheckInScope()
+			newest := finishCheckInScope()
+
+			removed, err := lifecycle.RemoveExcessChecks(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			Expect(checkExists(oldest)).To(BeFalse())
+			Expect(checkExists(older)).To(BeTrue())
+			Expect(checkExists(newest)).To(BeTrue())
+		})
+
+		It("keeps checks which are still running", func() {
+			finished := finishCheckInScope()
+
+			started := startCheck(defaultResource)
+			Expect(started.SetResourceConfigScope(scope)).To(Succeed())
+
+			removed, err := lifecycle.RemoveExcessChecks(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			Expect(checkExists(finished)).To(BeFalse())
+			Expect(checkExists(started)).To(BeTrue())
+		})
+
+		It("leaves checks which are not part of a scope's history to expire", func() {
+			check := startCheck(defaultResourceType)
+			Expect(check.Finish(nil)).To(Succeed())
+
+			removed, err := lifecycle.RemoveExcessChecks(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+			Expect(checkExists(check)).To(BeTrue())
+		})
+	})
+})
@@ -162,7 +162,8 @@ var _ = BeforeEach(func() {
 				},
 			},
 		},
-	}, db.ConfigVersion(0), db.PipelineUnpaused)
+	}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
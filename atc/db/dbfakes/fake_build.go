@@ -314,6 +314,17 @@ type FakeBuild struct {
 	reapTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	RecordSecretLookupsStub        func([]creds.SecretLookup) error
+	recordSecretLookupsMutex       sync.RWMutex
+	recordSecretLookupsArgsForCall []struct {
+		arg1 []creds.SecretLookup
+	}
+	recordSecretLookupsReturns struct {
+		result1 error
+	}
+	recordSecretLookupsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -326,6 +337,26 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunOfStub        func() int
+	rerunOfMutex       sync.RWMutex
+	rerunOfArgsForCall []struct {
+	}
+	rerunOfReturns struct {
+		result1 int
+	}
+	rerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RerunOfNameStub        func() string
+	rerunOfNameMutex       sync.RWMutex
+	rerunOfNameArgsForCall []struct {
+	}
+	rerunOfNameReturns struct {
+		result1 string
+	}
+	rerunOfNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -1974,6 +2005,71 @@ func (fake *FakeBuild) ReapTimeReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeBuild) RecordSecretLookups(arg1 []creds.SecretLookup) error {
+	var arg1Copy []creds.SecretLookup
+	if arg1 != nil {
+		arg1Copy = make([]creds.SecretLookup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.recordSecretLookupsMutex.Lock()
+	ret, specificReturn := fake.recordSecretLookupsReturnsOnCall[len(fake.recordSecretLookupsArgsForCall)]
+	fake.recordSecretLookupsArgsForCall = append(fake.recordSecretLookupsArgsForCall, struct {
+		arg1 []creds.SecretLookup
+	}{arg1Copy})
+	fake.recordInvocation("RecordSecretLookups", []interface{}{arg1Copy})
+	fake.recordSecretLookupsMutex.Unlock()
+	if fake.RecordSecretLookupsStub != nil {
+		return fake.RecordSecretLookupsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordSecretLookupsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RecordSecretLookupsCallCount() int {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	return len(fake.recordSecretLookupsArgsForCall)
+}
+
+func (fake *FakeBuild) RecordSecretLookupsCalls(stub func([]creds.SecretLookup) error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = stub
+}
+
+func (fake *FakeBuild) RecordSecretLookupsArgsForCall(i int) []creds.SecretLookup {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	argsForCall := fake.recordSecretLookupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) RecordSecretLookupsReturns(result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	fake.recordSecretLookupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RecordSecretLookupsReturnsOnCall(i int, result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	if fake.recordSecretLookupsReturnsOnCall == nil {
+		fake.recordSecretLookupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordSecretLookupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2029,6 +2125,110 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunOf() int {
+	fake.rerunOfMutex.Lock()
+	ret, specificReturn := fake.rerunOfReturnsOnCall[len(fake.rerunOfArgsForCall)]
+	fake.rerunOfArgsForCall = append(fake.rerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOf", []interface{}{})
+	fake.rerunOfMutex.Unlock()
+	if fake.RerunOfStub != nil {
+		return fake.RerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfCallCount() int {
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	return len(fake.rerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfCalls(stub func() int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = stub
+}
+
+func (fake *FakeBuild) RerunOfReturns(result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	fake.rerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfReturnsOnCall(i int, result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	if fake.rerunOfReturnsOnCall == nil {
+		fake.rerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfName() string {
+	fake.rerunOfNameMutex.Lock()
+	ret, specificReturn := fake.rerunOfNameReturnsOnCall[len(fake.rerunOfNameArgsForCall)]
+	fake.rerunOfNameArgsForCall = append(fake.rerunOfNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOfName", []interface{}{})
+	fake.rerunOfNameMutex.Unlock()
+	if fake.RerunOfNameStub != nil {
+		return fake.RerunOfNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfNameCallCount() int {
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
+	return len(fake.rerunOfNameArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfNameCalls(stub func() string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = stub
+}
+
+func (fake *FakeBuild) RerunOfNameReturns(result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	fake.rerunOfNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfNameReturnsOnCall(i int, result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	if fake.rerunOfNameReturnsOnCall == nil {
+		fake.rerunOfNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rerunOfNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -2895,8 +3095,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
 	fake.saveEventMutex.RLock()
@@ -0,0 +1,1489 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeCheck struct {
+	AppendOutputStub        func(string) error
+	appendOutputMutex       sync.RWMutex
+	appendOutputArgsForCall []struct {
+		arg1 string
+	}
+	appendOutputReturns struct {
+		result1 error
+	}
+	appendOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckErrorStub        func() error
+	checkErrorMutex       sync.RWMutex
+	checkErrorArgsForCall []struct {
+	}
+	checkErrorReturns struct {
+		result1 error
+	}
+	checkErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	EndTimeStub        func() time.Time
+	endTimeMutex       sync.RWMutex
+	endTimeArgsForCall []struct {
+	}
+	endTimeReturns struct {
+		result1 time.Time
+	}
+	endTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	FinishStub        func(error) error
+	finishMutex       sync.RWMutex
+	finishArgsForCall []struct {
+		arg1 error
+	}
+	finishReturns struct {
+		result1 error
+	}
+	finishReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IDStub        func() int
+	iDMutex       sync.RWMutex
+	iDArgsForCall []struct {
+	}
+	iDReturns struct {
+		result1 int
+	}
+	iDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ManuallyTriggeredStub        func() bool
+	manuallyTriggeredMutex       sync.RWMutex
+	manuallyTriggeredArgsForCall []struct {
+	}
+	manuallyTriggeredReturns struct {
+		result1 bool
+	}
+	manuallyTriggeredReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	OutputStub        func() string
+	outputMutex       sync.RWMutex
+	outputArgsForCall []struct {
+	}
+	outputReturns struct {
+		result1 string
+	}
+	outputReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PipelineStub        func() (db.Pipeline, bool, error)
+	pipelineMutex       sync.RWMutex
+	pipelineArgsForCall []struct {
+	}
+	pipelineReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	pipelineReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	PipelineIDStub        func() int
+	pipelineIDMutex       sync.RWMutex
+	pipelineIDArgsForCall []struct {
+	}
+	pipelineIDReturns struct {
+		result1 int
+	}
+	pipelineIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PipelineNameStub        func() string
+	pipelineNameMutex       sync.RWMutex
+	pipelineNameArgsForCall []struct {
+	}
+	pipelineNameReturns struct {
+		result1 string
+	}
+	pipelineNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PlanStub        func() atc.CheckPlan
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+	}
+	planReturns struct {
+		result1 atc.CheckPlan
+	}
+	planReturnsOnCall map[int]struct {
+		result1 atc.CheckPlan
+	}
+	ReloadStub        func() (bool, error)
+	reloadMutex       sync.RWMutex
+	reloadArgsForCall []struct {
+	}
+	reloadReturns struct {
+		result1 bool
+		result2 error
+	}
+	reloadReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ResourceConfigScopeIDStub        func() int
+	resourceConfigScopeIDMutex       sync.RWMutex
+	resourceConfigScopeIDArgsForCall []struct {
+	}
+	resourceConfigScopeIDReturns struct {
+		result1 int
+	}
+	resourceConfigScopeIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ResourceIDStub        func() int
+	resourceIDMutex       sync.RWMutex
+	resourceIDArgsForCall []struct {
+	}
+	resourceIDReturns struct {
+		result1 int
+	}
+	resourceIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ResourceTypeIDStub        func() int
+	resourceTypeIDMutex       sync.RWMutex
+	resourceTypeIDArgsForCall []struct {
+	}
+	resourceTypeIDReturns struct {
+		result1 int
+	}
+	resourceTypeIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	SetResourceConfigScopeStub        func(db.ResourceConfigScope) error
+	setResourceConfigScopeMutex       sync.RWMutex
+	setResourceConfigScopeArgsForCall []struct {
+		arg1 db.ResourceConfigScope
+	}
+	setResourceConfigScopeReturns struct {
+		result1 error
+	}
+	setResourceConfigScopeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SkipStub        func() error
+	skipMutex       sync.RWMutex
+	skipArgsForCall []struct {
+	}
+	skipReturns struct {
+		result1 error
+	}
+	skipReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartTimeStub        func() time.Time
+	startTimeMutex       sync.RWMutex
+	startTimeArgsForCall []struct {
+	}
+	startTimeReturns struct {
+		result1 time.Time
+	}
+	startTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	StatusStub        func() atc.CheckStatus
+	statusMutex       sync.RWMutex
+	statusArgsForCall []struct {
+	}
+	statusReturns struct {
+		result1 atc.CheckStatus
+	}
+	statusReturnsOnCall map[int]struct {
+		result1 atc.CheckStatus
+	}
+	TeamIDStub        func() int
+	teamIDMutex       sync.RWMutex
+	teamIDArgsForCall []struct {
+	}
+	teamIDReturns struct {
+		result1 int
+	}
+	teamIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	TeamNameStub        func() string
+	teamNameMutex       sync.RWMutex
+	teamNameArgsForCall []struct {
+	}
+	teamNameReturns struct {
+		result1 string
+	}
+	teamNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCheck) AppendOutput(arg1 string) error {
+	fake.appendOutputMutex.Lock()
+	ret, specificReturn := fake.appendOutputReturnsOnCall[len(fake.appendOutputArgsForCall)]
+	fake.appendOutputArgsForCall = append(fake.appendOutputArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("AppendOutput", []interface{}{arg1})
+	fake.appendOutputMutex.Unlock()
+	if fake.AppendOutputStub != nil {
+		return fake.AppendOutputStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.appendOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) AppendOutputCallCount() int {
+	fake.appendOutputMutex.RLock()
+	defer fake.appendOutputMutex.RUnlock()
+	return len(fake.appendOutputArgsForCall)
+}
+
+func (fake *FakeCheck) AppendOutputCalls(stub func(string) error) {
+	fake.appendOutputMutex.Lock()
+	defer fake.appendOutputMutex.Unlock()
+	fake.AppendOutputStub = stub
+}
+
+func (fake *FakeCheck) AppendOutputArgsForCall(i int) string {
+	fake.appendOutputMutex.RLock()
+	defer fake.appendOutputMutex.RUnlock()
+	argsForCall := fake.appendOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheck) AppendOutputReturns(result1 error) {
+	fake.appendOutputMutex.Lock()
+	defer fake.appendOutputMutex.Unlock()
+	fake.AppendOutputStub = nil
+	fake.appendOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) AppendOutputReturnsOnCall(i int, result1 error) {
+	fake.appendOutputMutex.Lock()
+	defer fake.appendOutputMutex.Unlock()
+	fake.AppendOutputStub = nil
+	if fake.appendOutputReturnsOnCall == nil {
+		fake.appendOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) CheckError() error {
+	fake.checkErrorMutex.Lock()
+	ret, specificReturn := fake.checkErrorReturnsOnCall[len(fake.checkErrorArgsForCall)]
+	fake.checkErrorArgsForCall = append(fake.checkErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckError", []interface{}{})
+	fake.checkErrorMutex.Unlock()
+	if fake.CheckErrorStub != nil {
+		return fake.CheckErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) CheckErrorCallCount() int {
+	fake.checkErrorMutex.RLock()
+	defer fake.checkErrorMutex.RUnlock()
+	return len(fake.checkErrorArgsForCall)
+}
+
+func (fake *FakeCheck) CheckErrorCalls(stub func() error) {
+	fake.checkErrorMutex.Lock()
+	defer fake.checkErrorMutex.Unlock()
+	fake.CheckErrorStub = stub
+}
+
+func (fake *FakeCheck) CheckErrorReturns(result1 error) {
+	fake.checkErrorMutex.Lock()
+	defer fake.checkErrorMutex.Unlock()
+	fake.CheckErrorStub = nil
+	fake.checkErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) CheckErrorReturnsOnCall(i int, result1 error) {
+	fake.checkErrorMutex.Lock()
+	defer fake.checkErrorMutex.Unlock()
+	fake.CheckErrorStub = nil
+	if fake.checkErrorReturnsOnCall == nil {
+		fake.checkErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if fake.CreateTimeStub != nil {
+		return fake.CreateTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeCheck) CreateTimeCalls(stub func() time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = stub
+}
+
+func (fake *FakeCheck) CreateTimeReturns(result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) EndTime() time.Time {
+	fake.endTimeMutex.Lock()
+	ret, specificReturn := fake.endTimeReturnsOnCall[len(fake.endTimeArgsForCall)]
+	fake.endTimeArgsForCall = append(fake.endTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EndTime", []interface{}{})
+	fake.endTimeMutex.Unlock()
+	if fake.EndTimeStub != nil {
+		return fake.EndTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.endTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) EndTimeCallCount() int {
+	fake.endTimeMutex.RLock()
+	defer fake.endTimeMutex.RUnlock()
+	return len(fake.endTimeArgsForCall)
+}
+
+func (fake *FakeCheck) EndTimeCalls(stub func() time.Time) {
+	fake.endTimeMutex.Lock()
+	defer fake.endTimeMutex.Unlock()
+	fake.EndTimeStub = stub
+}
+
+func (fake *FakeCheck) EndTimeReturns(result1 time.Time) {
+	fake.endTimeMutex.Lock()
+	defer fake.endTimeMutex.Unlock()
+	fake.EndTimeStub = nil
+	fake.endTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) EndTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.endTimeMutex.Lock()
+	defer fake.endTimeMutex.Unlock()
+	fake.EndTimeStub = nil
+	if fake.endTimeReturnsOnCall == nil {
+		fake.endTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.endTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) Finish(arg1 error) error {
+	fake.finishMutex.Lock()
+	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
+	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
+		arg1 error
+	}{arg1})
+	fake.recordInvocation("Finish", []interface{}{arg1})
+	fake.finishMutex.Unlock()
+	if fake.FinishStub != nil {
+		return fake.FinishStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.finishReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) FinishCallCount() int {
+	fake.finishMutex.RLock()
+	defer fake.finishMutex.RUnlock()
+	return len(fake.finishArgsForCall)
+}
+
+func (fake *FakeCheck) FinishCalls(stub func(error) error) {
+	fake.finishMutex.Lock()
+	defer fake.finishMutex.Unlock()
+	fake.FinishStub = stub
+}
+
+func (fake *FakeCheck) FinishArgsForCall(i int) error {
+	fake.finishMutex.RLock()
+	defer fake.finishMutex.RUnlock()
+	argsForCall := fake.finishArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheck) FinishReturns(result1 error) {
+	fake.finishMutex.Lock()
+	defer fake.finishMutex.Unlock()
+	fake.FinishStub = nil
+	fake.finishReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) FinishReturnsOnCall(i int, result1 error) {
+	fake.finishMutex.Lock()
+	defer fake.finishMutex.Unlock()
+	fake.FinishStub = nil
+	if fake.finishReturnsOnCall == nil {
+		fake.finishReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) ID() int {
+	fake.iDMutex.Lock()
+	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
+	fake.iDArgsForCall = append(fake.iDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ID", []interface{}{})
+	fake.iDMutex.Unlock()
+	if fake.IDStub != nil {
+		return fake.IDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) IDCallCount() int {
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	return len(fake.iDArgsForCall)
+}
+
+func (fake *FakeCheck) IDCalls(stub func() int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = stub
+}
+
+func (fake *FakeCheck) IDReturns(result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	fake.iDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) IDReturnsOnCall(i int, result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	if fake.iDReturnsOnCall == nil {
+		fake.iDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.iDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ManuallyTriggered() bool {
+	fake.manuallyTriggeredMutex.Lock()
+	ret, specificReturn := fake.manuallyTriggeredReturnsOnCall[len(fake.manuallyTriggeredArgsForCall)]
+	fake.manuallyTriggeredArgsForCall = append(fake.manuallyTriggeredArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ManuallyTriggered", []interface{}{})
+	fake.manuallyTriggeredMutex.Unlock()
+	if fake.ManuallyTriggeredStub != nil {
+		return fake.ManuallyTriggeredStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.manuallyTriggeredReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) ManuallyTriggeredCallCount() int {
+	fake.manuallyTriggeredMutex.RLock()
+	defer fake.manuallyTriggeredMutex.RUnlock()
+	return len(fake.manuallyTriggeredArgsForCall)
+}
+
+func (fake *FakeCheck) ManuallyTriggeredCalls(stub func() bool) {
+	fake.manuallyTriggeredMutex.Lock()
+	defer fake.manuallyTriggeredMutex.Unlock()
+	fake.ManuallyTriggeredStub = stub
+}
+
+func (fake *FakeCheck) ManuallyTriggeredReturns(result1 bool) {
+	fake.manuallyTriggeredMutex.Lock()
+	defer fake.manuallyTriggeredMutex.Unlock()
+	fake.ManuallyTriggeredStub = nil
+	fake.manuallyTriggeredReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCheck) ManuallyTriggeredReturnsOnCall(i int, result1 bool) {
+	fake.manuallyTriggeredMutex.Lock()
+	defer fake.manuallyTriggeredMutex.Unlock()
+	fake.ManuallyTriggeredStub = nil
+	if fake.manuallyTriggeredReturnsOnCall == nil {
+		fake.manuallyTriggeredReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.manuallyTriggeredReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCheck) Output() string {
+	fake.outputMutex.Lock()
+	ret, specificReturn := fake.outputReturnsOnCall[len(fake.outputArgsForCall)]
+	fake.outputArgsForCall = append(fake.outputArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Output", []interface{}{})
+	fake.outputMutex.Unlock()
+	if fake.OutputStub != nil {
+		return fake.OutputStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.outputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) OutputCallCount() int {
+	fake.outputMutex.RLock()
+	defer fake.outputMutex.RUnlock()
+	return len(fake.outputArgsForCall)
+}
+
+func (fake *FakeCheck) OutputCalls(stub func() string) {
+	fake.outputMutex.Lock()
+	defer fake.outputMutex.Unlock()
+	fake.OutputStub = stub
+}
+
+func (fake *FakeCheck) OutputReturns(result1 string) {
+	fake.outputMutex.Lock()
+	defer fake.outputMutex.Unlock()
+	fake.OutputStub = nil
+	fake.outputReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) OutputReturnsOnCall(i int, result1 string) {
+	fake.outputMutex.Lock()
+	defer fake.outputMutex.Unlock()
+	fake.OutputStub = nil
+	if fake.outputReturnsOnCall == nil {
+		fake.outputReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.outputReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) Pipeline() (db.Pipeline, bool, error) {
+	fake.pipelineMutex.Lock()
+	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
+	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Pipeline", []interface{}{})
+	fake.pipelineMutex.Unlock()
+	if fake.PipelineStub != nil {
+		return fake.PipelineStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCheck) PipelineCallCount() int {
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
+	return len(fake.pipelineArgsForCall)
+}
+
+func (fake *FakeCheck) PipelineCalls(stub func() (db.Pipeline, bool, error)) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = stub
+}
+
+func (fake *FakeCheck) PipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = nil
+	fake.pipelineReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheck) PipelineReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = nil
+	if fake.pipelineReturnsOnCall == nil {
+		fake.pipelineReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheck) PipelineID() int {
+	fake.pipelineIDMutex.Lock()
+	ret, specificReturn := fake.pipelineIDReturnsOnCall[len(fake.pipelineIDArgsForCall)]
+	fake.pipelineIDArgsForCall = append(fake.pipelineIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineID", []interface{}{})
+	fake.pipelineIDMutex.Unlock()
+	if fake.PipelineIDStub != nil {
+		return fake.PipelineIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) PipelineIDCallCount() int {
+	fake.pipelineIDMutex.RLock()
+	defer fake.pipelineIDMutex.RUnlock()
+	return len(fake.pipelineIDArgsForCall)
+}
+
+func (fake *FakeCheck) PipelineIDCalls(stub func() int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = stub
+}
+
+func (fake *FakeCheck) PipelineIDReturns(result1 int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = nil
+	fake.pipelineIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) PipelineIDReturnsOnCall(i int, result1 int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = nil
+	if fake.pipelineIDReturnsOnCall == nil {
+		fake.pipelineIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.pipelineIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) PipelineName() string {
+	fake.pipelineNameMutex.Lock()
+	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
+	fake.pipelineNameArgsForCall = append(fake.pipelineNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineName", []interface{}{})
+	fake.pipelineNameMutex.Unlock()
+	if fake.PipelineNameStub != nil {
+		return fake.PipelineNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) PipelineNameCallCount() int {
+	fake.pipelineNameMutex.RLock()
+	defer fake.pipelineNameMutex.RUnlock()
+	return len(fake.pipelineNameArgsForCall)
+}
+
+func (fake *FakeCheck) PipelineNameCalls(stub func() string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = stub
+}
+
+func (fake *FakeCheck) PipelineNameReturns(result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	fake.pipelineNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) PipelineNameReturnsOnCall(i int, result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	if fake.pipelineNameReturnsOnCall == nil {
+		fake.pipelineNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pipelineNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) Plan() atc.CheckPlan {
+	fake.planMutex.Lock()
+	ret, specificReturn := fake.planReturnsOnCall[len(fake.planArgsForCall)]
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Plan", []interface{}{})
+	fake.planMutex.Unlock()
+	if fake.PlanStub != nil {
+		return fake.PlanStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.planReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeCheck) PlanCalls(stub func() atc.CheckPlan) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = stub
+}
+
+func (fake *FakeCheck) PlanReturns(result1 atc.CheckPlan) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 atc.CheckPlan
+	}{result1}
+}
+
+func (fake *FakeCheck) PlanReturnsOnCall(i int, result1 atc.CheckPlan) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	if fake.planReturnsOnCall == nil {
+		fake.planReturnsOnCall = make(map[int]struct {
+			result1 atc.CheckPlan
+		})
+	}
+	fake.planReturnsOnCall[i] = struct {
+		result1 atc.CheckPlan
+	}{result1}
+}
+
+func (fake *FakeCheck) Reload() (bool, error) {
+	fake.reloadMutex.Lock()
+	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
+	fake.reloadArgsForCall = append(fake.reloadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Reload", []interface{}{})
+	fake.reloadMutex.Unlock()
+	if fake.ReloadStub != nil {
+		return fake.ReloadStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.reloadReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheck) ReloadCallCount() int {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return len(fake.reloadArgsForCall)
+}
+
+func (fake *FakeCheck) ReloadCalls(stub func() (bool, error)) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = stub
+}
+
+func (fake *FakeCheck) ReloadReturns(result1 bool, result2 error) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = nil
+	fake.reloadReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheck) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = nil
+	if fake.reloadReturnsOnCall == nil {
+		fake.reloadReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.reloadReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheck) ResourceConfigScopeID() int {
+	fake.resourceConfigScopeIDMutex.Lock()
+	ret, specificReturn := fake.resourceConfigScopeIDReturnsOnCall[len(fake.resourceConfigScopeIDArgsForCall)]
+	fake.resourceConfigScopeIDArgsForCall = append(fake.resourceConfigScopeIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceConfigScopeID", []interface{}{})
+	fake.resourceConfigScopeIDMutex.Unlock()
+	if fake.ResourceConfigScopeIDStub != nil {
+		return fake.ResourceConfigScopeIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceConfigScopeIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) ResourceConfigScopeIDCallCount() int {
+	fake.resourceConfigScopeIDMutex.RLock()
+	defer fake.resourceConfigScopeIDMutex.RUnlock()
+	return len(fake.resourceConfigScopeIDArgsForCall)
+}
+
+func (fake *FakeCheck) ResourceConfigScopeIDCalls(stub func() int) {
+	fake.resourceConfigScopeIDMutex.Lock()
+	defer fake.resourceConfigScopeIDMutex.Unlock()
+	fake.ResourceConfigScopeIDStub = stub
+}
+
+func (fake *FakeCheck) ResourceConfigScopeIDReturns(result1 int) {
+	fake.resourceConfigScopeIDMutex.Lock()
+	defer fake.resourceConfigScopeIDMutex.Unlock()
+	fake.ResourceConfigScopeIDStub = nil
+	fake.resourceConfigScopeIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ResourceConfigScopeIDReturnsOnCall(i int, result1 int) {
+	fake.resourceConfigScopeIDMutex.Lock()
+	defer fake.resourceConfigScopeIDMutex.Unlock()
+	fake.ResourceConfigScopeIDStub = nil
+	if fake.resourceConfigScopeIDReturnsOnCall == nil {
+		fake.resourceConfigScopeIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resourceConfigScopeIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ResourceID() int {
+	fake.resourceIDMutex.Lock()
+	ret, specificReturn := fake.resourceIDReturnsOnCall[len(fake.resourceIDArgsForCall)]
+	fake.resourceIDArgsForCall = append(fake.resourceIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceID", []interface{}{})
+	fake.resourceIDMutex.Unlock()
+	if fake.ResourceIDStub != nil {
+		return fake.ResourceIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) ResourceIDCallCount() int {
+	fake.resourceIDMutex.RLock()
+	defer fake.resourceIDMutex.RUnlock()
+	return len(fake.resourceIDArgsForCall)
+}
+
+func (fake *FakeCheck) ResourceIDCalls(stub func() int) {
+	fake.resourceIDMutex.Lock()
+	defer fake.resourceIDMutex.Unlock()
+	fake.ResourceIDStub = stub
+}
+
+func (fake *FakeCheck) ResourceIDReturns(result1 int) {
+	fake.resourceIDMutex.Lock()
+	defer fake.resourceIDMutex.Unlock()
+	fake.ResourceIDStub = nil
+	fake.resourceIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ResourceIDReturnsOnCall(i int, result1 int) {
+	fake.resourceIDMutex.Lock()
+	defer fake.resourceIDMutex.Unlock()
+	fake.ResourceIDStub = nil
+	if fake.resourceIDReturnsOnCall == nil {
+		fake.resourceIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resourceIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ResourceTypeID() int {
+	fake.resourceTypeIDMutex.Lock()
+	ret, specificReturn := fake.resourceTypeIDReturnsOnCall[len(fake.resourceTypeIDArgsForCall)]
+	fake.resourceTypeIDArgsForCall = append(fake.resourceTypeIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceTypeID", []interface{}{})
+	fake.resourceTypeIDMutex.Unlock()
+	if fake.ResourceTypeIDStub != nil {
+		return fake.ResourceTypeIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceTypeIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) ResourceTypeIDCallCount() int {
+	fake.resourceTypeIDMutex.RLock()
+	defer fake.resourceTypeIDMutex.RUnlock()
+	return len(fake.resourceTypeIDArgsForCall)
+}
+
+func (fake *FakeCheck) ResourceTypeIDCalls(stub func() int) {
+	fake.resourceTypeIDMutex.Lock()
+	defer fake.resourceTypeIDMutex.Unlock()
+	fake.ResourceTypeIDStub = stub
+}
+
+func (fake *FakeCheck) ResourceTypeIDReturns(result1 int) {
+	fake.resourceTypeIDMutex.Lock()
+	defer fake.resourceTypeIDMutex.Unlock()
+	fake.ResourceTypeIDStub = nil
+	fake.resourceTypeIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) ResourceTypeIDReturnsOnCall(i int, result1 int) {
+	fake.resourceTypeIDMutex.Lock()
+	defer fake.resourceTypeIDMutex.Unlock()
+	fake.ResourceTypeIDStub = nil
+	if fake.resourceTypeIDReturnsOnCall == nil {
+		fake.resourceTypeIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resourceTypeIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) SetResourceConfigScope(arg1 db.ResourceConfigScope) error {
+	fake.setResourceConfigScopeMutex.Lock()
+	ret, specificReturn := fake.setResourceConfigScopeReturnsOnCall[len(fake.setResourceConfigScopeArgsForCall)]
+	fake.setResourceConfigScopeArgsForCall = append(fake.setResourceConfigScopeArgsForCall, struct {
+		arg1 db.ResourceConfigScope
+	}{arg1})
+	fake.recordInvocation("SetResourceConfigScope", []interface{}{arg1})
+	fake.setResourceConfigScopeMutex.Unlock()
+	if fake.SetResourceConfigScopeStub != nil {
+		return fake.SetResourceConfigScopeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setResourceConfigScopeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) SetResourceConfigScopeCallCount() int {
+	fake.setResourceConfigScopeMutex.RLock()
+	defer fake.setResourceConfigScopeMutex.RUnlock()
+	return len(fake.setResourceConfigScopeArgsForCall)
+}
+
+func (fake *FakeCheck) SetResourceConfigScopeCalls(stub func(db.ResourceConfigScope) error) {
+	fake.setResourceConfigScopeMutex.Lock()
+	defer fake.setResourceConfigScopeMutex.Unlock()
+	fake.SetResourceConfigScopeStub = stub
+}
+
+func (fake *FakeCheck) SetResourceConfigScopeArgsForCall(i int) db.ResourceConfigScope {
+	fake.setResourceConfigScopeMutex.RLock()
+	defer fake.setResourceConfigScopeMutex.RUnlock()
+	argsForCall := fake.setResourceConfigScopeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheck) SetResourceConfigScopeReturns(result1 error) {
+	fake.setResourceConfigScopeMutex.Lock()
+	defer fake.setResourceConfigScopeMutex.Unlock()
+	fake.SetResourceConfigScopeStub = nil
+	fake.setResourceConfigScopeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) SetResourceConfigScopeReturnsOnCall(i int, result1 error) {
+	fake.setResourceConfigScopeMutex.Lock()
+	defer fake.setResourceConfigScopeMutex.Unlock()
+	fake.SetResourceConfigScopeStub = nil
+	if fake.setResourceConfigScopeReturnsOnCall == nil {
+		fake.setResourceConfigScopeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setResourceConfigScopeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) Skip() error {
+	fake.skipMutex.Lock()
+	ret, specificReturn := fake.skipReturnsOnCall[len(fake.skipArgsForCall)]
+	fake.skipArgsForCall = append(fake.skipArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Skip", []interface{}{})
+	fake.skipMutex.Unlock()
+	if fake.SkipStub != nil {
+		return fake.SkipStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.skipReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) SkipCallCount() int {
+	fake.skipMutex.RLock()
+	defer fake.skipMutex.RUnlock()
+	return len(fake.skipArgsForCall)
+}
+
+func (fake *FakeCheck) SkipCalls(stub func() error) {
+	fake.skipMutex.Lock()
+	defer fake.skipMutex.Unlock()
+	fake.SkipStub = stub
+}
+
+func (fake *FakeCheck) SkipReturns(result1 error) {
+	fake.skipMutex.Lock()
+	defer fake.skipMutex.Unlock()
+	fake.SkipStub = nil
+	fake.skipReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) SkipReturnsOnCall(i int, result1 error) {
+	fake.skipMutex.Lock()
+	defer fake.skipMutex.Unlock()
+	fake.SkipStub = nil
+	if fake.skipReturnsOnCall == nil {
+		fake.skipReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.skipReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheck) StartTime() time.Time {
+	fake.startTimeMutex.Lock()
+	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
+	fake.startTimeArgsForCall = append(fake.startTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartTime", []interface{}{})
+	fake.startTimeMutex.Unlock()
+	if fake.StartTimeStub != nil {
+		return fake.StartTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.startTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) StartTimeCallCount() int {
+	fake.startTimeMutex.RLock()
+	defer fake.startTimeMutex.RUnlock()
+	return len(fake.startTimeArgsForCall)
+}
+
+func (fake *FakeCheck) StartTimeCalls(stub func() time.Time) {
+	fake.startTimeMutex.Lock()
+	defer fake.startTimeMutex.Unlock()
+	fake.StartTimeStub = stub
+}
+
+func (fake *FakeCheck) StartTimeReturns(result1 time.Time) {
+	fake.startTimeMutex.Lock()
+	defer fake.startTimeMutex.Unlock()
+	fake.StartTimeStub = nil
+	fake.startTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) StartTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.startTimeMutex.Lock()
+	defer fake.startTimeMutex.Unlock()
+	fake.StartTimeStub = nil
+	if fake.startTimeReturnsOnCall == nil {
+		fake.startTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.startTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheck) Status() atc.CheckStatus {
+	fake.statusMutex.Lock()
+	ret, specificReturn := fake.statusReturnsOnCall[len(fake.statusArgsForCall)]
+	fake.statusArgsForCall = append(fake.statusArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Status", []interface{}{})
+	fake.statusMutex.Unlock()
+	if fake.StatusStub != nil {
+		return fake.StatusStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.statusReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) StatusCallCount() int {
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	return len(fake.statusArgsForCall)
+}
+
+func (fake *FakeCheck) StatusCalls(stub func() atc.CheckStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = stub
+}
+
+func (fake *FakeCheck) StatusReturns(result1 atc.CheckStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	fake.statusReturns = struct {
+		result1 atc.CheckStatus
+	}{result1}
+}
+
+func (fake *FakeCheck) StatusReturnsOnCall(i int, result1 atc.CheckStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	if fake.statusReturnsOnCall == nil {
+		fake.statusReturnsOnCall = make(map[int]struct {
+			result1 atc.CheckStatus
+		})
+	}
+	fake.statusReturnsOnCall[i] = struct {
+		result1 atc.CheckStatus
+	}{result1}
+}
+
+func (fake *FakeCheck) TeamID() int {
+	fake.teamIDMutex.Lock()
+	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
+	fake.teamIDArgsForCall = append(fake.teamIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TeamID", []interface{}{})
+	fake.teamIDMutex.Unlock()
+	if fake.TeamIDStub != nil {
+		return fake.TeamIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.teamIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) TeamIDCallCount() int {
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	return len(fake.teamIDArgsForCall)
+}
+
+func (fake *FakeCheck) TeamIDCalls(stub func() int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = stub
+}
+
+func (fake *FakeCheck) TeamIDReturns(result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	fake.teamIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) TeamIDReturnsOnCall(i int, result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	if fake.teamIDReturnsOnCall == nil {
+		fake.teamIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.teamIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheck) TeamName() string {
+	fake.teamNameMutex.Lock()
+	ret, specificReturn := fake.teamNameReturnsOnCall[len(fake.teamNameArgsForCall)]
+	fake.teamNameArgsForCall = append(fake.teamNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TeamName", []interface{}{})
+	fake.teamNameMutex.Unlock()
+	if fake.TeamNameStub != nil {
+		return fake.TeamNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.teamNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) TeamNameCallCount() int {
+	fake.teamNameMutex.RLock()
+	defer fake.teamNameMutex.RUnlock()
+	return len(fake.teamNameArgsForCall)
+}
+
+func (fake *FakeCheck) TeamNameCalls(stub func() string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = stub
+}
+
+func (fake *FakeCheck) TeamNameReturns(result1 string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = nil
+	fake.teamNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) TeamNameReturnsOnCall(i int, result1 string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = nil
+	if fake.teamNameReturnsOnCall == nil {
+		fake.teamNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.teamNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCheck) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.appendOutputMutex.RLock()
+	defer fake.appendOutputMutex.RUnlock()
+	fake.checkErrorMutex.RLock()
+	defer fake.checkErrorMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	fake.endTimeMutex.RLock()
+	defer fake.endTimeMutex.RUnlock()
+	fake.finishMutex.RLock()
+	defer fake.finishMutex.RUnlock()
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	fake.manuallyTriggeredMutex.RLock()
+	defer fake.manuallyTriggeredMutex.RUnlock()
+	fake.outputMutex.RLock()
+	defer fake.outputMutex.RUnlock()
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineIDMutex.RLock()
+	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineNameMutex.RLock()
+	defer fake.pipelineNameMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	fake.resourceConfigScopeIDMutex.RLock()
+	defer fake.resourceConfigScopeIDMutex.RUnlock()
+	fake.resourceIDMutex.RLock()
+	defer fake.resourceIDMutex.RUnlock()
+	fake.resourceTypeIDMutex.RLock()
+	defer fake.resourceTypeIDMutex.RUnlock()
+	fake.setResourceConfigScopeMutex.RLock()
+	defer fake.setResourceConfigScopeMutex.RUnlock()
+	fake.skipMutex.RLock()
+	defer fake.skipMutex.RUnlock()
+	fake.startTimeMutex.RLock()
+	defer fake.startTimeMutex.RUnlock()
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	fake.teamNameMutex.RLock()
+	defer fake.teamNameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCheck) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.Check = new(FakeCheck)
@@ -0,0 +1,356 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeCheckFactory struct {
+	CheckStub        func(int) (db.Check, bool, error)
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		arg1 int
+	}
+	checkReturns struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	CreateCheckStub        func(db.Checkable, atc.Version) (db.Check, error)
+	createCheckMutex       sync.RWMutex
+	createCheckArgsForCall []struct {
+		arg1 db.Checkable
+		arg2 atc.Version
+	}
+	createCheckReturns struct {
+		result1 db.Check
+		result2 error
+	}
+	createCheckReturnsOnCall map[int]struct {
+		result1 db.Check
+		result2 error
+	}
+	StartNextCheckStub        func() (db.Check, bool, error)
+	startNextCheckMutex       sync.RWMutex
+	startNextCheckArgsForCall []struct {
+	}
+	startNextCheckReturns struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	startNextCheckReturnsOnCall map[int]struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}
+	TryCreateCheckStub        func(db.Checkable, time.Duration) (bool, error)
+	tryCreateCheckMutex       sync.RWMutex
+	tryCreateCheckArgsForCall []struct {
+		arg1 db.Checkable
+		arg2 time.Duration
+	}
+	tryCreateCheckReturns struct {
+		result1 bool
+		result2 error
+	}
+	tryCreateCheckReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCheckFactory) Check(arg1 int) (db.Check, bool, error) {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Check", []interface{}{arg1})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCheckFactory) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeCheckFactory) CheckCalls(stub func(int) (db.Check, bool, error)) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakeCheckFactory) CheckArgsForCall(i int) int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	argsForCall := fake.checkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckFactory) CheckReturns(result1 db.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) CheckReturnsOnCall(i int, result1 db.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 db.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) CreateCheck(arg1 db.Checkable, arg2 atc.Version) (db.Check, error) {
+	fake.createCheckMutex.Lock()
+	ret, specificReturn := fake.createCheckReturnsOnCall[len(fake.createCheckArgsForCall)]
+	fake.createCheckArgsForCall = append(fake.createCheckArgsForCall, struct {
+		arg1 db.Checkable
+		arg2 atc.Version
+	}{arg1, arg2})
+	fake.recordInvocation("CreateCheck", []interface{}{arg1, arg2})
+	fake.createCheckMutex.Unlock()
+	if fake.CreateCheckStub != nil {
+		return fake.CreateCheckStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckFactory) CreateCheckCallCount() int {
+	fake.createCheckMutex.RLock()
+	defer fake.createCheckMutex.RUnlock()
+	return len(fake.createCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) CreateCheckCalls(stub func(db.Checkable, atc.Version) (db.Check, error)) {
+	fake.createCheckMutex.Lock()
+	defer fake.createCheckMutex.Unlock()
+	fake.CreateCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) CreateCheckArgsForCall(i int) (db.Checkable, atc.Version) {
+	fake.createCheckMutex.RLock()
+	defer fake.createCheckMutex.RUnlock()
+	argsForCall := fake.createCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCheckFactory) CreateCheckReturns(result1 db.Check, result2 error) {
+	fake.createCheckMutex.Lock()
+	defer fake.createCheckMutex.Unlock()
+	fake.CreateCheckStub = nil
+	fake.createCheckReturns = struct {
+		result1 db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) CreateCheckReturnsOnCall(i int, result1 db.Check, result2 error) {
+	fake.createCheckMutex.Lock()
+	defer fake.createCheckMutex.Unlock()
+	fake.CreateCheckStub = nil
+	if fake.createCheckReturnsOnCall == nil {
+		fake.createCheckReturnsOnCall = make(map[int]struct {
+			result1 db.Check
+			result2 error
+		})
+	}
+	fake.createCheckReturnsOnCall[i] = struct {
+		result1 db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) StartNextCheck() (db.Check, bool, error) {
+	fake.startNextCheckMutex.Lock()
+	ret, specificReturn := fake.startNextCheckReturnsOnCall[len(fake.startNextCheckArgsForCall)]
+	fake.startNextCheckArgsForCall = append(fake.startNextCheckArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartNextCheck", []interface{}{})
+	fake.startNextCheckMutex.Unlock()
+	if fake.StartNextCheckStub != nil {
+		return fake.StartNextCheckStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.startNextCheckReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCheckFactory) StartNextCheckCallCount() int {
+	fake.startNextCheckMutex.RLock()
+	defer fake.startNextCheckMutex.RUnlock()
+	return len(fake.startNextCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) StartNextCheckCalls(stub func() (db.Check, bool, error)) {
+	fake.startNextCheckMutex.Lock()
+	defer fake.startNextCheckMutex.Unlock()
+	fake.StartNextCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) StartNextCheckReturns(result1 db.Check, result2 bool, result3 error) {
+	fake.startNextCheckMutex.Lock()
+	defer fake.startNextCheckMutex.Unlock()
+	fake.StartNextCheckStub = nil
+	fake.startNextCheckReturns = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) StartNextCheckReturnsOnCall(i int, result1 db.Check, result2 bool, result3 error) {
+	fake.startNextCheckMutex.Lock()
+	defer fake.startNextCheckMutex.Unlock()
+	fake.StartNextCheckStub = nil
+	if fake.startNextCheckReturnsOnCall == nil {
+		fake.startNextCheckReturnsOnCall = make(map[int]struct {
+			result1 db.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.startNextCheckReturnsOnCall[i] = struct {
+		result1 db.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCheckFactory) TryCreateCheck(arg1 db.Checkable, arg2 time.Duration) (bool, error) {
+	fake.tryCreateCheckMutex.Lock()
+	ret, specificReturn := fake.tryCreateCheckReturnsOnCall[len(fake.tryCreateCheckArgsForCall)]
+	fake.tryCreateCheckArgsForCall = append(fake.tryCreateCheckArgsForCall, struct {
+		arg1 db.Checkable
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("TryCreateCheck", []interface{}{arg1, arg2})
+	fake.tryCreateCheckMutex.Unlock()
+	if fake.TryCreateCheckStub != nil {
+		return fake.TryCreateCheckStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.tryCreateCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckFactory) TryCreateCheckCallCount() int {
+	fake.tryCreateCheckMutex.RLock()
+	defer fake.tryCreateCheckMutex.RUnlock()
+	return len(fake.tryCreateCheckArgsForCall)
+}
+
+func (fake *FakeCheckFactory) TryCreateCheckCalls(stub func(db.Checkable, time.Duration) (bool, error)) {
+	fake.tryCreateCheckMutex.Lock()
+	defer fake.tryCreateCheckMutex.Unlock()
+	fake.TryCreateCheckStub = stub
+}
+
+func (fake *FakeCheckFactory) TryCreateCheckArgsForCall(i int) (db.Checkable, time.Duration) {
+	fake.tryCreateCheckMutex.RLock()
+	defer fake.tryCreateCheckMutex.RUnlock()
+	argsForCall := fake.tryCreateCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCheckFactory) TryCreateCheckReturns(result1 bool, result2 error) {
+	fake.tryCreateCheckMutex.Lock()
+	defer fake.tryCreateCheckMutex.Unlock()
+	fake.TryCreateCheckStub = nil
+	fake.tryCreateCheckReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) TryCreateCheckReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.tryCreateCheckMutex.Lock()
+	defer fake.tryCreateCheckMutex.Unlock()
+	fake.TryCreateCheckStub = nil
+	if fake.tryCreateCheckReturnsOnCall == nil {
+		fake.tryCreateCheckReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.tryCreateCheckReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	fake.createCheckMutex.RLock()
+	defer fake.createCheckMutex.RUnlock()
+	fake.startNextCheckMutex.RLock()
+	defer fake.startNextCheckMutex.RUnlock()
+	fake.tryCreateCheckMutex.RLock()
+	defer fake.tryCreateCheckMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCheckFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CheckFactory = new(FakeCheckFactory)
@@ -0,0 +1,272 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+	time "time"
+
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeCheckLifecycle struct {
+	ErrorTimedOutChecksStub        func(time.Duration) (int, error)
+	errorTimedOutChecksMutex       sync.RWMutex
+	errorTimedOutChecksArgsForCall []struct {
+		arg1 time.Duration
+	}
+	errorTimedOutChecksReturns struct {
+		result1 int
+		result2 error
+	}
+	errorTimedOutChecksReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RemoveExcessChecksStub        func(int) (int, error)
+	removeExcessChecksMutex       sync.RWMutex
+	removeExcessChecksArgsForCall []struct {
+		arg1 int
+	}
+	removeExcessChecksReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExcessChecksReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RemoveExpiredChecksStub        func(time.Duration) (int, error)
+	removeExpiredChecksMutex       sync.RWMutex
+	removeExpiredChecksArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredChecksReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredChecksReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecks(arg1 time.Duration) (int, error) {
+	fake.errorTimedOutChecksMutex.Lock()
+	ret, specificReturn := fake.errorTimedOutChecksReturnsOnCall[len(fake.errorTimedOutChecksArgsForCall)]
+	fake.errorTimedOutChecksArgsForCall = append(fake.errorTimedOutChecksArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("ErrorTimedOutChecks", []interface{}{arg1})
+	fake.errorTimedOutChecksMutex.Unlock()
+	if fake.ErrorTimedOutChecksStub != nil {
+		return fake.ErrorTimedOutChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.errorTimedOutChecksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecksCallCount() int {
+	fake.errorTimedOutChecksMutex.RLock()
+	defer fake.errorTimedOutChecksMutex.RUnlock()
+	return len(fake.errorTimedOutChecksArgsForCall)
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecksCalls(stub func(time.Duration) (int, error)) {
+	fake.errorTimedOutChecksMutex.Lock()
+	defer fake.errorTimedOutChecksMutex.Unlock()
+	fake.ErrorTimedOutChecksStub = stub
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecksArgsForCall(i int) time.Duration {
+	fake.errorTimedOutChecksMutex.RLock()
+	defer fake.errorTimedOutChecksMutex.RUnlock()
+	argsForCall := fake.errorTimedOutChecksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecksReturns(result1 int, result2 error) {
+	fake.errorTimedOutChecksMutex.Lock()
+	defer fake.errorTimedOutChecksMutex.Unlock()
+	fake.ErrorTimedOutChecksStub = nil
+	fake.errorTimedOutChecksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) ErrorTimedOutChecksReturnsOnCall(i int, result1 int, result2 error) {
+	fake.errorTimedOutChecksMutex.Lock()
+	defer fake.errorTimedOutChecksMutex.Unlock()
+	fake.ErrorTimedOutChecksStub = nil
+	if fake.errorTimedOutChecksReturnsOnCall == nil {
+		fake.errorTimedOutChecksReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.errorTimedOutChecksReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecks(arg1 int) (int, error) {
+	fake.removeExcessChecksMutex.Lock()
+	ret, specificReturn := fake.removeExcessChecksReturnsOnCall[len(fake.removeExcessChecksArgsForCall)]
+	fake.removeExcessChecksArgsForCall = append(fake.removeExcessChecksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RemoveExcessChecks", []interface{}{arg1})
+	fake.removeExcessChecksMutex.Unlock()
+	if fake.RemoveExcessChecksStub != nil {
+		return fake.RemoveExcessChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExcessChecksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecksCallCount() int {
+	fake.removeExcessChecksMutex.RLock()
+	defer fake.removeExcessChecksMutex.RUnlock()
+	return len(fake.removeExcessChecksArgsForCall)
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecksCalls(stub func(int) (int, error)) {
+	fake.removeExcessChecksMutex.Lock()
+	defer fake.removeExcessChecksMutex.Unlock()
+	fake.RemoveExcessChecksStub = stub
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecksArgsForCall(i int) int {
+	fake.removeExcessChecksMutex.RLock()
+	defer fake.removeExcessChecksMutex.RUnlock()
+	argsForCall := fake.removeExcessChecksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecksReturns(result1 int, result2 error) {
+	fake.removeExcessChecksMutex.Lock()
+	defer fake.removeExcessChecksMutex.Unlock()
+	fake.RemoveExcessChecksStub = nil
+	fake.removeExcessChecksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) RemoveExcessChecksReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExcessChecksMutex.Lock()
+	defer fake.removeExcessChecksMutex.Unlock()
+	fake.RemoveExcessChecksStub = nil
+	if fake.removeExcessChecksReturnsOnCall == nil {
+		fake.removeExcessChecksReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExcessChecksReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecks(arg1 time.Duration) (int, error) {
+	fake.removeExpiredChecksMutex.Lock()
+	ret, specificReturn := fake.removeExpiredChecksReturnsOnCall[len(fake.removeExpiredChecksArgsForCall)]
+	fake.removeExpiredChecksArgsForCall = append(fake.removeExpiredChecksArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveExpiredChecks", []interface{}{arg1})
+	fake.removeExpiredChecksMutex.Unlock()
+	if fake.RemoveExpiredChecksStub != nil {
+		return fake.RemoveExpiredChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExpiredChecksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecksCallCount() int {
+	fake.removeExpiredChecksMutex.RLock()
+	defer fake.removeExpiredChecksMutex.RUnlock()
+	return len(fake.removeExpiredChecksArgsForCall)
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecksCalls(stub func(time.Duration) (int, error)) {
+	fake.removeExpiredChecksMutex.Lock()
+	defer fake.removeExpiredChecksMutex.Unlock()
+	fake.RemoveExpiredChecksStub = stub
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecksArgsForCall(i int) time.Duration {
+	fake.removeExpiredChecksMutex.RLock()
+	defer fake.removeExpiredChecksMutex.RUnlock()
+	argsForCall := fake.removeExpiredChecksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecksReturns(result1 int, result2 error) {
+	fake.removeExpiredChecksMutex.Lock()
+	defer fake.removeExpiredChecksMutex.Unlock()
+	fake.RemoveExpiredChecksStub = nil
+	fake.removeExpiredChecksReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) RemoveExpiredChecksReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExpiredChecksMutex.Lock()
+	defer fake.removeExpiredChecksMutex.Unlock()
+	fake.RemoveExpiredChecksStub = nil
+	if fake.removeExpiredChecksReturnsOnCall == nil {
+		fake.removeExpiredChecksReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredChecksReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCheckLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.errorTimedOutChecksMutex.RLock()
+	defer fake.errorTimedOutChecksMutex.RUnlock()
+	fake.removeExcessChecksMutex.RLock()
+	defer fake.removeExcessChecksMutex.RUnlock()
+	fake.removeExpiredChecksMutex.RLock()
+	defer fake.removeExpiredChecksMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCheckLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CheckLifecycle = new(FakeCheckLifecycle)
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
@@ -91,6 +92,16 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CronTriggeredAtStub        func() time.Time
+	cronTriggeredAtMutex       sync.RWMutex
+	cronTriggeredAtArgsForCall []struct {
+	}
+	cronTriggeredAtReturns struct {
+		result1 time.Time
+	}
+	cronTriggeredAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteNextInputMappingStub        func() error
 	deleteNextInputMappingMutex       sync.RWMutex
 	deleteNextInputMappingArgsForCall []struct {
@@ -101,6 +112,20 @@ type FakeJob struct {
 	deleteNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnsureCronBuildExistsStub        func(time.Time, db.BuildInput) (bool, error)
+	ensureCronBuildExistsMutex       sync.RWMutex
+	ensureCronBuildExistsArgsForCall []struct {
+		arg1 time.Time
+		arg2 db.BuildInput
+	}
+	ensureCronBuildExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	ensureCronBuildExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	EnsurePendingBuildExistsStub        func() error
 	ensurePendingBuildExistsMutex       sync.RWMutex
 	ensurePendingBuildExistsArgsForCall []struct {
@@ -211,6 +236,23 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LatestSucceededBuildWithVersionStub        func(int, int, int) (db.Build, bool, error)
+	latestSucceededBuildWithVersionMutex       sync.RWMutex
+	latestSucceededBuildWithVersionArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}
+	latestSucceededBuildWithVersionReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	latestSucceededBuildWithVersionReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -283,6 +325,19 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	RerunBuildStub        func(db.Build) (db.Build, error)
+	rerunBuildMutex       sync.RWMutex
+	rerunBuildArgsForCall []struct {
+		arg1 db.Build
+	}
+	rerunBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	rerunBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveIndependentInputMappingStub        func(algorithm.InputMapping) error
 	saveIndependentInputMappingMutex       sync.RWMutex
 	saveIndependentInputMappingArgsForCall []struct {
@@ -740,6 +795,58 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CronTriggeredAt() time.Time {
+	fake.cronTriggeredAtMutex.Lock()
+	ret, specificReturn := fake.cronTriggeredAtReturnsOnCall[len(fake.cronTriggeredAtArgsForCall)]
+	fake.cronTriggeredAtArgsForCall = append(fake.cronTriggeredAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CronTriggeredAt", []interface{}{})
+	fake.cronTriggeredAtMutex.Unlock()
+	if fake.CronTriggeredAtStub != nil {
+		return fake.CronTriggeredAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cronTriggeredAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) CronTriggeredAtCallCount() int {
+	fake.cronTriggeredAtMutex.RLock()
+	defer fake.cronTriggeredAtMutex.RUnlock()
+	return len(fake.cronTriggeredAtArgsForCall)
+}
+
+func (fake *FakeJob) CronTriggeredAtCalls(stub func() time.Time) {
+	fake.cronTriggeredAtMutex.Lock()
+	defer fake.cronTriggeredAtMutex.Unlock()
+	fake.CronTriggeredAtStub = stub
+}
+
+func (fake *FakeJob) CronTriggeredAtReturns(result1 time.Time) {
+	fake.cronTriggeredAtMutex.Lock()
+	defer fake.cronTriggeredAtMutex.Unlock()
+	fake.CronTriggeredAtStub = nil
+	fake.cronTriggeredAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) CronTriggeredAtReturnsOnCall(i int, result1 time.Time) {
+	fake.cronTriggeredAtMutex.Lock()
+	defer fake.cronTriggeredAtMutex.Unlock()
+	fake.CronTriggeredAtStub = nil
+	if fake.cronTriggeredAtReturnsOnCall == nil {
+		fake.cronTriggeredAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.cronTriggeredAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) DeleteNextInputMapping() error {
 	fake.deleteNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.deleteNextInputMappingReturnsOnCall[len(fake.deleteNextInputMappingArgsForCall)]
@@ -792,6 +899,70 @@ func (fake *FakeJob) DeleteNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) EnsureCronBuildExists(arg1 time.Time, arg2 db.BuildInput) (bool, error) {
+	fake.ensureCronBuildExistsMutex.Lock()
+	ret, specificReturn := fake.ensureCronBuildExistsReturnsOnCall[len(fake.ensureCronBuildExistsArgsForCall)]
+	fake.ensureCronBuildExistsArgsForCall = append(fake.ensureCronBuildExistsArgsForCall, struct {
+		arg1 time.Time
+		arg2 db.BuildInput
+	}{arg1, arg2})
+	fake.recordInvocation("EnsureCronBuildExists", []interface{}{arg1, arg2})
+	fake.ensureCronBuildExistsMutex.Unlock()
+	if fake.EnsureCronBuildExistsStub != nil {
+		return fake.EnsureCronBuildExistsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.ensureCronBuildExistsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) EnsureCronBuildExistsCallCount() int {
+	fake.ensureCronBuildExistsMutex.RLock()
+	defer fake.ensureCronBuildExistsMutex.RUnlock()
+	return len(fake.ensureCronBuildExistsArgsForCall)
+}
+
+func (fake *FakeJob) EnsureCronBuildExistsCalls(stub func(time.Time, db.BuildInput) (bool, error)) {
+	fake.ensureCronBuildExistsMutex.Lock()
+	defer fake.ensureCronBuildExistsMutex.Unlock()
+	fake.EnsureCronBuildExistsStub = stub
+}
+
+func (fake *FakeJob) EnsureCronBuildExistsArgsForCall(i int) (time.Time, db.BuildInput) {
+	fake.ensureCronBuildExistsMutex.RLock()
+	defer fake.ensureCronBuildExistsMutex.RUnlock()
+	argsForCall := fake.ensureCronBuildExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) EnsureCronBuildExistsReturns(result1 bool, result2 error) {
+	fake.ensureCronBuildExistsMutex.Lock()
+	defer fake.ensureCronBuildExistsMutex.Unlock()
+	fake.EnsureCronBuildExistsStub = nil
+	fake.ensureCronBuildExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) EnsureCronBuildExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.ensureCronBuildExistsMutex.Lock()
+	defer fake.ensureCronBuildExistsMutex.Unlock()
+	fake.EnsureCronBuildExistsStub = nil
+	if fake.ensureCronBuildExistsReturnsOnCall == nil {
+		fake.ensureCronBuildExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.ensureCronBuildExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) EnsurePendingBuildExists() error {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	ret, specificReturn := fake.ensurePendingBuildExistsReturnsOnCall[len(fake.ensurePendingBuildExistsArgsForCall)]
@@ -1313,6 +1484,74 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) LatestSucceededBuildWithVersion(arg1 int, arg2 int, arg3 int) (db.Build, bool, error) {
+	fake.latestSucceededBuildWithVersionMutex.Lock()
+	ret, specificReturn := fake.latestSucceededBuildWithVersionReturnsOnCall[len(fake.latestSucceededBuildWithVersionArgsForCall)]
+	fake.latestSucceededBuildWithVersionArgsForCall = append(fake.latestSucceededBuildWithVersionArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LatestSucceededBuildWithVersion", []interface{}{arg1, arg2, arg3})
+	fake.latestSucceededBuildWithVersionMutex.Unlock()
+	if fake.LatestSucceededBuildWithVersionStub != nil {
+		return fake.LatestSucceededBuildWithVersionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.latestSucceededBuildWithVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) LatestSucceededBuildWithVersionCallCount() int {
+	fake.latestSucceededBuildWithVersionMutex.RLock()
+	defer fake.latestSucceededBuildWithVersionMutex.RUnlock()
+	return len(fake.latestSucceededBuildWithVersionArgsForCall)
+}
+
+func (fake *FakeJob) LatestSucceededBuildWithVersionCalls(stub func(int, int, int) (db.Build, bool, error)) {
+	fake.latestSucceededBuildWithVersionMutex.Lock()
+	defer fake.latestSucceededBuildWithVersionMutex.Unlock()
+	fake.LatestSucceededBuildWithVersionStub = stub
+}
+
+func (fake *FakeJob) LatestSucceededBuildWithVersionArgsForCall(i int) (int, int, int) {
+	fake.latestSucceededBuildWithVersionMutex.RLock()
+	defer fake.latestSucceededBuildWithVersionMutex.RUnlock()
+	argsForCall := fake.latestSucceededBuildWithVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJob) LatestSucceededBuildWithVersionReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.latestSucceededBuildWithVersionMutex.Lock()
+	defer fake.latestSucceededBuildWithVersionMutex.Unlock()
+	fake.LatestSucceededBuildWithVersionStub = nil
+	fake.latestSucceededBuildWithVersionReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) LatestSucceededBuildWithVersionReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.latestSucceededBuildWithVersionMutex.Lock()
+	defer fake.latestSucceededBuildWithVersionMutex.Unlock()
+	fake.LatestSucceededBuildWithVersionStub = nil
+	if fake.latestSucceededBuildWithVersionReturnsOnCall == nil {
+		fake.latestSucceededBuildWithVersionReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.latestSucceededBuildWithVersionReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1680,6 +1919,69 @@ func (fake *FakeJob) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+	fake.rerunBuildMutex.Lock()
+	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
+	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
+		arg1 db.Build
+	}{arg1})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+	fake.rerunBuildMutex.Unlock()
+	if fake.RerunBuildStub != nil {
+		return fake.RerunBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) RerunBuildCallCount() int {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	return len(fake.rerunBuildArgsForCall)
+}
+
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = stub
+}
+
+func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	argsForCall := fake.rerunBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	fake.rerunBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	if fake.rerunBuildReturnsOnCall == nil {
+		fake.rerunBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.rerunBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveIndependentInputMapping(arg1 algorithm.InputMapping) error {
 	fake.saveIndependentInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveIndependentInputMappingReturnsOnCall[len(fake.saveIndependentInputMappingArgsForCall)]
@@ -2143,8 +2445,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.cronTriggeredAtMutex.RLock()
+	defer fake.cronTriggeredAtMutex.RUnlock()
 	fake.deleteNextInputMappingMutex.RLock()
 	defer fake.deleteNextInputMappingMutex.RUnlock()
+	fake.ensureCronBuildExistsMutex.RLock()
+	defer fake.ensureCronBuildExistsMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
 	defer fake.ensurePendingBuildExistsMutex.RUnlock()
 	fake.finishedAndNextBuildMutex.RLock()
@@ -2163,6 +2469,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.getRunningBuildsBySerialGroupMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.latestSucceededBuildWithVersionMutex.RLock()
+	defer fake.latestSucceededBuildWithVersionMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2177,6 +2485,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveIndependentInputMappingMutex.RLock()
 	defer fake.saveIndependentInputMappingMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
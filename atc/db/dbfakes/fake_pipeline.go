@@ -84,6 +84,34 @@ type FakePipeline struct {
 		result1 bool
 		result2 error
 	}
+	ChecksStub        func(int) ([]db.Check, error)
+	checksMutex       sync.RWMutex
+	checksArgsForCall []struct {
+		arg1 int
+	}
+	checksReturns struct {
+		result1 []db.Check
+		result2 error
+	}
+	checksReturnsOnCall map[int]struct {
+		result1 []db.Check
+		result2 error
+	}
+	ConfigAtVersionStub        func(db.ConfigVersion) (atc.Config, bool, error)
+	configAtVersionMutex       sync.RWMutex
+	configAtVersionArgsForCall []struct {
+		arg1 db.ConfigVersion
+	}
+	configAtVersionReturns struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	configAtVersionReturnsOnCall map[int]struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -94,6 +122,18 @@ type FakePipeline struct {
 	configVersionReturnsOnCall map[int]struct {
 		result1 db.ConfigVersion
 	}
+	ConfigVersionsStub        func() ([]db.PipelineConfigVersion, error)
+	configVersionsMutex       sync.RWMutex
+	configVersionsArgsForCall []struct {
+	}
+	configVersionsReturns struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
+	configVersionsReturnsOnCall map[int]struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -232,6 +272,16 @@ type FakePipeline struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InstanceVarsStub        func() atc.InstanceVars
+	instanceVarsMutex       sync.RWMutex
+	instanceVarsArgsForCall []struct {
+	}
+	instanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	instanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	JobStub        func(string) (db.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -259,6 +309,16 @@ type FakePipeline struct {
 		result1 db.Jobs
 		result2 error
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LoadVersionsDBStub        func() (*algorithm.VersionsDB, error)
 	loadVersionsDBMutex       sync.RWMutex
 	loadVersionsDBArgsForCall []struct {
@@ -433,6 +493,18 @@ type FakePipeline struct {
 		result1 db.Resources
 		result2 error
 	}
+	SecretLookupsStub        func() ([]db.SecretLookup, error)
+	secretLookupsMutex       sync.RWMutex
+	secretLookupsArgsForCall []struct {
+	}
+	secretLookupsReturns struct {
+		result1 []db.SecretLookup
+		result2 error
+	}
+	secretLookupsReturnsOnCall map[int]struct {
+		result1 []db.SecretLookup
+		result2 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -784,6 +856,135 @@ func (fake *FakePipeline) CheckPausedReturnsOnCall(i int, result1 bool, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) Checks(arg1 int) ([]db.Check, error) {
+	fake.checksMutex.Lock()
+	ret, specificReturn := fake.checksReturnsOnCall[len(fake.checksArgsForCall)]
+	fake.checksArgsForCall = append(fake.checksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Checks", []interface{}{arg1})
+	fake.checksMutex.Unlock()
+	if fake.ChecksStub != nil {
+		return fake.ChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ChecksCallCount() int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	return len(fake.checksArgsForCall)
+}
+
+func (fake *FakePipeline) ChecksCalls(stub func(int) ([]db.Check, error)) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = stub
+}
+
+func (fake *FakePipeline) ChecksArgsForCall(i int) int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	argsForCall := fake.checksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ChecksReturns(result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	fake.checksReturns = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ChecksReturnsOnCall(i int, result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	if fake.checksReturnsOnCall == nil {
+		fake.checksReturnsOnCall = make(map[int]struct {
+			result1 []db.Check
+			result2 error
+		})
+	}
+	fake.checksReturnsOnCall[i] = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigAtVersion(arg1 db.ConfigVersion) (atc.Config, bool, error) {
+	fake.configAtVersionMutex.Lock()
+	ret, specificReturn := fake.configAtVersionReturnsOnCall[len(fake.configAtVersionArgsForCall)]
+	fake.configAtVersionArgsForCall = append(fake.configAtVersionArgsForCall, struct {
+		arg1 db.ConfigVersion
+	}{arg1})
+	fake.recordInvocation("ConfigAtVersion", []interface{}{arg1})
+	fake.configAtVersionMutex.Unlock()
+	if fake.ConfigAtVersionStub != nil {
+		return fake.ConfigAtVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.configAtVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigAtVersionCallCount() int {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	return len(fake.configAtVersionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigAtVersionCalls(stub func(db.ConfigVersion) (atc.Config, bool, error)) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = stub
+}
+
+func (fake *FakePipeline) ConfigAtVersionArgsForCall(i int) db.ConfigVersion {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	argsForCall := fake.configAtVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturns(result1 atc.Config, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	fake.configAtVersionReturns = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturnsOnCall(i int, result1 atc.Config, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	if fake.configAtVersionReturnsOnCall == nil {
+		fake.configAtVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Config
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configAtVersionReturnsOnCall[i] = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -836,6 +1037,61 @@ func (fake *FakePipeline) ConfigVersionReturnsOnCall(i int, result1 db.ConfigVer
 	}{result1}
 }
 
+func (fake *FakePipeline) ConfigVersions() ([]db.PipelineConfigVersion, error) {
+	fake.configVersionsMutex.Lock()
+	ret, specificReturn := fake.configVersionsReturnsOnCall[len(fake.configVersionsArgsForCall)]
+	fake.configVersionsArgsForCall = append(fake.configVersionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfigVersions", []interface{}{})
+	fake.configVersionsMutex.Unlock()
+	if fake.ConfigVersionsStub != nil {
+		return fake.ConfigVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.configVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigVersionsCallCount() int {
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
+	return len(fake.configVersionsArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigVersionsCalls(stub func() ([]db.PipelineConfigVersion, error)) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = stub
+}
+
+func (fake *FakePipeline) ConfigVersionsReturns(result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	fake.configVersionsReturns = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigVersionsReturnsOnCall(i int, result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	if fake.configVersionsReturnsOnCall == nil {
+		fake.configVersionsReturnsOnCall = make(map[int]struct {
+			result1 []db.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configVersionsReturnsOnCall[i] = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -1517,6 +1773,58 @@ func (fake *FakePipeline) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakePipeline) InstanceVars() atc.InstanceVars {
+	fake.instanceVarsMutex.Lock()
+	ret, specificReturn := fake.instanceVarsReturnsOnCall[len(fake.instanceVarsArgsForCall)]
+	fake.instanceVarsArgsForCall = append(fake.instanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InstanceVars", []interface{}{})
+	fake.instanceVarsMutex.Unlock()
+	if fake.InstanceVarsStub != nil {
+		return fake.InstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) InstanceVarsCallCount() int {
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
+	return len(fake.instanceVarsArgsForCall)
+}
+
+func (fake *FakePipeline) InstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = stub
+}
+
+func (fake *FakePipeline) InstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	fake.instanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakePipeline) InstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	if fake.instanceVarsReturnsOnCall == nil {
+		fake.instanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.instanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakePipeline) Job(arg1 string) (db.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -1638,6 +1946,58 @@ func (fake *FakePipeline) JobsReturnsOnCall(i int, result1 db.Jobs, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakePipeline) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakePipeline) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakePipeline) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakePipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 	fake.loadVersionsDBMutex.Lock()
 	ret, specificReturn := fake.loadVersionsDBReturnsOnCall[len(fake.loadVersionsDBArgsForCall)]
@@ -2456,6 +2816,61 @@ func (fake *FakePipeline) ResourcesReturnsOnCall(i int, result1 db.Resources, re
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) SecretLookups() ([]db.SecretLookup, error) {
+	fake.secretLookupsMutex.Lock()
+	ret, specificReturn := fake.secretLookupsReturnsOnCall[len(fake.secretLookupsArgsForCall)]
+	fake.secretLookupsArgsForCall = append(fake.secretLookupsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SecretLookups", []interface{}{})
+	fake.secretLookupsMutex.Unlock()
+	if fake.SecretLookupsStub != nil {
+		return fake.SecretLookupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.secretLookupsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) SecretLookupsCallCount() int {
+	fake.secretLookupsMutex.RLock()
+	defer fake.secretLookupsMutex.RUnlock()
+	return len(fake.secretLookupsArgsForCall)
+}
+
+func (fake *FakePipeline) SecretLookupsCalls(stub func() ([]db.SecretLookup, error)) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = stub
+}
+
+func (fake *FakePipeline) SecretLookupsReturns(result1 []db.SecretLookup, result2 error) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = nil
+	fake.secretLookupsReturns = struct {
+		result1 []db.SecretLookup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) SecretLookupsReturnsOnCall(i int, result1 []db.SecretLookup, result2 error) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = nil
+	if fake.secretLookupsReturnsOnCall == nil {
+		fake.secretLookupsReturnsOnCall = make(map[int]struct {
+			result1 []db.SecretLookup
+			result2 error
+		})
+	}
+	fake.secretLookupsReturnsOnCall[i] = struct {
+		result1 []db.SecretLookup
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -2625,8 +3040,14 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.causalityMutex.RUnlock()
 	fake.checkPausedMutex.RLock()
 	defer fake.checkPausedMutex.RUnlock()
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
@@ -2651,10 +3072,14 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.hideMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobsMutex.RLock()
 	defer fake.jobsMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.loadVersionsDBMutex.RLock()
 	defer fake.loadVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -2683,6 +3108,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.resourceVersionMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.secretLookupsMutex.RLock()
+	defer fake.secretLookupsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
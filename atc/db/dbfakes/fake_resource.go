@@ -42,6 +42,16 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckSetupErrorStub        func() error
 	checkSetupErrorMutex       sync.RWMutex
 	checkSetupErrorArgsForCall []struct {
@@ -62,6 +72,19 @@ type FakeResource struct {
 	checkTimeoutReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ChecksStub        func(int) ([]db.Check, error)
+	checksMutex       sync.RWMutex
+	checksArgsForCall []struct {
+		arg1 int
+	}
+	checksReturns struct {
+		result1 []db.Check
+		result2 error
+	}
+	checksReturnsOnCall map[int]struct {
+		result1 []db.Check
+		result2 error
+	}
 	ConfigPinnedVersionStub        func() atc.Version
 	configPinnedVersionMutex       sync.RWMutex
 	configPinnedVersionArgsForCall []struct {
@@ -124,6 +147,16 @@ type FakeResource struct {
 	iconReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LastActivityTimeStub        func() time.Time
+	lastActivityTimeMutex       sync.RWMutex
+	lastActivityTimeArgsForCall []struct {
+	}
+	lastActivityTimeReturns struct {
+		result1 time.Time
+	}
+	lastActivityTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	LastCheckEndTimeStub        func() time.Time
 	lastCheckEndTimeMutex       sync.RWMutex
 	lastCheckEndTimeArgsForCall []struct {
@@ -144,6 +177,21 @@ type FakeResource struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LatestMatchingVersionIDStub        func(atc.Version) (int, bool, error)
+	latestMatchingVersionIDMutex       sync.RWMutex
+	latestMatchingVersionIDArgsForCall []struct {
+		arg1 atc.Version
+	}
+	latestMatchingVersionIDReturns struct {
+		result1 int
+		result2 bool
+		result3 error
+	}
+	latestMatchingVersionIDReturnsOnCall map[int]struct {
+		result1 int
+		result2 bool
+		result3 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -174,10 +222,22 @@ type FakeResource struct {
 	pinCommentReturnsOnCall map[int]struct {
 		result1 string
 	}
-	PinVersionStub        func(int) error
+	PinExpiresAtStub        func() time.Time
+	pinExpiresAtMutex       sync.RWMutex
+	pinExpiresAtArgsForCall []struct {
+	}
+	pinExpiresAtReturns struct {
+		result1 time.Time
+	}
+	pinExpiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	PinVersionStub        func(int, string, time.Time) error
 	pinVersionMutex       sync.RWMutex
 	pinVersionArgsForCall []struct {
 		arg1 int
+		arg2 string
+		arg3 time.Time
 	}
 	pinVersionReturns struct {
 		result1 error
@@ -185,6 +245,16 @@ type FakeResource struct {
 	pinVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PinnedByStub        func() string
+	pinnedByMutex       sync.RWMutex
+	pinnedByArgsForCall []struct {
+	}
+	pinnedByReturns struct {
+		result1 string
+	}
+	pinnedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PipelineIDStub        func() int
 	pipelineIDMutex       sync.RWMutex
 	pipelineIDArgsForCall []struct {
@@ -215,6 +285,17 @@ type FakeResource struct {
 	publicReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RecordSecretLookupsStub        func([]creds.SecretLookup) error
+	recordSecretLookupsMutex       sync.RWMutex
+	recordSecretLookupsArgsForCall []struct {
+		arg1 []creds.SecretLookup
+	}
+	recordSecretLookupsReturns struct {
+		result1 error
+	}
+	recordSecretLookupsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -227,6 +308,16 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	ResetCheckIntervalStub        func() error
+	resetCheckIntervalMutex       sync.RWMutex
+	resetCheckIntervalArgsForCall []struct {
+	}
+	resetCheckIntervalReturns struct {
+		result1 error
+	}
+	resetCheckIntervalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResourceConfigIDStub        func() int
 	resourceConfigIDMutex       sync.RWMutex
 	resourceConfigIDArgsForCall []struct {
@@ -355,9 +446,10 @@ type FakeResource struct {
 	typeReturnsOnCall map[int]struct {
 		result1 string
 	}
-	UnpinVersionStub        func() error
+	UnpinVersionStub        func(string) error
 	unpinVersionMutex       sync.RWMutex
 	unpinVersionArgsForCall []struct {
+		arg1 string
 	}
 	unpinVersionReturns struct {
 		result1 error
@@ -365,6 +457,26 @@ type FakeResource struct {
 	unpinVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UnpinnedByStub        func() string
+	unpinnedByMutex       sync.RWMutex
+	unpinnedByArgsForCall []struct {
+	}
+	unpinnedByReturns struct {
+		result1 string
+	}
+	unpinnedByReturnsOnCall map[int]struct {
+		result1 string
+	}
+	VersionHistoryStub        func() int
+	versionHistoryMutex       sync.RWMutex
+	versionHistoryArgsForCall []struct {
+	}
+	versionHistoryReturns struct {
+		result1 int
+	}
+	versionHistoryReturnsOnCall map[int]struct {
+		result1 int
+	}
 	VersionsStub        func(db.Page) ([]atc.ResourceVersion, db.Pagination, bool, error)
 	versionsMutex       sync.RWMutex
 	versionsArgsForCall []struct {
@@ -552,6 +664,58 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResource) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResource) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) CheckSetupError() error {
 	fake.checkSetupErrorMutex.Lock()
 	ret, specificReturn := fake.checkSetupErrorReturnsOnCall[len(fake.checkSetupErrorArgsForCall)]
@@ -656,6 +820,69 @@ func (fake *FakeResource) CheckTimeoutReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) Checks(arg1 int) ([]db.Check, error) {
+	fake.checksMutex.Lock()
+	ret, specificReturn := fake.checksReturnsOnCall[len(fake.checksArgsForCall)]
+	fake.checksArgsForCall = append(fake.checksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Checks", []interface{}{arg1})
+	fake.checksMutex.Unlock()
+	if fake.ChecksStub != nil {
+		return fake.ChecksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) ChecksCallCount() int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	return len(fake.checksArgsForCall)
+}
+
+func (fake *FakeResource) ChecksCalls(stub func(int) ([]db.Check, error)) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = stub
+}
+
+func (fake *FakeResource) ChecksArgsForCall(i int) int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	argsForCall := fake.checksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) ChecksReturns(result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	fake.checksReturns = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) ChecksReturnsOnCall(i int, result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	if fake.checksReturnsOnCall == nil {
+		fake.checksReturnsOnCall = make(map[int]struct {
+			result1 []db.Check
+			result2 error
+		})
+	}
+	fake.checksReturnsOnCall[i] = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ConfigPinnedVersion() atc.Version {
 	fake.configPinnedVersionMutex.Lock()
 	ret, specificReturn := fake.configPinnedVersionReturnsOnCall[len(fake.configPinnedVersionArgsForCall)]
@@ -984,6 +1211,58 @@ func (fake *FakeResource) IconReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) LastActivityTime() time.Time {
+	fake.lastActivityTimeMutex.Lock()
+	ret, specificReturn := fake.lastActivityTimeReturnsOnCall[len(fake.lastActivityTimeArgsForCall)]
+	fake.lastActivityTimeArgsForCall = append(fake.lastActivityTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastActivityTime", []interface{}{})
+	fake.lastActivityTimeMutex.Unlock()
+	if fake.LastActivityTimeStub != nil {
+		return fake.LastActivityTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastActivityTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) LastActivityTimeCallCount() int {
+	fake.lastActivityTimeMutex.RLock()
+	defer fake.lastActivityTimeMutex.RUnlock()
+	return len(fake.lastActivityTimeArgsForCall)
+}
+
+func (fake *FakeResource) LastActivityTimeCalls(stub func() time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = stub
+}
+
+func (fake *FakeResource) LastActivityTimeReturns(result1 time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = nil
+	fake.lastActivityTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) LastActivityTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = nil
+	if fake.lastActivityTimeReturnsOnCall == nil {
+		fake.lastActivityTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastActivityTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResource) LastCheckEndTime() time.Time {
 	fake.lastCheckEndTimeMutex.Lock()
 	ret, specificReturn := fake.lastCheckEndTimeReturnsOnCall[len(fake.lastCheckEndTimeArgsForCall)]
@@ -1088,6 +1367,72 @@ func (fake *FakeResource) LastCheckStartTimeReturnsOnCall(i int, result1 time.Ti
 	}{result1}
 }
 
+func (fake *FakeResource) LatestMatchingVersionID(arg1 atc.Version) (int, bool, error) {
+	fake.latestMatchingVersionIDMutex.Lock()
+	ret, specificReturn := fake.latestMatchingVersionIDReturnsOnCall[len(fake.latestMatchingVersionIDArgsForCall)]
+	fake.latestMatchingVersionIDArgsForCall = append(fake.latestMatchingVersionIDArgsForCall, struct {
+		arg1 atc.Version
+	}{arg1})
+	fake.recordInvocation("LatestMatchingVersionID", []interface{}{arg1})
+	fake.latestMatchingVersionIDMutex.Unlock()
+	if fake.LatestMatchingVersionIDStub != nil {
+		return fake.LatestMatchingVersionIDStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.latestMatchingVersionIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeResource) LatestMatchingVersionIDCallCount() int {
+	fake.latestMatchingVersionIDMutex.RLock()
+	defer fake.latestMatchingVersionIDMutex.RUnlock()
+	return len(fake.latestMatchingVersionIDArgsForCall)
+}
+
+func (fake *FakeResource) LatestMatchingVersionIDCalls(stub func(atc.Version) (int, bool, error)) {
+	fake.latestMatchingVersionIDMutex.Lock()
+	defer fake.latestMatchingVersionIDMutex.Unlock()
+	fake.LatestMatchingVersionIDStub = stub
+}
+
+func (fake *FakeResource) LatestMatchingVersionIDArgsForCall(i int) atc.Version {
+	fake.latestMatchingVersionIDMutex.RLock()
+	defer fake.latestMatchingVersionIDMutex.RUnlock()
+	argsForCall := fake.latestMatchingVersionIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) LatestMatchingVersionIDReturns(result1 int, result2 bool, result3 error) {
+	fake.latestMatchingVersionIDMutex.Lock()
+	defer fake.latestMatchingVersionIDMutex.Unlock()
+	fake.LatestMatchingVersionIDStub = nil
+	fake.latestMatchingVersionIDReturns = struct {
+		result1 int
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeResource) LatestMatchingVersionIDReturnsOnCall(i int, result1 int, result2 bool, result3 error) {
+	fake.latestMatchingVersionIDMutex.Lock()
+	defer fake.latestMatchingVersionIDMutex.Unlock()
+	fake.LatestMatchingVersionIDStub = nil
+	if fake.latestMatchingVersionIDReturnsOnCall == nil {
+		fake.latestMatchingVersionIDReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 bool
+			result3 error
+		})
+	}
+	fake.latestMatchingVersionIDReturnsOnCall[i] = struct {
+		result1 int
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeResource) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1244,16 +1589,70 @@ func (fake *FakeResource) PinCommentReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeResource) PinVersion(arg1 int) error {
+func (fake *FakeResource) PinExpiresAt() time.Time {
+	fake.pinExpiresAtMutex.Lock()
+	ret, specificReturn := fake.pinExpiresAtReturnsOnCall[len(fake.pinExpiresAtArgsForCall)]
+	fake.pinExpiresAtArgsForCall = append(fake.pinExpiresAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinExpiresAt", []interface{}{})
+	fake.pinExpiresAtMutex.Unlock()
+	if fake.PinExpiresAtStub != nil {
+		return fake.PinExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinExpiresAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinExpiresAtCallCount() int {
+	fake.pinExpiresAtMutex.RLock()
+	defer fake.pinExpiresAtMutex.RUnlock()
+	return len(fake.pinExpiresAtArgsForCall)
+}
+
+func (fake *FakeResource) PinExpiresAtCalls(stub func() time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = stub
+}
+
+func (fake *FakeResource) PinExpiresAtReturns(result1 time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = nil
+	fake.pinExpiresAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) PinExpiresAtReturnsOnCall(i int, result1 time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = nil
+	if fake.pinExpiresAtReturnsOnCall == nil {
+		fake.pinExpiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.pinExpiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) PinVersion(arg1 int, arg2 string, arg3 time.Time) error {
 	fake.pinVersionMutex.Lock()
 	ret, specificReturn := fake.pinVersionReturnsOnCall[len(fake.pinVersionArgsForCall)]
 	fake.pinVersionArgsForCall = append(fake.pinVersionArgsForCall, struct {
 		arg1 int
-	}{arg1})
-	fake.recordInvocation("PinVersion", []interface{}{arg1})
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PinVersion", []interface{}{arg1, arg2, arg3})
 	fake.pinVersionMutex.Unlock()
 	if fake.PinVersionStub != nil {
-		return fake.PinVersionStub(arg1)
+		return fake.PinVersionStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1268,17 +1667,17 @@ func (fake *FakeResource) PinVersionCallCount() int {
 	return len(fake.pinVersionArgsForCall)
 }
 
-func (fake *FakeResource) PinVersionCalls(stub func(int) error) {
+func (fake *FakeResource) PinVersionCalls(stub func(int, string, time.Time) error) {
 	fake.pinVersionMutex.Lock()
 	defer fake.pinVersionMutex.Unlock()
 	fake.PinVersionStub = stub
 }
 
-func (fake *FakeResource) PinVersionArgsForCall(i int) int {
+func (fake *FakeResource) PinVersionArgsForCall(i int) (int, string, time.Time) {
 	fake.pinVersionMutex.RLock()
 	defer fake.pinVersionMutex.RUnlock()
 	argsForCall := fake.pinVersionArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeResource) PinVersionReturns(result1 error) {
@@ -1304,6 +1703,58 @@ func (fake *FakeResource) PinVersionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) PinnedBy() string {
+	fake.pinnedByMutex.Lock()
+	ret, specificReturn := fake.pinnedByReturnsOnCall[len(fake.pinnedByArgsForCall)]
+	fake.pinnedByArgsForCall = append(fake.pinnedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinnedBy", []interface{}{})
+	fake.pinnedByMutex.Unlock()
+	if fake.PinnedByStub != nil {
+		return fake.PinnedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinnedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinnedByCallCount() int {
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
+	return len(fake.pinnedByArgsForCall)
+}
+
+func (fake *FakeResource) PinnedByCalls(stub func() string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = stub
+}
+
+func (fake *FakeResource) PinnedByReturns(result1 string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = nil
+	fake.pinnedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinnedByReturnsOnCall(i int, result1 string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = nil
+	if fake.pinnedByReturnsOnCall == nil {
+		fake.pinnedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pinnedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeResource) PipelineID() int {
 	fake.pipelineIDMutex.Lock()
 	ret, specificReturn := fake.pipelineIDReturnsOnCall[len(fake.pipelineIDArgsForCall)]
@@ -1460,6 +1911,71 @@ func (fake *FakeResource) PublicReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResource) RecordSecretLookups(arg1 []creds.SecretLookup) error {
+	var arg1Copy []creds.SecretLookup
+	if arg1 != nil {
+		arg1Copy = make([]creds.SecretLookup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.recordSecretLookupsMutex.Lock()
+	ret, specificReturn := fake.recordSecretLookupsReturnsOnCall[len(fake.recordSecretLookupsArgsForCall)]
+	fake.recordSecretLookupsArgsForCall = append(fake.recordSecretLookupsArgsForCall, struct {
+		arg1 []creds.SecretLookup
+	}{arg1Copy})
+	fake.recordInvocation("RecordSecretLookups", []interface{}{arg1Copy})
+	fake.recordSecretLookupsMutex.Unlock()
+	if fake.RecordSecretLookupsStub != nil {
+		return fake.RecordSecretLookupsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordSecretLookupsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) RecordSecretLookupsCallCount() int {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	return len(fake.recordSecretLookupsArgsForCall)
+}
+
+func (fake *FakeResource) RecordSecretLookupsCalls(stub func([]creds.SecretLookup) error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = stub
+}
+
+func (fake *FakeResource) RecordSecretLookupsArgsForCall(i int) []creds.SecretLookup {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	argsForCall := fake.recordSecretLookupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) RecordSecretLookupsReturns(result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	fake.recordSecretLookupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) RecordSecretLookupsReturnsOnCall(i int, result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	if fake.recordSecretLookupsReturnsOnCall == nil {
+		fake.recordSecretLookupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordSecretLookupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -1515,6 +2031,58 @@ func (fake *FakeResource) ReloadReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeResource) ResetCheckInterval() error {
+	fake.resetCheckIntervalMutex.Lock()
+	ret, specificReturn := fake.resetCheckIntervalReturnsOnCall[len(fake.resetCheckIntervalArgsForCall)]
+	fake.resetCheckIntervalArgsForCall = append(fake.resetCheckIntervalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResetCheckInterval", []interface{}{})
+	fake.resetCheckIntervalMutex.Unlock()
+	if fake.ResetCheckIntervalStub != nil {
+		return fake.ResetCheckIntervalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resetCheckIntervalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ResetCheckIntervalCallCount() int {
+	fake.resetCheckIntervalMutex.RLock()
+	defer fake.resetCheckIntervalMutex.RUnlock()
+	return len(fake.resetCheckIntervalArgsForCall)
+}
+
+func (fake *FakeResource) ResetCheckIntervalCalls(stub func() error) {
+	fake.resetCheckIntervalMutex.Lock()
+	defer fake.resetCheckIntervalMutex.Unlock()
+	fake.ResetCheckIntervalStub = stub
+}
+
+func (fake *FakeResource) ResetCheckIntervalReturns(result1 error) {
+	fake.resetCheckIntervalMutex.Lock()
+	defer fake.resetCheckIntervalMutex.Unlock()
+	fake.ResetCheckIntervalStub = nil
+	fake.resetCheckIntervalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) ResetCheckIntervalReturnsOnCall(i int, result1 error) {
+	fake.resetCheckIntervalMutex.Lock()
+	defer fake.resetCheckIntervalMutex.Unlock()
+	fake.ResetCheckIntervalStub = nil
+	if fake.resetCheckIntervalReturnsOnCall == nil {
+		fake.resetCheckIntervalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetCheckIntervalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) ResourceConfigID() int {
 	fake.resourceConfigIDMutex.Lock()
 	ret, specificReturn := fake.resourceConfigIDReturnsOnCall[len(fake.resourceConfigIDArgsForCall)]
@@ -2144,15 +2712,16 @@ func (fake *FakeResource) TypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeResource) UnpinVersion() error {
+func (fake *FakeResource) UnpinVersion(arg1 string) error {
 	fake.unpinVersionMutex.Lock()
 	ret, specificReturn := fake.unpinVersionReturnsOnCall[len(fake.unpinVersionArgsForCall)]
 	fake.unpinVersionArgsForCall = append(fake.unpinVersionArgsForCall, struct {
-	}{})
-	fake.recordInvocation("UnpinVersion", []interface{}{})
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnpinVersion", []interface{}{arg1})
 	fake.unpinVersionMutex.Unlock()
 	if fake.UnpinVersionStub != nil {
-		return fake.UnpinVersionStub()
+		return fake.UnpinVersionStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -2167,12 +2736,19 @@ func (fake *FakeResource) UnpinVersionCallCount() int {
 	return len(fake.unpinVersionArgsForCall)
 }
 
-func (fake *FakeResource) UnpinVersionCalls(stub func() error) {
+func (fake *FakeResource) UnpinVersionCalls(stub func(string) error) {
 	fake.unpinVersionMutex.Lock()
 	defer fake.unpinVersionMutex.Unlock()
 	fake.UnpinVersionStub = stub
 }
 
+func (fake *FakeResource) UnpinVersionArgsForCall(i int) string {
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
+	argsForCall := fake.unpinVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeResource) UnpinVersionReturns(result1 error) {
 	fake.unpinVersionMutex.Lock()
 	defer fake.unpinVersionMutex.Unlock()
@@ -2196,6 +2772,110 @@ func (fake *FakeResource) UnpinVersionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) UnpinnedBy() string {
+	fake.unpinnedByMutex.Lock()
+	ret, specificReturn := fake.unpinnedByReturnsOnCall[len(fake.unpinnedByArgsForCall)]
+	fake.unpinnedByArgsForCall = append(fake.unpinnedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UnpinnedBy", []interface{}{})
+	fake.unpinnedByMutex.Unlock()
+	if fake.UnpinnedByStub != nil {
+		return fake.UnpinnedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unpinnedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) UnpinnedByCallCount() int {
+	fake.unpinnedByMutex.RLock()
+	defer fake.unpinnedByMutex.RUnlock()
+	return len(fake.unpinnedByArgsForCall)
+}
+
+func (fake *FakeResource) UnpinnedByCalls(stub func() string) {
+	fake.unpinnedByMutex.Lock()
+	defer fake.unpinnedByMutex.Unlock()
+	fake.UnpinnedByStub = stub
+}
+
+func (fake *FakeResource) UnpinnedByReturns(result1 string) {
+	fake.unpinnedByMutex.Lock()
+	defer fake.unpinnedByMutex.Unlock()
+	fake.UnpinnedByStub = nil
+	fake.unpinnedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) UnpinnedByReturnsOnCall(i int, result1 string) {
+	fake.unpinnedByMutex.Lock()
+	defer fake.unpinnedByMutex.Unlock()
+	fake.UnpinnedByStub = nil
+	if fake.unpinnedByReturnsOnCall == nil {
+		fake.unpinnedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.unpinnedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) VersionHistory() int {
+	fake.versionHistoryMutex.Lock()
+	ret, specificReturn := fake.versionHistoryReturnsOnCall[len(fake.versionHistoryArgsForCall)]
+	fake.versionHistoryArgsForCall = append(fake.versionHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VersionHistory", []interface{}{})
+	fake.versionHistoryMutex.Unlock()
+	if fake.VersionHistoryStub != nil {
+		return fake.VersionHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.versionHistoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) VersionHistoryCallCount() int {
+	fake.versionHistoryMutex.RLock()
+	defer fake.versionHistoryMutex.RUnlock()
+	return len(fake.versionHistoryArgsForCall)
+}
+
+func (fake *FakeResource) VersionHistoryCalls(stub func() int) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = stub
+}
+
+func (fake *FakeResource) VersionHistoryReturns(result1 int) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = nil
+	fake.versionHistoryReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) VersionHistoryReturnsOnCall(i int, result1 int) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = nil
+	if fake.versionHistoryReturnsOnCall == nil {
+		fake.versionHistoryReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.versionHistoryReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) Versions(arg1 db.Page) ([]atc.ResourceVersion, db.Pagination, bool, error) {
 	fake.versionsMutex.Lock()
 	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
@@ -2326,10 +3006,14 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkSetupErrorMutex.RLock()
 	defer fake.checkSetupErrorMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
 	fake.configPinnedVersionMutex.RLock()
 	defer fake.configPinnedVersionMutex.RUnlock()
 	fake.currentPinnedVersionMutex.RLock()
@@ -2342,26 +3026,38 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.iconMutex.RLock()
 	defer fake.iconMutex.RUnlock()
+	fake.lastActivityTimeMutex.RLock()
+	defer fake.lastActivityTimeMutex.RUnlock()
 	fake.lastCheckEndTimeMutex.RLock()
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.latestMatchingVersionIDMutex.RLock()
+	defer fake.latestMatchingVersionIDMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.notifyScanMutex.RLock()
 	defer fake.notifyScanMutex.RUnlock()
 	fake.pinCommentMutex.RLock()
 	defer fake.pinCommentMutex.RUnlock()
+	fake.pinExpiresAtMutex.RLock()
+	defer fake.pinExpiresAtMutex.RUnlock()
 	fake.pinVersionMutex.RLock()
 	defer fake.pinVersionMutex.RUnlock()
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.resetCheckIntervalMutex.RLock()
+	defer fake.resetCheckIntervalMutex.RUnlock()
 	fake.resourceConfigIDMutex.RLock()
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
@@ -2386,6 +3082,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.typeMutex.RUnlock()
 	fake.unpinVersionMutex.RLock()
 	defer fake.unpinVersionMutex.RUnlock()
+	fake.unpinnedByMutex.RLock()
+	defer fake.unpinnedByMutex.RUnlock()
+	fake.versionHistoryMutex.RLock()
+	defer fake.versionHistoryMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
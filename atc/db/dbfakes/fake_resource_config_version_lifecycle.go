@@ -0,0 +1,132 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+
+	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeResourceConfigVersionLifecycle struct {
+	RemoveExcessVersionsStub        func(int, int, []atc.Version, []int) (int, error)
+	removeExcessVersionsMutex       sync.RWMutex
+	removeExcessVersionsArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 []atc.Version
+		arg4 []int
+	}
+	removeExcessVersionsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExcessVersionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersions(arg1 int, arg2 int, arg3 []atc.Version, arg4 []int) (int, error) {
+	var arg3Copy []atc.Version
+	if arg3 != nil {
+		arg3Copy = make([]atc.Version, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []int
+	if arg4 != nil {
+		arg4Copy = make([]int, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.removeExcessVersionsMutex.Lock()
+	ret, specificReturn := fake.removeExcessVersionsReturnsOnCall[len(fake.removeExcessVersionsArgsForCall)]
+	fake.removeExcessVersionsArgsForCall = append(fake.removeExcessVersionsArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 []atc.Version
+		arg4 []int
+	}{arg1, arg2, arg3Copy, arg4Copy})
+	fake.recordInvocation("RemoveExcessVersions", []interface{}{arg1, arg2, arg3Copy, arg4Copy})
+	fake.removeExcessVersionsMutex.Unlock()
+	if fake.RemoveExcessVersionsStub != nil {
+		return fake.RemoveExcessVersionsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExcessVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersionsCallCount() int {
+	fake.removeExcessVersionsMutex.RLock()
+	defer fake.removeExcessVersionsMutex.RUnlock()
+	return len(fake.removeExcessVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersionsCalls(stub func(int, int, []atc.Version, []int) (int, error)) {
+	fake.removeExcessVersionsMutex.Lock()
+	defer fake.removeExcessVersionsMutex.Unlock()
+	fake.RemoveExcessVersionsStub = stub
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersionsArgsForCall(i int) (int, int, []atc.Version, []int) {
+	fake.removeExcessVersionsMutex.RLock()
+	defer fake.removeExcessVersionsMutex.RUnlock()
+	argsForCall := fake.removeExcessVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersionsReturns(result1 int, result2 error) {
+	fake.removeExcessVersionsMutex.Lock()
+	defer fake.removeExcessVersionsMutex.Unlock()
+	fake.RemoveExcessVersionsStub = nil
+	fake.removeExcessVersionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExcessVersionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExcessVersionsMutex.Lock()
+	defer fake.removeExcessVersionsMutex.Unlock()
+	fake.RemoveExcessVersionsStub = nil
+	if fake.removeExcessVersionsReturnsOnCall == nil {
+		fake.removeExcessVersionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExcessVersionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.removeExcessVersionsMutex.RLock()
+	defer fake.removeExcessVersionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourceConfigVersionLifecycle = new(FakeResourceConfigVersionLifecycle)
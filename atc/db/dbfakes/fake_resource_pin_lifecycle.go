@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sync "sync"
+
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeResourcePinLifecycle struct {
+	UnpinExpiredVersionsStub        func(string) ([]db.ExpiredPin, error)
+	unpinExpiredVersionsMutex       sync.RWMutex
+	unpinExpiredVersionsArgsForCall []struct {
+		arg1 string
+	}
+	unpinExpiredVersionsReturns struct {
+		result1 []db.ExpiredPin
+		result2 error
+	}
+	unpinExpiredVersionsReturnsOnCall map[int]struct {
+		result1 []db.ExpiredPin
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersions(arg1 string) ([]db.ExpiredPin, error) {
+	fake.unpinExpiredVersionsMutex.Lock()
+	ret, specificReturn := fake.unpinExpiredVersionsReturnsOnCall[len(fake.unpinExpiredVersionsArgsForCall)]
+	fake.unpinExpiredVersionsArgsForCall = append(fake.unpinExpiredVersionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnpinExpiredVersions", []interface{}{arg1})
+	fake.unpinExpiredVersionsMutex.Unlock()
+	if fake.UnpinExpiredVersionsStub != nil {
+		return fake.UnpinExpiredVersionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpinExpiredVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersionsCallCount() int {
+	fake.unpinExpiredVersionsMutex.RLock()
+	defer fake.unpinExpiredVersionsMutex.RUnlock()
+	return len(fake.unpinExpiredVersionsArgsForCall)
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersionsCalls(stub func(string) ([]db.ExpiredPin, error)) {
+	fake.unpinExpiredVersionsMutex.Lock()
+	defer fake.unpinExpiredVersionsMutex.Unlock()
+	fake.UnpinExpiredVersionsStub = stub
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersionsArgsForCall(i int) string {
+	fake.unpinExpiredVersionsMutex.RLock()
+	defer fake.unpinExpiredVersionsMutex.RUnlock()
+	argsForCall := fake.unpinExpiredVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersionsReturns(result1 []db.ExpiredPin, result2 error) {
+	fake.unpinExpiredVersionsMutex.Lock()
+	defer fake.unpinExpiredVersionsMutex.Unlock()
+	fake.UnpinExpiredVersionsStub = nil
+	fake.unpinExpiredVersionsReturns = struct {
+		result1 []db.ExpiredPin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourcePinLifecycle) UnpinExpiredVersionsReturnsOnCall(i int, result1 []db.ExpiredPin, result2 error) {
+	fake.unpinExpiredVersionsMutex.Lock()
+	defer fake.unpinExpiredVersionsMutex.Unlock()
+	fake.UnpinExpiredVersionsStub = nil
+	if fake.unpinExpiredVersionsReturnsOnCall == nil {
+		fake.unpinExpiredVersionsReturnsOnCall = make(map[int]struct {
+			result1 []db.ExpiredPin
+			result2 error
+		})
+	}
+	fake.unpinExpiredVersionsReturnsOnCall[i] = struct {
+		result1 []db.ExpiredPin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourcePinLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.unpinExpiredVersionsMutex.RLock()
+	defer fake.unpinExpiredVersionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourcePinLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourcePinLifecycle = new(FakeResourcePinLifecycle)
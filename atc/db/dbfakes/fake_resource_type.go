@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
@@ -31,6 +32,16 @@ type FakeResourceType struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckSetupErrorStub        func() error
 	checkSetupErrorMutex       sync.RWMutex
 	checkSetupErrorArgsForCall []struct {
@@ -41,6 +52,26 @@ type FakeResourceType struct {
 	checkSetupErrorReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DefaultsStub        func() atc.Source
+	defaultsMutex       sync.RWMutex
+	defaultsArgsForCall []struct {
+	}
+	defaultsReturns struct {
+		result1 atc.Source
+	}
+	defaultsReturnsOnCall map[int]struct {
+		result1 atc.Source
+	}
+	FromTeamStub        func() bool
+	fromTeamMutex       sync.RWMutex
+	fromTeamArgsForCall []struct {
+	}
+	fromTeamReturns struct {
+		result1 bool
+	}
+	fromTeamReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -51,6 +82,16 @@ type FakeResourceType struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LastActivityTimeStub        func() time.Time
+	lastActivityTimeMutex       sync.RWMutex
+	lastActivityTimeArgsForCall []struct {
+	}
+	lastActivityTimeReturns struct {
+		result1 time.Time
+	}
+	lastActivityTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -71,6 +112,16 @@ type FakeResourceType struct {
 	paramsReturnsOnCall map[int]struct {
 		result1 atc.Params
 	}
+	PipelineIDStub        func() int
+	pipelineIDMutex       sync.RWMutex
+	pipelineIDArgsForCall []struct {
+	}
+	pipelineIDReturns struct {
+		result1 int
+	}
+	pipelineIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PrivilegedStub        func() bool
 	privilegedMutex       sync.RWMutex
 	privilegedArgsForCall []struct {
@@ -81,6 +132,17 @@ type FakeResourceType struct {
 	privilegedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RecordSecretLookupsStub        func([]creds.SecretLookup) error
+	recordSecretLookupsMutex       sync.RWMutex
+	recordSecretLookupsArgsForCall []struct {
+		arg1 []creds.SecretLookup
+	}
+	recordSecretLookupsReturns struct {
+		result1 error
+	}
+	recordSecretLookupsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -277,6 +339,58 @@ func (fake *FakeResourceType) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResourceType) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResourceType) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResourceType) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResourceType) CheckSetupError() error {
 	fake.checkSetupErrorMutex.Lock()
 	ret, specificReturn := fake.checkSetupErrorReturnsOnCall[len(fake.checkSetupErrorArgsForCall)]
@@ -329,6 +443,110 @@ func (fake *FakeResourceType) CheckSetupErrorReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeResourceType) Defaults() atc.Source {
+	fake.defaultsMutex.Lock()
+	ret, specificReturn := fake.defaultsReturnsOnCall[len(fake.defaultsArgsForCall)]
+	fake.defaultsArgsForCall = append(fake.defaultsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Defaults", []interface{}{})
+	fake.defaultsMutex.Unlock()
+	if fake.DefaultsStub != nil {
+		return fake.DefaultsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.defaultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) DefaultsCallCount() int {
+	fake.defaultsMutex.RLock()
+	defer fake.defaultsMutex.RUnlock()
+	return len(fake.defaultsArgsForCall)
+}
+
+func (fake *FakeResourceType) DefaultsCalls(stub func() atc.Source) {
+	fake.defaultsMutex.Lock()
+	defer fake.defaultsMutex.Unlock()
+	fake.DefaultsStub = stub
+}
+
+func (fake *FakeResourceType) DefaultsReturns(result1 atc.Source) {
+	fake.defaultsMutex.Lock()
+	defer fake.defaultsMutex.Unlock()
+	fake.DefaultsStub = nil
+	fake.defaultsReturns = struct {
+		result1 atc.Source
+	}{result1}
+}
+
+func (fake *FakeResourceType) DefaultsReturnsOnCall(i int, result1 atc.Source) {
+	fake.defaultsMutex.Lock()
+	defer fake.defaultsMutex.Unlock()
+	fake.DefaultsStub = nil
+	if fake.defaultsReturnsOnCall == nil {
+		fake.defaultsReturnsOnCall = make(map[int]struct {
+			result1 atc.Source
+		})
+	}
+	fake.defaultsReturnsOnCall[i] = struct {
+		result1 atc.Source
+	}{result1}
+}
+
+func (fake *FakeResourceType) FromTeam() bool {
+	fake.fromTeamMutex.Lock()
+	ret, specificReturn := fake.fromTeamReturnsOnCall[len(fake.fromTeamArgsForCall)]
+	fake.fromTeamArgsForCall = append(fake.fromTeamArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FromTeam", []interface{}{})
+	fake.fromTeamMutex.Unlock()
+	if fake.FromTeamStub != nil {
+		return fake.FromTeamStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.fromTeamReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) FromTeamCallCount() int {
+	fake.fromTeamMutex.RLock()
+	defer fake.fromTeamMutex.RUnlock()
+	return len(fake.fromTeamArgsForCall)
+}
+
+func (fake *FakeResourceType) FromTeamCalls(stub func() bool) {
+	fake.fromTeamMutex.Lock()
+	defer fake.fromTeamMutex.Unlock()
+	fake.FromTeamStub = stub
+}
+
+func (fake *FakeResourceType) FromTeamReturns(result1 bool) {
+	fake.fromTeamMutex.Lock()
+	defer fake.fromTeamMutex.Unlock()
+	fake.FromTeamStub = nil
+	fake.fromTeamReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResourceType) FromTeamReturnsOnCall(i int, result1 bool) {
+	fake.fromTeamMutex.Lock()
+	defer fake.fromTeamMutex.Unlock()
+	fake.FromTeamStub = nil
+	if fake.fromTeamReturnsOnCall == nil {
+		fake.fromTeamReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.fromTeamReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeResourceType) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -381,6 +599,58 @@ func (fake *FakeResourceType) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) LastActivityTime() time.Time {
+	fake.lastActivityTimeMutex.Lock()
+	ret, specificReturn := fake.lastActivityTimeReturnsOnCall[len(fake.lastActivityTimeArgsForCall)]
+	fake.lastActivityTimeArgsForCall = append(fake.lastActivityTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastActivityTime", []interface{}{})
+	fake.lastActivityTimeMutex.Unlock()
+	if fake.LastActivityTimeStub != nil {
+		return fake.LastActivityTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastActivityTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) LastActivityTimeCallCount() int {
+	fake.lastActivityTimeMutex.RLock()
+	defer fake.lastActivityTimeMutex.RUnlock()
+	return len(fake.lastActivityTimeArgsForCall)
+}
+
+func (fake *FakeResourceType) LastActivityTimeCalls(stub func() time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = stub
+}
+
+func (fake *FakeResourceType) LastActivityTimeReturns(result1 time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = nil
+	fake.lastActivityTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResourceType) LastActivityTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastActivityTimeMutex.Lock()
+	defer fake.lastActivityTimeMutex.Unlock()
+	fake.LastActivityTimeStub = nil
+	if fake.lastActivityTimeReturnsOnCall == nil {
+		fake.lastActivityTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastActivityTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResourceType) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -485,6 +755,58 @@ func (fake *FakeResourceType) ParamsReturnsOnCall(i int, result1 atc.Params) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) PipelineID() int {
+	fake.pipelineIDMutex.Lock()
+	ret, specificReturn := fake.pipelineIDReturnsOnCall[len(fake.pipelineIDArgsForCall)]
+	fake.pipelineIDArgsForCall = append(fake.pipelineIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineID", []interface{}{})
+	fake.pipelineIDMutex.Unlock()
+	if fake.PipelineIDStub != nil {
+		return fake.PipelineIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) PipelineIDCallCount() int {
+	fake.pipelineIDMutex.RLock()
+	defer fake.pipelineIDMutex.RUnlock()
+	return len(fake.pipelineIDArgsForCall)
+}
+
+func (fake *FakeResourceType) PipelineIDCalls(stub func() int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = stub
+}
+
+func (fake *FakeResourceType) PipelineIDReturns(result1 int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = nil
+	fake.pipelineIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResourceType) PipelineIDReturnsOnCall(i int, result1 int) {
+	fake.pipelineIDMutex.Lock()
+	defer fake.pipelineIDMutex.Unlock()
+	fake.PipelineIDStub = nil
+	if fake.pipelineIDReturnsOnCall == nil {
+		fake.pipelineIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.pipelineIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResourceType) Privileged() bool {
 	fake.privilegedMutex.Lock()
 	ret, specificReturn := fake.privilegedReturnsOnCall[len(fake.privilegedArgsForCall)]
@@ -537,6 +859,71 @@ func (fake *FakeResourceType) PrivilegedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) RecordSecretLookups(arg1 []creds.SecretLookup) error {
+	var arg1Copy []creds.SecretLookup
+	if arg1 != nil {
+		arg1Copy = make([]creds.SecretLookup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.recordSecretLookupsMutex.Lock()
+	ret, specificReturn := fake.recordSecretLookupsReturnsOnCall[len(fake.recordSecretLookupsArgsForCall)]
+	fake.recordSecretLookupsArgsForCall = append(fake.recordSecretLookupsArgsForCall, struct {
+		arg1 []creds.SecretLookup
+	}{arg1Copy})
+	fake.recordInvocation("RecordSecretLookups", []interface{}{arg1Copy})
+	fake.recordSecretLookupsMutex.Unlock()
+	if fake.RecordSecretLookupsStub != nil {
+		return fake.RecordSecretLookupsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordSecretLookupsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) RecordSecretLookupsCallCount() int {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	return len(fake.recordSecretLookupsArgsForCall)
+}
+
+func (fake *FakeResourceType) RecordSecretLookupsCalls(stub func([]creds.SecretLookup) error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = stub
+}
+
+func (fake *FakeResourceType) RecordSecretLookupsArgsForCall(i int) []creds.SecretLookup {
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
+	argsForCall := fake.recordSecretLookupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceType) RecordSecretLookupsReturns(result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	fake.recordSecretLookupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceType) RecordSecretLookupsReturnsOnCall(i int, result1 error) {
+	fake.recordSecretLookupsMutex.Lock()
+	defer fake.recordSecretLookupsMutex.Unlock()
+	fake.RecordSecretLookupsStub = nil
+	if fake.recordSecretLookupsReturnsOnCall == nil {
+		fake.recordSecretLookupsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordSecretLookupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceType) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -984,16 +1371,28 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkSetupErrorMutex.RLock()
 	defer fake.checkSetupErrorMutex.RUnlock()
+	fake.defaultsMutex.RLock()
+	defer fake.defaultsMutex.RUnlock()
+	fake.fromTeamMutex.RLock()
+	defer fake.fromTeamMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.lastActivityTimeMutex.RLock()
+	defer fake.lastActivityTimeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.paramsMutex.RLock()
 	defer fake.paramsMutex.RUnlock()
+	fake.pipelineIDMutex.RLock()
+	defer fake.pipelineIDMutex.RUnlock()
 	fake.privilegedMutex.RLock()
 	defer fake.privilegedMutex.RUnlock()
+	fake.recordSecretLookupsMutex.RLock()
+	defer fake.recordSecretLookupsMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.setCheckSetupErrorMutex.RLock()
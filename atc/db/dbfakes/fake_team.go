@@ -110,6 +110,32 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyPipelinesStub        func([]int) ([]int, error)
+	destroyPipelinesMutex       sync.RWMutex
+	destroyPipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	destroyPipelinesReturns struct {
+		result1 []int
+		result2 error
+	}
+	destroyPipelinesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
+	ExposePipelinesStub        func([]int) ([]int, error)
+	exposePipelinesMutex       sync.RWMutex
+	exposePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	exposePipelinesReturns struct {
+		result1 []int
+		result2 error
+	}
+	exposePipelinesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, string, string, creds.VariablesFactory) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -216,6 +242,19 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	HidePipelinesStub        func([]int) ([]int, error)
+	hidePipelinesMutex       sync.RWMutex
+	hidePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	hidePipelinesReturns struct {
+		result1 []int
+		result2 error
+	}
+	hidePipelinesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -274,6 +313,19 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PausePipelinesStub        func([]int) ([]int, error)
+	pausePipelinesMutex       sync.RWMutex
+	pausePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	pausePipelinesReturns struct {
+		result1 []int
+		result2 error
+	}
+	pausePipelinesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	PipelineStub        func(string) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -289,6 +341,21 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	PipelineInstanceStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
+	pipelineInstanceMutex       sync.RWMutex
+	pipelineInstanceArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineInstanceReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	pipelineInstanceReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
 	PipelinesStub        func() ([]db.Pipeline, error)
 	pipelinesMutex       sync.RWMutex
 	pipelinesArgsForCall []struct {
@@ -328,6 +395,17 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	RecordWebhookDeliveryStub        func(db.WebhookDelivery) error
+	recordWebhookDeliveryMutex       sync.RWMutex
+	recordWebhookDeliveryArgsForCall []struct {
+		arg1 db.WebhookDelivery
+	}
+	recordWebhookDeliveryReturns struct {
+		result1 error
+	}
+	recordWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -339,13 +417,26 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
+	ResourceTypesStub        func() (atc.ResourceTypes, error)
+	resourceTypesMutex       sync.RWMutex
+	resourceTypesArgsForCall []struct {
+	}
+	resourceTypesReturns struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}
+	resourceTypesReturnsOnCall map[int]struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}
+	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
 		arg1 string
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -357,6 +448,25 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	SavePipelineInstanceStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) (db.Pipeline, bool, error)
+	savePipelineInstanceMutex       sync.RWMutex
+	savePipelineInstanceArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 db.PipelinePausedState
+		arg5 string
+	}
+	savePipelineInstanceReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	savePipelineInstanceReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
 	SaveWorkerStub        func(atc.Worker, time.Duration) (db.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -371,6 +481,30 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SetResourceTypesStub        func(atc.ResourceTypes) error
+	setResourceTypesMutex       sync.RWMutex
+	setResourceTypesArgsForCall []struct {
+		arg1 atc.ResourceTypes
+	}
+	setResourceTypesReturns struct {
+		result1 error
+	}
+	setResourceTypesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnpausePipelinesStub        func([]int) ([]int, error)
+	unpausePipelinesMutex       sync.RWMutex
+	unpausePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	unpausePipelinesReturns struct {
+		result1 []int
+		result2 error
+	}
+	unpausePipelinesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -394,6 +528,19 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	WebhookDeliveriesStub        func(int) ([]db.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -879,6 +1026,142 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyPipelines(arg1 []int) ([]int, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.destroyPipelinesMutex.Lock()
+	ret, specificReturn := fake.destroyPipelinesReturnsOnCall[len(fake.destroyPipelinesArgsForCall)]
+	fake.destroyPipelinesArgsForCall = append(fake.destroyPipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("DestroyPipelines", []interface{}{arg1Copy})
+	fake.destroyPipelinesMutex.Unlock()
+	if fake.DestroyPipelinesStub != nil {
+		return fake.DestroyPipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyPipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyPipelinesCallCount() int {
+	fake.destroyPipelinesMutex.RLock()
+	defer fake.destroyPipelinesMutex.RUnlock()
+	return len(fake.destroyPipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyPipelinesCalls(stub func([]int) ([]int, error)) {
+	fake.destroyPipelinesMutex.Lock()
+	defer fake.destroyPipelinesMutex.Unlock()
+	fake.DestroyPipelinesStub = stub
+}
+
+func (fake *FakeTeam) DestroyPipelinesArgsForCall(i int) []int {
+	fake.destroyPipelinesMutex.RLock()
+	defer fake.destroyPipelinesMutex.RUnlock()
+	argsForCall := fake.destroyPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyPipelinesReturns(result1 []int, result2 error) {
+	fake.destroyPipelinesMutex.Lock()
+	defer fake.destroyPipelinesMutex.Unlock()
+	fake.DestroyPipelinesStub = nil
+	fake.destroyPipelinesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyPipelinesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.destroyPipelinesMutex.Lock()
+	defer fake.destroyPipelinesMutex.Unlock()
+	fake.DestroyPipelinesStub = nil
+	if fake.destroyPipelinesReturnsOnCall == nil {
+		fake.destroyPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.destroyPipelinesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExposePipelines(arg1 []int) ([]int, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.exposePipelinesMutex.Lock()
+	ret, specificReturn := fake.exposePipelinesReturnsOnCall[len(fake.exposePipelinesArgsForCall)]
+	fake.exposePipelinesArgsForCall = append(fake.exposePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("ExposePipelines", []interface{}{arg1Copy})
+	fake.exposePipelinesMutex.Unlock()
+	if fake.ExposePipelinesStub != nil {
+		return fake.ExposePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.exposePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ExposePipelinesCallCount() int {
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
+	return len(fake.exposePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) ExposePipelinesCalls(stub func([]int) ([]int, error)) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = stub
+}
+
+func (fake *FakeTeam) ExposePipelinesArgsForCall(i int) []int {
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
+	argsForCall := fake.exposePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ExposePipelinesReturns(result1 []int, result2 error) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = nil
+	fake.exposePipelinesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExposePipelinesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = nil
+	if fake.exposePipelinesReturnsOnCall == nil {
+		fake.exposePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.exposePipelinesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.VariablesFactory) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -1341,6 +1624,74 @@ func (fake *FakeTeam) FindWorkerForVolumeReturnsOnCall(i int, result1 db.Worker,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) HidePipelines(arg1 []int) ([]int, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.hidePipelinesMutex.Lock()
+	ret, specificReturn := fake.hidePipelinesReturnsOnCall[len(fake.hidePipelinesArgsForCall)]
+	fake.hidePipelinesArgsForCall = append(fake.hidePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("HidePipelines", []interface{}{arg1Copy})
+	fake.hidePipelinesMutex.Unlock()
+	if fake.HidePipelinesStub != nil {
+		return fake.HidePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hidePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) HidePipelinesCallCount() int {
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
+	return len(fake.hidePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) HidePipelinesCalls(stub func([]int) ([]int, error)) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = stub
+}
+
+func (fake *FakeTeam) HidePipelinesArgsForCall(i int) []int {
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
+	argsForCall := fake.hidePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) HidePipelinesReturns(result1 []int, result2 error) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = nil
+	fake.hidePipelinesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) HidePipelinesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = nil
+	if fake.hidePipelinesReturnsOnCall == nil {
+		fake.hidePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.hidePipelinesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -1637,6 +1988,74 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) PausePipelines(arg1 []int) ([]int, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.pausePipelinesMutex.Lock()
+	ret, specificReturn := fake.pausePipelinesReturnsOnCall[len(fake.pausePipelinesArgsForCall)]
+	fake.pausePipelinesArgsForCall = append(fake.pausePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("PausePipelines", []interface{}{arg1Copy})
+	fake.pausePipelinesMutex.Unlock()
+	if fake.PausePipelinesStub != nil {
+		return fake.PausePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pausePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PausePipelinesCallCount() int {
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
+	return len(fake.pausePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) PausePipelinesCalls(stub func([]int) ([]int, error)) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = stub
+}
+
+func (fake *FakeTeam) PausePipelinesArgsForCall(i int) []int {
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
+	argsForCall := fake.pausePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PausePipelinesReturns(result1 []int, result2 error) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = nil
+	fake.pausePipelinesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PausePipelinesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = nil
+	if fake.pausePipelinesReturnsOnCall == nil {
+		fake.pausePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.pausePipelinesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1703,6 +2122,72 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 db.Pipeline, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) PipelineInstance(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
+	fake.pipelineInstanceMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceReturnsOnCall[len(fake.pipelineInstanceArgsForCall)]
+	fake.pipelineInstanceArgsForCall = append(fake.pipelineInstanceArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("PipelineInstance", []interface{}{arg1})
+	fake.pipelineInstanceMutex.Unlock()
+	if fake.PipelineInstanceStub != nil {
+		return fake.PipelineInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineInstanceCallCount() int {
+	fake.pipelineInstanceMutex.RLock()
+	defer fake.pipelineInstanceMutex.RUnlock()
+	return len(fake.pipelineInstanceArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineInstanceCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
+	fake.pipelineInstanceMutex.Lock()
+	defer fake.pipelineInstanceMutex.Unlock()
+	fake.PipelineInstanceStub = stub
+}
+
+func (fake *FakeTeam) PipelineInstanceArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineInstanceMutex.RLock()
+	defer fake.pipelineInstanceMutex.RUnlock()
+	argsForCall := fake.pipelineInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineInstanceReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.pipelineInstanceMutex.Lock()
+	defer fake.pipelineInstanceMutex.Unlock()
+	fake.PipelineInstanceStub = nil
+	fake.pipelineInstanceReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineInstanceReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.pipelineInstanceMutex.Lock()
+	defer fake.pipelineInstanceMutex.Unlock()
+	fake.PipelineInstanceStub = nil
+	if fake.pipelineInstanceReturnsOnCall == nil {
+		fake.pipelineInstanceReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineInstanceReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Pipelines() ([]db.Pipeline, error) {
 	fake.pipelinesMutex.Lock()
 	ret, specificReturn := fake.pipelinesReturnsOnCall[len(fake.pipelinesArgsForCall)]
@@ -1879,6 +2364,66 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) RecordWebhookDelivery(arg1 db.WebhookDelivery) error {
+	fake.recordWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.recordWebhookDeliveryReturnsOnCall[len(fake.recordWebhookDeliveryArgsForCall)]
+	fake.recordWebhookDeliveryArgsForCall = append(fake.recordWebhookDeliveryArgsForCall, struct {
+		arg1 db.WebhookDelivery
+	}{arg1})
+	fake.recordInvocation("RecordWebhookDelivery", []interface{}{arg1})
+	fake.recordWebhookDeliveryMutex.Unlock()
+	if fake.RecordWebhookDeliveryStub != nil {
+		return fake.RecordWebhookDeliveryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordWebhookDeliveryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) RecordWebhookDeliveryCallCount() int {
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
+	return len(fake.recordWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeTeam) RecordWebhookDeliveryCalls(stub func(db.WebhookDelivery) error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = stub
+}
+
+func (fake *FakeTeam) RecordWebhookDeliveryArgsForCall(i int) db.WebhookDelivery {
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.recordWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RecordWebhookDeliveryReturns(result1 error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = nil
+	fake.recordWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) RecordWebhookDeliveryReturnsOnCall(i int, result1 error) {
+	fake.recordWebhookDeliveryMutex.Lock()
+	defer fake.recordWebhookDeliveryMutex.Unlock()
+	fake.RecordWebhookDeliveryStub = nil
+	if fake.recordWebhookDeliveryReturnsOnCall == nil {
+		fake.recordWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -1939,7 +2484,62 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) ResourceTypes() (atc.ResourceTypes, error) {
+	fake.resourceTypesMutex.Lock()
+	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
+	fake.resourceTypesArgsForCall = append(fake.resourceTypesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceTypes", []interface{}{})
+	fake.resourceTypesMutex.Unlock()
+	if fake.ResourceTypesStub != nil {
+		return fake.ResourceTypesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resourceTypesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ResourceTypesCallCount() int {
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
+	return len(fake.resourceTypesArgsForCall)
+}
+
+func (fake *FakeTeam) ResourceTypesCalls(stub func() (atc.ResourceTypes, error)) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = stub
+}
+
+func (fake *FakeTeam) ResourceTypesReturns(result1 atc.ResourceTypes, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	fake.resourceTypesReturns = struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ResourceTypesReturnsOnCall(i int, result1 atc.ResourceTypes, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	if fake.resourceTypesReturnsOnCall == nil {
+		fake.resourceTypesReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceTypes
+			result2 error
+		})
+	}
+	fake.resourceTypesReturnsOnCall[i] = struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
@@ -1947,11 +2547,12 @@ func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigV
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
-		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1966,17 +2567,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -2008,6 +2609,76 @@ func (fake *FakeTeam) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) SavePipelineInstance(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState, arg5 string) (db.Pipeline, bool, error) {
+	fake.savePipelineInstanceMutex.Lock()
+	ret, specificReturn := fake.savePipelineInstanceReturnsOnCall[len(fake.savePipelineInstanceArgsForCall)]
+	fake.savePipelineInstanceArgsForCall = append(fake.savePipelineInstanceArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 db.PipelinePausedState
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipelineInstance", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.savePipelineInstanceMutex.Unlock()
+	if fake.SavePipelineInstanceStub != nil {
+		return fake.SavePipelineInstanceStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.savePipelineInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SavePipelineInstanceCallCount() int {
+	fake.savePipelineInstanceMutex.RLock()
+	defer fake.savePipelineInstanceMutex.RUnlock()
+	return len(fake.savePipelineInstanceArgsForCall)
+}
+
+func (fake *FakeTeam) SavePipelineInstanceCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) (db.Pipeline, bool, error)) {
+	fake.savePipelineInstanceMutex.Lock()
+	defer fake.savePipelineInstanceMutex.Unlock()
+	fake.SavePipelineInstanceStub = stub
+}
+
+func (fake *FakeTeam) SavePipelineInstanceArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState, string) {
+	fake.savePipelineInstanceMutex.RLock()
+	defer fake.savePipelineInstanceMutex.RUnlock()
+	argsForCall := fake.savePipelineInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTeam) SavePipelineInstanceReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineInstanceMutex.Lock()
+	defer fake.savePipelineInstanceMutex.Unlock()
+	fake.SavePipelineInstanceStub = nil
+	fake.savePipelineInstanceReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SavePipelineInstanceReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineInstanceMutex.Lock()
+	defer fake.savePipelineInstanceMutex.Unlock()
+	fake.SavePipelineInstanceStub = nil
+	if fake.savePipelineInstanceReturnsOnCall == nil {
+		fake.savePipelineInstanceReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.savePipelineInstanceReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) SaveWorker(arg1 atc.Worker, arg2 time.Duration) (db.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -2072,6 +2743,134 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetResourceTypes(arg1 atc.ResourceTypes) error {
+	fake.setResourceTypesMutex.Lock()
+	ret, specificReturn := fake.setResourceTypesReturnsOnCall[len(fake.setResourceTypesArgsForCall)]
+	fake.setResourceTypesArgsForCall = append(fake.setResourceTypesArgsForCall, struct {
+		arg1 atc.ResourceTypes
+	}{arg1})
+	fake.recordInvocation("SetResourceTypes", []interface{}{arg1})
+	fake.setResourceTypesMutex.Unlock()
+	if fake.SetResourceTypesStub != nil {
+		return fake.SetResourceTypesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setResourceTypesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetResourceTypesCallCount() int {
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
+	return len(fake.setResourceTypesArgsForCall)
+}
+
+func (fake *FakeTeam) SetResourceTypesCalls(stub func(atc.ResourceTypes) error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = stub
+}
+
+func (fake *FakeTeam) SetResourceTypesArgsForCall(i int) atc.ResourceTypes {
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
+	argsForCall := fake.setResourceTypesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetResourceTypesReturns(result1 error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = nil
+	fake.setResourceTypesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetResourceTypesReturnsOnCall(i int, result1 error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = nil
+	if fake.setResourceTypesReturnsOnCall == nil {
+		fake.setResourceTypesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setResourceTypesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UnpausePipelines(arg1 []int) ([]int, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.unpausePipelinesMutex.Lock()
+	ret, specificReturn := fake.unpausePipelinesReturnsOnCall[len(fake.unpausePipelinesArgsForCall)]
+	fake.unpausePipelinesArgsForCall = append(fake.unpausePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("UnpausePipelines", []interface{}{arg1Copy})
+	fake.unpausePipelinesMutex.Unlock()
+	if fake.UnpausePipelinesStub != nil {
+		return fake.UnpausePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpausePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UnpausePipelinesCallCount() int {
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
+	return len(fake.unpausePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) UnpausePipelinesCalls(stub func([]int) ([]int, error)) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = stub
+}
+
+func (fake *FakeTeam) UnpausePipelinesArgsForCall(i int) []int {
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
+	argsForCall := fake.unpausePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UnpausePipelinesReturns(result1 []int, result2 error) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = nil
+	fake.unpausePipelinesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UnpausePipelinesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = nil
+	if fake.unpausePipelinesReturnsOnCall == nil {
+		fake.unpausePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.unpausePipelinesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2187,6 +2986,69 @@ func (fake *FakeTeam) VisiblePipelinesReturnsOnCall(i int, result1 []db.Pipeline
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 int) ([]db.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(int) ([]db.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []db.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []db.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2261,6 +3123,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createStartedBuildMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.destroyPipelinesMutex.RLock()
+	defer fake.destroyPipelinesMutex.RUnlock()
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2275,6 +3141,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findWorkerForContainerMutex.RUnlock()
 	fake.findWorkerForVolumeMutex.RLock()
 	defer fake.findWorkerForVolumeMutex.RUnlock()
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.isCheckContainerMutex.RLock()
@@ -2285,24 +3153,40 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineInstanceMutex.RLock()
+	defer fake.pipelineInstanceMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
 	defer fake.pipelinesMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.recordWebhookDeliveryMutex.RLock()
+	defer fake.recordWebhookDeliveryMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.savePipelineInstanceMutex.RLock()
+	defer fake.savePipelineInstanceMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.visiblePipelinesMutex.RLock()
 	defer fake.visiblePipelinesMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
@@ -26,6 +27,7 @@ type Job interface {
 	Config() atc.JobConfig
 	Tags() []string
 	Public() bool
+	CronTriggeredAt() time.Time
 
 	Reload() (bool, error)
 
@@ -33,12 +35,15 @@ type Job interface {
 	Unpause() error
 
 	CreateBuild() (Build, error)
+	RerunBuild(build Build) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
+	LatestSucceededBuildWithVersion(resourceID int, resourceConfigVersionID int, beforeBuildID int) (Build, bool, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
+	EnsureCronBuildExists(triggeredAt time.Time, input BuildInput) (bool, error)
 	GetPendingBuilds() ([]Build, error)
 
 	GetIndependentBuildInputs() ([]BuildInput, error)
@@ -54,7 +59,7 @@ type Job interface {
 	ClearTaskCache(string, string) (int64, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.cron_triggered_at").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -80,6 +85,7 @@ type job struct {
 	teamName           string
 	config             atc.JobConfig
 	tags               []string
+	cronTriggeredAt    time.Time
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -97,17 +103,18 @@ func (jobs Jobs) Configs() atc.JobConfigs {
 	return configs
 }
 
-func (j *job) ID() int                 { return j.id }
-func (j *job) Name() string            { return j.name }
-func (j *job) Paused() bool            { return j.paused }
-func (j *job) FirstLoggedBuildID() int { return j.firstLoggedBuildID }
-func (j *job) PipelineID() int         { return j.pipelineID }
-func (j *job) PipelineName() string    { return j.pipelineName }
-func (j *job) TeamID() int             { return j.teamID }
-func (j *job) TeamName() string        { return j.teamName }
-func (j *job) Config() atc.JobConfig   { return j.config }
-func (j *job) Tags() []string          { return j.tags }
-func (j *job) Public() bool            { return j.Config().Public }
+func (j *job) ID() int                    { return j.id }
+func (j *job) Name() string               { return j.name }
+func (j *job) Paused() bool               { return j.paused }
+func (j *job) FirstLoggedBuildID() int    { return j.firstLoggedBuildID }
+func (j *job) PipelineID() int            { return j.pipelineID }
+func (j *job) PipelineName() string       { return j.pipelineName }
+func (j *job) TeamID() int                { return j.teamID }
+func (j *job) TeamName() string           { return j.teamName }
+func (j *job) Config() atc.JobConfig      { return j.config }
+func (j *job) Tags() []string             { return j.tags }
+func (j *job) Public() bool               { return j.Config().Public }
+func (j *job) CronTriggeredAt() time.Time { return j.cronTriggeredAt }
 
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
@@ -240,6 +247,51 @@ func (j *job) Build(name string) (Build, bool, error) {
 	return build, true, nil
 }
 
+// LatestSucceededBuildWithVersion returns the job's latest succeeded build,
+// preceding the given build, which had the version as an input or output.
+func (j *job) LatestSucceededBuildWithVersion(resourceID int, resourceConfigVersionID int, beforeBuildID int) (Build, bool, error) {
+	row := buildsQuery.
+		Where(sq.Eq{
+			"b.job_id": j.id,
+			"b.status": BuildStatusSucceeded,
+		}).
+		Where(sq.Lt{"b.id": beforeBuildID}).
+		Where(sq.Or{
+			sq.Expr(`EXISTS (
+				SELECT 1
+				FROM build_resource_config_version_inputs bi
+				JOIN resource_config_versions rcv ON rcv.version_md5 = bi.version_md5
+				WHERE bi.build_id = b.id
+				AND bi.resource_id = ?
+				AND rcv.id = ?
+			)`, resourceID, resourceConfigVersionID),
+			sq.Expr(`EXISTS (
+				SELECT 1
+				FROM build_resource_config_version_outputs bo
+				JOIN resource_config_versions rcv ON rcv.version_md5 = bo.version_md5
+				WHERE bo.build_id = b.id
+				AND bo.resource_id = ?
+				AND rcv.id = ?
+			)`, resourceID, resourceConfigVersionID),
+		}).
+		OrderBy("b.id DESC").
+		Limit(1).
+		RunWith(j.conn).
+		QueryRow()
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+
+	err := scanBuild(build, row, j.conn.EncryptionStrategy())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return build, true, nil
+}
+
 func (j *job) GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bool, error) {
 	err := j.updateSerialGroups(serialGroups)
 	if err != nil {
@@ -450,6 +502,85 @@ func (j *job) EnsurePendingBuildExists() error {
 	return nil
 }
 
+// EnsureCronBuildExists records that the job's cron triggers fired at the
+// given time and ensures a pending build exists, recording the input on the
+// build if one was created. It returns false if the job has already been
+// triggered at or after the given time.
+func (j *job) EnsureCronBuildExists(triggeredAt time.Time, input BuildInput) (bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("cron_triggered_at", triggeredAt).
+		Where(sq.Eq{"id": j.id}).
+		Where(sq.Lt{"cron_triggered_at": triggeredAt}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return false, err
+	}
+
+	var buildID int
+	err = tx.QueryRow(`
+		INSERT INTO builds (name, job_id, pipeline_id, team_id, status)
+		SELECT $1, $2, $3, $4, 'pending'
+		WHERE NOT EXISTS
+			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
+		RETURNING id
+	`, buildName, j.id, j.pipelineID, j.teamID).Scan(&buildID)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+
+	if err == nil {
+		err = createBuildEventSeq(tx, buildID)
+		if err != nil {
+			return false, err
+		}
+
+		version, err := json.Marshal(input.Version)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = psql.Insert("build_cron_inputs").
+			Columns("build_id", "name", "version").
+			Values(buildID, input.Name, version).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	j.cronTriggeredAt = triggeredAt
+
+	return true, nil
+}
+
 func (j *job) GetPendingBuilds() ([]Build, error) {
 	builds := []Build{}
 
@@ -531,6 +662,68 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
+// RerunBuild creates a pending build which will run with the same input
+// versions as the given build, rather than the job's latest inputs.
+func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":               buildName,
+		"job_id":             j.id,
+		"pipeline_id":        j.pipelineID,
+		"team_id":            j.teamID,
+		"status":             BuildStatusPending,
+		"manually_triggered": true,
+		"rerun_of":           buildToRerun.ID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO build_resource_config_version_inputs (build_id, resource_id, version_md5, name)
+		SELECT $1, resource_id, version_md5, name
+		FROM build_resource_config_version_inputs
+		WHERE build_id = $2
+	`, build.id, buildToRerun.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO build_cron_inputs (build_id, name, version)
+		SELECT $1, name, version
+		FROM build_cron_inputs
+		WHERE build_id = $2
+	`, build.id, buildToRerun.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
 func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -805,7 +998,7 @@ func scanJob(j *job, row scannable) error {
 		nonce      sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags))
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.cronTriggeredAt)
 	if err != nil {
 		return err
 	}
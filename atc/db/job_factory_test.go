@@ -23,7 +23,8 @@ var _ = Describe("Job Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "public-pipeline-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
@@ -31,7 +32,8 @@ var _ = Describe("Job Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "private-pipeline-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -87,7 +87,8 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -162,7 +163,8 @@ var _ = Describe("Job", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -388,7 +390,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -516,6 +518,81 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("LatestSucceededBuildWithVersion", func() {
+		var (
+			resource db.Resource
+			version  db.ResourceConfigVersion
+
+			inputBuild  db.Build
+			outputBuild db.Build
+			failedBuild db.Build
+			laterBuild  db.Build
+		)
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfigScope, err := resource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfigScope.SaveVersions([]atc.Version{{"version": "v1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			version, found, err = resourceConfigScope.FindVersion(atc.Version{"version": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			input := db.BuildInput{
+				Name:       "some-input",
+				Version:    atc.Version{"version": "v1"},
+				ResourceID: resource.ID(),
+			}
+
+			inputBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inputBuild.UseInputs([]db.BuildInput{input})).To(Succeed())
+			Expect(inputBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			outputBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			err = outputBuild.SaveOutput(logger, "some-type", atc.Source{"some": "source"}, creds.VersionedResourceTypes{}, atc.Version{"version": "v1"}, nil, "some-output", "some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			failedBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failedBuild.UseInputs([]db.BuildInput{input})).To(Succeed())
+			Expect(failedBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			laterBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the latest succeeded build which had the version as an input or output", func() {
+			build, found, err := job.LatestSucceededBuildWithVersion(resource.ID(), version.ID(), laterBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.ID()).To(Equal(outputBuild.ID()))
+		})
+
+		It("only considers builds preceding the given build", func() {
+			build, found, err := job.LatestSucceededBuildWithVersion(resource.ID(), version.ID(), outputBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.ID()).To(Equal(inputBuild.ID()))
+		})
+
+		It("returns false when there is no such build", func() {
+			_, found, err := job.LatestSucceededBuildWithVersion(resource.ID(), version.ID(), inputBuild.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Describe("GetRunningBuildsBySerialGroup", func() {
 		Describe("same job", func() {
 			var startedBuild, scheduledBuild db.Build
@@ -787,7 +864,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -967,7 +1044,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1119,7 +1196,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1317,6 +1394,218 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("EnsureCronBuildExists", func() {
+		var (
+			triggeredAt time.Time
+			input       db.BuildInput
+		)
+
+		BeforeEach(func() {
+			triggeredAt = job.CronTriggeredAt().Add(time.Hour)
+			input = db.BuildInput{
+				Name:    "cron",
+				Version: atc.Version{"time": triggeredAt.Format(time.RFC3339)},
+			}
+		})
+
+		It("creates a pending build with the cron input", func() {
+			triggered, err := job.EnsureCronBuildExists(triggeredAt, input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(triggered).To(BeTrue())
+
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+
+			inputs, _, err := pendingBuilds[0].Resources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs).To(ConsistOf(db.BuildInput{
+				Name:            "cron",
+				Version:         input.Version,
+				FirstOccurrence: true,
+			}))
+		})
+
+		It("records when the job was triggered", func() {
+			_, err := job.EnsureCronBuildExists(triggeredAt, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.CronTriggeredAt()).To(BeTemporally("==", triggeredAt))
+		})
+
+		It("does not trigger the job twice for the same time", func() {
+			_, err := job.EnsureCronBuildExists(triggeredAt, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			triggered, err := job.EnsureCronBuildExists(triggeredAt, input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(triggered).To(BeFalse())
+		})
+
+		Context("when a pending build already exists", func() {
+			BeforeEach(func() {
+				err := job.EnsurePendingBuildExists()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not create another one", func() {
+				triggered, err := job.EnsureCronBuildExists(triggeredAt, input)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(triggered).To(BeTrue())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("saving the job's cron triggers", func() {
+		var (
+			cronPipeline db.Pipeline
+			cronConfig   atc.Config
+		)
+
+		BeforeEach(func() {
+			cronConfig = atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "cron-job"},
+				},
+			}
+
+			var err error
+			cronPipeline, _, err = team.SavePipeline("cron-pipeline", cronConfig, db.ConfigVersion(0), db.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			// as if the job had been saved long before its triggers are changed
+			_, err = dbConn.Exec(`UPDATE jobs SET cron_triggered_at = now() - '2 days'::interval WHERE pipeline_id = $1`, cronPipeline.ID())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		saveCronJob := func(job atc.JobConfig) db.Job {
+			found, err := cronPipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			cronConfig.Jobs = atc.JobConfigs{job}
+
+			cronPipeline, _, err = team.SavePipeline("cron-pipeline", cronConfig, cronPipeline.ConfigVersion(), db.PipelineNoChange, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			cronJob, found, err := cronPipeline.Job(job.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			return cronJob
+		}
+
+		It("waits for the next occurrence of a newly added trigger", func() {
+			trigger := atc.JobTrigger{Cron: "0 * * * *"}
+			cronJob := saveCronJob(atc.JobConfig{Name: "cron-job", Triggers: atc.JobTriggers{trigger}})
+
+			Expect(cronJob.CronTriggeredAt()).To(BeTemporally("~", time.Now(), time.Minute))
+
+			schedule, err := trigger.Schedule()
+			Expect(err).ToNot(HaveOccurred())
+
+			next, err := schedule.Next(cronJob.CronTriggeredAt())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally(">", time.Now()))
+		})
+
+		Context("when the job already has the trigger", func() {
+			var triggeredAt time.Time
+
+			BeforeEach(func() {
+				saveCronJob(atc.JobConfig{Name: "cron-job", Triggers: atc.JobTriggers{{Cron: "0 * * * *"}}})
+
+				_, err := dbConn.Exec(`UPDATE jobs SET cron_triggered_at = now() - '2 days'::interval WHERE pipeline_id = $1`, cronPipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				cronJob, found, err := cronPipeline.Job("cron-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				triggeredAt = cronJob.CronTriggeredAt()
+			})
+
+			It("keeps when it was last triggered if the triggers are unchanged", func() {
+				cronJob := saveCronJob(atc.JobConfig{Name: "cron-job", Public: true, Triggers: atc.JobTriggers{{Cron: "0 * * * *"}}})
+				Expect(cronJob.CronTriggeredAt()).To(BeTemporally("==", triggeredAt))
+			})
+
+			It("waits for the next occurrence of a changed schedule", func() {
+				cronJob := saveCronJob(atc.JobConfig{Name: "cron-job", Triggers: atc.JobTriggers{{Cron: "30 * * * *"}}})
+				Expect(cronJob.CronTriggeredAt()).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+
+			It("waits for the next occurrence when the job is removed and added back", func() {
+				saveCronJob(atc.JobConfig{Name: "other-job"})
+
+				_, err := dbConn.Exec(`UPDATE jobs SET cron_triggered_at = now() - '2 days'::interval WHERE pipeline_id = $1`, cronPipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				cronJob := saveCronJob(atc.JobConfig{Name: "cron-job", Triggers: atc.JobTriggers{{Cron: "0 * * * *"}}})
+				Expect(cronJob.CronTriggeredAt()).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+		})
+	})
+
+	Describe("RerunBuild", func() {
+		var buildToRerun db.Build
+
+		BeforeEach(func() {
+			triggeredAt := job.CronTriggeredAt().Add(time.Hour)
+			_, err := job.EnsureCronBuildExists(triggeredAt, db.BuildInput{
+				Name:    "cron",
+				Version: atc.Version{"time": triggeredAt.Format(time.RFC3339)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+
+			buildToRerun = pendingBuilds[0]
+
+			err = buildToRerun.Finish(db.BuildStatusErrored)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a pending build linked to the build being rerun", func() {
+			rerunBuild, err := job.RerunBuild(buildToRerun)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rerunBuild.Status()).To(Equal(db.BuildStatusPending))
+			Expect(rerunBuild.IsManuallyTriggered()).To(BeTrue())
+			Expect(rerunBuild.RerunOf()).To(Equal(buildToRerun.ID()))
+			Expect(rerunBuild.RerunOfName()).To(Equal(buildToRerun.Name()))
+		})
+
+		It("copies the inputs of the build being rerun", func() {
+			rerunBuild, err := job.RerunBuild(buildToRerun)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedInputs, _, err := buildToRerun.Resources()
+			Expect(err).NotTo(HaveOccurred())
+
+			inputs, _, err := rerunBuild.Resources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs).To(Equal(expectedInputs))
+		})
+
+		It("becomes the job's next build", func() {
+			rerunBuild, err := job.RerunBuild(buildToRerun)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, nextBuild, err := job.FinishedAndNextBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nextBuild.ID()).To(Equal(rerunBuild.ID()))
+		})
+	})
+
 	Describe("Clear worker task cache", func() {
 		Context("when worker task cache exists", func() {
 			var (
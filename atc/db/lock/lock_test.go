@@ -74,7 +74,8 @@ var _ = Describe("Locks", func() {
 					},
 				},
 			},
-		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 		Expect(err).NotTo(HaveOccurred())
 	})
 
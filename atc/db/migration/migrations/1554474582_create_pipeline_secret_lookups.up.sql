@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE pipeline_secret_lookups (
+    id serial PRIMARY KEY,
+    pipeline_id integer NOT NULL
+      REFERENCES pipelines(id) ON DELETE CASCADE,
+    used_by_type text NOT NULL,
+    used_by_name text NOT NULL,
+    name text NOT NULL,
+    backend text NOT NULL,
+    path text NOT NULL,
+    found boolean NOT NULL,
+    last_looked_up timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE UNIQUE INDEX pipeline_secret_lookups_uniq
+    ON pipeline_secret_lookups (pipeline_id, used_by_type, used_by_name, name);
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE webhook_deliveries (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL
+      REFERENCES teams(id) ON DELETE CASCADE,
+    provider text NOT NULL,
+    event text NOT NULL,
+    delivery_id text NOT NULL,
+    matched jsonb NOT NULL DEFAULT '[]',
+    error text NOT NULL DEFAULT '',
+    received_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX webhook_deliveries_team_id_idx ON webhook_deliveries (team_id, id);
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+  CREATE TABLE checks (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL
+      REFERENCES teams(id) ON DELETE CASCADE,
+    pipeline_id integer NOT NULL
+      REFERENCES pipelines(id) ON DELETE CASCADE,
+    resource_id integer
+      REFERENCES resources(id) ON DELETE CASCADE,
+    resource_type_id integer
+      REFERENCES resource_types(id) ON DELETE CASCADE,
+    status text NOT NULL DEFAULT 'pending',
+    manually_triggered boolean NOT NULL DEFAULT false,
+    plan jsonb NOT NULL,
+    create_time timestamp with time zone NOT NULL DEFAULT now(),
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    check_error text
+  );
+
+  CREATE UNIQUE INDEX checks_pending_resource_id_uniq
+    ON checks (resource_id) WHERE status = 'pending';
+
+  CREATE UNIQUE INDEX checks_pending_resource_type_id_uniq
+    ON checks (resource_type_id) WHERE status = 'pending';
+
+  CREATE INDEX checks_resource_id_idx ON checks (resource_id, id);
+  CREATE INDEX checks_resource_type_id_idx ON checks (resource_type_id, id);
+  CREATE INDEX checks_pipeline_id_idx ON checks (pipeline_id, id);
+  CREATE INDEX checks_pending_idx ON checks (id) WHERE status = 'pending';
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX checks_resource_config_scope_id_idx;
+
+  ALTER TABLE checks
+    DROP COLUMN resource_config_scope_id,
+    DROP COLUMN output;
+COMMIT;
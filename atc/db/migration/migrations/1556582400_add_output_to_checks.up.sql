@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE checks
+    ADD COLUMN resource_config_scope_id integer
+      REFERENCES resource_config_scopes(id) ON DELETE SET NULL,
+    ADD COLUMN output text NOT NULL DEFAULT '';
+
+  CREATE INDEX checks_resource_config_scope_id_idx ON checks (resource_config_scope_id, id);
+COMMIT;
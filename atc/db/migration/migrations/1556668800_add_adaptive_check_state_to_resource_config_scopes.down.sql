@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes
+    DROP COLUMN check_failures,
+    DROP COLUMN last_activity_time;
+COMMIT;
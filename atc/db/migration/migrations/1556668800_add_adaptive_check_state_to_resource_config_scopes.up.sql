@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes
+    ADD COLUMN check_failures integer NOT NULL DEFAULT 0,
+    ADD COLUMN last_activity_time timestamp with time zone;
+COMMIT;
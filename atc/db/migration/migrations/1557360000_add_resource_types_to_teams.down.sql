@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    DROP COLUMN resource_types,
+    DROP COLUMN resource_types_nonce;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    ADD COLUMN resource_types text,
+    ADD COLUMN resource_types_nonce text;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  DROP TABLE build_cron_inputs;
+
+  ALTER TABLE jobs
+    DROP COLUMN cron_triggered_at;
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN cron_triggered_at timestamp with time zone NOT NULL DEFAULT now();
+
+  CREATE TABLE build_cron_inputs (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    version jsonb NOT NULL
+  );
+
+  CREATE INDEX build_cron_inputs_build_id_idx ON build_cron_inputs (build_id);
+COMMIT;
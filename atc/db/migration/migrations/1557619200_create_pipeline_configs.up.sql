@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE pipeline_configs (
+    id serial PRIMARY KEY,
+    pipeline_id integer NOT NULL
+      REFERENCES pipelines(id) ON DELETE CASCADE,
+    version integer NOT NULL,
+    config text NOT NULL,
+    nonce text,
+    author text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE UNIQUE INDEX pipeline_configs_pipeline_id_version_uniq
+    ON pipeline_configs (pipeline_id, version);
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+  DELETE FROM pipelines
+    WHERE instance_vars IS NOT NULL;
+
+  DROP INDEX pipelines_name_team_id_instance_vars;
+
+  ALTER TABLE pipelines
+    DROP COLUMN instance_vars,
+    ADD CONSTRAINT pipelines_name_team_id UNIQUE (name, team_id);
+COMMIT;
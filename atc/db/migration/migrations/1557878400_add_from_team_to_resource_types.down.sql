@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resource_types
+    DROP COLUMN from_team;
+COMMIT;
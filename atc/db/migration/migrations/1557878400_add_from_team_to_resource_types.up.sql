@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resource_types
+    ADD COLUMN from_team boolean NOT NULL DEFAULT false;
+COMMIT;
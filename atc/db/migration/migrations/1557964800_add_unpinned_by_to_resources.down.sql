@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resources
+    DROP COLUMN unpinned_by;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resources
+    ADD COLUMN unpinned_by text NOT NULL DEFAULT '';
+COMMIT;
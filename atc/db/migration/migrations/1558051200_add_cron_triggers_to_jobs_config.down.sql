@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN cron_triggers;
+COMMIT;
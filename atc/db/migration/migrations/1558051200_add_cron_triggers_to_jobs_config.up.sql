@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN cron_triggers jsonb;
+COMMIT;
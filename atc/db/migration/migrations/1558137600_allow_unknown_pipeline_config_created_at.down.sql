@@ -0,0 +1,8 @@
+BEGIN;
+  UPDATE pipeline_configs
+    SET created_at = now()
+    WHERE created_at IS NULL;
+
+  ALTER TABLE pipeline_configs
+    ALTER COLUMN created_at SET NOT NULL;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE pipeline_configs
+    ALTER COLUMN created_at DROP NOT NULL;
+COMMIT;
@@ -37,6 +37,8 @@ type Pipeline interface {
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
+	Labels() map[string]string
+	InstanceVars() atc.InstanceVars
 	ConfigVersion() ConfigVersion
 	Public() bool
 	Paused() bool
@@ -71,10 +73,17 @@ type Pipeline interface {
 	ResourceType(name string) (ResourceType, bool, error)
 	ResourceTypeByID(id int) (ResourceType, bool, error)
 
+	Checks(limit int) ([]Check, error)
+
 	Job(name string) (Job, bool, error)
 	Jobs() (Jobs, error)
 	Dashboard() (Dashboard, error)
 
+	SecretLookups() ([]SecretLookup, error)
+
+	ConfigVersions() ([]PipelineConfigVersion, error)
+	ConfigAtVersion(version ConfigVersion) (atc.Config, bool, error)
+
 	Expose() error
 	Hide() error
 
@@ -91,6 +100,8 @@ type pipeline struct {
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
+	labels        map[string]string
+	instanceVars  atc.InstanceVars
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
@@ -115,11 +126,17 @@ var pipelinesQuery = psql.Select(`
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.labels,
+		p.instance_vars
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
 
+// pipelineInstancesOrdering keeps the instances of a pipeline together when
+// listing pipelines, placing them where the first of them is ordered.
+const pipelineInstancesOrdering = "MIN(p.ordering) OVER (PARTITION BY p.team_id, p.name)"
+
 const (
 	PipelinePaused   PipelinePausedState = "paused"
 	PipelineUnpaused PipelinePausedState = "unpaused"
@@ -149,14 +166,16 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                      { return p.id }
-func (p *pipeline) Name() string                 { return p.name }
-func (p *pipeline) TeamID() int                  { return p.teamID }
-func (p *pipeline) TeamName() string             { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
-func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
-func (p *pipeline) Public() bool                 { return p.public }
-func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) ID() int                        { return p.id }
+func (p *pipeline) Name() string                   { return p.name }
+func (p *pipeline) TeamID() int                    { return p.teamID }
+func (p *pipeline) TeamName() string               { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs       { return p.groups }
+func (p *pipeline) Labels() map[string]string      { return p.labels }
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+func (p *pipeline) ConfigVersion() ConfigVersion   { return p.configVersion }
+func (p *pipeline) Public() bool                   { return p.public }
+func (p *pipeline) Paused() bool                   { return p.paused }
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -617,6 +636,33 @@ func (p *pipeline) Dashboard() (Dashboard, error) {
 	return dashboard, nil
 }
 
+func (p *pipeline) SecretLookups() ([]SecretLookup, error) {
+	rows, err := psql.Select("used_by_type", "used_by_name", "name", "backend", "path", "found", "last_looked_up").
+		From("pipeline_secret_lookups").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("used_by_type", "used_by_name", "name").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var lookups []SecretLookup
+	for rows.Next() {
+		var lookup SecretLookup
+		err := rows.Scan(&lookup.UsedByType, &lookup.UsedByName, &lookup.Name, &lookup.Backend, &lookup.Path, &lookup.Found, &lookup.LastLookedUp)
+		if err != nil {
+			return nil, err
+		}
+
+		lookups = append(lookups, lookup)
+	}
+
+	return lookups, nil
+}
+
 func (p *pipeline) Pause() error {
 	_, err := psql.Update("pipelines").
 		Set("paused", true).
@@ -0,0 +1,252 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+// PipelineConfigVersion is a config which was saved for a pipeline, kept so
+// that it can be compared against or rolled back to. Configs which were saved
+// before configs started being kept have no author or CreatedAt.
+type PipelineConfigVersion struct {
+	Version   ConfigVersion
+	Author    string
+	CreatedAt time.Time
+}
+
+func encryptPipelineConfig(es encryption.Strategy, config atc.Config) (string, *string, error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return es.Encrypt(payload)
+}
+
+func savePipelineConfigVersion(tx Tx, es encryption.Strategy, pipelineID int, version ConfigVersion, config atc.Config, author string) error {
+	encryptedPayload, nonce, err := encryptPipelineConfig(es, config)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		SetMap(map[string]interface{}{
+			"pipeline_id": pipelineID,
+			"version":     version,
+			"config":      encryptedPayload,
+			"nonce":       nonce,
+			"author":      author,
+		}).
+		Suffix("ON CONFLICT (pipeline_id, version) DO NOTHING").
+		RunWith(tx).
+		Exec()
+
+	return err
+}
+
+// recordCurrentConfigVersion saves the pipeline's current config if it is
+// not in its history yet, as is the case for pipelines which have not been
+// saved since configs started being kept. It is called before the pipeline
+// is saved again so that the config being replaced is kept too. Who saved
+// that config and when is not known, so neither is recorded.
+func recordCurrentConfigVersion(tx Tx, conn Conn, lockFactory lock.LockFactory, pipelineID int, version ConfigVersion) error {
+	var recorded bool
+	err := tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM pipeline_configs
+			WHERE pipeline_id = $1
+			AND version = $2
+		)
+	`, pipelineID, version).Scan(&recorded)
+	if err != nil {
+		return err
+	}
+
+	if recorded {
+		return nil
+	}
+
+	current := newPipeline(conn, lockFactory)
+
+	err = scanPipeline(
+		current,
+		pipelinesQuery.
+			Where(sq.Eq{"p.id": pipelineID}).
+			RunWith(tx).
+			QueryRow(),
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := jobsQuery.
+		Where(sq.Eq{
+			"pipeline_id": pipelineID,
+			"active":      true,
+		}).
+		OrderBy("j.id ASC").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	jobs, err := scanJobs(conn, lockFactory, rows)
+	if err != nil {
+		return err
+	}
+
+	rows, err = resourcesQuery.
+		Where(sq.Eq{"r.pipeline_id": pipelineID}).
+		OrderBy("r.name").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	var resources Resources
+	for rows.Next() {
+		resource := &resource{conn: conn, lockFactory: lockFactory}
+		err := scanResource(resource, rows)
+		if err != nil {
+			return err
+		}
+
+		resources = append(resources, resource)
+	}
+
+	rows, err = resourceTypesQuery.
+		Where(sq.Eq{"r.pipeline_id": pipelineID}).
+		OrderBy("r.name").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	var resourceTypes ResourceTypes
+	for rows.Next() {
+		resourceType := &resourceType{conn: conn, lockFactory: lockFactory}
+		err := scanResourceType(resourceType, rows)
+		if err != nil {
+			return err
+		}
+
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	config := atc.Config{
+		Groups:        current.Groups(),
+		Labels:        current.Labels(),
+		Resources:     resources.Configs(),
+		ResourceTypes: resourceTypes.Configs(),
+		Jobs:          jobs.Configs(),
+	}
+
+	encryptedPayload, nonce, err := encryptPipelineConfig(conn.EncryptionStrategy(), config)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		SetMap(map[string]interface{}{
+			"pipeline_id": pipelineID,
+			"version":     version,
+			"config":      encryptedPayload,
+			"nonce":       nonce,
+			"created_at":  nil,
+		}).
+		RunWith(tx).
+		Exec()
+
+	return err
+}
+
+func (p *pipeline) ConfigVersions() ([]PipelineConfigVersion, error) {
+	rows, err := psql.Select("version", "author", "created_at").
+		From("pipeline_configs").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var versions []PipelineConfigVersion
+	for rows.Next() {
+		var (
+			version   PipelineConfigVersion
+			createdAt pq.NullTime
+		)
+
+		err := rows.Scan(&version.Version, &version.Author, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if createdAt.Valid {
+			version.CreatedAt = createdAt.Time
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (p *pipeline) ConfigAtVersion(version ConfigVersion) (atc.Config, bool, error) {
+	var (
+		configBlob string
+		nonce      sql.NullString
+	)
+
+	err := psql.Select("config", "nonce").
+		From("pipeline_configs").
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+			"version":     version,
+		}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.Config{}, false, nil
+		}
+
+		return atc.Config{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := p.conn.EncryptionStrategy().Decrypt(configBlob, noncense)
+	if err != nil {
+		return atc.Config{}, false, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return atc.Config{}, false, err
+	}
+
+	return config, true, nil
+}
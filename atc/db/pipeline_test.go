@@ -118,7 +118,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused)
+		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -233,6 +233,129 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("ConfigVersions", func() {
+		var updatedConfig atc.Config
+
+		BeforeEach(func() {
+			updatedConfig = pipelineConfig
+			updatedConfig.Jobs = pipelineConfig.Jobs[:1]
+
+			_, _, err := team.SavePipeline("fake-pipeline", updatedConfig, pipeline.ConfigVersion(), db.PipelineNoChange, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists each saved config, newest first", func() {
+			versions, err := pipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+
+			Expect(versions[0].Version).To(Equal(pipeline.ConfigVersion() + 1))
+			Expect(versions[0].Author).To(Equal("some-user"))
+			Expect(versions[0].CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+			Expect(versions[1].Version).To(Equal(pipeline.ConfigVersion()))
+			Expect(versions[1].Author).To(BeEmpty())
+		})
+
+		It("returns the config saved at each version", func() {
+			config, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(config).To(Equal(pipelineConfig))
+
+			config, found, err = pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(config).To(Equal(updatedConfig))
+		})
+
+		It("does not find versions which were never saved", func() {
+			_, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("keeps every saved config", func() {
+			saved := pipeline
+			for i := 0; i < 101; i++ {
+				var err error
+				saved, _, err = team.SavePipeline("fake-pipeline", updatedConfig, saved.ConfigVersion(), db.PipelineNoChange, "some-user")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			versions, err := pipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(HaveLen(103))
+			Expect(versions[0].Version).To(Equal(saved.ConfigVersion()))
+			Expect(versions[102].Version).To(Equal(pipeline.ConfigVersion()))
+
+			_, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when the current config was saved before configs were kept", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`DELETE FROM pipeline_configs WHERE pipeline_id = $1`, pipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not record it when the history is read", func() {
+				versions, err := pipeline.ConfigVersions()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versions).To(BeEmpty())
+
+				_, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when the pipeline is saved again", func() {
+				var saved db.Pipeline
+
+				BeforeEach(func() {
+					var err error
+					saved, _, err = team.SavePipeline("fake-pipeline", pipelineConfig, pipeline.ConfigVersion()+1, db.PipelineNoChange, "some-other-user")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("records the replaced config without an author or time", func() {
+					versions, err := pipeline.ConfigVersions()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(versions).To(HaveLen(2))
+
+					Expect(versions[0].Version).To(Equal(saved.ConfigVersion()))
+					Expect(versions[0].Author).To(Equal("some-other-user"))
+
+					Expect(versions[1].Version).To(Equal(pipeline.ConfigVersion() + 1))
+					Expect(versions[1].Author).To(BeEmpty())
+					Expect(versions[1].CreatedAt).To(BeZero())
+				})
+
+				It("returns the replaced config at its version", func() {
+					config, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 1)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(config.Jobs).To(Equal(updatedConfig.Jobs))
+					Expect(config.Resources).To(Equal(updatedConfig.Resources))
+				})
+			})
+		})
+
+		Context("when the pipeline is destroyed", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Destroy()).To(Succeed())
+			})
+
+			It("removes its saved configs", func() {
+				var count int
+				err := dbConn.QueryRow("SELECT COUNT(*) FROM pipeline_configs WHERE pipeline_id = $1", pipeline.ID()).Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Resource Config Versions", func() {
 		resourceName := "some-resource"
 		otherResourceName := "some-other-resource"
@@ -399,10 +522,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, db.PipelineUnpaused)
+			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -970,7 +1093,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -1211,7 +1334,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2172,7 +2295,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2192,7 +2315,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
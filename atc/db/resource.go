@@ -31,6 +31,8 @@ type Resource interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	CheckFailures() int
+	LastActivityTime() time.Time
 	Tags() atc.Tags
 	CheckSetupError() error
 	CheckError() error
@@ -38,31 +40,40 @@ type Resource interface {
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
+	PinnedBy() string
+	PinExpiresAt() time.Time
+	UnpinnedBy() string
 	SetPinComment(string) error
 	ResourceConfigID() int
 	ResourceConfigScopeID() int
 	Icon() string
+	VersionHistory() int
 
 	CurrentPinnedVersion() atc.Version
 
 	ResourceConfigVersionID(atc.Version) (int, bool, error)
+	LatestMatchingVersionID(atc.Version) (int, bool, error)
 	Versions(page Page) ([]atc.ResourceVersion, Pagination, bool, error)
 	SaveUncheckedVersion(atc.Version, ResourceConfigMetadataFields, ResourceConfig, creds.VersionedResourceTypes) (bool, error)
 
 	EnableVersion(rcvID int) error
 	DisableVersion(rcvID int) error
 
-	PinVersion(rcvID int) error
-	UnpinVersion() error
+	PinVersion(rcvID int, pinnedBy string, expiresAt time.Time) error
+	UnpinVersion(unpinnedBy string) error
 
 	SetResourceConfig(lager.Logger, atc.Source, creds.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
 	NotifyScan() error
+	ResetCheckInterval() error
+	Checks(limit int) ([]Check, error)
+
+	RecordSecretLookups([]creds.SecretLookup) error
 
 	Reload() (bool, error)
 }
 
-var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, rs.last_check_start_time, rs.last_check_end_time, r.pipeline_id, r.nonce, r.resource_config_id, r.resource_config_scope_id, p.name, t.name, rs.check_error, rp.version, rp.comment_text").
+var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, rs.last_check_start_time, rs.last_check_end_time, r.pipeline_id, r.nonce, r.resource_config_id, r.resource_config_scope_id, p.name, t.name, rs.check_error, rs.check_failures, rs.last_activity_time, rp.version, rp.comment_text, rp.pinned_by, rp.expires_at, r.unpinned_by").
 	From("resources r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
@@ -83,6 +94,8 @@ type resource struct {
 	checkTimeout          string
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	checkFailures         int
+	lastActivityTime      time.Time
 	tags                  atc.Tags
 	checkSetupError       error
 	checkError            error
@@ -90,9 +103,13 @@ type resource struct {
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
+	pinnedBy              string
+	unpinnedBy            string
+	pinExpiresAt          time.Time
 	resourceConfigID      int
 	resourceConfigScopeID int
 	icon                  string
+	versionHistory        int
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -123,15 +140,16 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			Public:       r.Public(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.ConfigPinnedVersion(),
-			Icon:         r.Icon(),
+			Name:           r.Name(),
+			Public:         r.Public(),
+			WebhookToken:   r.WebhookToken(),
+			Type:           r.Type(),
+			Source:         r.Source(),
+			CheckEvery:     r.CheckEvery(),
+			Tags:           r.Tags(),
+			Version:        r.ConfigPinnedVersion(),
+			Icon:           r.Icon(),
+			VersionHistory: r.VersionHistory(),
 		})
 	}
 
@@ -150,6 +168,8 @@ func (r *resource) CheckEvery() string               { return r.checkEvery }
 func (r *resource) CheckTimeout() string             { return r.checkTimeout }
 func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
 func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
+func (r *resource) CheckFailures() int               { return r.checkFailures }
+func (r *resource) LastActivityTime() time.Time      { return r.lastActivityTime }
 func (r *resource) Tags() atc.Tags                   { return r.tags }
 func (r *resource) CheckSetupError() error           { return r.checkSetupError }
 func (r *resource) CheckError() error                { return r.checkError }
@@ -157,9 +177,13 @@ func (r *resource) WebhookToken() string             { return r.webhookToken }
 func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
 func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
 func (r *resource) PinComment() string               { return r.pinComment }
+func (r *resource) PinnedBy() string                 { return r.pinnedBy }
+func (r *resource) UnpinnedBy() string               { return r.unpinnedBy }
+func (r *resource) PinExpiresAt() time.Time          { return r.pinExpiresAt }
 func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
 func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
 func (r *resource) Icon() string                     { return r.icon }
+func (r *resource) VersionHistory() int              { return r.versionHistory }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -321,6 +345,36 @@ func (r *resource) ResourceConfigVersionID(version atc.Version) (int, bool, erro
 	return id, true, nil
 }
 
+// LatestMatchingVersionID returns the most recently checked version of the
+// resource which contains all of the given version fields.
+func (r *resource) LatestMatchingVersionID(version atc.Version) (int, bool, error) {
+	requestedVersion, err := json.Marshal(version)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var id int
+	err = psql.Select("rcv.id").
+		From("resource_config_versions rcv").
+		Join("resources r ON rcv.resource_config_scope_id = r.resource_config_scope_id").
+		Where(sq.Eq{"r.id": r.ID()}).
+		Where(sq.Expr("rcv.version @> ?::jsonb", string(requestedVersion))).
+		Where(sq.NotEq{"rcv.check_order": 0}).
+		OrderBy("rcv.check_order DESC").
+		Limit(1).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
 func (r *resource) SetPinComment(comment string) error {
 	_, err := psql.Update("resource_pins").
 		Set("comment_text", comment).
@@ -331,6 +385,14 @@ func (r *resource) SetPinComment(comment string) error {
 	return err
 }
 
+func (r *resource) RecordSecretLookups(lookups []creds.SecretLookup) error {
+	if len(lookups) == 0 {
+		return nil
+	}
+
+	return recordSecretLookups(r.conn, r.pipelineID, SecretLookupUsedByResource, r.name, lookups)
+}
+
 func (r *resource) CurrentPinnedVersion() atc.Version {
 	if r.configPinnedVersion != nil {
 		return r.configPinnedVersion
@@ -505,14 +567,27 @@ func (r *resource) DisableVersion(rcvID int) error {
 	return r.toggleVersion(rcvID, false)
 }
 
-func (r *resource) PinVersion(rcvID int) error {
+// PinVersion pins the resource to the version, recording who pinned it and
+// replacing any existing pin. A non-zero expiresAt makes the pin lift
+// automatically after that time.
+func (r *resource) PinVersion(rcvID int, pinnedBy string, expiresAt time.Time) error {
+	var expiry pq.NullTime
+	if !expiresAt.IsZero() {
+		expiry = pq.NullTime{Time: expiresAt, Valid: true}
+	}
+
 	results, err := r.conn.Exec(`
-	    INSERT INTO resource_pins(resource_id, version, comment_text)
+	    INSERT INTO resource_pins(resource_id, version, comment_text, pinned_by, expires_at)
 			VALUES ($1,
 				( SELECT rcv.version
 				FROM resource_config_versions rcv
 				WHERE rcv.id = $2 ),
-				'')`, r.id, rcvID)
+				'', $3, $4)
+			ON CONFLICT (resource_id) DO UPDATE SET
+				version = EXCLUDED.version,
+				comment_text = EXCLUDED.comment_text,
+				pinned_by = EXCLUDED.pinned_by,
+				expires_at = EXCLUDED.expires_at`, r.id, rcvID, pinnedBy, expiry)
 	if err != nil {
 		return err
 	}
@@ -529,10 +604,18 @@ func (r *resource) PinVersion(rcvID int) error {
 	return nil
 }
 
-func (r *resource) UnpinVersion() error {
+// UnpinVersion lifts the resource's pin, recording who lifted it.
+func (r *resource) UnpinVersion(unpinnedBy string) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
 	results, err := psql.Delete("resource_pins").
 		Where(sq.Eq{"resource_pins.resource_id": r.id}).
-		RunWith(r.conn).
+		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
@@ -547,7 +630,16 @@ func (r *resource) UnpinVersion() error {
 		return nonOneRowAffectedError{rowsAffected}
 	}
 
-	return nil
+	_, err = psql.Update("resources").
+		Set("unpinned_by", unpinnedBy).
+		Where(sq.Eq{"id": r.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (r *resource) toggleVersion(rcvID int, enable bool) error {
@@ -594,24 +686,44 @@ func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	return tx.Commit()
 }
 
+// NotifyScan queues a check of the resource to be run as soon as possible.
 func (r *resource) NotifyScan() error {
-	return r.conn.Bus().Notify(fmt.Sprintf("resource_scan_%d", r.id))
+	_, err := NewCheckFactory(r.conn, r.lockFactory).CreateCheck(r, nil)
+	return err
+}
+
+// ResetCheckInterval clears the consecutive check failures of the resource's
+// config scope and marks it as active, so that an adaptive check interval
+// falls back to the configured one.
+func (r *resource) ResetCheckInterval() error {
+	_, err := psql.Update("resource_config_scopes").
+		Set("check_failures", 0).
+		Set("last_activity_time", sq.Expr("now()")).
+		Where(sq.Expr("id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", r.id)).
+		RunWith(r.conn).
+		Exec()
+	return err
 }
 
 func scanResource(r *resource, row scannable) error {
 	var (
-		configBlob                                                                  []byte
-		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, apiPinnedVersion, pinComment sql.NullString
-		lastCheckStartTime, lastCheckEndTime                                        pq.NullTime
+		configBlob                                                                            []byte
+		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, apiPinnedVersion, pinComment, pinnedBy sql.NullString
+		lastCheckStartTime, lastCheckEndTime, lastActivityTime, pinExpiresAt                  pq.NullTime
+		checkFailures                                                                         sql.NullInt64
 	)
 
-	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamName, &rcsCheckErr, &apiPinnedVersion, &pinComment)
+	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamName, &rcsCheckErr, &checkFailures, &lastActivityTime, &apiPinnedVersion, &pinComment, &pinnedBy, &pinExpiresAt, &r.unpinnedBy)
 	if err != nil {
 		return err
 	}
 
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
+	r.checkFailures = int(checkFailures.Int64)
+	r.lastActivityTime = lastActivityTime.Time
+	r.pinnedBy = pinnedBy.String
+	r.pinExpiresAt = pinExpiresAt.Time
 
 	es := r.conn.EncryptionStrategy()
 
@@ -640,6 +752,7 @@ func scanResource(r *resource, row scannable) error {
 	r.webhookToken = config.WebhookToken
 	r.configPinnedVersion = config.Version
 	r.icon = config.Icon
+	r.versionHistory = config.VersionHistory
 
 	if apiPinnedVersion.Valid {
 		err = json.Unmarshal([]byte(apiPinnedVersion.String), &r.apiPinnedVersion)
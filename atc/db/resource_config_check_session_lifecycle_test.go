@@ -94,7 +94,8 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 							},
 						},
 					},
-				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused)
+				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused, "")
+
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
@@ -191,7 +192,8 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 						},
 					},
 					ResourceTypes: atc.ResourceTypes{},
-				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused)
+				}, defaultPipeline.ConfigVersion(), db.PipelineUnpaused, "")
+
 				Expect(err).NotTo(HaveOccurred())
 
 				By("cleaning up inactive sessions")
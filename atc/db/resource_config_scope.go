@@ -75,12 +75,15 @@ func (r *resourceConfigScope) SaveVersions(versions []atc.Version) error {
 
 	defer Rollback(tx)
 
+	var foundNew bool
 	for _, version := range versions {
-		_, err = saveResourceVersion(tx, r, version, nil)
+		isNew, err := saveResourceVersion(tx, r, version, nil)
 		if err != nil {
 			return err
 		}
 
+		foundNew = foundNew || isNew
+
 		versionJSON, err := json.Marshal(version)
 		if err != nil {
 			return err
@@ -92,6 +95,17 @@ func (r *resourceConfigScope) SaveVersions(versions []atc.Version) error {
 		}
 	}
 
+	if foundNew {
+		_, err = psql.Update("resource_config_scopes").
+			Set("last_activity_time", sq.Expr("now()")).
+			Where(sq.Eq{"id": r.id}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -165,12 +179,14 @@ func (r *resourceConfigScope) SetCheckError(cause error) error {
 	if cause == nil {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", nil).
+			Set("check_failures", 0).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
 	} else {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", cause.Error()).
+			Set("check_failures", sq.Expr("check_failures + 1")).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
@@ -37,7 +37,8 @@ var _ = Describe("Resource Config Scope", func() {
 					},
 				},
 			},
-		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 		Expect(err).NotTo(HaveOccurred())
 
 		resource, found, err := pipeline.Resource("some-resource")
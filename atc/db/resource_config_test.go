@@ -33,8 +33,8 @@ var _ = Describe("ResourceConfig", func() {
 				"pipeline-one-resource",
 				config,
 				0,
-				db.PipelineUnpaused,
-			)
+				db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . ResourceConfigVersionLifecycle
+
+type ResourceConfigVersionLifecycle interface {
+	RemoveExcessVersions(resourceConfigScopeID int, historyLimit int, pinnedVersions []atc.Version, passedJobIDs []int) (int, error)
+}
+
+type resourceConfigVersionLifecycle struct {
+	conn Conn
+}
+
+func NewResourceConfigVersionLifecycle(conn Conn) ResourceConfigVersionLifecycle {
+	return &resourceConfigVersionLifecycle{
+		conn: conn,
+	}
+}
+
+// RemoveExcessVersions keeps only the most recently checked versions of the
+// resource config scope. Older versions are kept as long as they match one of
+// the given pinned versions or a version pinned through the API, are still
+// waiting to be used as build inputs, or are an input or output of a build
+// which is still running, is one of the history limit's most recent builds of
+// its job or is the latest successful build of its job. Versions which went
+// through any successful build of the given jobs are kept too, as passed
+// constraints on them may still select any of them.
+func (lifecycle *resourceConfigVersionLifecycle) RemoveExcessVersions(resourceConfigScopeID int, historyLimit int, pinnedVersions []atc.Version, passedJobIDs []int) (int, error) {
+	pins := []string{}
+	for _, version := range pinnedVersions {
+		if len(version) == 0 {
+			continue
+		}
+
+		pin, err := json.Marshal(version)
+		if err != nil {
+			return 0, err
+		}
+
+		pins = append(pins, string(pin))
+	}
+
+	result, err := lifecycle.conn.Exec(`
+		WITH retained_builds AS (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (
+					PARTITION BY job_id
+					ORDER BY id DESC
+				) AS n
+				FROM builds
+				WHERE job_id IS NOT NULL
+			) recent
+			WHERE recent.n <= $2
+			UNION
+			SELECT id FROM builds
+			WHERE status IN ('pending', 'started')
+			UNION
+			SELECT max(id) FROM builds
+			WHERE status = 'succeeded'
+			AND job_id IS NOT NULL
+			GROUP BY job_id
+			UNION
+			SELECT id FROM builds
+			WHERE status = 'succeeded'
+			AND job_id = ANY($4::integer[])
+		), scope_resources AS (
+			SELECT id FROM resources
+			WHERE resource_config_scope_id = $1
+		)
+		DELETE FROM resource_config_versions v
+		WHERE v.id IN (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (
+					ORDER BY check_order DESC, id DESC
+				) AS n
+				FROM resource_config_versions
+				WHERE resource_config_scope_id = $1
+			) history
+			WHERE history.n > $2
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM unnest($3::text[]) pin
+			WHERE v.version @> pin::jsonb
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM resource_pins p
+			JOIN scope_resources r ON r.id = p.resource_id
+			WHERE v.version @> p.version
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM next_build_inputs n
+			WHERE n.resource_config_version_id = v.id
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM independent_build_inputs n
+			WHERE n.resource_config_version_id = v.id
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_inputs i
+			JOIN scope_resources r ON r.id = i.resource_id
+			JOIN retained_builds b ON b.id = i.build_id
+			WHERE i.version_md5 = v.version_md5
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_outputs o
+			JOIN scope_resources r ON r.id = o.resource_id
+			JOIN retained_builds b ON b.id = o.build_id
+			WHERE o.version_md5 = v.version_md5
+		)
+	`, resourceConfigScopeID, historyLimit, pq.Array(pins), pq.Array(passedJobIDs))
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if rows > 0 {
+		err = bumpCacheIndexForPipelinesUsingResourceConfigScope(lifecycle.conn, resourceConfigScopeID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return int(rows), nil
+}
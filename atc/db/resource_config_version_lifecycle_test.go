@@ -0,0 +1,172 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionLifecycle", func() {
+	var (
+		lifecycle           db.ResourceConfigVersionLifecycle
+		resourceConfigScope db.ResourceConfigScope
+	)
+
+	BeforeEach(func() {
+		lifecycle = db.NewResourceConfigVersionLifecycle(dbConn)
+
+		var err error
+		resourceConfigScope, err = defaultResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = resourceConfigScope.SaveVersions([]atc.Version{
+			{"ref": "v1"},
+			{"ref": "v2"},
+			{"ref": "v3"},
+			{"ref": "v4"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	versionExists := func(version atc.Version) bool {
+		_, found, err := resourceConfigScope.FindVersion(version)
+		Expect(err).ToNot(HaveOccurred())
+		return found
+	}
+
+	findVersion := func(version atc.Version) db.ResourceConfigVersion {
+		rcv, found, err := resourceConfigScope.FindVersion(version)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return rcv
+	}
+
+	finishedBuildWithInput := func(job db.Job, version atc.Version, status db.BuildStatus) db.Build {
+		build, err := job.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.UseInputs([]db.BuildInput{
+			{Name: "some-input", Version: version, ResourceID: defaultResource.ID()},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(build.Finish(status)).To(Succeed())
+
+		return build
+	}
+
+	Describe("RemoveExcessVersions", func() {
+		It("removes the versions beyond the history limit", func() {
+			removed, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(2))
+
+			Expect(versionExists(atc.Version{"ref": "v1"})).To(BeFalse())
+			Expect(versionExists(atc.Version{"ref": "v2"})).To(BeFalse())
+			Expect(versionExists(atc.Version{"ref": "v3"})).To(BeTrue())
+			Expect(versionExists(atc.Version{"ref": "v4"})).To(BeTrue())
+		})
+
+		It("keeps the given pinned versions, ignoring empty ones", func() {
+			_, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, []atc.Version{nil, {"ref": "v1"}}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(versionExists(atc.Version{"ref": "v1"})).To(BeTrue())
+			Expect(versionExists(atc.Version{"ref": "v2"})).To(BeFalse())
+		})
+
+		It("keeps the version pinned through the API", func() {
+			err := defaultResource.PinVersion(findVersion(atc.Version{"ref": "v2"}).ID(), "some-user", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(versionExists(atc.Version{"ref": "v1"})).To(BeFalse())
+			Expect(versionExists(atc.Version{"ref": "v2"})).To(BeTrue())
+		})
+
+		It("keeps versions used by builds which are still running", func() {
+			build, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.UseInputs([]db.BuildInput{
+				{Name: "some-input", Version: atc.Version{"ref": "v1"}, ResourceID: defaultResource.ID()},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			finishedBuildWithInput(defaultJob, atc.Version{"ref": "v4"}, db.BuildStatusFailed)
+			finishedBuildWithInput(defaultJob, atc.Version{"ref": "v4"}, db.BuildStatusFailed)
+
+			_, err = lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(versionExists(atc.Version{"ref": "v1"})).To(BeTrue())
+			Expect(versionExists(atc.Version{"ref": "v2"})).To(BeFalse())
+		})
+
+		It("keeps versions used by as many of each job's most recent builds as the history limit", func() {
+			finishedBuildWithInput(defaultJob, atc.Version{"ref": "v1"}, db.BuildStatusFailed)
+			finishedBuildWithInput(defaultJob, atc.Version{"ref": "v2"}, db.BuildStatusFailed)
+			finishedBuildWithInput(defaultJob, atc.Version{"ref": "v3"}, db.BuildStatusFailed)
+
+			_, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(versionExists(atc.Version{"ref": "v1"})).To(BeFalse())
+			Expect(versionExists(atc.Version{"ref": "v2"})).To(BeTrue())
+		})
+
+		Context("when the job's succeeded builds are older than its most recent builds", func() {
+			BeforeEach(func() {
+				finishedBuildWithInput(defaultJob, atc.Version{"ref": "v1"}, db.BuildStatusSucceeded)
+				finishedBuildWithInput(defaultJob, atc.Version{"ref": "v2"}, db.BuildStatusSucceeded)
+				finishedBuildWithInput(defaultJob, atc.Version{"ref": "v4"}, db.BuildStatusFailed)
+				finishedBuildWithInput(defaultJob, atc.Version{"ref": "v4"}, db.BuildStatusFailed)
+			})
+
+			It("keeps only the versions of the job's latest succeeded build", func() {
+				_, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(versionExists(atc.Version{"ref": "v1"})).To(BeFalse())
+				Expect(versionExists(atc.Version{"ref": "v2"})).To(BeTrue())
+			})
+
+			Context("when another job's inputs must have passed the job", func() {
+				It("keeps the versions of every succeeded build of the job", func() {
+					_, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, []int{defaultJob.ID()})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(versionExists(atc.Version{"ref": "v1"})).To(BeTrue())
+					Expect(versionExists(atc.Version{"ref": "v2"})).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the version is a pending build input", func() {
+			BeforeEach(func() {
+				err := defaultJob.SaveNextInputMapping(algorithm.InputMapping{
+					"some-input": {
+						ResourceID: defaultResource.ID(),
+						VersionID:  findVersion(atc.Version{"ref": "v1"}).ID(),
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps it", func() {
+				_, err := lifecycle.RemoveExcessVersions(resourceConfigScope.ID(), 2, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(versionExists(atc.Version{"ref": "v1"})).To(BeTrue())
+				Expect(versionExists(atc.Version{"ref": "v2"})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . ResourcePinLifecycle
+
+type ResourcePinLifecycle interface {
+	UnpinExpiredVersions(unpinnedBy string) ([]ExpiredPin, error)
+}
+
+// ExpiredPin describes a pin which was lifted because it expired.
+type ExpiredPin struct {
+	TeamName     string
+	PipelineName string
+	ResourceName string
+	Version      atc.Version
+	PinnedBy     string
+}
+
+type resourcePinLifecycle struct {
+	conn Conn
+}
+
+func NewResourcePinLifecycle(conn Conn) ResourcePinLifecycle {
+	return &resourcePinLifecycle{
+		conn: conn,
+	}
+}
+
+// UnpinExpiredVersions removes every pin whose expiry has passed, recording
+// who unpinned each resource, and returns the pins which were removed.
+func (lifecycle *resourcePinLifecycle) UnpinExpiredVersions(unpinnedBy string) ([]ExpiredPin, error) {
+	rows, err := lifecycle.conn.Query(`
+		WITH expired AS (
+			DELETE FROM resource_pins rp
+			USING resources r, pipelines p, teams t
+			WHERE rp.resource_id = r.id
+			AND r.pipeline_id = p.id
+			AND p.team_id = t.id
+			AND rp.expires_at IS NOT NULL
+			AND rp.expires_at <= now()
+			RETURNING rp.resource_id, t.name AS team_name, p.name AS pipeline_name, r.name AS resource_name, rp.version, rp.pinned_by
+		), unpinned AS (
+			UPDATE resources
+			SET unpinned_by = $1
+			WHERE id IN (SELECT resource_id FROM expired)
+		)
+		SELECT team_name, pipeline_name, resource_name, version, pinned_by
+		FROM expired
+	`, unpinnedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	expired := []ExpiredPin{}
+	for rows.Next() {
+		var (
+			pin     ExpiredPin
+			version []byte
+		)
+
+		err = rows.Scan(&pin.TeamName, &pin.PipelineName, &pin.ResourceName, &version, &pin.PinnedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(version, &pin.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		expired = append(expired, pin)
+	}
+
+	return expired, nil
+}
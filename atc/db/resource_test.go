@@ -3,6 +3,7 @@ package db_test
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
@@ -54,8 +55,8 @@ var _ = Describe("Resource", func() {
 				},
 			},
 			0,
-			db.PipelineUnpaused,
-		)
+			db.PipelineUnpaused, "")
+
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 	})
@@ -241,8 +242,8 @@ var _ = Describe("Resource", func() {
 				"pipeline-with-same-resources",
 				config,
 				0,
-				db.PipelineUnpaused,
-			)
+				db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
@@ -461,8 +462,8 @@ var _ = Describe("Resource", func() {
 							"pipeline-with-same-resources",
 							config,
 							pipeline.ConfigVersion(),
-							db.PipelineUnpaused,
-						)
+							db.PipelineUnpaused, "")
+
 						Expect(err).ToNot(HaveOccurred())
 
 						var found bool
@@ -493,8 +494,8 @@ var _ = Describe("Resource", func() {
 							"pipeline-with-same-resources",
 							config,
 							pipeline.ConfigVersion(),
-							db.PipelineUnpaused,
-						)
+							db.PipelineUnpaused, "")
+
 						Expect(err).ToNot(HaveOccurred())
 
 						var found bool
@@ -1102,7 +1103,7 @@ var _ = Describe("Resource", func() {
 
 		Context("when we pin a resource to a version", func() {
 			BeforeEach(func() {
-				err := resource.PinVersion(resID)
+				err := resource.PinVersion(resID, "some-user", time.Time{})
 				Expect(err).ToNot(HaveOccurred())
 
 				found, err := resource.Reload()
@@ -1115,6 +1116,14 @@ var _ = Describe("Resource", func() {
 				Expect(resource.CurrentPinnedVersion()).To(Equal(resource.APIPinnedVersion()))
 			})
 
+			It("records who pinned it", func() {
+				Expect(resource.PinnedBy()).To(Equal("some-user"))
+			})
+
+			It("does not expire", func() {
+				Expect(resource.PinExpiresAt()).To(BeZero())
+			})
+
 			Context("when we set the pin comment on a resource", func() {
 				BeforeEach(func() {
 					err := resource.SetPinComment("foo")
@@ -1129,7 +1138,7 @@ var _ = Describe("Resource", func() {
 
 				Context("when we unpin a resource to a version", func() {
 					BeforeEach(func() {
-						err := resource.UnpinVersion()
+						err := resource.UnpinVersion("some-other-user")
 						Expect(err).ToNot(HaveOccurred())
 
 						found, err := resource.Reload()
@@ -1145,10 +1154,84 @@ var _ = Describe("Resource", func() {
 					It("unsets the pin comment", func() {
 						Expect(resource.PinComment()).To(BeEmpty())
 					})
+
+					It("records who unpinned it", func() {
+						Expect(resource.UnpinnedBy()).To(Equal("some-other-user"))
+					})
 				})
 			})
 		})
 
+		Context("when we pin a resource with an expiry", func() {
+			var lifecycle db.ResourcePinLifecycle
+
+			BeforeEach(func() {
+				lifecycle = db.NewResourcePinLifecycle(dbConn)
+			})
+
+			Context("which has not passed", func() {
+				BeforeEach(func() {
+					err := resource.PinVersion(resID, "some-user", time.Now().Add(time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps the pin", func() {
+					expired, err := lifecycle.UnpinExpiredVersions("pin-expiry")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(expired).To(BeEmpty())
+
+					found, err := resource.Reload()
+					Expect(found).To(BeTrue())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
+					Expect(resource.PinExpiresAt()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+					Expect(resource.UnpinnedBy()).To(BeEmpty())
+				})
+			})
+
+			Context("which has passed", func() {
+				BeforeEach(func() {
+					err := resource.PinVersion(resID, "some-user", time.Now().Add(-time.Minute))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("unpins the resource", func() {
+					expired, err := lifecycle.UnpinExpiredVersions("pin-expiry")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(expired).To(Equal([]db.ExpiredPin{
+						{
+							TeamName:     "default-team",
+							PipelineName: pipeline.Name(),
+							ResourceName: "some-other-resource",
+							Version:      atc.Version{"version": "v1"},
+							PinnedBy:     "some-user",
+						},
+					}))
+
+					found, err := resource.Reload()
+					Expect(found).To(BeTrue())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resource.APIPinnedVersion()).To(BeNil())
+					Expect(resource.UnpinnedBy()).To(Equal("pin-expiry"))
+				})
+			})
+		})
+
+		Describe("LatestMatchingVersionID", func() {
+			It("finds the latest version containing the fields", func() {
+				id, found, err := resource.LatestMatchingVersionID(atc.Version{"version": "v1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(id).To(Equal(resID))
+			})
+
+			It("does not find versions which don't match", func() {
+				_, found, err := resource.LatestMatchingVersionID(atc.Version{"version": "v4"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
 		Context("when we pin a resource that is already pinned to a version (through the config)", func() {
 			BeforeEach(func() {
 				var found bool
@@ -1182,7 +1265,7 @@ var _ = Describe("Resource", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				err = resource.PinVersion(resConf.ID())
+				err = resource.PinVersion(resConf.ID(), "some-user", time.Time{})
 				Expect(err).ToNot(HaveOccurred())
 
 				found, err = resource.Reload()
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 type ResourceTypeNotFoundError struct {
@@ -25,6 +27,7 @@ func (e ResourceTypeNotFoundError) Error() string {
 
 type ResourceType interface {
 	ID() int
+	PipelineID() int
 	Name() string
 	Type() string
 	Privileged() bool
@@ -34,11 +37,17 @@ type ResourceType interface {
 	CheckEvery() string
 	CheckSetupError() error
 	CheckError() error
+	CheckFailures() int
+	LastActivityTime() time.Time
 	UniqueVersionHistory() bool
+	Defaults() atc.Source
+	FromTeam() bool
 
 	SetResourceConfig(lager.Logger, atc.Source, creds.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
 
+	RecordSecretLookups([]creds.SecretLookup) error
+
 	Version() atc.Version
 
 	Reload() (bool, error)
@@ -60,6 +69,7 @@ func (resourceTypes ResourceTypes) Deserialize() atc.VersionedResourceTypes {
 				Tags:                 t.Tags(),
 				Params:               t.Params(),
 				UniqueVersionHistory: t.UniqueVersionHistory(),
+				Defaults:             t.Defaults(),
 			},
 			Version: t.Version(),
 		})
@@ -68,10 +78,16 @@ func (resourceTypes ResourceTypes) Deserialize() atc.VersionedResourceTypes {
 	return versionedResourceTypes
 }
 
+// Configs returns the config of each resource type declared by the pipeline,
+// leaving out those it uses from the team's resource types.
 func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 	var configs atc.ResourceTypes
 
 	for _, r := range resourceTypes {
+		if r.FromTeam() {
+			continue
+		}
+
 		configs = append(configs, atc.ResourceType{
 			Name:                 r.Name(),
 			Type:                 r.Type(),
@@ -81,13 +97,14 @@ func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 			Tags:                 r.Tags(),
 			Params:               r.Params(),
 			UniqueVersionHistory: r.UniqueVersionHistory(),
+			Defaults:             r.Defaults(),
 		})
 	}
 
 	return configs
 }
 
-var resourceTypesQuery = psql.Select("r.id, r.name, r.type, r.config, rcv.version, r.nonce, r.check_error, ro.check_error").
+var resourceTypesQuery = psql.Select("r.id, r.pipeline_id, r.name, r.type, r.config, rcv.version, r.nonce, r.check_error, ro.check_error, ro.check_failures, ro.last_activity_time, r.from_team").
 	From("resource_types r").
 	LeftJoin("resource_configs c ON c.id = r.resource_config_id").
 	LeftJoin("resource_config_scopes ro ON ro.resource_config_id = c.id").
@@ -102,6 +119,7 @@ var resourceTypesQuery = psql.Select("r.id, r.name, r.type, r.config, rcv.versio
 
 type resourceType struct {
 	id                   int
+	pipelineID           int
 	name                 string
 	type_                string
 	privileged           bool
@@ -112,26 +130,43 @@ type resourceType struct {
 	checkEvery           string
 	checkSetupError      error
 	checkError           error
+	checkFailures        int
+	lastActivityTime     time.Time
 	uniqueVersionHistory bool
+	defaults             atc.Source
+	fromTeam             bool
 
 	conn        Conn
 	lockFactory lock.LockFactory
 }
 
-func (t *resourceType) ID() int                    { return t.id }
-func (t *resourceType) Name() string               { return t.name }
-func (t *resourceType) Type() string               { return t.type_ }
-func (t *resourceType) Privileged() bool           { return t.privileged }
-func (t *resourceType) CheckEvery() string         { return t.checkEvery }
-func (t *resourceType) Source() atc.Source         { return t.source }
-func (t *resourceType) Params() atc.Params         { return t.params }
-func (t *resourceType) Tags() atc.Tags             { return t.tags }
-func (t *resourceType) CheckSetupError() error     { return t.checkSetupError }
-func (t *resourceType) CheckError() error          { return t.checkError }
-func (t *resourceType) UniqueVersionHistory() bool { return t.uniqueVersionHistory }
+func (t *resourceType) PipelineID() int             { return t.pipelineID }
+func (t *resourceType) ID() int                     { return t.id }
+func (t *resourceType) Name() string                { return t.name }
+func (t *resourceType) Type() string                { return t.type_ }
+func (t *resourceType) Privileged() bool            { return t.privileged }
+func (t *resourceType) CheckEvery() string          { return t.checkEvery }
+func (t *resourceType) Source() atc.Source          { return t.source }
+func (t *resourceType) Params() atc.Params          { return t.params }
+func (t *resourceType) Tags() atc.Tags              { return t.tags }
+func (t *resourceType) CheckSetupError() error      { return t.checkSetupError }
+func (t *resourceType) CheckError() error           { return t.checkError }
+func (t *resourceType) CheckFailures() int          { return t.checkFailures }
+func (t *resourceType) LastActivityTime() time.Time { return t.lastActivityTime }
+func (t *resourceType) UniqueVersionHistory() bool  { return t.uniqueVersionHistory }
+func (t *resourceType) Defaults() atc.Source        { return t.defaults }
+func (t *resourceType) FromTeam() bool              { return t.fromTeam }
 
 func (t *resourceType) Version() atc.Version { return t.version }
 
+func (t *resourceType) RecordSecretLookups(lookups []creds.SecretLookup) error {
+	if len(lookups) == 0 {
+		return nil
+	}
+
+	return recordSecretLookups(t.conn, t.pipelineID, SecretLookupUsedByResourceType, t.name, lookups)
+}
+
 func (t *resourceType) Reload() (bool, error) {
 	row := resourceTypesQuery.Where(sq.Eq{"r.id": t.id}).RunWith(t.conn).QueryRow()
 
@@ -213,13 +248,18 @@ func scanResourceType(t *resourceType, row scannable) error {
 	var (
 		configJSON                            []byte
 		checkErr, rcsCheckErr, version, nonce sql.NullString
+		checkFailures                         sql.NullInt64
+		lastActivityTime                      pq.NullTime
 	)
 
-	err := row.Scan(&t.id, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &rcsCheckErr)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &rcsCheckErr, &checkFailures, &lastActivityTime, &t.fromTeam)
 	if err != nil {
 		return err
 	}
 
+	t.checkFailures = int(checkFailures.Int64)
+	t.lastActivityTime = lastActivityTime.Time
+
 	if version.Valid {
 		err = json.Unmarshal([]byte(version.String), &t.version)
 		if err != nil {
@@ -251,6 +291,7 @@ func scanResourceType(t *resourceType, row scannable) error {
 	t.tags = config.Tags
 	t.checkEvery = config.CheckEvery
 	t.uniqueVersionHistory = config.UniqueVersionHistory
+	t.defaults = config.Defaults
 
 	if checkErr.Valid {
 		t.checkSetupError = errors.New(checkErr.String)
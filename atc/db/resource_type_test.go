@@ -49,8 +49,8 @@ var _ = Describe("ResourceType", func() {
 				},
 			},
 			0,
-			db.PipelineUnpaused,
-		)
+			db.PipelineUnpaused, "")
+
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 	})
@@ -119,8 +119,8 @@ var _ = Describe("ResourceType", func() {
 						},
 					},
 					pipeline.ConfigVersion(),
-					db.PipelineUnpaused,
-				)
+					db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
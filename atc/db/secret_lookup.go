@@ -0,0 +1,59 @@
+package db
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+const (
+	SecretLookupUsedByJob          = "job"
+	SecretLookupUsedByResource     = "resource"
+	SecretLookupUsedByResourceType = "resource_type"
+)
+
+// SecretLookup is the most recent lookup of a var made on behalf of a job,
+// resource or resource type in a pipeline.
+type SecretLookup struct {
+	UsedByType string
+	UsedByName string
+
+	Name    string
+	Backend string
+	Path    string
+	Found   bool
+
+	LastLookedUp time.Time
+}
+
+func recordSecretLookups(conn Conn, pipelineID int, usedByType string, usedByName string, lookups []creds.SecretLookup) error {
+	// a var can only be upserted once per statement, so only its last lookup
+	// is kept
+	latest := map[string]int{}
+	for i, lookup := range lookups {
+		latest[lookup.Name] = i
+	}
+
+	insert := psql.Insert("pipeline_secret_lookups").
+		Columns("pipeline_id", "used_by_type", "used_by_name", "name", "backend", "path", "found")
+
+	for i, lookup := range lookups {
+		if latest[lookup.Name] != i {
+			continue
+		}
+
+		insert = insert.Values(pipelineID, usedByType, usedByName, lookup.Name, lookup.Backend, lookup.Path, lookup.Found)
+	}
+
+	_, err := insert.
+		Suffix(`
+			ON CONFLICT (pipeline_id, used_by_type, used_by_name, name) DO UPDATE SET
+				backend = EXCLUDED.backend,
+				path = EXCLUDED.path,
+				found = EXCLUDED.found,
+				last_looked_up = now()
+		`).
+		RunWith(conn).
+		Exec()
+	return err
+}
@@ -0,0 +1,119 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Secret lookups", func() {
+	lookupFields := func(usedByType string, usedByName string, name string, backend string, path string, found bool) Fields {
+		return Fields{
+			"UsedByType":   Equal(usedByType),
+			"UsedByName":   Equal(usedByName),
+			"Name":         Equal(name),
+			"Backend":      Equal(backend),
+			"Path":         Equal(path),
+			"Found":        Equal(found),
+			"LastLookedUp": Not(BeZero()),
+		}
+	}
+
+	Describe("(Resource).RecordSecretLookups", func() {
+		It("records every lookup for the resource", func() {
+			err := defaultResource.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Path: "/concourse/default-team/some-var", Found: true},
+				{Name: "other-var", Backend: "vault"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(ConsistOf(
+				MatchAllFields(lookupFields(db.SecretLookupUsedByResource, "some-resource", "other-var", "vault", "", false)),
+				MatchAllFields(lookupFields(db.SecretLookupUsedByResource, "some-resource", "some-var", "vault", "/concourse/default-team/some-var", true)),
+			))
+		})
+
+		It("updates lookups which were already recorded", func() {
+			err := defaultResource.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = defaultResource.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Path: "/concourse/default-team/some-var", Found: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(ConsistOf(
+				MatchAllFields(lookupFields(db.SecretLookupUsedByResource, "some-resource", "some-var", "vault", "/concourse/default-team/some-var", true)),
+			))
+		})
+
+		It("keeps the last of several lookups of the same var", func() {
+			err := defaultResource.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Path: "/concourse/default-team/default-pipeline/some-var"},
+				{Name: "some-var", Backend: "vault", Path: "/concourse/default-team/some-var", Found: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(ConsistOf(
+				MatchAllFields(lookupFields(db.SecretLookupUsedByResource, "some-resource", "some-var", "vault", "/concourse/default-team/some-var", true)),
+			))
+		})
+	})
+
+	Describe("(ResourceType).RecordSecretLookups", func() {
+		It("records the lookups for the resource type", func() {
+			err := defaultResourceType.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Found: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(ConsistOf(
+				MatchAllFields(lookupFields(db.SecretLookupUsedByResourceType, "some-type", "some-var", "vault", "", true)),
+			))
+		})
+	})
+
+	Describe("(Build).RecordSecretLookups", func() {
+		It("records the lookups for the build's job", func() {
+			build, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Found: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(ConsistOf(
+				MatchAllFields(lookupFields(db.SecretLookupUsedByJob, "some-job", "some-var", "vault", "", true)),
+			))
+		})
+
+		It("does not record lookups made by one-off builds", func() {
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.RecordSecretLookups([]creds.SecretLookup{
+				{Name: "some-var", Backend: "vault", Found: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			lookups, err := defaultPipeline.SecretLookups()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(BeEmpty())
+		})
+	})
+})
@@ -35,14 +35,29 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
+		author string,
+	) (Pipeline, bool, error)
+	SavePipelineInstance(
+		pipelineRef atc.PipelineRef,
+		config atc.Config,
+		from ConfigVersion,
+		pausedState PipelinePausedState,
+		author string,
 	) (Pipeline, bool, error)
 
 	Pipeline(pipelineName string) (Pipeline, bool, error)
+	PipelineInstance(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	VisiblePipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
 
+	PausePipelines(pipelineIDs []int) ([]int, error)
+	UnpausePipelines(pipelineIDs []int) ([]int, error)
+	ExposePipelines(pipelineIDs []int) ([]int, error)
+	HidePipelines(pipelineIDs []int) ([]int, error)
+	DestroyPipelines(pipelineIDs []int) ([]int, error)
+
 	CreateOneOffBuild() (Build, error)
 	CreateStartedBuild(plan atc.Plan) (Build, error)
 
@@ -66,6 +81,12 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	ResourceTypes() (atc.ResourceTypes, error)
+	SetResourceTypes(atc.ResourceTypes) error
+
+	RecordWebhookDelivery(WebhookDelivery) error
+	WebhookDeliveries(limit int) ([]WebhookDelivery, error)
 }
 
 type team struct {
@@ -345,12 +366,42 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
+	author string,
 ) (Pipeline, bool, error) {
+	return t.SavePipelineInstance(atc.PipelineRef{Name: pipelineName}, config, from, pausedState, author)
+}
+
+// SavePipelineInstance saves the pipeline with the name and instance vars,
+// creating it as a new instance if there isn't one with the vars yet.
+func (t *team) SavePipelineInstance(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	pausedState PipelinePausedState,
+	author string,
+) (Pipeline, bool, error) {
+	pipelineName := pipelineRef.Name
+
+	instanceVars, err := instanceVarsValue(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	groupsPayload, err := json.Marshal(config.Groups)
 	if err != nil {
 		return nil, false, err
 	}
 
+	labels := config.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labelsPayload, err := json.Marshal(labels)
+	if err != nil {
+		return nil, false, err
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -368,17 +419,22 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	err = tx.QueryRow(`
-		SELECT COUNT(1)
-		FROM pipelines
-		WHERE name = $1
-	  AND team_id = $2
-	`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("COUNT(1)").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":    pipelineName,
+			"team_id": t.id,
+		}).
+		Where(instanceVarsEq("instance_vars", instanceVars)).
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
 
 	var pipelineID int
+	var version ConfigVersion
 	if existingConfig == 0 {
 		if pausedState == PipelineNoChange {
 			pausedState = PipelinePaused
@@ -386,37 +442,62 @@ func (t *team) SavePipeline(
 
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":          pipelineName,
+				"instance_vars": instanceVars,
+				"groups":        groupsPayload,
+				"labels":        labelsPayload,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"ordering":      sq.Expr("currval('pipelines_id_seq')"),
+				"paused":        pausedState.Bool(),
+				"team_id":       t.id,
 			}).
-			Suffix("RETURNING id").
+			Suffix("RETURNING id, version").
 			RunWith(tx).
-			QueryRow().Scan(&pipelineID)
+			QueryRow().Scan(&pipelineID, &version)
 		if err != nil {
 			return nil, false, err
 		}
 
 		created = true
 	} else {
+		err = psql.Select("id", "version").
+			From("pipelines").
+			Where(sq.Eq{
+				"name":    pipelineName,
+				"team_id": t.id,
+			}).
+			Where(instanceVarsEq("instance_vars", instanceVars)).
+			RunWith(tx).
+			QueryRow().
+			Scan(&pipelineID, &version)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if version == from {
+			err = recordCurrentConfigVersion(tx, t.conn, t.lockFactory, pipelineID, version)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
+			Set("labels", labelsPayload).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Where(sq.Eq{
 				"name":    pipelineName,
 				"version": from,
 				"team_id": t.id,
 			}).
-			Suffix("RETURNING id")
+			Where(instanceVarsEq("instance_vars", instanceVars)).
+			Suffix("RETURNING id, version")
 
 		if pausedState != PipelineNoChange {
 			update = update.Set("paused", pausedState.Bool())
 		}
 
-		err = update.RunWith(tx).QueryRow().Scan(&pipelineID)
+		err = update.RunWith(tx).QueryRow().Scan(&pipelineID, &version)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, false, ErrConfigComparisonFailed
@@ -483,12 +564,22 @@ func (t *team) SavePipeline(
 	}
 
 	for _, resourceType := range config.ResourceTypes {
-		err = t.saveResourceType(tx, resourceType, pipelineID)
+		err = t.saveResourceType(tx, resourceType, pipelineID, false)
 		if err != nil {
 			return nil, false, err
 		}
 	}
 
+	teamResourceTypes, err := t.resourceTypes(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = t.saveTeamResourceTypes(tx, pipelineID, config, teamResourceTypes)
+	if err != nil {
+		return nil, false, err
+	}
+
 	for _, job := range config.Jobs {
 		err = t.saveJob(tx, job, pipelineID, jobGroups[job.Name])
 		if err != nil {
@@ -508,6 +599,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = savePipelineConfigVersion(tx, t.conn.EncryptionStrategy(), pipelineID, version, config, author)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
 	err = scanPipeline(
@@ -530,15 +626,27 @@ func (t *team) SavePipeline(
 }
 
 func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+	return t.PipelineInstance(atc.PipelineRef{Name: pipelineName})
+}
+
+// PipelineInstance finds the pipeline with the name and instance vars, which
+// is the pipeline that isn't instanced when there are no vars.
+func (t *team) PipelineInstance(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVars, err := instanceVarsValue(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVarsEq("p.instance_vars", instanceVars)).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -557,7 +665,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy(pipelineInstancesOrdering, "ordering").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -578,7 +686,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", pipelineInstancesOrdering, "ordering ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -596,7 +704,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
 		Where(sq.Eq{"team_id": t.id}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", pipelineInstancesOrdering, "ordering ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -611,7 +719,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"team_id": t.id}).
 		Where(sq.Eq{"public": true}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", pipelineInstancesOrdering, "ordering ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -658,6 +766,130 @@ func (t *team) OrderPipelines(pipelineNames []string) error {
 	return tx.Commit()
 }
 
+// PausePipelines pauses each of the given pipelines in one transaction,
+// returning the IDs which did not match any of the team's pipelines.
+func (t *team) PausePipelines(pipelineIDs []int) ([]int, error) {
+	return t.updatePipelines(pipelineIDs, func(tx Tx, pipelineID int) error {
+		_, err := psql.Update("pipelines").
+			Set("paused", true).
+			Where(sq.Eq{"id": pipelineID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Update("resources").
+			Set("resource_config_id", nil).
+			Set("resource_config_scope_id", nil).
+			Where(sq.Eq{"pipeline_id": pipelineID}).
+			RunWith(tx).
+			Exec()
+
+		return err
+	})
+}
+
+func (t *team) UnpausePipelines(pipelineIDs []int) ([]int, error) {
+	return t.updatePipelines(pipelineIDs, setPipelineColumn("paused", false))
+}
+
+func (t *team) ExposePipelines(pipelineIDs []int) ([]int, error) {
+	return t.updatePipelines(pipelineIDs, setPipelineColumn("public", true))
+}
+
+func (t *team) HidePipelines(pipelineIDs []int) ([]int, error) {
+	return t.updatePipelines(pipelineIDs, setPipelineColumn("public", false))
+}
+
+func (t *team) DestroyPipelines(pipelineIDs []int) ([]int, error) {
+	return t.updatePipelines(pipelineIDs, func(tx Tx, pipelineID int) error {
+		_, err := psql.Delete("pipelines").
+			Where(sq.Eq{"id": pipelineID}).
+			RunWith(tx).
+			Exec()
+
+		return err
+	})
+}
+
+func setPipelineColumn(column string, value bool) func(Tx, int) error {
+	return func(tx Tx, pipelineID int) error {
+		_, err := psql.Update("pipelines").
+			Set(column, value).
+			Where(sq.Eq{"id": pipelineID}).
+			RunWith(tx).
+			Exec()
+
+		return err
+	}
+}
+
+// updatePipelines applies the update to each of the given pipelines in one
+// transaction, so that either all of them are changed or none are. Each ID
+// refers to exactly one pipeline, so selecting one instance of a pipeline
+// leaves the other instances with the same name alone.
+func (t *team) updatePipelines(pipelineIDs []int, update func(Tx, int) error) ([]int, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := psql.Select("id").
+		From("pipelines").
+		Where(sq.Eq{
+			"id":      pipelineIDs,
+			"team_id": t.id,
+		}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[int]bool{}
+	foundIDs := []int{}
+	for rows.Next() {
+		var pipelineID int
+		err := rows.Scan(&pipelineID)
+		if err != nil {
+			Close(rows)
+			return nil, err
+		}
+
+		foundIDs = append(foundIDs, pipelineID)
+		found[pipelineID] = true
+	}
+
+	Close(rows)
+
+	for _, pipelineID := range foundIDs {
+		err = update(tx, pipelineID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	notFound := []int{}
+	for _, pipelineID := range pipelineIDs {
+		if !found[pipelineID] {
+			notFound = append(notFound, pipelineID)
+			found[pipelineID] = true
+		}
+	}
+
+	return notFound, nil
+}
+
 func (t *team) CreateOneOffBuild() (Build, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -803,6 +1035,135 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+// ResourceTypes returns the resource types defined for every pipeline of the
+// team.
+func (t *team) ResourceTypes() (atc.ResourceTypes, error) {
+	return t.resourceTypes(t.conn)
+}
+
+func (t *team) resourceTypes(runner sq.BaseRunner) (atc.ResourceTypes, error) {
+	var (
+		payload, nonce sql.NullString
+	)
+
+	err := psql.Select("resource_types", "resource_types_nonce").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&payload, &nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes := atc.ResourceTypes{}
+	if !payload.Valid {
+		return resourceTypes, nil
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := t.conn.EncryptionStrategy().Decrypt(payload.String, noncense)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(decrypted, &resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceTypes, nil
+}
+
+// SetResourceTypes replaces the team's resource types, updating the resource
+// types of each pipeline which uses them without declaring its own.
+func (t *team) SetResourceTypes(resourceTypes atc.ResourceTypes) error {
+	payload, err := json.Marshal(resourceTypes)
+	if err != nil {
+		return err
+	}
+
+	encrypted, nonce, err := t.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	pipelines, err := t.Pipelines()
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("teams").
+		Set("resource_types", encrypted).
+		Set("resource_types_nonce", nonce).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, pipeline := range pipelines {
+		resources, err := pipeline.Resources()
+		if err != nil {
+			return err
+		}
+
+		pipelineResourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			return err
+		}
+
+		config := atc.Config{
+			Resources:     resources.Configs(),
+			ResourceTypes: pipelineResourceTypes.Configs(),
+		}
+
+		err = t.saveTeamResourceTypes(tx, pipeline.ID(), config, resourceTypes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// saveTeamResourceTypes saves the team's resource types which the pipeline
+// uses without declaring them itself, replacing those it used before.
+func (t *team) saveTeamResourceTypes(tx Tx, pipelineID int, config atc.Config, teamResourceTypes atc.ResourceTypes) error {
+	_, err := psql.Update("resource_types").
+		Set("active", false).
+		Where(sq.Eq{
+			"pipeline_id": pipelineID,
+			"from_team":   true,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, resourceType := range config.TeamResourceTypes(teamResourceTypes) {
+		err = t.saveResourceType(tx, resourceType, pipelineID, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -829,7 +1190,7 @@ func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, res
 
 	versionedResourceTypes := pipelineResourceTypes.Deserialize()
 
-	source, err := creds.NewSource(variables, resource.Source()).Evaluate()
+	source, err := creds.NewSource(variables, versionedResourceTypes.SourceWithDefaults(resource.Type(), resource.Source())).Evaluate()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -909,11 +1270,27 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return err
 	}
 
+	var cronTriggers interface{}
+	if len(job.Triggers) > 0 {
+		cronTriggers, err = json.Marshal(job.Triggers)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the cron triggers are only evaluated from when they were set, so that
+	// adding or changing one doesn't fire it for times which have already
+	// passed
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE jobs
-		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6
+		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6,
+			cron_triggered_at = CASE
+				WHEN cron_triggers IS DISTINCT FROM $7 OR NOT active THEN now()
+				ELSE cron_triggered_at
+			END,
+			cron_triggers = $7
 		WHERE name = $1 AND pipeline_id = $2
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups))
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups), cronTriggers)
 	if err != nil {
 		return err
 	}
@@ -923,9 +1300,9 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 	}
 
 	_, err = tx.Exec(`
-		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags)
-		VALUES ($1, $2, $3, $4, true, $5, $6)
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups))
+		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags, cron_triggers)
+		VALUES ($1, $2, $3, $4, true, $5, $6, $7)
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups), cronTriggers)
 
 	return swallowUniqueViolation(err)
 }
@@ -994,7 +1371,7 @@ func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int)
 	return swallowUniqueViolation(err)
 }
 
-func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID int) error {
+func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID int, fromTeam bool) error {
 	configPayload, err := json.Marshal(resourceType)
 	if err != nil {
 		return err
@@ -1008,9 +1385,9 @@ func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID
 
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE resource_types
-		SET config = $3, type = $4, active = true, nonce = $5
+		SET config = $3, type = $4, active = true, nonce = $5, from_team = $6
 		WHERE name = $1 AND pipeline_id = $2
-	`, resourceType.Name, pipelineID, encryptedPayload, resourceType.Type, nonce)
+	`, resourceType.Name, pipelineID, encryptedPayload, resourceType.Type, nonce, fromTeam)
 	if err != nil {
 		return err
 	}
@@ -1020,9 +1397,9 @@ func (t *team) saveResourceType(tx Tx, resourceType atc.ResourceType, pipelineID
 	}
 
 	_, err = tx.Exec(`
-		INSERT INTO resource_types (name, type, pipeline_id, config, active, nonce)
-		VALUES ($1, $2, $3, $4, true, $5)
-	`, resourceType.Name, resourceType.Type, pipelineID, encryptedPayload, nonce)
+		INSERT INTO resource_types (name, type, pipeline_id, config, active, nonce, from_team)
+		VALUES ($1, $2, $3, $4, true, $5, $6)
+	`, resourceType.Name, resourceType.Type, pipelineID, encryptedPayload, nonce, fromTeam)
 
 	return swallowUniqueViolation(err)
 }
@@ -1081,13 +1458,44 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 	return creating, created, nil
 }
 
+// instanceVarsValue returns the instance vars as stored, which is NULL for a
+// pipeline that isn't instanced.
+func instanceVarsValue(instanceVars atc.InstanceVars) (interface{}, error) {
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(instanceVars)
+}
+
+func instanceVarsEq(column string, value interface{}) sq.Sqlizer {
+	if value == nil {
+		return sq.Eq{column: nil}
+	}
+
+	return sq.Expr(column+" = ?", value)
+}
+
 func scanPipeline(p *pipeline, scan scannable) error {
 	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var labels, instanceVars []byte
+	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &labels, &instanceVars)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(labels, &p.labels)
 	if err != nil {
 		return err
 	}
 
+	if instanceVars != nil {
+		err = json.Unmarshal(instanceVars, &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
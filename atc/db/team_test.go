@@ -46,7 +46,8 @@ var _ = Describe("Team", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), db.PipelineUnpaused)
+			}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 			Expect(err).ToNot(HaveOccurred())
 
 			err = otherTeam.Delete()
@@ -542,7 +543,8 @@ var _ = Describe("Team", func() {
 								},
 							},
 						},
-					}, db.ConfigVersion(0), db.PipelineUnpaused)
+					}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 					Expect(err).NotTo(HaveOccurred())
 
 					otherResource, found, err := otherPipeline.Resource("some-resource")
@@ -847,6 +849,102 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("ResourceTypes", func() {
+		It("returns no resource types by default", func() {
+			resourceTypes, err := team.ResourceTypes()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resourceTypes).To(BeEmpty())
+		})
+
+		Context("when the team's resource types are set", func() {
+			var resourceTypes atc.ResourceTypes
+
+			BeforeEach(func() {
+				resourceTypes = atc.ResourceTypes{
+					{
+						Name:     "some-type",
+						Type:     "registry-image",
+						Source:   atc.Source{"repository": "some-repository"},
+						Defaults: atc.Source{"some": "default"},
+					},
+				}
+
+				err := team.SetResourceTypes(resourceTypes)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns them", func() {
+				Expect(team.ResourceTypes()).To(Equal(resourceTypes))
+			})
+
+			It("replaces them when set again", func() {
+				err := team.SetResourceTypes(atc.ResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.ResourceTypes()).To(BeEmpty())
+			})
+
+			Context("when a pipeline uses them", func() {
+				var pipeline db.Pipeline
+
+				BeforeEach(func() {
+					var err error
+					pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+						Resources: atc.ResourceConfigs{
+							{Name: "some-resource", Type: "some-type"},
+						},
+					}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("gives the pipeline the team's resource types", func() {
+					resourceTypes, err := pipeline.ResourceTypes()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resourceTypes).To(HaveLen(1))
+					Expect(resourceTypes[0].Name()).To(Equal("some-type"))
+					Expect(resourceTypes[0].FromTeam()).To(BeTrue())
+					Expect(resourceTypes[0].Defaults()).To(Equal(atc.Source{"some": "default"}))
+				})
+
+				It("does not include them in the pipeline's config", func() {
+					config, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(config.ResourceTypes).To(BeEmpty())
+
+					resourceTypes, err := pipeline.ResourceTypes()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resourceTypes.Configs()).To(BeEmpty())
+				})
+
+				It("updates the pipeline's resource types when they're set again", func() {
+					err := team.SetResourceTypes(atc.ResourceTypes{
+						{
+							Name:   "some-type",
+							Type:   "registry-image",
+							Source: atc.Source{"repository": "other-repository"},
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					resourceTypes, err := pipeline.ResourceTypes()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resourceTypes).To(HaveLen(1))
+					Expect(resourceTypes[0].Source()).To(Equal(atc.Source{"repository": "other-repository"}))
+				})
+
+				It("removes them from the pipeline when they're unset", func() {
+					err := team.SetResourceTypes(atc.ResourceTypes{})
+					Expect(err).ToNot(HaveOccurred())
+
+					resourceTypes, err := pipeline.ResourceTypes()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resourceTypes).To(BeEmpty())
+				})
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -867,14 +965,16 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -908,14 +1008,16 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -957,14 +1059,16 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = otherTeam.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), db.PipelineUnpaused)
+				}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pipeline2.Expose()).To(Succeed())
@@ -982,7 +1086,8 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-fake-again"},
 						},
-					}, db.ConfigVersion(1), db.PipelineUnpaused)
+					}, db.ConfigVersion(1), db.PipelineUnpaused, "")
+
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1007,14 +1112,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused)
+			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused)
+			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused)
+			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1047,6 +1152,89 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("bulk pipeline updates", func() {
+		var pipeline1 db.Pipeline
+		var pipeline2 db.Pipeline
+		var otherPipeline db.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, db.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			otherPipeline, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, db.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("PausePipelines", func() {
+			It("pauses the given pipelines of the team and reports the IDs it did not find", func() {
+				notFound, err := team.PausePipelines([]int{pipeline1.ID(), pipeline2.ID(), otherPipeline.ID()})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(notFound).To(Equal([]int{otherPipeline.ID()}))
+
+				Expect(pipeline1.Reload()).To(BeTrue())
+				Expect(pipeline1.Paused()).To(BeTrue())
+				Expect(pipeline2.Reload()).To(BeTrue())
+				Expect(pipeline2.Paused()).To(BeTrue())
+
+				Expect(otherPipeline.Reload()).To(BeTrue())
+				Expect(otherPipeline.Paused()).To(BeFalse())
+			})
+		})
+
+		Describe("UnpausePipelines", func() {
+			BeforeEach(func() {
+				Expect(pipeline1.Pause()).To(Succeed())
+				Expect(pipeline2.Pause()).To(Succeed())
+			})
+
+			It("unpauses the given pipelines", func() {
+				notFound, err := team.UnpausePipelines([]int{pipeline1.ID()})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(notFound).To(BeEmpty())
+
+				Expect(pipeline1.Reload()).To(BeTrue())
+				Expect(pipeline1.Paused()).To(BeFalse())
+				Expect(pipeline2.Reload()).To(BeTrue())
+				Expect(pipeline2.Paused()).To(BeTrue())
+			})
+		})
+
+		Describe("ExposePipelines and HidePipelines", func() {
+			It("changes the visibility of the given pipelines", func() {
+				_, err := team.ExposePipelines([]int{pipeline1.ID(), pipeline2.ID()})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pipeline1.Reload()).To(BeTrue())
+				Expect(pipeline1.Public()).To(BeTrue())
+
+				_, err = team.HidePipelines([]int{pipeline1.ID()})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pipeline1.Reload()).To(BeTrue())
+				Expect(pipeline1.Public()).To(BeFalse())
+				Expect(pipeline2.Reload()).To(BeTrue())
+				Expect(pipeline2.Public()).To(BeTrue())
+			})
+		})
+
+		Describe("DestroyPipelines", func() {
+			It("destroys the given pipelines of the team", func() {
+				notFound, err := team.DestroyPipelines([]int{pipeline1.ID(), pipeline2.ID()})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(notFound).To(BeEmpty())
+
+				pipelines, err := team.Pipelines()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pipelines).To(BeEmpty())
+
+				Expect(otherPipeline.Reload()).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("CreateOneOffBuild", func() {
 		var (
 			oneOffBuild db.Build
@@ -1171,7 +1359,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1322,7 +1510,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1428,7 +1616,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1680,13 +1868,13 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, created, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1696,7 +1884,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1707,7 +1895,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1718,7 +1906,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("defaults to paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -1729,7 +1917,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1742,14 +1930,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1762,7 +1950,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("clears out api pinned version when resaving a pinned version on the pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1793,7 +1981,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			err = resource.PinVersion(rcv.ID())
+			err = resource.PinVersion(rcv.ID(), "some-user", time.Time{})
 			Expect(err).ToNot(HaveOccurred())
 
 			reloaded, err := resource.Reload()
@@ -1805,7 +1993,7 @@ var _ = Describe("Team", func() {
 				"version": "v2",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1816,7 +2004,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("does not clear the api pinned version when resaving pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1847,7 +2035,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			err = resource.PinVersion(rcv.ID())
+			err = resource.PinVersion(rcv.ID(), "some-user", time.Time{})
 			Expect(err).ToNot(HaveOccurred())
 
 			reloaded, err := resource.Reload()
@@ -1855,7 +2043,7 @@ var _ = Describe("Team", func() {
 			Expect(reloaded).To(BeTrue())
 			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1865,12 +2053,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-resource")
@@ -1879,7 +2067,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1892,14 +2080,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1912,12 +2100,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1926,7 +2114,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -1936,12 +2124,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1951,12 +2139,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -1965,7 +2153,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes worker task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1981,7 +2169,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = workerTaskCacheFactory.Find(job.ID(), "some-task", "some-path", defaultWorker.Name())
@@ -1990,7 +2178,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes worker task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2016,7 +2204,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = workerTaskCacheFactory.Find(job.ID(), "some-task", "some-path", defaultWorker.Name())
@@ -2025,7 +2213,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2056,7 +2244,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2067,7 +2255,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange)
+			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2087,7 +2275,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), db.PipelineNoChange)
+			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2098,16 +2286,16 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
 
 		It("updating from paused to unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -2115,7 +2303,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Paused()).To(BeTrue())
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err = team.Pipeline(pipelineName)
@@ -2125,7 +2313,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updating from unpaused to paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -2133,7 +2321,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Paused()).To(BeFalse())
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelinePaused)
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelinePaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err = team.Pipeline(pipelineName)
@@ -2144,7 +2332,7 @@ var _ = Describe("Team", func() {
 
 		Context("updating with no change", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused)
+				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelinePaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineName)
@@ -2152,7 +2340,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineName)
@@ -2162,7 +2350,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+				_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineName)
@@ -2170,7 +2358,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineName)
@@ -2180,13 +2368,177 @@ var _ = Describe("Team", func() {
 			})
 		})
 
+		It("saves and updates the pipeline's labels", func() {
+			config.Labels = map[string]string{"env": "prod", "tier": "web"}
+
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, found, err := team.Pipeline(pipelineName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.Labels()).To(Equal(map[string]string{"env": "prod", "tier": "web"}))
+
+			config.Labels = nil
+
+			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), db.PipelineNoChange, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, found, err = team.Pipeline(pipelineName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.Labels()).To(BeEmpty())
+		})
+
+		Context("when saving instances of the pipeline", func() {
+			var (
+				releaseOne atc.PipelineRef
+				releaseTwo atc.PipelineRef
+			)
+
+			BeforeEach(func() {
+				releaseOne = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "1.2"}}
+				releaseTwo = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "1.3"}}
+
+				_, created, err := team.SavePipelineInstance(releaseOne, config, 0, db.PipelineUnpaused, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				_, created, err = team.SavePipelineInstance(releaseTwo, otherConfig, 0, db.PipelineUnpaused, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("creates a separate pipeline for each set of instance vars", func() {
+				pipelineOne, found, err := team.PipelineInstance(releaseOne)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipelineOne.Name()).To(Equal(pipelineName))
+				Expect(pipelineOne.InstanceVars()).To(Equal(atc.InstanceVars{"branch": "1.2"}))
+
+				pipelineTwo, found, err := team.PipelineInstance(releaseTwo)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipelineTwo.ID()).ToNot(Equal(pipelineOne.ID()))
+				Expect(pipelineTwo.InstanceVars()).To(Equal(atc.InstanceVars{"branch": "1.3"}))
+			})
+
+			It("does not find an instance by its name alone", func() {
+				_, found, err := team.Pipeline(pipelineName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("updates an existing instance rather than creating another", func() {
+				pipeline, found, err := team.PipelineInstance(releaseOne)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				savedPipeline, created, err := team.SavePipelineInstance(releaseOne, otherConfig, pipeline.ConfigVersion(), db.PipelineNoChange, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(savedPipeline.ID()).To(Equal(pipeline.ID()))
+			})
+
+			Context("when only one of the instances is labelled", func() {
+				var labelled, unlabelled db.Pipeline
+
+				BeforeEach(func() {
+					var found bool
+					var err error
+					labelled, found, err = team.PipelineInstance(releaseOne)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					labelledConfig := config
+					labelledConfig.Labels = map[string]string{"env": "prod"}
+
+					_, _, err = team.SavePipelineInstance(releaseOne, labelledConfig, labelled.ConfigVersion(), db.PipelineNoChange, "")
+					Expect(err).ToNot(HaveOccurred())
+
+					unlabelled, found, err = team.PipelineInstance(releaseTwo)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				selectedIDs := func() []int {
+					selectors, err := atc.ParseLabelSelectors([]string{"env=prod"})
+					Expect(err).ToNot(HaveOccurred())
+
+					pipelines, err := team.Pipelines()
+					Expect(err).ToNot(HaveOccurred())
+
+					ids := []int{}
+					for _, pipeline := range pipelines {
+						if atc.MatchesLabels(pipeline.Labels(), selectors) {
+							ids = append(ids, pipeline.ID())
+						}
+					}
+
+					return ids
+				}
+
+				It("pauses only the labelled instance", func() {
+					Expect(selectedIDs()).To(Equal([]int{labelled.ID()}))
+
+					notFound, err := team.PausePipelines(selectedIDs())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(notFound).To(BeEmpty())
+
+					Expect(labelled.Reload()).To(BeTrue())
+					Expect(labelled.Paused()).To(BeTrue())
+					Expect(unlabelled.Reload()).To(BeTrue())
+					Expect(unlabelled.Paused()).To(BeFalse())
+				})
+
+				It("destroys only the labelled instance", func() {
+					notFound, err := team.DestroyPipelines(selectedIDs())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(notFound).To(BeEmpty())
+
+					_, found, err := team.PipelineInstance(releaseOne)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+
+					_, found, err = team.PipelineInstance(releaseTwo)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+
+			It("lists the instances together", func() {
+				_, _, err := team.SavePipeline("another-pipeline", config, 0, db.PipelineUnpaused, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				releaseThree := atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "1.4"}}
+				_, _, err = team.SavePipelineInstance(releaseThree, config, 0, db.PipelineUnpaused, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				pipelines, err := team.Pipelines()
+				Expect(err).ToNot(HaveOccurred())
+
+				refs := []string{}
+				for _, pipeline := range pipelines {
+					refs = append(refs, atc.PipelineRef{Name: pipeline.Name(), InstanceVars: pipeline.InstanceVars()}.String())
+				}
+
+				Expect(refs).To(ContainElement("another-pipeline"))
+				Expect(refs[len(refs)-1]).To(Equal("another-pipeline"))
+				Expect(refs[len(refs)-4 : len(refs)-1]).To(Equal([]string{
+					pipelineName + "/branch:1.2",
+					pipelineName + "/branch:1.3",
+					pipelineName + "/branch:1.4",
+				}))
+			})
+		})
+
 		It("can lookup a pipeline by name", func() {
 			pipelineName := "a-pipeline-name"
 			otherPipelineName := "an-other-pipeline-name"
 
-			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			_, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineName)
@@ -2232,10 +2584,10 @@ var _ = Describe("Team", func() {
 			otherPipelineName := "an-other-pipeline-name"
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused)
+			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -2305,22 +2657,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, db.PipelineUnpaused, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, db.PipelineUnpaused, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, db.PipelineUnpaused, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, db.PipelineUnpaused)
+			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, db.PipelineUnpaused, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -2377,7 +2729,7 @@ var _ = Describe("Team", func() {
 
 			pipelineName := "a-pipeline-name"
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused)
+			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -2395,22 +2747,22 @@ var _ = Describe("Team", func() {
 
 		Context("when there are multiple teams", func() {
 			It("can allow pipelines with the same name across teams", func() {
-				teamPipeline, _, err := team.SavePipeline("steve", config, 0, db.PipelineUnpaused)
+				teamPipeline, _, err := team.SavePipeline("steve", config, 0, db.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, db.PipelineUnpaused)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, db.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelineNoChange)
+				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelineNoChange, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("pausing the correct team's pipeline")
-				_, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelinePaused)
+				_, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), db.PipelinePaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pausedPipeline, found, err := team.Pipeline("steve")
@@ -2425,10 +2777,10 @@ var _ = Describe("Team", func() {
 				Expect(unpausedPipeline.Paused()).To(BeFalse())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange)
+				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelineNoChange, "")
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelinePaused)
+				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), db.PipelinePaused, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -2506,7 +2858,8 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), db.PipelineUnpaused)
+							}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// webhookDeliveriesToKeep is the number of deliveries kept per team; older
+// ones are removed as new ones are recorded.
+const webhookDeliveriesToKeep = 100
+
+// WebhookDelivery is a payload received by a team's webhook endpoint, along
+// with the resources it caused to be checked.
+type WebhookDelivery struct {
+	ID int
+
+	Provider   string
+	Event      string
+	DeliveryID string
+
+	Matched []WebhookMatch
+	Error   string
+
+	ReceivedAt time.Time
+}
+
+type WebhookMatch struct {
+	PipelineName string `json:"pipeline_name"`
+	ResourceName string `json:"resource_name"`
+}
+
+func (t *team) RecordWebhookDelivery(delivery WebhookDelivery) error {
+	matched := delivery.Matched
+	if matched == nil {
+		matched = []WebhookMatch{}
+	}
+
+	matchedJSON, err := json.Marshal(matched)
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("webhook_deliveries").
+		Columns("team_id", "provider", "event", "delivery_id", "matched", "error").
+		Values(t.id, delivery.Provider, delivery.Event, delivery.DeliveryID, matchedJSON, delivery.Error).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM webhook_deliveries
+		WHERE team_id = $1
+		AND id NOT IN (
+			SELECT id FROM webhook_deliveries
+			WHERE team_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)
+	`, t.id, webhookDeliveriesToKeep)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) WebhookDeliveries(limit int) ([]WebhookDelivery, error) {
+	query := psql.Select("id", "provider", "event", "delivery_id", "matched", "error", "received_at").
+		From("webhook_deliveries").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("id DESC")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		var (
+			delivery    WebhookDelivery
+			matchedJSON []byte
+		)
+
+		err := rows.Scan(&delivery.ID, &delivery.Provider, &delivery.Event, &delivery.DeliveryID, &matchedJSON, &delivery.Error, &delivery.ReceivedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(matchedJSON, &delivery.Matched)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
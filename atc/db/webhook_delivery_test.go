@@ -0,0 +1,115 @@
+package db_test
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookDelivery", func() {
+	var team db.Team
+
+	BeforeEach(func() {
+		var err error
+		team, err = teamFactory.CreateTeam(atc.Team{Name: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	deliveryIDs := func(deliveries []db.WebhookDelivery) []string {
+		ids := []string{}
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.DeliveryID)
+		}
+		return ids
+	}
+
+	Describe("RecordWebhookDelivery", func() {
+		It("records the delivery along with the resources it matched", func() {
+			err := team.RecordWebhookDelivery(db.WebhookDelivery{
+				Provider:   "github",
+				Event:      "push",
+				DeliveryID: "some-delivery",
+				Matched: []db.WebhookMatch{
+					{PipelineName: "some-pipeline", ResourceName: "some-resource"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, err := team.WebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+
+			delivery := deliveries[0]
+			Expect(delivery.ID).ToNot(BeZero())
+			Expect(delivery.Provider).To(Equal("github"))
+			Expect(delivery.Event).To(Equal("push"))
+			Expect(delivery.DeliveryID).To(Equal("some-delivery"))
+			Expect(delivery.Matched).To(Equal([]db.WebhookMatch{
+				{PipelineName: "some-pipeline", ResourceName: "some-resource"},
+			}))
+			Expect(delivery.Error).To(BeEmpty())
+			Expect(delivery.ReceivedAt).ToNot(BeZero())
+		})
+
+		It("records deliveries which matched nothing, along with their error", func() {
+			err := team.RecordWebhookDelivery(db.WebhookDelivery{
+				Provider: "github",
+				Error:    "malformed payload",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, err := team.WebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Matched).To(BeEmpty())
+			Expect(deliveries[0].Error).To(Equal("malformed payload"))
+		})
+
+		It("keeps only the team's 100 most recent deliveries", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherTeam.RecordWebhookDelivery(db.WebhookDelivery{Provider: "github", DeliveryID: "other-team-delivery"})
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 101; i++ {
+				err := team.RecordWebhookDelivery(db.WebhookDelivery{Provider: "github", DeliveryID: strconv.Itoa(i)})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			deliveries, err := team.WebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(100))
+			Expect(deliveries[0].DeliveryID).To(Equal("100"))
+			Expect(deliveries[99].DeliveryID).To(Equal("1"))
+
+			otherDeliveries, err := otherTeam.WebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveryIDs(otherDeliveries)).To(Equal([]string{"other-team-delivery"}))
+		})
+	})
+
+	Describe("WebhookDeliveries", func() {
+		BeforeEach(func() {
+			for _, id := range []string{"first", "second", "third"} {
+				err := team.RecordWebhookDelivery(db.WebhookDelivery{Provider: "github", DeliveryID: id})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("returns the deliveries, newest first", func() {
+			deliveries, err := team.WebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveryIDs(deliveries)).To(Equal([]string{"third", "second", "first"}))
+		})
+
+		It("returns at most the given number of deliveries", func() {
+			deliveries, err := team.WebhookDeliveries(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveryIDs(deliveries)).To(Equal([]string{"third", "second"}))
+		})
+	})
+})
@@ -582,7 +582,8 @@ var _ = Describe("WorkerFactory", func() {
 								},
 							},
 						},
-					}, db.ConfigVersion(0), db.PipelineUnpaused)
+					}, db.ConfigVersion(0), db.PipelineUnpaused, "")
+
 					Expect(err).NotTo(HaveOccurred())
 
 					taggedWorkerSpec := atc.Worker{
@@ -0,0 +1,45 @@
+package atc
+
+import (
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// DecodeConfig decodes a config from its raw form, e.g. as unmarshalled from
+// YAML or JSON, returning the nested keys which are not part of a config.
+// Unknown top-level keys are left out, as they are allowed.
+func DecodeConfig(raw interface{}) (Config, []string, error) {
+	var config Config
+	var md mapstructure.Metadata
+	msConfig := &mapstructure.DecoderConfig{
+		Metadata:         &md,
+		Result:           &config,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			SanitizeDecodeHook,
+			VersionConfigDecodeHook,
+			InputsConfigDecodeHook,
+			ContainerLimitsDecodeHook,
+		),
+	}
+
+	decoder, err := mapstructure.NewDecoder(msConfig)
+	if err != nil {
+		return Config{}, nil, err
+	}
+
+	err = decoder.Decode(raw)
+	if err != nil {
+		return Config{}, nil, err
+	}
+
+	nestedUnused := []string{}
+	for _, unused := range md.Unused {
+		if strings.Contains(unused, ".") {
+			nestedUnused = append(nestedUnused, unused)
+		}
+	}
+
+	return config, nestedUnused, nil
+}
@@ -99,13 +99,19 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		plan.Attempts,
 	)
 
-	return build.factory.Task(
+	step := build.factory.Task(
 		logger,
 		plan,
 		build.dbBuild,
 		containerMetadata,
 		build.delegate.TaskDelegate(plan.ID),
 	)
+
+	return meteredStep{
+		Step:     step,
+		build:    build.dbBuild,
+		stepType: "task",
+	}
 }
 
 func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -119,7 +125,7 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 		plan.Attempts,
 	)
 
-	return build.factory.Get(
+	step := build.factory.Get(
 		logger,
 		plan,
 		build.dbBuild,
@@ -127,6 +133,12 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 		containerMetadata,
 		build.delegate.GetDelegate(plan.ID),
 	)
+
+	return meteredStep{
+		Step:     step,
+		build:    build.dbBuild,
+		stepType: "get",
+	}
 }
 
 func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -140,7 +152,7 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 		plan.Attempts,
 	)
 
-	return build.factory.Put(
+	step := build.factory.Put(
 		logger,
 		plan,
 		build.dbBuild,
@@ -148,6 +160,12 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 		containerMetadata,
 		build.delegate.PutDelegate(plan.ID),
 	)
+
+	return meteredStep{
+		Step:     step,
+		build:    build.dbBuild,
+		stepType: "put",
+	}
 }
 
 func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata atc.Plan
@@ -21,6 +22,7 @@ const execEngineSchema = "exec.v2"
 type execEngine struct {
 	factory         exec.Factory
 	delegateFactory BuildDelegateFactory
+	secretLookups   exec.BuildSecretLookups
 	externalURL     string
 
 	releaseCh     chan struct{}
@@ -30,11 +32,13 @@ type execEngine struct {
 func NewExecEngine(
 	factory exec.Factory,
 	delegateFactory BuildDelegateFactory,
+	secretLookups exec.BuildSecretLookups,
 	externalURL string,
 ) Engine {
 	return &execEngine{
 		factory:         factory,
 		delegateFactory: delegateFactory,
+		secretLookups:   secretLookups,
 		externalURL:     externalURL,
 
 		releaseCh:     make(chan struct{}),
@@ -54,9 +58,10 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build db.Build, plan
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
-		metadata: execMetadata(plan),
+		factory:       engine.factory,
+		delegate:      engine.delegateFactory.Delegate(build),
+		secretLookups: engine.secretLookups,
+		metadata:      execMetadata(plan),
 
 		ctx:    ctx,
 		cancel: cancel,
@@ -82,9 +87,10 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build db.Build) (Buil
 
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
-		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
-		metadata: metadata,
+		factory:       engine.factory,
+		delegate:      engine.delegateFactory.Delegate(build),
+		secretLookups: engine.secretLookups,
+		metadata:      metadata,
 
 		ctx:    ctx,
 		cancel: cancel,
@@ -114,8 +120,9 @@ type execBuild struct {
 	dbBuild      db.Build
 	stepMetadata StepMetadata
 
-	factory  exec.Factory
-	delegate BuildDelegate
+	factory       exec.Factory
+	delegate      BuildDelegate
+	secretLookups exec.BuildSecretLookups
 
 	ctx    context.Context
 	cancel func()
@@ -143,7 +150,15 @@ func (build *execBuild) Abort(lager.Logger) error {
 func (build *execBuild) Resume(logger lager.Logger) {
 	step := build.buildStep(logger, atc.Plan(build.metadata))
 
-	runCtx := lagerctx.NewContext(build.ctx, logger)
+	runCtx, span := tracing.StartSpan(build.ctx, "build", tracing.Attrs{
+		"team":     build.dbBuild.TeamName(),
+		"pipeline": build.dbBuild.PipelineName(),
+		"job":      build.dbBuild.JobName(),
+		"build":    build.dbBuild.Name(),
+		"build_id": strconv.Itoa(build.dbBuild.ID()),
+	})
+
+	runCtx = lagerctx.NewContext(runCtx, logger)
 
 	state := build.runState()
 	defer build.clearRunState()
@@ -157,9 +172,13 @@ func (build *execBuild) Resume(logger lager.Logger) {
 		select {
 		case <-build.releaseCh:
 			logger.Info("releasing")
+			span.SetAttribute("released", "true")
+			span.End()
 			return
 		case err := <-done:
+			build.secretLookups.Record(logger, build.dbBuild)
 			build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
+			tracing.End(span, err)
 			return
 		}
 	}
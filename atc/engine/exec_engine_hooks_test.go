@@ -40,6 +40,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 		execEngine = engine.NewExecEngine(
 			fakeFactory,
 			fakeDelegateFactory,
+			new(execfakes.FakeBuildSecretLookups),
 			"http://example.com",
 		)
 
@@ -16,6 +16,7 @@ var _ = Describe("ExecEngine", func() {
 	var (
 		fakeFactory         *execfakes.FakeFactory
 		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+		fakeSecretLookups   *execfakes.FakeBuildSecretLookups
 		logger              *lagertest.TestLogger
 
 		execEngine engine.Engine
@@ -29,11 +30,13 @@ var _ = Describe("ExecEngine", func() {
 	BeforeEach(func() {
 		fakeFactory = new(execfakes.FakeFactory)
 		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+		fakeSecretLookups = new(execfakes.FakeBuildSecretLookups)
 		logger = lagertest.NewTestLogger("test")
 
 		execEngine = engine.NewExecEngine(
 			fakeFactory,
 			fakeDelegateFactory,
+			fakeSecretLookups,
 			"http://example.com",
 		)
 	})
@@ -630,6 +633,16 @@ var _ = Describe("ExecEngine", func() {
 					Attempt:      "1",
 				}))
 			})
+
+			It("records the build's secret lookups once it finishes", func() {
+				foundBuild, err := execEngine.LookupBuild(logger, dbBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				foundBuild.Resume(logger)
+				Expect(fakeSecretLookups.RecordCallCount()).To(Equal(1))
+				_, recordedBuild := fakeSecretLookups.RecordArgsForCall(0)
+				Expect(recordedBuild).To(Equal(dbBuild))
+			})
 		})
 
 		Context("when engine metadata is empty", func() {
@@ -41,6 +41,7 @@ var _ = Describe("Exec Engine with Try", func() {
 		execEngine = engine.NewExecEngine(
 			fakeFactory,
 			fakeDelegateFactory,
+			new(execfakes.FakeBuildSecretLookups),
 			"http://example.com",
 		)
 
@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// meteredStep emits the duration and outcome of the step it wraps.
+type meteredStep struct {
+	exec.Step
+
+	build    db.Build
+	stepType string
+}
+
+func (step meteredStep) Run(ctx context.Context, state exec.RunState) error {
+	start := time.Now()
+
+	err := step.Step.Run(ctx, state)
+
+	metric.StepFinished{
+		PipelineName: step.build.PipelineName(),
+		JobName:      step.build.JobName(),
+		TeamName:     step.build.TeamName(),
+		StepType:     step.stepType,
+		Succeeded:    err == nil && step.Step.Succeeded(),
+		Duration:     time.Since(start),
+	}.Emit(lagerctx.FromContext(ctx))
+
+	return err
+}
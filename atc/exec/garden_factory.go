@@ -1,16 +1,12 @@
 package exec
 
 import (
-	"crypto/sha1"
-	"fmt"
-	"path/filepath"
-
 	"code.cloudfoundry.org/lager"
 	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/taskspec"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -25,6 +21,7 @@ type gardenFactory struct {
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	resourceFactory       resource.ResourceFactory
+	secretLookups         BuildSecretLookups
 }
 
 func NewGardenFactory(
@@ -37,6 +34,7 @@ func NewGardenFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	resourceFactory resource.ResourceFactory,
+	secretLookups BuildSecretLookups,
 ) Factory {
 	return &gardenFactory{
 		pool:                  pool,
@@ -48,6 +46,7 @@ func NewGardenFactory(
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
 		resourceFactory:       resourceFactory,
+		secretLookups:         secretLookups,
 	}
 }
 
@@ -61,7 +60,10 @@ func (factory *gardenFactory) Get(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.secretLookups.Variables(
+		build,
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+	)
 
 	getStep := NewGetStep(
 		build,
@@ -102,7 +104,10 @@ func (factory *gardenFactory) Put(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.secretLookups.Variables(
+		build,
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+	)
 
 	var putInputs PutInputs
 	if plan.Put.Inputs == nil {
@@ -151,10 +156,13 @@ func (factory *gardenFactory) Task(
 	containerMetadata db.ContainerMetadata,
 	delegate TaskDelegate,
 ) Step {
-	workingDirectory := factory.taskWorkingDirectory(artifact.Name(plan.Task.Name))
+	workingDirectory := taskspec.WorkingDirectory(plan.Task.Name)
 	containerMetadata.WorkingDirectory = workingDirectory
 
-	credMgrVariables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	credMgrVariables := factory.secretLookups.Variables(
+		build,
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+	)
 
 	var taskConfigSource TaskConfigSource
 	var taskVars []boshtemplate.Variables
@@ -226,8 +234,3 @@ func (factory *gardenFactory) ArtifactOutputStep(
 ) Step {
 	return NewArtifactOutputStep(plan, build, factory.client, delegate)
 }
-
-func (factory *gardenFactory) taskWorkingDirectory(sourceName artifact.Name) string {
-	sum := sha1.Sum([]byte(sourceName))
-	return filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
-}
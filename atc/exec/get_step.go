@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -152,6 +153,19 @@ func NewGetStep(
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
 func (step *GetStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "get", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *GetStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	step.delegate.Initializing(logger)
@@ -210,7 +224,9 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		ResourceTypes: step.resourceTypes,
 	}
 
+	_, workerSpan := tracing.StartSpan(ctx, "choose-worker", nil)
 	chosenWorker, err := step.workerPool.FindOrChooseWorkerForContainer(logger, resourceInstance.ContainerOwner(), containerSpec, workerSpec, step.strategy)
+	tracing.End(workerSpan, err)
 	if err != nil {
 		return err
 	}
@@ -44,6 +44,7 @@ var _ = Describe("GetStep", func() {
 		fakeResourceCacheFactory  *dbfakes.FakeResourceCacheFactory
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeVariablesFactory      *credsfakes.FakeVariablesFactory
+		fakeSecretLookups         *execfakes.FakeBuildSecretLookups
 		variables                 creds.Variables
 		fakeBuild                 *dbfakes.FakeBuild
 		fakeDelegate              *execfakes.FakeGetDelegate
@@ -89,6 +90,11 @@ var _ = Describe("GetStep", func() {
 		}
 		fakeVariablesFactory.NewVariablesReturns(variables)
 
+		fakeSecretLookups = new(execfakes.FakeBuildSecretLookups)
+		fakeSecretLookups.VariablesStub = func(_ db.Build, variables creds.Variables) creds.Variables {
+			return variables
+		}
+
 		artifactRepository = artifact.NewRepository()
 		state = new(execfakes.FakeRunState)
 		state.ArtifactsReturns(artifactRepository)
@@ -122,7 +128,7 @@ var _ = Describe("GetStep", func() {
 			VersionedResourceTypes: resourceTypes,
 		}
 
-		factory = exec.NewGardenFactory(fakePool, fakeClient, fakeResourceFetcher, fakeResourceCacheFactory, fakeResourceConfigFactory, fakeVariablesFactory, atc.ContainerLimits{}, fakeStrategy, fakeResourceFactory)
+		factory = exec.NewGardenFactory(fakePool, fakeClient, fakeResourceFetcher, fakeResourceCacheFactory, fakeResourceConfigFactory, fakeVariablesFactory, atc.ContainerLimits{}, fakeStrategy, fakeResourceFactory, fakeSecretLookups)
 
 		fakeDelegate = new(execfakes.FakeGetDelegate)
 	})
@@ -148,6 +154,13 @@ var _ = Describe("GetStep", func() {
 		stepErr = getStep.Run(ctx, state)
 	})
 
+	It("collects the build's secret lookups", func() {
+		Expect(fakeSecretLookups.VariablesCallCount()).To(Equal(1))
+		build, actualVariables := fakeSecretLookups.VariablesArgsForCall(0)
+		Expect(build).To(Equal(fakeBuild))
+		Expect(actualVariables).To(Equal(variables))
+	})
+
 	It("finds or chooses a worker", func() {
 		Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(1))
 		_, actualOwner, actualContainerSpec, actualWorkerSpec, strategy := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -101,6 +102,19 @@ func NewPutStep(
 // The resource's put script is then invoked. If the context is canceled, the
 // script will be interrupted.
 func (step *PutStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "put", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *PutStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	step.delegate.Initializing(logger)
@@ -132,7 +146,9 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 	}
 
 	owner := db.NewBuildStepContainerOwner(step.build.ID(), step.planID, step.build.TeamID())
+	_, workerSpan := tracing.StartSpan(ctx, "choose-worker", nil)
 	chosenWorker, err := step.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, step.strategy)
+	tracing.End(workerSpan, err)
 	if err != nil {
 		return err
 	}
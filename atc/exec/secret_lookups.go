@@ -0,0 +1,46 @@
+package exec
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . BuildSecretLookups
+
+// BuildSecretLookups collects the vars looked up by each build's steps, so
+// that they're recorded once when the build finishes rather than as each var
+// is looked up.
+type BuildSecretLookups interface {
+	Variables(db.Build, creds.Variables) creds.Variables
+	Record(lager.Logger, db.Build)
+}
+
+type buildSecretLookups struct {
+	builds *sync.Map
+}
+
+func NewBuildSecretLookups() BuildSecretLookups {
+	return buildSecretLookups{
+		builds: &sync.Map{},
+	}
+}
+
+func (l buildSecretLookups) Variables(build db.Build, variables creds.Variables) creds.Variables {
+	lookups, _ := l.builds.LoadOrStore(build.ID(), creds.NewSecretLookups())
+	return lookups.(*creds.SecretLookups).Variables(variables)
+}
+
+func (l buildSecretLookups) Record(logger lager.Logger, build db.Build) {
+	lookups, found := l.builds.LoadAndDelete(build.ID())
+	if !found {
+		return
+	}
+
+	err := lookups.(*creds.SecretLookups).Record(logger, build)
+	if err != nil {
+		logger.Error("failed-to-record-secret-lookups", err)
+	}
+}
@@ -0,0 +1,75 @@
+package exec_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildSecretLookups", func() {
+	var (
+		logger    *lagertest.TestLogger
+		fakeBuild *dbfakes.FakeBuild
+		variables template.StaticVariables
+
+		secretLookups exec.BuildSecretLookups
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+
+		variables = template.StaticVariables{
+			"some-var":  "some-value",
+			"other-var": "other-value",
+		}
+
+		secretLookups = exec.NewBuildSecretLookups()
+	})
+
+	It("records the lookups made by all of the build's steps at once", func() {
+		_, _, err := secretLookups.Variables(fakeBuild, variables).Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, _, err = secretLookups.Variables(fakeBuild, variables).Get(template.VariableDefinition{Name: "other-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeBuild.RecordSecretLookupsCallCount()).To(BeZero())
+
+		secretLookups.Record(logger, fakeBuild)
+
+		Expect(fakeBuild.RecordSecretLookupsCallCount()).To(Equal(1))
+		Expect(fakeBuild.RecordSecretLookupsArgsForCall(0)).To(Equal([]creds.SecretLookup{
+			{Name: "some-var", Found: true},
+			{Name: "other-var", Found: true},
+		}))
+	})
+
+	It("does not record the lookups of other builds", func() {
+		otherBuild := new(dbfakes.FakeBuild)
+		otherBuild.IDReturns(43)
+
+		_, _, err := secretLookups.Variables(otherBuild, variables).Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		secretLookups.Record(logger, fakeBuild)
+
+		Expect(fakeBuild.RecordSecretLookupsCallCount()).To(BeZero())
+	})
+
+	It("forgets the build's lookups once they're recorded", func() {
+		_, _, err := secretLookups.Variables(fakeBuild, variables).Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		secretLookups.Record(logger, fakeBuild)
+		secretLookups.Record(logger, fakeBuild)
+
+		Expect(fakeBuild.RecordSecretLookupsCallCount()).To(Equal(1))
+	})
+})
@@ -5,10 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"path"
 	"path/filepath"
 	"strconv"
-	"strings"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -18,6 +16,9 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/taskspec"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -26,14 +27,7 @@ const taskExitStatusPropertyName = "concourse:exit-status"
 
 // MissingInputsError is returned when any of the task's required inputs are
 // missing.
-type MissingInputsError struct {
-	Inputs []string
-}
-
-// Error prints a human-friendly message listing the inputs that were missing.
-func (err MissingInputsError) Error() string {
-	return fmt.Sprintf("missing inputs: %s", strings.Join(err.Inputs, ", "))
-}
+type MissingInputsError = taskspec.MissingInputsError
 
 type MissingTaskImageSourceError struct {
 	SourceName string
@@ -152,6 +146,17 @@ func NewTaskStep(
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
 func (action *TaskStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
+		"name": action.stepName,
+	})
+
+	err := action.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (action *TaskStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	repository := state.Artifacts()
@@ -185,7 +190,9 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 	}
 
 	owner := db.NewBuildStepContainerOwner(action.buildID, action.planID, action.teamID)
+	_, workerSpan := tracing.StartSpan(ctx, "choose-worker", nil)
 	chosenWorker, err := action.workerPool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, action.strategy)
+	tracing.End(workerSpan, err)
 	if err != nil {
 		return err
 	}
@@ -242,7 +249,11 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 				Path: config.Run.Path,
 				Args: config.Run.Args,
 
-				Dir: path.Join(action.artifactsRoot, config.Run.Dir),
+				Dir: taskspec.RunDir(action.artifactsRoot, config),
+
+				// Hand the task's span to the process so that tools which
+				// understand W3C trace context can continue the trace
+				Env: tracing.Env(ctx),
 
 				// Guardian sets the default TTY window size to width: 80, height: 24,
 				// which creates ANSI control sequences that do not work with other window sizes
@@ -259,12 +270,15 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 
 	logger.Info("attached")
 
+	metric.TaskStarted{WorkerName: chosenWorker.Name()}.Emit(logger)
+
 	exited := make(chan struct{})
 	var processStatus int
 	var processErr error
 
 	go func() {
 		processStatus, processErr = process.Wait()
+		metric.TaskExited{WorkerName: chosenWorker.Name()}.Emit(logger)
 		close(exited)
 	}()
 
@@ -368,7 +382,7 @@ func (action *TaskStep) containerInputs(logger lager.Logger, repository *artifac
 	}
 
 	if len(missingRequiredInputs) > 0 {
-		return nil, MissingInputsError{missingRequiredInputs}
+		return nil, MissingInputsError{Inputs: missingRequiredInputs}
 	}
 
 	for _, cacheConfig := range config.Caches {
@@ -376,7 +390,7 @@ func (action *TaskStep) containerInputs(logger lager.Logger, repository *artifac
 		inputs = append(inputs, &taskCacheInputSource{
 			source:        source,
 			artifactsRoot: action.artifactsRoot,
-			cachePath:     cacheConfig.Path,
+			cache:         cacheConfig,
 		})
 	}
 
@@ -397,7 +411,7 @@ func (action *TaskStep) containerSpec(logger lager.Logger, repository *artifact.
 		Limits:    worker.ContainerLimits(config.Limits),
 		User:      config.Run.User,
 		Dir:       action.artifactsRoot,
-		Env:       action.envForParams(config.Params),
+		Env:       taskspec.Env(config.Params),
 
 		Inputs:  []worker.InputSource{},
 		Outputs: worker.OutputPaths{},
@@ -409,7 +423,7 @@ func (action *TaskStep) containerSpec(logger lager.Logger, repository *artifact.
 	}
 
 	for _, output := range config.Outputs {
-		path := artifactsPath(output, action.artifactsRoot)
+		path := taskspec.OutputPath(action.artifactsRoot, output)
 		containerSpec.Outputs[output.Name] = path
 	}
 
@@ -447,7 +461,7 @@ func (action *TaskStep) registerOutputs(logger lager.Logger, repository *artifac
 			outputName = destinationName
 		}
 
-		outputPath := artifactsPath(output, action.artifactsRoot)
+		outputPath := taskspec.OutputPath(action.artifactsRoot, output)
 
 		for _, mount := range volumeMounts {
 			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
@@ -463,7 +477,7 @@ func (action *TaskStep) registerOutputs(logger lager.Logger, repository *artifac
 
 		for _, cacheConfig := range config.Caches {
 			for _, volumeMount := range volumeMounts {
-				if volumeMount.MountPath == filepath.Join(action.artifactsRoot, cacheConfig.Path) {
+				if volumeMount.MountPath == taskspec.CachePath(action.artifactsRoot, cacheConfig) {
 					logger.Debug("initializing-cache", lager.Data{"path": volumeMount.MountPath})
 
 					err := volumeMount.Volume.InitializeTaskCache(logger, action.jobID, action.stepName, cacheConfig.Path, bool(action.privileged))
@@ -480,16 +494,6 @@ func (action *TaskStep) registerOutputs(logger lager.Logger, repository *artifac
 	return nil
 }
 
-func (TaskStep) envForParams(params map[string]string) []string {
-	env := make([]string, 0, len(params))
-
-	for k, v := range params {
-		env = append(env, k+"="+v)
-	}
-
-	return env
-}
-
 type taskArtifactSource struct {
 	worker.Volume
 }
@@ -525,33 +529,19 @@ type taskInputSource struct {
 func (s *taskInputSource) Source() worker.ArtifactSource { return s.source }
 
 func (s *taskInputSource) DestinationPath() string {
-	subdir := s.config.Path
-	if s.config.Path == "" {
-		subdir = s.config.Name
-	}
-
-	return filepath.Join(s.artifactsRoot, subdir)
-}
-
-func artifactsPath(outputConfig atc.TaskOutputConfig, artifactsRoot string) string {
-	outputSrc := outputConfig.Path
-	if len(outputSrc) == 0 {
-		outputSrc = outputConfig.Name
-	}
-
-	return path.Join(artifactsRoot, outputSrc) + "/"
+	return taskspec.InputPath(s.artifactsRoot, s.config)
 }
 
 type taskCacheInputSource struct {
 	source        worker.ArtifactSource
 	artifactsRoot string
-	cachePath     string
+	cache         atc.CacheConfig
 }
 
 func (s *taskCacheInputSource) Source() worker.ArtifactSource { return s.source }
 
 func (s *taskCacheInputSource) DestinationPath() string {
-	return filepath.Join(s.artifactsRoot, s.cachePath)
+	return taskspec.CachePath(s.artifactsRoot, s.cache)
 }
 
 type taskCacheSource struct {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
@@ -20,6 +21,8 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
@@ -516,6 +519,24 @@ var _ = Describe("TaskStep", func() {
 						Expect(io.Stderr).To(Equal(stderrBuf))
 					})
 
+					Context("when tracing is configured", func() {
+						BeforeEach(func() {
+							tracing.ConfigureExporter(logger, new(tracingfakes.FakeExporter), 1, 1, time.Hour)
+						})
+
+						AfterEach(func() {
+							tracing.Deinitialize()
+						})
+
+						It("propagates the trace context to the process", func() {
+							Expect(fakeContainer.RunCallCount()).To(Equal(1))
+
+							containerSpec, _ := fakeContainer.RunArgsForCall(0)
+							Expect(containerSpec.Env).To(HaveLen(1))
+							Expect(containerSpec.Env[0]).To(MatchRegexp(`^TRACEPARENT=00-[0-9a-f]{32}-[0-9a-f]{16}-01$`))
+						})
+					})
+
 					Context("when privileged", func() {
 						BeforeEach(func() {
 							privileged = true
@@ -0,0 +1,105 @@
+// Package taskspec is how a task's config maps onto the container it runs in:
+// where its inputs, outputs and caches are placed, which directory it runs in,
+// and how its params and limits are set.
+//
+// It is shared by the task step and by 'fly execute --local', so that a task
+// sees the same container whether it is run on a worker or on the local
+// machine.
+package taskspec
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+// GardenLimitDefault lets Garden pick the container's limits when the task
+// does not set them.
+const GardenLimitDefault = uint64(0)
+
+// MissingInputsError is returned when any of the task's required inputs are
+// missing.
+type MissingInputsError struct {
+	Inputs []string
+}
+
+// Error prints a human-friendly message listing the inputs that were missing.
+func (err MissingInputsError) Error() string {
+	return fmt.Sprintf("missing inputs: %s", strings.Join(err.Inputs, ", "))
+}
+
+// WorkingDirectory is the directory the task's inputs, outputs and caches are
+// placed under. It is derived from the name of the task step.
+func WorkingDirectory(stepName string) string {
+	sum := sha1.Sum([]byte(stepName))
+	return path.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
+}
+
+// RunDir is the directory the task's process is run in.
+func RunDir(workingDirectory string, config atc.TaskConfig) string {
+	return path.Join(workingDirectory, config.Run.Dir)
+}
+
+// InputPath is where the input is placed, which is its path if it has one or
+// its name otherwise.
+func InputPath(workingDirectory string, input atc.TaskInputConfig) string {
+	subdir := input.Path
+	if subdir == "" {
+		subdir = input.Name
+	}
+
+	return path.Join(workingDirectory, subdir)
+}
+
+// OutputPath is the directory the output is collected from, which is its path
+// if it has one or its name otherwise.
+func OutputPath(workingDirectory string, output atc.TaskOutputConfig) string {
+	subdir := output.Path
+	if subdir == "" {
+		subdir = output.Name
+	}
+
+	return path.Join(workingDirectory, subdir) + "/"
+}
+
+// CachePath is where the cache is placed.
+func CachePath(workingDirectory string, cache atc.CacheConfig) string {
+	return path.Join(workingDirectory, cache.Path)
+}
+
+// Env is the task's params as environment variables, sorted by name.
+func Env(params map[string]string) []string {
+	env := make([]string, 0, len(params))
+
+	for k, v := range params {
+		env = append(env, k+"="+v)
+	}
+
+	sort.Strings(env)
+
+	return env
+}
+
+// Limits is the task's container limits as Garden limits, leaving any which
+// are not set to Garden.
+func Limits(limits atc.ContainerLimits) garden.Limits {
+	gardenLimits := garden.Limits{
+		CPU:    garden.CPULimits{LimitInShares: GardenLimitDefault},
+		Memory: garden.MemoryLimits{LimitInBytes: GardenLimitDefault},
+	}
+
+	if limits.CPU != nil {
+		gardenLimits.CPU.LimitInShares = *limits.CPU
+	}
+
+	if limits.Memory != nil {
+		gardenLimits.Memory.LimitInBytes = *limits.Memory
+	}
+
+	return gardenLimits
+}
@@ -0,0 +1,13 @@
+package taskspec_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTaskspec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Taskspec Suite")
+}
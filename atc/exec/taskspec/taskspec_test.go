@@ -0,0 +1,77 @@
+package taskspec_test
+
+import (
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec/taskspec"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Taskspec", func() {
+	Describe("WorkingDirectory", func() {
+		It("is derived from the step name", func() {
+			Expect(taskspec.WorkingDirectory("some-task")).To(MatchRegexp(`^/tmp/build/[0-9a-f]{8}$`))
+			Expect(taskspec.WorkingDirectory("some-task")).To(Equal(taskspec.WorkingDirectory("some-task")))
+			Expect(taskspec.WorkingDirectory("some-task")).ToNot(Equal(taskspec.WorkingDirectory("some-other-task")))
+		})
+	})
+
+	Describe("RunDir", func() {
+		It("is the run dir under the working directory", func() {
+			Expect(taskspec.RunDir("/tmp/build/abc", atc.TaskConfig{})).To(Equal("/tmp/build/abc"))
+			Expect(taskspec.RunDir("/tmp/build/abc", atc.TaskConfig{Run: atc.TaskRunConfig{Dir: "some/dir"}})).To(Equal("/tmp/build/abc/some/dir"))
+		})
+	})
+
+	Describe("InputPath", func() {
+		It("uses the input's path if it has one", func() {
+			Expect(taskspec.InputPath("/tmp/build/abc", atc.TaskInputConfig{Name: "some-input", Path: "some/path"})).To(Equal("/tmp/build/abc/some/path"))
+		})
+
+		It("uses the input's name otherwise", func() {
+			Expect(taskspec.InputPath("/tmp/build/abc", atc.TaskInputConfig{Name: "some-input"})).To(Equal("/tmp/build/abc/some-input"))
+		})
+	})
+
+	Describe("OutputPath", func() {
+		It("uses the output's path if it has one", func() {
+			Expect(taskspec.OutputPath("/tmp/build/abc", atc.TaskOutputConfig{Name: "some-output", Path: "some/path"})).To(Equal("/tmp/build/abc/some/path/"))
+		})
+
+		It("uses the output's name otherwise", func() {
+			Expect(taskspec.OutputPath("/tmp/build/abc", atc.TaskOutputConfig{Name: "some-output"})).To(Equal("/tmp/build/abc/some-output/"))
+		})
+	})
+
+	Describe("CachePath", func() {
+		It("is the cache's path under the working directory", func() {
+			Expect(taskspec.CachePath("/tmp/build/abc", atc.CacheConfig{Path: "some/cache"})).To(Equal("/tmp/build/abc/some/cache"))
+		})
+	})
+
+	Describe("Env", func() {
+		It("sets the params as environment variables, sorted by name", func() {
+			Expect(taskspec.Env(map[string]string{"B": "2", "A": "1=1"})).To(Equal([]string{"A=1=1", "B=2"}))
+		})
+	})
+
+	Describe("Limits", func() {
+		It("leaves unset limits to Garden", func() {
+			Expect(taskspec.Limits(atc.ContainerLimits{})).To(Equal(garden.Limits{
+				CPU:    garden.CPULimits{LimitInShares: taskspec.GardenLimitDefault},
+				Memory: garden.MemoryLimits{LimitInBytes: taskspec.GardenLimitDefault},
+			}))
+		})
+
+		It("sets the given limits", func() {
+			cpu := uint64(512)
+			memory := uint64(1024)
+
+			Expect(taskspec.Limits(atc.ContainerLimits{CPU: &cpu, Memory: &memory})).To(Equal(garden.Limits{
+				CPU:    garden.CPULimits{LimitInShares: 512},
+				Memory: garden.MemoryLimits{LimitInBytes: 1024},
+			}))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type checkCollector struct {
+	checkLifecycle db.CheckLifecycle
+	recyclePeriod  time.Duration
+	historyLimit   int
+	checkTimeout   time.Duration
+}
+
+// NewCheckCollector returns a collector which ends checks left started by an
+// ATC which stopped, and removes old checks. Checks time out after the
+// resource's check timeout, or the given timeout if it has none.
+func NewCheckCollector(
+	checkLifecycle db.CheckLifecycle,
+	recyclePeriod time.Duration,
+	historyLimit int,
+	checkTimeout time.Duration,
+) Collector {
+	return &checkCollector{
+		checkLifecycle: checkLifecycle,
+		recyclePeriod:  recyclePeriod,
+		historyLimit:   historyLimit,
+		checkTimeout:   checkTimeout,
+	}
+}
+
+func (cc *checkCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	errored, err := cc.checkLifecycle.ErrorTimedOutChecks(cc.checkTimeout)
+	if err != nil {
+		logger.Error("failed-to-error-timed-out-checks", err)
+		return err
+	}
+
+	if errored > 0 {
+		logger.Info("errored-timed-out-checks", lager.Data{"count": errored})
+	}
+
+	removed, err := cc.checkLifecycle.RemoveExpiredChecks(cc.recyclePeriod)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-checks", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-checks", lager.Data{"count": removed})
+	}
+
+	removed, err = cc.checkLifecycle.RemoveExcessChecks(cc.historyLimit)
+	if err != nil {
+		logger.Error("failed-to-remove-excess-checks", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-excess-checks", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckCollector", func() {
+	var (
+		collector          gc.Collector
+		fakeCheckLifecycle *dbfakes.FakeCheckLifecycle
+	)
+
+	BeforeEach(func() {
+		fakeCheckLifecycle = new(dbfakes.FakeCheckLifecycle)
+
+		collector = gc.NewCheckCollector(fakeCheckLifecycle, 6*time.Hour, 10, time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("errors checks which were started longer than the check timeout ago", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeCheckLifecycle.ErrorTimedOutChecksCallCount()).To(Equal(1))
+			Expect(fakeCheckLifecycle.ErrorTimedOutChecksArgsForCall(0)).To(Equal(time.Hour))
+		})
+
+		It("removes checks older than the recycle period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeCheckLifecycle.RemoveExpiredChecksCallCount()).To(Equal(1))
+			Expect(fakeCheckLifecycle.RemoveExpiredChecksArgsForCall(0)).To(Equal(6 * time.Hour))
+		})
+
+		It("removes checks beyond the history limit", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeCheckLifecycle.RemoveExcessChecksCallCount()).To(Equal(1))
+			Expect(fakeCheckLifecycle.RemoveExcessChecksArgsForCall(0)).To(Equal(10))
+		})
+
+		Context("when erroring timed out checks fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeCheckLifecycle.ErrorTimedOutChecksReturns(0, disaster)
+			})
+
+			It("returns the error without removing any checks", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(Equal(disaster))
+
+				Expect(fakeCheckLifecycle.RemoveExpiredChecksCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when removing expired checks fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeCheckLifecycle.RemoveExpiredChecksReturns(0, disaster)
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(Equal(disaster))
+			})
+		})
+
+		Context("when removing excess checks fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeCheckLifecycle.RemoveExcessChecksReturns(0, disaster)
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type resourceConfigVersionCollector struct {
+	pipelineFactory  db.PipelineFactory
+	versionLifecycle db.ResourceConfigVersionLifecycle
+	historyLimit     int
+}
+
+// NewResourceConfigVersionCollector removes versions exceeding the
+// version_history of the resources using them, falling back to the given
+// history limit for resources which don't configure one. A limit of 0 keeps
+// every version.
+func NewResourceConfigVersionCollector(
+	pipelineFactory db.PipelineFactory,
+	versionLifecycle db.ResourceConfigVersionLifecycle,
+	historyLimit int,
+) Collector {
+	return &resourceConfigVersionCollector{
+		pipelineFactory:  pipelineFactory,
+		versionLifecycle: versionLifecycle,
+		historyLimit:     historyLimit,
+	}
+}
+
+type versionRetention struct {
+	unlimited      bool
+	historyLimit   int
+	pinnedVersions []atc.Version
+	passedJobIDs   []int
+}
+
+func (vc *resourceConfigVersionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("resource-config-version-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := vc.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	// resource config scopes are shared between resources, so a version is
+	// only removed once it exceeds the history of every resource using it
+	scopes := map[int]*versionRetention{}
+	scopeIDs := []int{}
+
+	for _, pipeline := range pipelines {
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			return err
+		}
+
+		jobs, err := pipeline.Jobs()
+		if err != nil {
+			logger.Error("failed-to-get-jobs", err)
+			return err
+		}
+
+		passedJobIDs := passedJobIDsByResource(jobs)
+
+		for _, resource := range resources {
+			scopeID := resource.ResourceConfigScopeID()
+			if scopeID == 0 {
+				continue
+			}
+
+			retention, found := scopes[scopeID]
+			if !found {
+				retention = &versionRetention{}
+				scopes[scopeID] = retention
+				scopeIDs = append(scopeIDs, scopeID)
+			}
+
+			historyLimit := resource.VersionHistory()
+			if historyLimit == 0 {
+				historyLimit = vc.historyLimit
+			}
+
+			if historyLimit == 0 {
+				retention.unlimited = true
+			} else if historyLimit > retention.historyLimit {
+				retention.historyLimit = historyLimit
+			}
+
+			if pinnedVersion := resource.CurrentPinnedVersion(); pinnedVersion != nil {
+				retention.pinnedVersions = append(retention.pinnedVersions, pinnedVersion)
+			}
+
+			retention.passedJobIDs = append(retention.passedJobIDs, passedJobIDs[resource.Name()]...)
+		}
+	}
+
+	for _, scopeID := range scopeIDs {
+		retention := scopes[scopeID]
+		if retention.unlimited {
+			continue
+		}
+
+		removed, err := vc.versionLifecycle.RemoveExcessVersions(scopeID, retention.historyLimit, retention.pinnedVersions, retention.passedJobIDs)
+		if err != nil {
+			logger.Error("failed-to-remove-excess-versions", err, lager.Data{"resource-config-scope": scopeID})
+			return err
+		}
+
+		if removed > 0 {
+			logger.Debug("removed-excess-versions", lager.Data{
+				"resource-config-scope": scopeID,
+				"count":                 removed,
+			})
+
+			metric.ResourceVersionsDeleted.IncDelta(removed)
+		}
+	}
+
+	return nil
+}
+
+// passedJobIDsByResource returns, for each resource, the jobs which other
+// jobs' inputs of it are constrained to have passed.
+func passedJobIDsByResource(jobs db.Jobs) map[string][]int {
+	jobIDs := map[string]int{}
+	for _, job := range jobs {
+		jobIDs[job.Name()] = job.ID()
+	}
+
+	passedJobIDs := map[string][]int{}
+	for _, job := range jobs {
+		for _, input := range job.Config().Inputs() {
+			for _, passed := range input.Passed {
+				jobID, found := jobIDs[passed]
+				if !found {
+					continue
+				}
+
+				passedJobIDs[input.Resource] = append(passedJobIDs[input.Resource], jobID)
+			}
+		}
+	}
+
+	return passedJobIDs
+}
@@ -0,0 +1,221 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionCollector", func() {
+	var (
+		collector            gc.Collector
+		fakePipelineFactory  *dbfakes.FakePipelineFactory
+		fakeVersionLifecycle *dbfakes.FakeResourceConfigVersionLifecycle
+		fakePipeline         *dbfakes.FakePipeline
+		fakeResource         *dbfakes.FakeResource
+		historyLimit         int
+	)
+
+	BeforeEach(func() {
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakeVersionLifecycle = new(dbfakes.FakeResourceConfigVersionLifecycle)
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.ResourceConfigScopeIDReturns(7)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+
+		historyLimit = 0
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewResourceConfigVersionCollector(fakePipelineFactory, fakeVersionLifecycle, historyLimit)
+	})
+
+	Describe("Run", func() {
+		var err error
+
+		JustBeforeEach(func() {
+			err = collector.Run(context.TODO())
+		})
+
+		Context("when neither the resource nor the default limit the history", func() {
+			It("keeps every version", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeVersionLifecycle.RemoveExcessVersionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when there is a default history limit", func() {
+			BeforeEach(func() {
+				historyLimit = 100
+			})
+
+			It("removes versions beyond it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeVersionLifecycle.RemoveExcessVersionsCallCount()).To(Equal(1))
+
+				scopeID, limit, pinned, passedJobIDs := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+				Expect(scopeID).To(Equal(7))
+				Expect(limit).To(Equal(100))
+				Expect(pinned).To(BeEmpty())
+				Expect(passedJobIDs).To(BeEmpty())
+			})
+
+			Context("when the resource configures its own version_history", func() {
+				BeforeEach(func() {
+					fakeResource.VersionHistoryReturns(10)
+				})
+
+				It("uses it instead", func() {
+					_, limit, _, _ := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+					Expect(limit).To(Equal(10))
+				})
+			})
+
+			Context("when the resource is pinned", func() {
+				BeforeEach(func() {
+					fakeResource.CurrentPinnedVersionReturns(atc.Version{"ref": "v1"})
+				})
+
+				It("protects the pinned version", func() {
+					_, _, pinned, _ := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+					Expect(pinned).To(Equal([]atc.Version{{"ref": "v1"}}))
+				})
+			})
+
+			Context("when jobs' inputs of the resource have passed constraints", func() {
+				BeforeEach(func() {
+					fakeResource.NameReturns("some-resource")
+
+					upstreamJob := new(dbfakes.FakeJob)
+					upstreamJob.IDReturns(1)
+					upstreamJob.NameReturns("upstream-job")
+					upstreamJob.ConfigReturns(atc.JobConfig{
+						Name: "upstream-job",
+						Plan: atc.PlanSequence{{Get: "some-resource"}},
+					})
+
+					downstreamJob := new(dbfakes.FakeJob)
+					downstreamJob.IDReturns(2)
+					downstreamJob.NameReturns("downstream-job")
+					downstreamJob.ConfigReturns(atc.JobConfig{
+						Name: "downstream-job",
+						Plan: atc.PlanSequence{
+							{Get: "some-input", Resource: "some-resource", Passed: []string{"upstream-job"}},
+							{Get: "other-resource", Passed: []string{"upstream-job"}},
+						},
+					})
+
+					fakePipeline.JobsReturns(db.Jobs{upstreamJob, downstreamJob}, nil)
+				})
+
+				It("protects the versions which went through the passed jobs", func() {
+					_, _, _, passedJobIDs := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+					Expect(passedJobIDs).To(Equal([]int{1}))
+				})
+			})
+
+			Context("when getting the jobs fails", func() {
+				disaster := errors.New("disaster")
+
+				BeforeEach(func() {
+					fakePipeline.JobsReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+				})
+			})
+
+			Context("when the resource has no resource config scope yet", func() {
+				BeforeEach(func() {
+					fakeResource.ResourceConfigScopeIDReturns(0)
+				})
+
+				It("skips it", func() {
+					Expect(fakeVersionLifecycle.RemoveExcessVersionsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when another resource shares the resource config scope", func() {
+				var otherResource *dbfakes.FakeResource
+
+				BeforeEach(func() {
+					otherResource = new(dbfakes.FakeResource)
+					otherResource.ResourceConfigScopeIDReturns(7)
+					otherResource.CurrentPinnedVersionReturns(atc.Version{"ref": "v2"})
+
+					otherPipeline := new(dbfakes.FakePipeline)
+					otherPipeline.ResourcesReturns(db.Resources{otherResource}, nil)
+
+					fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, otherPipeline}, nil)
+				})
+
+				It("removes versions of the scope once", func() {
+					Expect(fakeVersionLifecycle.RemoveExcessVersionsCallCount()).To(Equal(1))
+
+					_, _, pinned, _ := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+					Expect(pinned).To(Equal([]atc.Version{{"ref": "v2"}}))
+				})
+
+				Context("with a longer version_history", func() {
+					BeforeEach(func() {
+						otherResource.VersionHistoryReturns(500)
+					})
+
+					It("keeps the longest history", func() {
+						_, limit, _, _ := fakeVersionLifecycle.RemoveExcessVersionsArgsForCall(0)
+						Expect(limit).To(Equal(500))
+					})
+				})
+			})
+
+			Context("when versions are removed", func() {
+				BeforeEach(func() {
+					metric.ResourceVersionsDeleted.Delta()
+					fakeVersionLifecycle.RemoveExcessVersionsReturns(3, nil)
+				})
+
+				It("reports them as deleted", func() {
+					Expect(metric.ResourceVersionsDeleted.Delta()).To(Equal(3))
+				})
+			})
+
+			Context("when removing versions fails", func() {
+				disaster := errors.New("disaster")
+
+				BeforeEach(func() {
+					fakeVersionLifecycle.RemoveExcessVersionsReturns(0, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+				})
+			})
+		})
+
+		Context("when getting the pipelines fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakePipelineFactory.AllPipelinesReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type resourcePinCollector struct {
+	pinLifecycle db.ResourcePinLifecycle
+}
+
+// PinExpiryUnpinner is recorded as who unpinned a resource when its pin is
+// lifted because it expired.
+const PinExpiryUnpinner = "pin-expiry"
+
+// NewResourcePinCollector lifts pins whose expiry has passed, recording the
+// expiry as who unpinned each resource. Every lifted pin is logged along with
+// who pinned it.
+func NewResourcePinCollector(pinLifecycle db.ResourcePinLifecycle) Collector {
+	return &resourcePinCollector{
+		pinLifecycle: pinLifecycle,
+	}
+}
+
+func (pc *resourcePinCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("resource-pin-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	expired, err := pc.pinLifecycle.UnpinExpiredVersions(PinExpiryUnpinner)
+	if err != nil {
+		logger.Error("failed-to-unpin-expired-versions", err)
+		return err
+	}
+
+	for _, pin := range expired {
+		logger.Info("unpinned-expired-version", lager.Data{
+			"team":        pin.TeamName,
+			"pipeline":    pin.PipelineName,
+			"resource":    pin.ResourceName,
+			"version":     pin.Version,
+			"pinned-by":   pin.PinnedBy,
+			"unpinned-by": PinExpiryUnpinner,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourcePinCollector", func() {
+	var (
+		collector        gc.Collector
+		fakePinLifecycle *dbfakes.FakeResourcePinLifecycle
+	)
+
+	BeforeEach(func() {
+		fakePinLifecycle = new(dbfakes.FakeResourcePinLifecycle)
+
+		collector = gc.NewResourcePinCollector(fakePinLifecycle)
+	})
+
+	Describe("Run", func() {
+		It("unpins expired versions, recording the expiry as who unpinned them", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePinLifecycle.UnpinExpiredVersionsCallCount()).To(Equal(1))
+			Expect(fakePinLifecycle.UnpinExpiredVersionsArgsForCall(0)).To(Equal(gc.PinExpiryUnpinner))
+		})
+
+		Context("when unpinning fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakePinLifecycle.UnpinExpiredVersionsReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// InstanceVarsQueryParam is the query parameter with which requests for a
+// pipeline address one of its instances, as a JSON object.
+const InstanceVarsQueryParam = "vars"
+
+// InstanceVars distinguish the instances of a pipeline, which share its name
+// but are set separately, e.g. one for each release branch.
+type InstanceVars map[string]string
+
+// PipelineRef identifies a pipeline by its name and, for an instance, its
+// instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	return ref.Name + "/" + ref.InstanceVars.String()
+}
+
+func (vars InstanceVars) String() string {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + ":" + vars[key]
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// QueryParams returns the query with which to address the instance, which
+// is empty for a pipeline that isn't instanced.
+func (vars InstanceVars) QueryParams() url.Values {
+	query := url.Values{}
+	if len(vars) == 0 {
+		return query
+	}
+
+	payload, _ := json.Marshal(vars)
+	query.Set(InstanceVarsQueryParam, string(payload))
+
+	return query
+}
+
+func InstanceVarsFromQueryParams(query url.Values) (InstanceVars, error) {
+	payload := query.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var vars InstanceVars
+	err := json.Unmarshal([]byte(payload), &vars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %s", err)
+	}
+
+	return vars, nil
+}
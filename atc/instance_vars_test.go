@@ -0,0 +1,52 @@
+package atc_test
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceVars", func() {
+	Describe("PipelineRef.String", func() {
+		It("is just the name for a pipeline that isn't instanced", func() {
+			Expect(atc.PipelineRef{Name: "release"}.String()).To(Equal("release"))
+		})
+
+		It("includes the instance vars sorted by key", func() {
+			ref := atc.PipelineRef{
+				Name:         "release",
+				InstanceVars: atc.InstanceVars{"os": "linux", "branch": "1.2"},
+			}
+
+			Expect(ref.String()).To(Equal("release/branch:1.2,os:linux"))
+		})
+	})
+
+	Describe("query params", func() {
+		It("round-trips the instance vars", func() {
+			vars := atc.InstanceVars{"branch": "1.2"}
+
+			query := vars.QueryParams()
+			Expect(query.Get("vars")).To(MatchJSON(`{"branch":"1.2"}`))
+
+			parsed, err := atc.InstanceVarsFromQueryParams(query)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(vars))
+		})
+
+		It("is empty for a pipeline that isn't instanced", func() {
+			Expect(atc.InstanceVars{}.QueryParams()).To(BeEmpty())
+
+			parsed, err := atc.InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(BeNil())
+		})
+
+		It("rejects malformed instance vars", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{"vars": {"branch"}})
+			Expect(err).To(MatchError(HavePrefix("malformed instance vars")))
+		})
+	})
+})
@@ -1,5 +1,11 @@
 package atc
 
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+)
+
 type JobConfig struct {
 	Name   string `yaml:"name" json:"name" mapstructure:"name"`
 	Public bool   `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
@@ -11,6 +17,8 @@ type JobConfig struct {
 	RawMaxInFlight       int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
 	BuildLogsToRetain    int      `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
 
+	Triggers JobTriggers `yaml:"triggers,omitempty" json:"triggers,omitempty" mapstructure:"triggers"`
+
 	Plan PlanSequence `yaml:"plan,omitempty" json:"plan,omitempty" mapstructure:"plan"`
 
 	Abort   *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
@@ -20,6 +28,30 @@ type JobConfig struct {
 	Success *PlanConfig `yaml:"on_success,omitempty" json:"on_success,omitempty" mapstructure:"on_success"`
 }
 
+// A JobTrigger creates builds of the job on a cron schedule, evaluated by the
+// scheduler rather than by checking a resource.
+type JobTrigger struct {
+	Cron     string `yaml:"cron" json:"cron" mapstructure:"cron"`
+	Timezone string `yaml:"timezone,omitempty" json:"timezone,omitempty" mapstructure:"timezone"`
+}
+
+type JobTriggers []JobTrigger
+
+// Schedule parses the trigger's cron expression.
+func (trigger JobTrigger) Schedule() (cron.Schedule, error) {
+	return cron.Parse(trigger.Cron)
+}
+
+// Location returns the timezone the cron expression is evaluated in,
+// defaulting to UTC.
+func (trigger JobTrigger) Location() (*time.Location, error) {
+	if trigger.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(trigger.Timezone)
+}
+
 func (config JobConfig) Hooks() Hooks {
 	return Hooks{Abort: config.Abort, Error: config.Error, Failure: config.Failure, Ensure: config.Ensure, Success: config.Success}
 }
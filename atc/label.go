@@ -0,0 +1,85 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LabelSelector matches pipelines by one of their labels. It is written as
+// 'key=value' to match pipelines with the label set to the value, or as just
+// 'key' to match pipelines with the label set to anything.
+type LabelSelector struct {
+	Key      string
+	Value    string
+	AnyValue bool
+}
+
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	parts := strings.SplitN(selector, "=", 2)
+
+	err := ValidateLabelKey(parts[0])
+	if err != nil {
+		return LabelSelector{}, err
+	}
+
+	if len(parts) == 1 {
+		return LabelSelector{Key: parts[0], AnyValue: true}, nil
+	}
+
+	return LabelSelector{Key: parts[0], Value: parts[1]}, nil
+}
+
+func ParseLabelSelectors(selectors []string) ([]LabelSelector, error) {
+	parsed := []LabelSelector{}
+	for _, selector := range selectors {
+		labelSelector, err := ParseLabelSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, labelSelector)
+	}
+
+	return parsed, nil
+}
+
+func (selector LabelSelector) String() string {
+	if selector.AnyValue {
+		return selector.Key
+	}
+
+	return selector.Key + "=" + selector.Value
+}
+
+func (selector LabelSelector) Matches(labels map[string]string) bool {
+	value, found := labels[selector.Key]
+	if !found {
+		return false
+	}
+
+	return selector.AnyValue || value == selector.Value
+}
+
+// MatchesLabels returns whether the labels match all of the selectors.
+func MatchesLabels(labels map[string]string, selectors []LabelSelector) bool {
+	for _, selector := range selectors {
+		if !selector.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func ValidateLabelKey(key string) error {
+	if key == "" {
+		return errors.New("label key cannot be empty")
+	}
+
+	if strings.ContainsAny(key, "=,") {
+		return fmt.Errorf("label key '%s' cannot contain '=' or ','", key)
+	}
+
+	return nil
+}
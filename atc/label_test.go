@@ -0,0 +1,68 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelector", func() {
+	Describe("ParseLabelSelector", func() {
+		It("parses 'key=value' as matching that value", func() {
+			selector, err := atc.ParseLabelSelector("env=prod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(Equal(atc.LabelSelector{Key: "env", Value: "prod"}))
+		})
+
+		It("keeps any '=' after the first in the value", func() {
+			selector, err := atc.ParseLabelSelector("query=a=b")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(Equal(atc.LabelSelector{Key: "query", Value: "a=b"}))
+		})
+
+		It("parses a bare key as matching any value", func() {
+			selector, err := atc.ParseLabelSelector("env")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(Equal(atc.LabelSelector{Key: "env", AnyValue: true}))
+		})
+
+		It("rejects an empty key", func() {
+			_, err := atc.ParseLabelSelector("=prod")
+			Expect(err).To(MatchError("label key cannot be empty"))
+		})
+	})
+
+	Describe("MatchesLabels", func() {
+		var labels map[string]string
+
+		BeforeEach(func() {
+			labels = map[string]string{
+				"env":   "prod",
+				"owner": "some-team",
+			}
+		})
+
+		It("matches when every selector matches", func() {
+			Expect(atc.MatchesLabels(labels, []atc.LabelSelector{
+				{Key: "env", Value: "prod"},
+				{Key: "owner", AnyValue: true},
+			})).To(BeTrue())
+		})
+
+		It("does not match when a value differs", func() {
+			Expect(atc.MatchesLabels(labels, []atc.LabelSelector{
+				{Key: "env", Value: "staging"},
+			})).To(BeFalse())
+		})
+
+		It("does not match when a label is missing", func() {
+			Expect(atc.MatchesLabels(labels, []atc.LabelSelector{
+				{Key: "lifecycle", AnyValue: true},
+			})).To(BeFalse())
+		})
+
+		It("matches anything with no selectors", func() {
+			Expect(atc.MatchesLabels(nil, nil)).To(BeTrue())
+		})
+	})
+})
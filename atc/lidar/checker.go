@@ -0,0 +1,216 @@
+package lidar
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/radar"
+	"github.com/tedsuo/ifrit"
+)
+
+var ErrPipelineNotFound = errors.New("pipeline not found")
+
+var ErrCheckInterrupted = errors.New("check interrupted by the ATC shutting down")
+
+//go:generate counterfeiter . ScannerFactory
+
+type ScannerFactory interface {
+	NewResourceScanner(dbPipeline db.Pipeline) radar.Scanner
+	NewResourceTypeScanner(dbPipeline db.Pipeline) radar.Scanner
+}
+
+//go:generate counterfeiter . Notifications
+
+type Notifications interface {
+	Listen(channel string) (chan bool, error)
+	Unlisten(channel string, notifier chan bool) error
+}
+
+type checker struct {
+	logger         lager.Logger
+	checkFactory   db.CheckFactory
+	scannerFactory ScannerFactory
+	notifications  Notifications
+	clock          clock.Clock
+	interval       time.Duration
+
+	inFlight chan struct{}
+	finished chan struct{}
+
+	runningLock sync.Mutex
+	running     map[int]db.Check
+}
+
+// NewChecker returns a runner which picks up queued checks and runs them,
+// with at most maxInFlight checks running at once. Every ATC runs a checker;
+// each check is only ever picked up by one of them.
+func NewChecker(
+	logger lager.Logger,
+	checkFactory db.CheckFactory,
+	scannerFactory ScannerFactory,
+	notifications Notifications,
+	clock clock.Clock,
+	interval time.Duration,
+	maxInFlight int,
+) ifrit.Runner {
+	if maxInFlight <= 0 {
+		maxInFlight = 1
+	}
+
+	return &checker{
+		logger:         logger,
+		checkFactory:   checkFactory,
+		scannerFactory: scannerFactory,
+		notifications:  notifications,
+		clock:          clock,
+		interval:       interval,
+
+		inFlight: make(chan struct{}, maxInFlight),
+		finished: make(chan struct{}, 1),
+
+		running: map[int]db.Check{},
+	}
+}
+
+func (c *checker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	notifier, err := c.notifications.Listen(db.CheckerChannel)
+	if err != nil {
+		return err
+	}
+
+	defer c.notifications.Unlisten(db.CheckerChannel, notifier)
+
+	ticker := c.clock.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	c.startChecks()
+
+	for {
+		select {
+		case <-notifier:
+			c.startChecks()
+		case <-c.finished:
+			c.startChecks()
+		case <-ticker.C():
+			c.startChecks()
+		case <-signals:
+			c.interruptChecks()
+			return nil
+		}
+	}
+}
+
+func (c *checker) startChecks() {
+	for {
+		select {
+		case c.inFlight <- struct{}{}:
+		default:
+			return
+		}
+
+		check, found, err := c.checkFactory.StartNextCheck()
+		if err != nil {
+			c.logger.Error("failed-to-start-next-check", err)
+		}
+
+		if err != nil || !found {
+			<-c.inFlight
+			return
+		}
+
+		c.runningLock.Lock()
+		c.running[check.ID()] = check
+		c.runningLock.Unlock()
+
+		go func(check db.Check) {
+			c.runCheck(check)
+
+			c.runningLock.Lock()
+			delete(c.running, check.ID())
+			c.runningLock.Unlock()
+
+			<-c.inFlight
+
+			select {
+			case c.finished <- struct{}{}:
+			default:
+			}
+		}(check)
+	}
+}
+
+func (c *checker) runCheck(check db.Check) {
+	logger := c.logger.Session("check", lager.Data{
+		"check":    check.ID(),
+		"team":     check.TeamName(),
+		"pipeline": check.PipelineName(),
+		"name":     check.Plan().Name,
+	})
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := c.scan(logger, check)
+	if err == radar.ErrFailedToAcquireLock {
+		// the same resource config is being checked elsewhere, or was checked
+		// recently enough
+		logger.Debug("check-skipped")
+
+		err = check.Skip()
+		if err != nil {
+			logger.Error("failed-to-skip-check", err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		logger.Info("check-errored", lager.Data{"error": err.Error()})
+	}
+
+	err = check.Finish(err)
+	if err != nil {
+		logger.Error("failed-to-finish-check", err)
+	}
+}
+
+// interruptChecks finishes the checks which are still running as errored,
+// rather than leaving them started once this ATC is gone. Their goroutines
+// finishing them afterwards leaves them as interrupted, as only started
+// checks can be finished.
+func (c *checker) interruptChecks() {
+	c.runningLock.Lock()
+	defer c.runningLock.Unlock()
+
+	for _, check := range c.running {
+		err := check.Finish(ErrCheckInterrupted)
+		if err != nil {
+			c.logger.Error("failed-to-interrupt-check", err, lager.Data{"check": check.ID()})
+		}
+	}
+}
+
+func (c *checker) scan(logger lager.Logger, check db.Check) error {
+	pipeline, found, err := check.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		return err
+	}
+
+	if !found {
+		return ErrPipelineNotFound
+	}
+
+	if check.ResourceID() != 0 {
+		return c.scannerFactory.NewResourceScanner(pipeline).RunCheck(logger, check)
+	}
+
+	return c.scannerFactory.NewResourceTypeScanner(pipeline).RunCheck(logger, check)
+}
@@ -0,0 +1,261 @@
+package lidar_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/lidar/lidarfakes"
+	"github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeCheckFactory        *dbfakes.FakeCheckFactory
+		fakeScannerFactory      *lidarfakes.FakeScannerFactory
+		fakeNotifications       *lidarfakes.FakeNotifications
+		fakeResourceScanner     *radarfakes.FakeScanner
+		fakeResourceTypeScanner *radarfakes.FakeScanner
+		fakePipeline            *dbfakes.FakePipeline
+		fakeClock               *fakeclock.FakeClock
+
+		checkerNotify chan bool
+		maxInFlight   int
+		pending       chan db.Check
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeScannerFactory = new(lidarfakes.FakeScannerFactory)
+		fakeNotifications = new(lidarfakes.FakeNotifications)
+		fakeResourceScanner = new(radarfakes.FakeScanner)
+		fakeResourceTypeScanner = new(radarfakes.FakeScanner)
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 123))
+
+		fakeScannerFactory.NewResourceScannerReturns(fakeResourceScanner)
+		fakeScannerFactory.NewResourceTypeScannerReturns(fakeResourceTypeScanner)
+
+		checkerNotify = make(chan bool)
+		fakeNotifications.ListenReturns(checkerNotify, nil)
+
+		pending = make(chan db.Check, 10)
+		fakeCheckFactory.StartNextCheckStub = func() (db.Check, bool, error) {
+			select {
+			case check := <-pending:
+				return check, true, nil
+			default:
+				return nil, false, nil
+			}
+		}
+
+		maxInFlight = 4
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(lidar.NewChecker(
+			lagertest.NewTestLogger("test"),
+			fakeCheckFactory,
+			fakeScannerFactory,
+			fakeNotifications,
+			fakeClock,
+			10*time.Second,
+			maxInFlight,
+		))
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		<-process.Wait()
+	})
+
+	newCheck := func(resourceID int, resourceTypeID int) *dbfakes.FakeCheck {
+		check := new(dbfakes.FakeCheck)
+		check.ResourceIDReturns(resourceID)
+		check.ResourceTypeIDReturns(resourceTypeID)
+		check.PipelineReturns(fakePipeline, true, nil)
+		return check
+	}
+
+	It("listens for queued checks", func() {
+		Expect(fakeNotifications.ListenCallCount()).To(Equal(1))
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(db.CheckerChannel))
+	})
+
+	Context("when a resource check is pending", func() {
+		var check *dbfakes.FakeCheck
+
+		BeforeEach(func() {
+			check = newCheck(42, 0)
+			pending <- check
+		})
+
+		It("runs it with the resource scanner for its pipeline", func() {
+			Eventually(check.FinishCallCount).Should(Equal(1))
+
+			Expect(fakeScannerFactory.NewResourceScannerArgsForCall(0)).To(Equal(fakePipeline))
+
+			_, runCheck := fakeResourceScanner.RunCheckArgsForCall(0)
+			Expect(runCheck).To(Equal(check))
+
+			Expect(check.FinishArgsForCall(0)).To(BeNil())
+		})
+
+		Context("when the check fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeResourceScanner.RunCheckReturns(disaster)
+			})
+
+			It("finishes the check with the error", func() {
+				Eventually(check.FinishCallCount).Should(Equal(1))
+				Expect(check.FinishArgsForCall(0)).To(Equal(disaster))
+			})
+		})
+
+		Context("when the resource config is being checked elsewhere", func() {
+			BeforeEach(func() {
+				fakeResourceScanner.RunCheckReturns(radar.ErrFailedToAcquireLock)
+			})
+
+			It("marks the check as skipped", func() {
+				Eventually(check.SkipCallCount).Should(Equal(1))
+				Expect(check.FinishCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the checker is stopped while the check is running", func() {
+			var release chan struct{}
+
+			BeforeEach(func() {
+				release = make(chan struct{})
+				fakeResourceScanner.RunCheckStub = func(lager.Logger, db.Check) error {
+					<-release
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				close(release)
+			})
+
+			It("finishes the check as interrupted", func() {
+				Eventually(fakeResourceScanner.RunCheckCallCount).Should(Equal(1))
+
+				process.Signal(os.Interrupt)
+				<-process.Wait()
+
+				Expect(check.FinishCallCount()).To(Equal(1))
+				Expect(check.FinishArgsForCall(0)).To(Equal(lidar.ErrCheckInterrupted))
+			})
+		})
+
+		Context("when the pipeline is gone", func() {
+			BeforeEach(func() {
+				check.PipelineReturns(nil, false, nil)
+			})
+
+			It("finishes the check with an error", func() {
+				Eventually(check.FinishCallCount).Should(Equal(1))
+				Expect(check.FinishArgsForCall(0)).To(Equal(lidar.ErrPipelineNotFound))
+			})
+		})
+	})
+
+	Context("when a resource type check is pending", func() {
+		var check *dbfakes.FakeCheck
+
+		BeforeEach(func() {
+			check = newCheck(0, 7)
+			pending <- check
+		})
+
+		It("runs it with the resource type scanner", func() {
+			Eventually(check.FinishCallCount).Should(Equal(1))
+
+			Expect(fakeResourceScanner.RunCheckCallCount()).To(BeZero())
+
+			_, runCheck := fakeResourceTypeScanner.RunCheckArgsForCall(0)
+			Expect(runCheck).To(Equal(check))
+		})
+	})
+
+	Context("when more checks are pending than may run at once", func() {
+		var (
+			checks  []*dbfakes.FakeCheck
+			release chan struct{}
+		)
+
+		BeforeEach(func() {
+			maxInFlight = 2
+
+			checks = []*dbfakes.FakeCheck{}
+			for i := 1; i <= 3; i++ {
+				check := newCheck(i, 0)
+				checks = append(checks, check)
+				pending <- check
+			}
+
+			release = make(chan struct{})
+			fakeResourceScanner.RunCheckStub = func(lager.Logger, db.Check) error {
+				<-release
+				return nil
+			}
+		})
+
+		It("only starts as many as may run at once, and the rest as they finish", func() {
+			Eventually(fakeResourceScanner.RunCheckCallCount).Should(Equal(2))
+			Consistently(fakeResourceScanner.RunCheckCallCount).Should(Equal(2))
+
+			release <- struct{}{}
+
+			Eventually(fakeResourceScanner.RunCheckCallCount).Should(Equal(3))
+
+			close(release)
+
+			for _, check := range checks {
+				Eventually(check.FinishCallCount).Should(Equal(1))
+			}
+		})
+	})
+
+	Context("when a check is queued while idle", func() {
+		var check *dbfakes.FakeCheck
+
+		BeforeEach(func() {
+			check = newCheck(42, 0)
+		})
+
+		It("starts it when notified", func() {
+			Eventually(fakeCheckFactory.StartNextCheckCallCount).Should(Equal(1))
+
+			pending <- check
+			checkerNotify <- true
+
+			Eventually(check.FinishCallCount).Should(Equal(1))
+		})
+
+		It("starts it on the next tick", func() {
+			Eventually(fakeCheckFactory.StartNextCheckCallCount).Should(Equal(1))
+
+			pending <- check
+			fakeClock.WaitForWatcherAndIncrement(10 * time.Second)
+
+			Eventually(check.FinishCallCount).Should(Equal(1))
+		})
+	})
+})
@@ -1,10 +1,10 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package radarfakes
+package lidarfakes
 
 import (
-	"sync"
+	sync "sync"
 
-	"github.com/concourse/concourse/atc/radar"
+	lidar "github.com/concourse/concourse/atc/lidar"
 )
 
 type FakeNotifications struct {
@@ -187,4 +187,4 @@ func (fake *FakeNotifications) recordInvocation(key string, args []interface{})
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ radar.Notifications = new(FakeNotifications)
+var _ lidar.Notifications = new(FakeNotifications)
@@ -1,11 +1,11 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package resourceserverfakes
+package lidarfakes
 
 import (
 	sync "sync"
 
-	resourceserver "github.com/concourse/concourse/atc/api/resourceserver"
 	db "github.com/concourse/concourse/atc/db"
+	lidar "github.com/concourse/concourse/atc/lidar"
 	radar "github.com/concourse/concourse/atc/radar"
 )
 
@@ -182,4 +182,4 @@ func (fake *FakeScannerFactory) recordInvocation(key string, args []interface{})
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ resourceserver.ScannerFactory = new(FakeScannerFactory)
+var _ lidar.ScannerFactory = new(FakeScannerFactory)
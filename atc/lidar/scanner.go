@@ -0,0 +1,111 @@
+package lidar
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/atc/radar"
+)
+
+type scanner struct {
+	pipelineFactory db.PipelineFactory
+	checkFactory    db.CheckFactory
+
+	resourceCheckingInterval     time.Duration
+	resourceTypeCheckingInterval time.Duration
+	checkIntervalPolicy          radar.CheckIntervalPolicy
+}
+
+// NewScanner returns a task which queues a check for each resource and
+// resource type of every unpaused pipeline once its check interval has
+// elapsed. Resources and resource types configured to check every 'never' are
+// skipped. It is meant to be run on a single ATC at a time.
+func NewScanner(
+	pipelineFactory db.PipelineFactory,
+	checkFactory db.CheckFactory,
+	resourceCheckingInterval time.Duration,
+	resourceTypeCheckingInterval time.Duration,
+	checkIntervalPolicy radar.CheckIntervalPolicy,
+) lockrunner.Task {
+	return &scanner{
+		pipelineFactory: pipelineFactory,
+		checkFactory:    checkFactory,
+
+		resourceCheckingInterval:     resourceCheckingInterval,
+		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+		checkIntervalPolicy:          checkIntervalPolicy,
+	}
+}
+
+func (s *scanner) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("scanner")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := s.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	for _, pipeline := range pipelines {
+		if pipeline.Paused() {
+			continue
+		}
+
+		pipelineLogger := logger.WithData(lager.Data{
+			"team":     pipeline.TeamName(),
+			"pipeline": pipeline.Name(),
+		})
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			pipelineLogger.Error("failed-to-get-resource-types", err)
+			continue
+		}
+
+		for _, resourceType := range resourceTypes {
+			s.tryCreateCheck(pipelineLogger, resourceType, s.resourceTypeCheckingInterval)
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			pipelineLogger.Error("failed-to-get-resources", err)
+			continue
+		}
+
+		for _, resource := range resources {
+			s.tryCreateCheck(pipelineLogger, resource, s.resourceCheckingInterval)
+		}
+	}
+
+	return nil
+}
+
+func (s *scanner) tryCreateCheck(logger lager.Logger, checkable db.Checkable, defaultInterval time.Duration) {
+	if checkable.CheckEvery() == atc.CheckEveryNever {
+		return
+	}
+
+	interval, err := s.checkIntervalPolicy.Interval(checkable, defaultInterval, time.Now())
+	if err != nil {
+		// an invalid interval is reported as the check's error once it runs
+		interval = defaultInterval
+	}
+
+	created, err := s.checkFactory.TryCreateCheck(checkable, interval)
+	if err != nil {
+		logger.Error("failed-to-create-check", err, lager.Data{"name": checkable.Name()})
+		return
+	}
+
+	if created {
+		logger.Debug("created-check", lager.Data{"name": checkable.Name()})
+	}
+}
@@ -0,0 +1,156 @@
+package lidar_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/atc/radar"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scanner", func() {
+	var (
+		fakePipelineFactory *dbfakes.FakePipelineFactory
+		fakeCheckFactory    *dbfakes.FakeCheckFactory
+		fakePipeline        *dbfakes.FakePipeline
+		fakeResource        *dbfakes.FakeResource
+		fakeResourceType    *dbfakes.FakeResourceType
+		intervalPolicy      radar.CheckIntervalPolicy
+
+		scanner lockrunner.Task
+		runErr  error
+	)
+
+	BeforeEach(func() {
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.NameReturns("some-resource")
+
+		fakeResourceType = new(dbfakes.FakeResourceType)
+		fakeResourceType.NameReturns("some-type")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+		fakePipeline.ResourceTypesReturns(db.ResourceTypes{fakeResourceType}, nil)
+
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+
+		intervalPolicy = radar.CheckIntervalPolicy{}
+	})
+
+	JustBeforeEach(func() {
+		scanner = lidar.NewScanner(fakePipelineFactory, fakeCheckFactory, time.Minute, 2*time.Minute, intervalPolicy)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = scanner.Run(ctx)
+	})
+
+	It("tries to create a check for each resource type and resource with the default intervals", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(2))
+
+		checkable, interval := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+		Expect(checkable).To(Equal(fakeResourceType))
+		Expect(interval).To(Equal(2 * time.Minute))
+
+		checkable, interval = fakeCheckFactory.TryCreateCheckArgsForCall(1)
+		Expect(checkable).To(Equal(fakeResource))
+		Expect(interval).To(Equal(time.Minute))
+	})
+
+	Context("when the resource has a check interval configured", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns("10s")
+		})
+
+		It("uses it", func() {
+			_, interval := fakeCheckFactory.TryCreateCheckArgsForCall(1)
+			Expect(interval).To(Equal(10 * time.Second))
+		})
+	})
+
+	Context("when the resource's check interval is invalid", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns("bogus")
+		})
+
+		It("still creates a check, with the default interval", func() {
+			_, interval := fakeCheckFactory.TryCreateCheckArgsForCall(1)
+			Expect(interval).To(Equal(time.Minute))
+		})
+	})
+
+	Context("when the resource is configured to check every 'never'", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns(atc.CheckEveryNever)
+		})
+
+		It("only creates a check for the resource type", func() {
+			Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+
+			checkable, _ := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(checkable).To(Equal(fakeResourceType))
+		})
+	})
+
+	Context("when adaptive check intervals are enabled", func() {
+		BeforeEach(func() {
+			intervalPolicy = radar.CheckIntervalPolicy{
+				Adaptive:    true,
+				MaxInterval: time.Hour,
+			}
+
+			fakeResource.CheckFailuresReturns(2)
+		})
+
+		It("backs off the interval of failing resources", func() {
+			_, interval := fakeCheckFactory.TryCreateCheckArgsForCall(1)
+			Expect(interval).To(Equal(4 * time.Minute))
+		})
+	})
+
+	Context("when the pipeline is paused", func() {
+		BeforeEach(func() {
+			fakePipeline.PausedReturns(true)
+		})
+
+		It("does not create any checks", func() {
+			Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when creating a check fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.TryCreateCheckReturnsOnCall(0, false, errors.New("nope"))
+		})
+
+		It("carries on with the other checks", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when getting the pipelines fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakePipelineFactory.AllPipelinesReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
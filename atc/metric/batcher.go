@@ -0,0 +1,117 @@
+package metric
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// FlushFunc sends a batch of events to a backend.
+type FlushFunc func(lager.Logger, []Event) error
+
+// Batcher buffers events in a bounded queue and flushes them in batches from
+// a single goroutine, so that a slow backend never blocks the emit loop.
+// Events are dropped when the queue is full.
+type Batcher struct {
+	flush     FlushFunc
+	batchSize int
+	interval  time.Duration
+
+	queue chan eventEmission
+	done  chan struct{}
+	wg    *sync.WaitGroup
+}
+
+func NewBatcher(flush FlushFunc, batchSize int, queueSize int, interval time.Duration) *Batcher {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	if queueSize < batchSize {
+		queueSize = batchSize
+	}
+
+	batcher := &Batcher{
+		flush:     flush,
+		batchSize: batchSize,
+		interval:  interval,
+
+		queue: make(chan eventEmission, queueSize),
+		done:  make(chan struct{}),
+		wg:    new(sync.WaitGroup),
+	}
+
+	batcher.wg.Add(1)
+	go batcher.run()
+
+	return batcher
+}
+
+// Add queues the event to be flushed with the next batch.
+func (batcher *Batcher) Add(logger lager.Logger, event Event) {
+	select {
+	case batcher.queue <- eventEmission{logger: logger, event: event}:
+	default:
+		logger.Error("batch-queue-full", nil)
+	}
+}
+
+// Stop flushes any queued events and stops the batcher.
+func (batcher *Batcher) Stop() {
+	close(batcher.done)
+	batcher.wg.Wait()
+}
+
+func (batcher *Batcher) run() {
+	defer batcher.wg.Done()
+
+	ticker := time.NewTicker(batcher.interval)
+	defer ticker.Stop()
+
+	var logger lager.Logger
+	batch := make([]Event, 0, batcher.batchSize)
+
+	add := func(emission eventEmission) {
+		logger = emission.logger
+		batch = append(batch, emission.event)
+
+		if len(batch) >= batcher.batchSize {
+			batch = batcher.send(logger, batch)
+		}
+	}
+
+	for {
+		select {
+		case emission := <-batcher.queue:
+			add(emission)
+
+		case <-ticker.C:
+			batch = batcher.send(logger, batch)
+
+		case <-batcher.done:
+			for {
+				select {
+				case emission := <-batcher.queue:
+					add(emission)
+				default:
+					batcher.send(logger, batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (batcher *Batcher) send(logger lager.Logger, batch []Event) []Event {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	err := batcher.flush(logger, batch)
+	if err != nil {
+		logger.Error("failed-to-flush-events", err, lager.Data{"events": len(batch)})
+	}
+
+	return make([]Event, 0, batcher.batchSize)
+}
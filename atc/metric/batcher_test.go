@@ -0,0 +1,118 @@
+package metric_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Batcher", func() {
+	var (
+		logger *lagertest.TestLogger
+
+		flushed   [][]Event
+		flushedL  sync.Mutex
+		flushErr  error
+		unblock   chan struct{}
+		batchSize int
+		queueSize int
+
+		batcher *Batcher
+	)
+
+	flushedBatches := func() [][]Event {
+		flushedL.Lock()
+		defer flushedL.Unlock()
+		return flushed
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		flushed = nil
+		flushErr = nil
+		unblock = nil
+		batchSize = 2
+		queueSize = 10
+	})
+
+	JustBeforeEach(func() {
+		batcher = NewBatcher(func(logger lager.Logger, events []Event) error {
+			if unblock != nil {
+				<-unblock
+			}
+
+			flushedL.Lock()
+			flushed = append(flushed, events)
+			flushedL.Unlock()
+
+			return flushErr
+		}, batchSize, queueSize, time.Hour)
+	})
+
+	It("flushes full batches", func() {
+		batcher.Add(logger, Event{Name: "a"})
+		batcher.Add(logger, Event{Name: "b"})
+		batcher.Add(logger, Event{Name: "c"})
+
+		Eventually(flushedBatches).Should(Equal([][]Event{
+			{{Name: "a"}, {Name: "b"}},
+		}))
+
+		batcher.Stop()
+
+		Expect(flushedBatches()).To(Equal([][]Event{
+			{{Name: "a"}, {Name: "b"}},
+			{{Name: "c"}},
+		}))
+	})
+
+	Context("when the backend fails", func() {
+		BeforeEach(func() {
+			flushErr = errors.New("nope")
+		})
+
+		It("logs the error and carries on", func() {
+			batcher.Add(logger, Event{Name: "a"})
+			batcher.Add(logger, Event{Name: "b"})
+			batcher.Add(logger, Event{Name: "c"})
+			batcher.Stop()
+
+			Expect(flushedBatches()).To(HaveLen(2))
+			Expect(logger.LogMessages()).To(ContainElement("test.failed-to-flush-events"))
+		})
+	})
+
+	Context("when the backend is slow", func() {
+		BeforeEach(func() {
+			unblock = make(chan struct{})
+			batchSize = 1
+			queueSize = 1
+		})
+
+		It("drops events rather than blocking", func() {
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+
+				for i := 0; i < 10; i++ {
+					batcher.Add(logger, Event{Name: "a"})
+				}
+			}()
+
+			Eventually(done).Should(BeClosed())
+			Expect(logger.LogMessages()).To(ContainElement("test.batch-queue-full"))
+
+			close(unblock)
+			batcher.Stop()
+		})
+	})
+})
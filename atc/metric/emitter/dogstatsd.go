@@ -85,9 +85,15 @@ func (config *DogstatsDBConfig) NewEmitter() (metric.Emitter, error) {
 
 var specialChars = regexp.MustCompile("[^a-zA-Z0-9_]+")
 
+// metricName converts an event name such as "scheduling: full duration (ms)"
+// into a metric name such as "scheduling_full_duration_ms".
+func metricName(eventName string) string {
+	return specialChars.ReplaceAllString(strings.Replace(strings.ToLower(eventName), " ", "_", -1), "")
+}
+
 func (emitter *DogstatsdEmitter) Emit(logger lager.Logger, event metric.Event) {
 
-	name := specialChars.ReplaceAllString(strings.Replace(strings.ToLower(event.Name), " ", "_", -1), "")
+	name := metricName(event.Name)
 
 	tags := []string{
 		fmt.Sprintf("host:%s", event.Host),
@@ -0,0 +1,99 @@
+package emitter
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+const graphiteTimeout = 10 * time.Second
+
+type GraphiteEmitter struct {
+	address   string
+	conn      net.Conn
+	templates metricTemplates
+	batcher   *metric.Batcher
+}
+
+type GraphiteConfig struct {
+	Host      string            `long:"graphite-host"     description:"Graphite (carbon) server host to emit metrics to using the plaintext protocol."`
+	Port      string            `long:"graphite-port"     description:"Graphite (carbon) server port to emit metrics to using the plaintext protocol."`
+	Prefix    string            `long:"graphite-prefix"   default:"concourse" description:"Prefix for all metric names."`
+	Templates map[string]string `long:"graphite-template" description:"Naming template for a metric, e.g. build_finished:builds.{team_name}.{pipeline}.{job}. May refer to {name}, {host}, {state} and the metric's attributes. Can be specified multiple times." value-name:"METRIC:TEMPLATE"`
+
+	FlushInterval time.Duration `long:"graphite-flush-interval" default:"10s"  description:"Interval on which to send buffered metrics."`
+	QueueSize     int           `long:"graphite-queue-size"     default:"1000" description:"Maximum number of metrics to buffer before dropping them."`
+}
+
+func init() {
+	metric.RegisterEmitter(&GraphiteConfig{})
+}
+
+func (config *GraphiteConfig) Description() string { return "Graphite" }
+func (config *GraphiteConfig) IsConfigured() bool  { return config.Host != "" && config.Port != "" }
+
+func (config *GraphiteConfig) NewEmitter() (metric.Emitter, error) {
+	emitter := &GraphiteEmitter{
+		address: net.JoinHostPort(config.Host, config.Port),
+		templates: metricTemplates{
+			prefix:    config.Prefix,
+			templates: config.Templates,
+		},
+	}
+
+	emitter.batcher = metric.NewBatcher(emitter.send, 500, config.QueueSize, config.FlushInterval)
+
+	return emitter, nil
+}
+
+func (emitter *GraphiteEmitter) Emit(logger lager.Logger, event metric.Event) {
+	emitter.batcher.Add(logger, event)
+}
+
+func (emitter *GraphiteEmitter) send(logger lager.Logger, events []metric.Event) error {
+	payload := new(bytes.Buffer)
+
+	for _, event := range events {
+		value, err := getFloatHelper(event.Value)
+		if err != nil {
+			logger.Error("failed-to-convert-metric-for-graphite", nil, lager.Data{
+				"metric-name": event.Name,
+			})
+			continue
+		}
+
+		fmt.Fprintf(payload, "%s %s %d\n", emitter.templates.name(event), strconv.FormatFloat(value, 'f', -1, 64), event.Time.Unix())
+	}
+
+	if payload.Len() == 0 {
+		return nil
+	}
+
+	if emitter.conn == nil {
+		conn, err := net.DialTimeout("tcp", emitter.address, graphiteTimeout)
+		if err != nil {
+			return err
+		}
+
+		emitter.conn = conn
+	}
+
+	err := emitter.conn.SetWriteDeadline(time.Now().Add(graphiteTimeout))
+	if err == nil {
+		_, err = emitter.conn.Write(payload.Bytes())
+	}
+
+	if err != nil {
+		// reconnect on the next flush
+		emitter.conn.Close()
+		emitter.conn = nil
+		return err
+	}
+
+	return nil
+}
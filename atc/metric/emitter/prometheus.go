@@ -36,22 +36,41 @@ type PrometheusEmitter struct {
 
 	pipelineScheduled *prometheus.CounterVec
 
-	resourceChecksVec *prometheus.CounterVec
+	jobBuildDurationsVec *prometheus.HistogramVec
+	jobsPendingBuilds    *prometheus.GaugeVec
+
+	resourceChecksVec            *prometheus.CounterVec
+	resourceCheckDurations       *prometheus.HistogramVec
+	resourceCheckErrorsTotal     *prometheus.CounterVec
+	resourceVersionsDeletedTotal prometheus.Counter
 
 	schedulingFullDuration    *prometheus.CounterVec
 	schedulingLoadingDuration *prometheus.CounterVec
 
-	workerContainers  *prometheus.GaugeVec
-	workerVolumes     *prometheus.GaugeVec
-	workersRegistered *prometheus.GaugeVec
+	stepDurationsVec *prometheus.HistogramVec
+
+	volumesStreamedBytes     *prometheus.HistogramVec
+	volumesStreamingDuration *prometheus.HistogramVec
+
+	workerContainers         *prometheus.GaugeVec
+	workerSelectionDurations *prometheus.HistogramVec
+	workerTasksRunning       *prometheus.GaugeVec
+	workerVolumes            *prometheus.GaugeVec
+	workersRegistered        *prometheus.GaugeVec
+
+	labels metric.LabelFilter
 
 	workerLastSeen map[string]time.Time
+	workerTasks    map[string]int
 	mu             sync.Mutex
 }
 
 type PrometheusConfig struct {
 	BindIP   string `long:"prometheus-bind-ip" description:"IP to listen on to expose Prometheus metrics."`
 	BindPort string `long:"prometheus-bind-port" description:"Port to listen on to expose Prometheus metrics."`
+
+	AllowedLabels      []string `long:"prometheus-allow-label"       description:"Only attach the given label (team, pipeline, job, step_type, worker or resource_type) to per-build, per-step, per-worker and per-check metrics. Can be specified multiple times. All labels are attached if none are given. The per-job build duration histogram is only reported if 'job' is given."`
+	AllowedLabelValues []string `long:"prometheus-allow-label-value" description:"Only report the given value for a label, reporting any other value as 'other'. Can be specified multiple times." value-name:"LABEL:VALUE"`
 }
 
 func init() {
@@ -67,6 +86,11 @@ func (config *PrometheusConfig) bind() string {
 }
 
 func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
+	labels, err := metric.NewLabelFilter(config.AllowedLabels, config.AllowedLabelValues)
+	if err != nil {
+		return nil, err
+	}
+
 	// error log metrics
 	errorLogs := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -159,6 +183,43 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	// job metrics
+	jobBuildDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "build_duration_seconds",
+			Help:      "Build time per job in seconds",
+			Buckets:   []float64{1, 60, 180, 300, 600, 900, 1200, 1800, 2700, 3600, 7200, 18000, 36000},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobBuildDurationsVec)
+
+	// step metrics
+	stepDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Step time in seconds",
+			Buckets:   []float64{1, 5, 15, 30, 60, 180, 300, 600, 900, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step_type"},
+	)
+	prometheus.MustRegister(stepDurationsVec)
+
+	jobsPendingBuilds := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "pending_builds",
+			Help:      "Number of pending builds per job",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobsPendingBuilds)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -193,6 +254,53 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workersRegistered)
 
+	workerSelectionDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "selection_duration_seconds",
+			Help:      "Time taken to select a worker for a container in seconds",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerSelectionDurations)
+
+	workerTasksRunning := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "tasks_running",
+			Help:      "Number of tasks running per worker",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerTasksRunning)
+
+	// volume metrics
+	volumesStreamedBytes := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streamed_bytes",
+			Help:      "Size of inputs streamed to a worker in bytes",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 12),
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(volumesStreamedBytes)
+
+	volumesStreamingDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streaming_duration_seconds",
+			Help:      "Time taken to stream inputs to a worker in seconds",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 180, 300, 600},
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(volumesStreamingDuration)
+
 	// http metrics
 	httpRequestsDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -269,6 +377,37 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	resourceCheckDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "check_duration_seconds",
+			Help:      "Resource check time in seconds",
+			Buckets:   []float64{0.5, 1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"resource_type"},
+	)
+	prometheus.MustRegister(resourceCheckDurations)
+
+	resourceCheckErrorsTotal := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "check_errors_total",
+			Help:      "Counts the number of resource checks which failed",
+		},
+		[]string{"resource_type"},
+	)
+	prometheus.MustRegister(resourceCheckErrorsTotal)
+
+	resourceVersionsDeletedTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "concourse",
+		Subsystem: "resource",
+		Name:      "versions_deleted_total",
+		Help:      "Total number of resource versions removed for exceeding their version history",
+	})
+	prometheus.MustRegister(resourceVersionsDeletedTotal)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -295,17 +434,33 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		locksHeld: locksHeld,
 
+		jobBuildDurationsVec: jobBuildDurationsVec,
+		jobsPendingBuilds:    jobsPendingBuilds,
+
 		pipelineScheduled: pipelineScheduled,
 
-		resourceChecksVec: resourceChecksVec,
+		resourceChecksVec:            resourceChecksVec,
+		resourceCheckDurations:       resourceCheckDurations,
+		resourceCheckErrorsTotal:     resourceCheckErrorsTotal,
+		resourceVersionsDeletedTotal: resourceVersionsDeletedTotal,
 
 		schedulingFullDuration:    schedulingFullDuration,
 		schedulingLoadingDuration: schedulingLoadingDuration,
 
-		workerContainers:  workerContainers,
-		workersRegistered: workersRegistered,
-		workerLastSeen:    map[string]time.Time{},
-		workerVolumes:     workerVolumes,
+		stepDurationsVec: stepDurationsVec,
+
+		volumesStreamedBytes:     volumesStreamedBytes,
+		volumesStreamingDuration: volumesStreamingDuration,
+
+		workerContainers:         workerContainers,
+		workerSelectionDurations: workerSelectionDurations,
+		workerTasksRunning:       workerTasksRunning,
+		workersRegistered:        workersRegistered,
+		workerLastSeen:           map[string]time.Time{},
+		workerTasks:              map[string]int{},
+		workerVolumes:            workerVolumes,
+
+		labels: labels,
 	}
 	go emitter.periodicMetricGC()
 
@@ -350,6 +505,22 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "resource check duration (ms)":
+		emitter.resourceCheckDurationMetric(logger, event)
+	case "resource versions deleted":
+		emitter.resourceVersionsDeletedMetric(logger, event)
+	case "step finished":
+		emitter.stepFinishedMetric(logger, event)
+	case "pending builds":
+		emitter.pendingBuildsMetric(logger, event)
+	case "worker selection duration (ms)":
+		emitter.workerSelectionMetric(logger, event)
+	case "tasks running":
+		emitter.tasksRunningMetric(logger, event)
+	case "volume streamed bytes":
+		emitter.volumeStreamingMetrics(logger, event)
+	case "volume streaming duration (ms)":
+		emitter.volumeStreamingMetrics(logger, event)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -408,7 +579,12 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 		logger.Error("failed-to-find-build_status-in-event", fmt.Errorf("expected build_status to exist in event.Attributes"))
 		return
 	}
-	emitter.buildsFinishedVec.WithLabelValues(team, pipeline, job, buildStatus).Inc()
+	emitter.buildsFinishedVec.WithLabelValues(
+		emitter.labels.Value("team", team),
+		emitter.labels.Value("pipeline", pipeline),
+		emitter.labels.Value("job", job),
+		buildStatus,
+	).Inc()
 
 	// concourse_builds_(aborted|succeeded|failed|errored)_total
 	switch buildStatus {
@@ -435,6 +611,88 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	// seconds are the standard prometheus base unit for time
 	duration = duration / 1000
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline).Observe(duration)
+
+	// concourse_jobs_build_duration_seconds
+	if emitter.labels.Allows("job") {
+		emitter.jobBuildDurationsVec.WithLabelValues(
+			emitter.labels.Value("team", team),
+			emitter.labels.Value("pipeline", pipeline),
+			emitter.labels.Value("job", job),
+		).Observe(duration)
+	}
+}
+
+func (emitter *PrometheusEmitter) stepFinishedMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	stepType, exists := event.Attributes["step_type"]
+	if !exists {
+		logger.Error("failed-to-find-step-type-in-event", fmt.Errorf("expected step_type to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("step-finished-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// concourse_steps_duration_seconds
+	emitter.stepDurationsVec.WithLabelValues(
+		emitter.labels.Value("team", team),
+		emitter.labels.Value("pipeline", pipeline),
+		emitter.labels.Value("job", job),
+		emitter.labels.Value("step_type", stepType),
+	).Observe(duration / 1000)
+}
+
+func (emitter *PrometheusEmitter) pendingBuildsMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	builds, ok := event.Value.(int)
+	if !ok {
+		logger.Error("pending-builds-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	// concourse_jobs_pending_builds
+	emitter.jobsPendingBuilds.WithLabelValues(
+		emitter.labels.Value("team", team),
+		emitter.labels.Value("pipeline", pipeline),
+		emitter.labels.Value("job", job),
+	).Set(float64(builds))
 }
 
 func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, event metric.Event) {
@@ -458,6 +716,89 @@ func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, ev
 	emitter.workerContainers.WithLabelValues(worker, platform).Set(float64(containers))
 }
 
+func (emitter *PrometheusEmitter) workerSelectionMetric(logger lager.Logger, event metric.Event) {
+	worker, exists := event.Attributes["worker"]
+	if !exists {
+		logger.Error("failed-to-find-worker-in-event", fmt.Errorf("expected worker to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("worker-selection-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// concourse_workers_selection_duration_seconds
+	emitter.workerSelectionDurations.WithLabelValues(emitter.labels.Value("worker", worker)).Observe(duration / 1000)
+}
+
+func (emitter *PrometheusEmitter) tasksRunningMetric(logger lager.Logger, event metric.Event) {
+	worker, exists := event.Attributes["worker"]
+	if !exists {
+		logger.Error("failed-to-find-worker-in-event", fmt.Errorf("expected worker to exist in event.Attributes"))
+		return
+	}
+
+	tasks, ok := event.Value.(int)
+	if !ok {
+		logger.Error("tasks-running-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	emitter.workerTasks[worker] = tasks
+	emitter.setTasksRunning(emitter.labels.Value("worker", worker))
+}
+
+// setTasksRunning sets the gauge from the last count reported for each worker
+// reported under the label, as workers may be collapsed by the allow-lists.
+func (emitter *PrometheusEmitter) setTasksRunning(label string) {
+	total := 0
+	for worker, tasks := range emitter.workerTasks {
+		if emitter.labels.Value("worker", worker) == label {
+			total += tasks
+		}
+	}
+
+	// concourse_workers_tasks_running
+	emitter.workerTasksRunning.WithLabelValues(label).Set(float64(total))
+}
+
+func (emitter *PrometheusEmitter) volumeStreamingMetrics(logger lager.Logger, event metric.Event) {
+	worker, exists := event.Attributes["worker"]
+	if !exists {
+		logger.Error("failed-to-find-worker-in-event", fmt.Errorf("expected worker to exist in event.Attributes"))
+		return
+	}
+
+	worker = emitter.labels.Value("worker", worker)
+
+	switch event.Name {
+	case "volume streamed bytes":
+		bytes, ok := event.Value.(int)
+		if !ok {
+			logger.Error("volume-streamed-bytes-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+			return
+		}
+
+		// concourse_volumes_streamed_bytes
+		emitter.volumesStreamedBytes.WithLabelValues(worker).Observe(float64(bytes))
+	case "volume streaming duration (ms)":
+		duration, ok := event.Value.(float64)
+		if !ok {
+			logger.Error("volume-streaming-duration-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+			return
+		}
+
+		// concourse_volumes_streaming_duration_seconds
+		emitter.volumesStreamingDuration.WithLabelValues(worker).Observe(duration / 1000)
+	default:
+	}
+}
+
 func (emitter *PrometheusEmitter) workersRegisteredMetric(logger lager.Logger, event metric.Event) {
 	state, exists := event.Attributes["state"]
 	if !exists {
@@ -585,6 +926,40 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
 }
 
+func (emitter *PrometheusEmitter) resourceVersionsDeletedMetric(logger lager.Logger, event metric.Event) {
+	value, ok := event.Value.(int)
+	if !ok {
+		logger.Error("resource-versions-deleted-value-type-mismatch", fmt.Errorf("expected event.Value to be a int"))
+		return
+	}
+
+	emitter.resourceVersionsDeletedTotal.Add(float64(value))
+}
+
+func (emitter *PrometheusEmitter) resourceCheckDurationMetric(logger lager.Logger, event metric.Event) {
+	resourceType, exists := event.Attributes["resource_type"]
+	if !exists {
+		logger.Error("failed-to-find-resource-type-in-event", fmt.Errorf("expected resource_type to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("resource-check-duration-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	resourceType = emitter.labels.Value("resource_type", resourceType)
+
+	// concourse_resource_check_duration_seconds
+	emitter.resourceCheckDurations.WithLabelValues(resourceType).Observe(duration / 1000)
+
+	// concourse_resource_check_errors_total
+	if event.Attributes["success"] == "false" {
+		emitter.resourceCheckErrorsTotal.WithLabelValues(resourceType).Inc()
+	}
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
@@ -608,7 +983,24 @@ func (emitter *PrometheusEmitter) periodicMetricGC() {
 					emitter.workerContainers.DeleteLabelValues(worker, platform)
 					emitter.workerVolumes.DeleteLabelValues(worker, platform)
 				}
+
+				// only remove series which belong to this worker alone, i.e. ones
+				// which haven't been collapsed by the label allow-lists
+				label := emitter.labels.Value("worker", worker)
+				if label == worker {
+					emitter.workerSelectionDurations.DeleteLabelValues(worker)
+					emitter.workerTasksRunning.DeleteLabelValues(worker)
+					emitter.volumesStreamedBytes.DeleteLabelValues(worker)
+					emitter.volumesStreamingDuration.DeleteLabelValues(worker)
+				}
 				delete(emitter.workerLastSeen, worker)
+
+				if _, found := emitter.workerTasks[worker]; found {
+					delete(emitter.workerTasks, worker)
+					if label != worker {
+						emitter.setTasksRunning(label)
+					}
+				}
 			}
 		}
 		emitter.mu.Unlock()
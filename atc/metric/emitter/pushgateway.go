@@ -0,0 +1,286 @@
+package emitter
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+var validLabelName = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+
+type PushgatewayEmitter struct {
+	url    string
+	job    string
+	client *http.Client
+
+	clock     clock.Clock
+	registry  *prometheus.Registry
+	metrics   map[string]*pushgatewayMetric
+	seriesTTL time.Duration
+
+	labelNames []string
+	labels     metric.LabelFilter
+
+	batcher *metric.Batcher
+}
+
+type PushgatewayConfig struct {
+	URL string `long:"pushgateway-url" description:"Prometheus Pushgateway URL to push metrics to."`
+	Job string `long:"pushgateway-job" default:"concourse" description:"Job name to push metrics under."`
+
+	Labels      []string `long:"pushgateway-label"       description:"Attach the given attribute (e.g. team_name, pipeline or worker) to pushed metrics as a label. Can be specified multiple times."`
+	LabelValues []string `long:"pushgateway-label-value" description:"Only report the given value for a label, reporting any other value as 'other'. Can be specified multiple times." value-name:"LABEL:VALUE"`
+
+	PushInterval time.Duration `long:"pushgateway-push-interval" default:"15s"  description:"Interval on which to push metrics."`
+	QueueSize    int           `long:"pushgateway-queue-size"    default:"1000" description:"Maximum number of metrics to buffer before dropping them."`
+	SeriesTTL    time.Duration `long:"pushgateway-series-ttl"    default:"10m"  description:"Stop pushing a labelled series once it has not been reported for this long."`
+}
+
+func init() {
+	metric.RegisterEmitter(&PushgatewayConfig{})
+}
+
+func (config *PushgatewayConfig) Description() string { return "Prometheus Pushgateway" }
+func (config *PushgatewayConfig) IsConfigured() bool  { return config.URL != "" }
+
+func (config *PushgatewayConfig) NewEmitter() (metric.Emitter, error) {
+	labels, err := metric.NewLabelFilter(config.Labels, config.LabelValues)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, label := range config.Labels {
+		if !validLabelName.MatchString(label) {
+			return nil, fmt.Errorf("invalid label name '%s'", label)
+		}
+	}
+
+	labelNames := append([]string{}, config.Labels...)
+	sort.Strings(labelNames)
+
+	emitter := &PushgatewayEmitter{
+		url:    config.URL,
+		job:    config.Job,
+		client: &http.Client{Timeout: time.Minute},
+
+		clock:     clock.NewClock(),
+		registry:  prometheus.NewRegistry(),
+		metrics:   map[string]*pushgatewayMetric{},
+		seriesTTL: config.SeriesTTL,
+
+		labelNames: labelNames,
+		labels:     labels,
+	}
+
+	emitter.batcher = metric.NewBatcher(emitter.push, 1000, config.QueueSize, config.PushInterval)
+
+	return emitter, nil
+}
+
+func (emitter *PushgatewayEmitter) Emit(logger lager.Logger, event metric.Event) {
+	emitter.batcher.Add(logger, event)
+}
+
+// push records each event and pushes all metrics to the gateway, replacing
+// the ones previously pushed by this ATC.
+func (emitter *PushgatewayEmitter) push(logger lager.Logger, events []metric.Event) error {
+	var host string
+
+	now := emitter.clock.Now()
+
+	for _, event := range events {
+		value, err := getFloatHelper(event.Value)
+		if err != nil {
+			logger.Error("failed-to-convert-metric-for-pushgateway", nil, lager.Data{
+				"metric-name": event.Name,
+			})
+			continue
+		}
+
+		labelValues := make([]string, len(emitter.labelNames))
+		for i, label := range emitter.labelNames {
+			labelValues[i] = emitter.labels.Value(label, event.Attributes[label])
+		}
+
+		emitter.metric(event).record(labelValues, value, now)
+
+		host = event.Host
+	}
+
+	for _, metric := range emitter.metrics {
+		metric.expire(now.Add(-emitter.seriesTTL))
+	}
+
+	pusher := push.New(emitter.url, emitter.job).
+		Gatherer(emitter.registry).
+		Client(emitter.client)
+
+	if host != "" {
+		pusher = pusher.Grouping("instance", host)
+	}
+
+	return pusher.Push()
+}
+
+func (emitter *PushgatewayEmitter) metric(event metric.Event) *pushgatewayMetric {
+	name := metricName(event.Name)
+
+	metric, found := emitter.metrics[name]
+	if !found {
+		metric = newPushgatewayMetric(event, name, emitter.labelNames)
+		emitter.registry.MustRegister(metric.vec)
+		emitter.metrics[name] = metric
+	}
+
+	return metric
+}
+
+// pushgatewayCounters are the events whose values are increments, e.g. the
+// number of queries since the last tick.
+var pushgatewayCounters = map[string]bool{
+	"error log":                 true,
+	"database queries":          true,
+	"containers created":        true,
+	"containers deleted":        true,
+	"volumes created":           true,
+	"volumes deleted":           true,
+	"failed containers":         true,
+	"failed volumes":            true,
+	"resource checked":          true,
+	"resource versions deleted": true,
+}
+
+// pushgatewayOccurrences are the events which are counted, ignoring their
+// values.
+var pushgatewayOccurrences = map[string]bool{
+	"build started": true,
+}
+
+// pushgatewayHistograms are the events whose values are observations, other
+// than timings.
+var pushgatewayHistograms = map[string]bool{
+	"build finished":        true,
+	"step finished":         true,
+	"http response time":    true,
+	"volume streamed bytes": true,
+}
+
+type pushgatewayVec interface {
+	prometheus.Collector
+	DeleteLabelValues(...string) bool
+}
+
+// pushgatewayMetric is a metric of the kind matching its events, along with
+// when each of its series was last recorded, so that series which are no
+// longer reported, e.g. for removed pipelines, don't pile up.
+type pushgatewayMetric struct {
+	vec    pushgatewayVec
+	record func(labelValues []string, value float64, now time.Time)
+
+	lastSeen map[string]pushgatewaySeries
+}
+
+type pushgatewaySeries struct {
+	labelValues []string
+	time        time.Time
+}
+
+func newPushgatewayMetric(event metric.Event, name string, labelNames []string) *pushgatewayMetric {
+	metric := &pushgatewayMetric{
+		lastSeen: map[string]pushgatewaySeries{},
+	}
+
+	var observe func([]string, float64)
+
+	switch {
+	case pushgatewayCounters[event.Name] || pushgatewayOccurrences[event.Name]:
+		counter := prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "concourse",
+				Name:      name + "_total",
+				Help:      event.Name,
+			},
+			labelNames,
+		)
+
+		occurrences := pushgatewayOccurrences[event.Name]
+
+		metric.vec = counter
+		observe = func(labelValues []string, value float64) {
+			if occurrences {
+				value = 1
+			}
+
+			if value > 0 {
+				counter.WithLabelValues(labelValues...).Add(value)
+			}
+		}
+
+	case isTiming(event) || pushgatewayHistograms[event.Name]:
+		buckets := prometheus.ExponentialBuckets(10, 4, 12)
+		if event.Name == "volume streamed bytes" {
+			buckets = prometheus.ExponentialBuckets(1024, 4, 12)
+		}
+
+		histogram := prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "concourse",
+				Name:      name,
+				Help:      event.Name,
+				Buckets:   buckets,
+			},
+			labelNames,
+		)
+
+		metric.vec = histogram
+		observe = func(labelValues []string, value float64) {
+			histogram.WithLabelValues(labelValues...).Observe(value)
+		}
+
+	default:
+		gauge := prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "concourse",
+				Name:      name,
+				Help:      event.Name,
+			},
+			labelNames,
+		)
+
+		metric.vec = gauge
+		observe = func(labelValues []string, value float64) {
+			gauge.WithLabelValues(labelValues...).Set(value)
+		}
+	}
+
+	metric.record = func(labelValues []string, value float64, now time.Time) {
+		observe(labelValues, value)
+
+		metric.lastSeen[strings.Join(labelValues, "\x00")] = pushgatewaySeries{
+			labelValues: labelValues,
+			time:        now,
+		}
+	}
+
+	return metric
+}
+
+// expire removes the series which have not been recorded since the given
+// time.
+func (metric *pushgatewayMetric) expire(before time.Time) {
+	for key, series := range metric.lastSeen {
+		if series.time.Before(before) {
+			metric.vec.DeleteLabelValues(series.labelValues...)
+			delete(metric.lastSeen, key)
+		}
+	}
+}
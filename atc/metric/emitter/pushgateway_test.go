@@ -0,0 +1,186 @@
+package emitter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushgatewayEmitter", func() {
+	var (
+		gateway *httptest.Server
+
+		requestsL sync.Mutex
+		requests  []*http.Request
+
+		fakeClock *fakeclock.FakeClock
+		emitter   *PushgatewayEmitter
+	)
+
+	BeforeEach(func() {
+		requests = nil
+
+		gateway = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestsL.Lock()
+			requests = append(requests, r)
+			requestsL.Unlock()
+
+			w.WriteHeader(http.StatusAccepted)
+		}))
+
+		config := &PushgatewayConfig{
+			URL:          gateway.URL,
+			Job:          "concourse",
+			Labels:       []string{"worker"},
+			PushInterval: time.Hour,
+			QueueSize:    1000,
+			SeriesTTL:    10 * time.Minute,
+		}
+
+		e, err := config.NewEmitter()
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+		emitter = e.(*PushgatewayEmitter)
+		emitter.clock = fakeClock
+	})
+
+	AfterEach(func() {
+		emitter.batcher.Stop()
+		gateway.Close()
+	})
+
+	push := func(events ...metric.Event) {
+		err := emitter.push(lagertest.NewTestLogger("test"), events)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	type family struct {
+		kind   string
+		series map[string]float64
+	}
+
+	// gather returns each metric family's type and the value, count or sum of
+	// each series by its worker label
+	gather := func() map[string]family {
+		families, err := emitter.registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+
+		gathered := map[string]family{}
+		for _, f := range families {
+			series := map[string]float64{}
+			for _, m := range f.GetMetric() {
+				worker := ""
+				for _, label := range m.GetLabel() {
+					if label.GetName() == "worker" {
+						worker = label.GetValue()
+					}
+				}
+
+				switch f.GetType().String() {
+				case "COUNTER":
+					series[worker] = m.GetCounter().GetValue()
+				case "GAUGE":
+					series[worker] = m.GetGauge().GetValue()
+				case "HISTOGRAM":
+					series[worker] = float64(m.GetHistogram().GetSampleCount())
+				}
+			}
+
+			gathered[f.GetName()] = family{kind: f.GetType().String(), series: series}
+		}
+
+		return gathered
+	}
+
+	It("pushes the metrics under the job and instance", func() {
+		push(metric.Event{Name: "goroutines", Value: 10, Host: "some-host"})
+
+		requestsL.Lock()
+		defer requestsL.Unlock()
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal("PUT"))
+		Expect(requests[0].URL.Path).To(Equal("/metrics/job/concourse/instance/some-host"))
+	})
+
+	It("reports gauges with their latest value", func() {
+		push(
+			metric.Event{Name: "tasks running", Value: 1, Attributes: map[string]string{"worker": "some-worker"}},
+			metric.Event{Name: "tasks running", Value: 3, Attributes: map[string]string{"worker": "some-worker"}},
+		)
+
+		Expect(gather()["concourse_tasks_running"]).To(Equal(family{
+			kind:   "GAUGE",
+			series: map[string]float64{"some-worker": 3},
+		}))
+	})
+
+	It("adds up counters", func() {
+		push(
+			metric.Event{Name: "database queries", Value: 5},
+			metric.Event{Name: "database queries", Value: 7},
+			metric.Event{Name: "build started", Value: 1234},
+			metric.Event{Name: "build started", Value: 1235},
+		)
+
+		gathered := gather()
+		Expect(gathered["concourse_database_queries_total"]).To(Equal(family{
+			kind:   "COUNTER",
+			series: map[string]float64{"": 12},
+		}))
+		Expect(gathered["concourse_build_started_total"]).To(Equal(family{
+			kind:   "COUNTER",
+			series: map[string]float64{"": 2},
+		}))
+	})
+
+	It("observes timings and other measurements in histograms", func() {
+		push(
+			metric.Event{Name: "worker selection duration (ms)", Value: 12.5, Attributes: map[string]string{"worker": "some-worker"}},
+			metric.Event{Name: "worker selection duration (ms)", Value: 50.0, Attributes: map[string]string{"worker": "some-worker"}},
+			metric.Event{Name: "build finished", Value: 60000.0},
+		)
+
+		gathered := gather()
+		Expect(gathered["concourse_worker_selection_duration_ms"]).To(Equal(family{
+			kind:   "HISTOGRAM",
+			series: map[string]float64{"some-worker": 2},
+		}))
+		Expect(gathered["concourse_build_finished"]).To(Equal(family{
+			kind:   "HISTOGRAM",
+			series: map[string]float64{"": 1},
+		}))
+	})
+
+	It("stops pushing series which haven't been reported within the TTL", func() {
+		push(
+			metric.Event{Name: "tasks running", Value: 1, Attributes: map[string]string{"worker": "old-worker"}},
+			metric.Event{Name: "tasks running", Value: 2, Attributes: map[string]string{"worker": "some-worker"}},
+		)
+
+		fakeClock.Increment(5 * time.Minute)
+		push(metric.Event{Name: "tasks running", Value: 3, Attributes: map[string]string{"worker": "some-worker"}})
+
+		Expect(gather()["concourse_tasks_running"].series).To(Equal(map[string]float64{
+			"old-worker":  1,
+			"some-worker": 3,
+		}))
+
+		fakeClock.Increment(6 * time.Minute)
+		push(metric.Event{Name: "tasks running", Value: 4, Attributes: map[string]string{"worker": "some-worker"}})
+
+		Expect(gather()["concourse_tasks_running"].series).To(Equal(map[string]float64{
+			"some-worker": 4,
+		}))
+	})
+})
@@ -0,0 +1,104 @@
+package emitter
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// maxStatsdPacketSize keeps each packet within a typical Ethernet MTU.
+const maxStatsdPacketSize = 1432
+
+type StatsdEmitter struct {
+	conn      net.Conn
+	templates metricTemplates
+	batcher   *metric.Batcher
+}
+
+type StatsdConfig struct {
+	Host      string            `long:"statsd-host"     description:"StatsD server host to emit metrics to."`
+	Port      string            `long:"statsd-port"     description:"StatsD server port to emit metrics to."`
+	Prefix    string            `long:"statsd-prefix"   default:"concourse" description:"Prefix for all metric names."`
+	Templates map[string]string `long:"statsd-template" description:"Naming template for a metric, e.g. build_finished:builds.{team_name}.{pipeline}.{job}. May refer to {name}, {host}, {state} and the metric's attributes. Can be specified multiple times." value-name:"METRIC:TEMPLATE"`
+
+	FlushInterval time.Duration `long:"statsd-flush-interval" default:"1s"   description:"Interval on which to send buffered metrics."`
+	QueueSize     int           `long:"statsd-queue-size"     default:"1000" description:"Maximum number of metrics to buffer before dropping them."`
+}
+
+func init() {
+	metric.RegisterEmitter(&StatsdConfig{})
+}
+
+func (config *StatsdConfig) Description() string { return "StatsD" }
+func (config *StatsdConfig) IsConfigured() bool  { return config.Host != "" && config.Port != "" }
+
+func (config *StatsdConfig) NewEmitter() (metric.Emitter, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(config.Host, config.Port))
+	if err != nil {
+		return &StatsdEmitter{}, err
+	}
+
+	emitter := &StatsdEmitter{
+		conn: conn,
+		templates: metricTemplates{
+			prefix:    config.Prefix,
+			templates: config.Templates,
+		},
+	}
+
+	emitter.batcher = metric.NewBatcher(emitter.send, 100, config.QueueSize, config.FlushInterval)
+
+	return emitter, nil
+}
+
+func (emitter *StatsdEmitter) Emit(logger lager.Logger, event metric.Event) {
+	emitter.batcher.Add(logger, event)
+}
+
+func (emitter *StatsdEmitter) send(logger lager.Logger, events []metric.Event) error {
+	packet := new(bytes.Buffer)
+
+	for _, event := range events {
+		value, err := getFloatHelper(event.Value)
+		if err != nil {
+			logger.Error("failed-to-convert-metric-for-statsd", nil, lager.Data{
+				"metric-name": event.Name,
+			})
+			continue
+		}
+
+		kind := "g"
+		if isTiming(event) {
+			kind = "ms"
+		}
+
+		line := fmt.Sprintf("%s:%s|%s", emitter.templates.name(event), strconv.FormatFloat(value, 'f', -1, 64), kind)
+
+		if packet.Len() > 0 && packet.Len()+len(line)+1 > maxStatsdPacketSize {
+			_, err := emitter.conn.Write(packet.Bytes())
+			if err != nil {
+				return err
+			}
+
+			packet.Reset()
+		}
+
+		if packet.Len() > 0 {
+			packet.WriteByte('\n')
+		}
+
+		packet.WriteString(line)
+	}
+
+	if packet.Len() == 0 {
+		return nil
+	}
+
+	_, err := emitter.conn.Write(packet.Bytes())
+	return err
+}
@@ -0,0 +1,105 @@
+package emitter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StatsdEmitter", func() {
+	var (
+		server  net.PacketConn
+		emitter *StatsdEmitter
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		conn, err := net.Dial("udp", server.LocalAddr().String())
+		Expect(err).ToNot(HaveOccurred())
+
+		emitter = &StatsdEmitter{
+			conn:      conn,
+			templates: metricTemplates{prefix: "concourse"},
+		}
+	})
+
+	AfterEach(func() {
+		emitter.conn.Close()
+		server.Close()
+	})
+
+	receive := func() []string {
+		packets := []string{}
+
+		buf := make([]byte, 64*1024)
+		for {
+			err := server.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+			Expect(err).ToNot(HaveOccurred())
+
+			n, _, err := server.ReadFrom(buf)
+			if err != nil {
+				break
+			}
+
+			packets = append(packets, string(buf[:n]))
+		}
+
+		return packets
+	}
+
+	It("sends gauges and timings", func() {
+		err := emitter.send(lagertest.NewTestLogger("test"), []metric.Event{
+			{Name: "goroutines", Value: 42},
+			{Name: "scheduling: full duration (ms)", Value: 1.5},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(receive()).To(Equal([]string{
+			"concourse.goroutines:42|g\nconcourse.scheduling_full_duration_ms:1.5|ms",
+		}))
+	})
+
+	It("skips events whose values aren't numbers", func() {
+		err := emitter.send(lagertest.NewTestLogger("test"), []metric.Event{
+			{Name: "goroutines", Value: "many"},
+			{Name: "mallocs", Value: 1},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(receive()).To(Equal([]string{"concourse.mallocs:1|g"}))
+	})
+
+	It("splits the events into packets which fit within the MTU", func() {
+		events := []metric.Event{}
+		lines := []string{}
+		for i := 0; i < 100; i++ {
+			name := fmt.Sprintf("some long metric name which takes up space %d", i)
+			events = append(events, metric.Event{Name: name, Value: i})
+			lines = append(lines, fmt.Sprintf("concourse.%s:%d|g", metricName(name), i))
+		}
+
+		err := emitter.send(lagertest.NewTestLogger("test"), events)
+		Expect(err).ToNot(HaveOccurred())
+
+		packets := receive()
+		Expect(len(packets)).To(BeNumerically(">", 1))
+
+		received := []string{}
+		for _, packet := range packets {
+			Expect(len(packet)).To(BeNumerically("<=", maxStatsdPacketSize))
+			received = append(received, strings.Split(packet, "\n")...)
+		}
+
+		Expect(received).To(Equal(lines))
+	})
+})
@@ -0,0 +1,66 @@
+package emitter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/concourse/concourse/atc/metric"
+)
+
+var (
+	templatePlaceholder = regexp.MustCompile(`\{([a-zA-Z0-9_]+)\}`)
+	unsafePathChars     = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+)
+
+// metricTemplates names metrics for backends without tags, such as StatsD and
+// Graphite, where attributes have to be encoded in the metric's dotted path.
+//
+// Templates are keyed by metric name (e.g. "build_finished") and may refer to
+// {name}, {host}, {state} and any of the event's attributes, e.g.
+// "builds.{team_name}.{pipeline}.{job}.duration". Events without a template
+// are named after the event alone.
+type metricTemplates struct {
+	prefix    string
+	templates map[string]string
+}
+
+func (templates metricTemplates) name(event metric.Event) string {
+	name := metricName(event.Name)
+
+	path := name
+	if template, found := templates.templates[name]; found {
+		path = templatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+			key := placeholder[1 : len(placeholder)-1]
+
+			var value string
+			switch key {
+			case "name":
+				value = name
+			case "host":
+				value = event.Host
+			case "state":
+				value = string(event.State)
+			default:
+				value = event.Attributes[key]
+			}
+
+			if value == "" {
+				return "none"
+			}
+
+			return unsafePathChars.ReplaceAllString(value, "_")
+		})
+	}
+
+	prefix := strings.TrimSuffix(templates.prefix, ".")
+	if prefix == "" {
+		return path
+	}
+
+	return prefix + "." + path
+}
+
+// isTiming returns whether the event measures a duration, in milliseconds.
+func isTiming(event metric.Event) bool {
+	return strings.HasSuffix(event.Name, "(ms)")
+}
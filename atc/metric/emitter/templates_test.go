@@ -0,0 +1,73 @@
+package emitter
+
+import (
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("metricTemplates", func() {
+	var (
+		templates metricTemplates
+		event     metric.Event
+	)
+
+	BeforeEach(func() {
+		templates = metricTemplates{
+			prefix: "concourse",
+			templates: map[string]string{
+				"build_finished": "builds.{team_name}.{pipeline}.{job}.{name}",
+				"worker_state":   "workers.{host}.{state}.{worker}",
+			},
+		}
+
+		event = metric.Event{
+			Name:  "build finished",
+			Host:  "some-host",
+			State: metric.EventStateOK,
+			Attributes: map[string]string{
+				"team_name": "main",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+			},
+		}
+	})
+
+	It("names metrics without a template after the event", func() {
+		event.Name = "http response time"
+		Expect(templates.name(event)).To(Equal("concourse.http_response_time"))
+	})
+
+	It("fills in the event's attributes", func() {
+		Expect(templates.name(event)).To(Equal("concourse.builds.main.some-pipeline.some-job.build_finished"))
+	})
+
+	It("fills in the host and state", func() {
+		event.Name = "worker state"
+		event.Attributes = map[string]string{"worker": "some-worker"}
+		Expect(templates.name(event)).To(Equal("concourse.workers.some-host.ok.some-worker"))
+	})
+
+	It("replaces characters which would break up the path", func() {
+		event.Attributes["pipeline"] = "some.pipe line/v1"
+		Expect(templates.name(event)).To(Equal("concourse.builds.main.some_pipe_line_v1.some-job.build_finished"))
+	})
+
+	It("reports missing attributes as 'none'", func() {
+		delete(event.Attributes, "job")
+		Expect(templates.name(event)).To(Equal("concourse.builds.main.some-pipeline.none.build_finished"))
+	})
+
+	Context("when the prefix is empty or ends in a dot", func() {
+		It("doesn't add an extra dot", func() {
+			event.Name = "goroutines"
+
+			templates.prefix = ""
+			Expect(templates.name(event)).To(Equal("goroutines"))
+
+			templates.prefix = "concourse."
+			Expect(templates.name(event)).To(Equal("concourse.goroutines"))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package metric
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OtherLabelValue replaces label values which are not in a label's allow-list.
+const OtherLabelValue = "other"
+
+// LabelFilter bounds the cardinality of labelled metrics. Labels which are not
+// allowed are reported as empty, and values which are not in a label's
+// allow-list are collapsed into OtherLabelValue.
+type LabelFilter struct {
+	labels map[string]bool
+	values map[string]map[string]bool
+}
+
+// NewLabelFilter constructs a LabelFilter allowing the given labels, or all
+// labels if none are given. Each of the values must be of the form
+// LABEL:VALUE and restricts the values reported for that label.
+func NewLabelFilter(labels []string, values []string) (LabelFilter, error) {
+	filter := LabelFilter{}
+
+	if len(labels) > 0 {
+		filter.labels = map[string]bool{}
+		for _, label := range labels {
+			filter.labels[label] = true
+		}
+	}
+
+	for _, value := range values {
+		segs := strings.SplitN(value, ":", 2)
+		if len(segs) != 2 || segs[0] == "" {
+			return LabelFilter{}, fmt.Errorf("invalid label value '%s', expected LABEL:VALUE", value)
+		}
+
+		if filter.values == nil {
+			filter.values = map[string]map[string]bool{}
+		}
+
+		if filter.values[segs[0]] == nil {
+			filter.values[segs[0]] = map[string]bool{}
+		}
+
+		filter.values[segs[0]][segs[1]] = true
+	}
+
+	return filter, nil
+}
+
+// Allows returns whether the label was explicitly allowed. Metrics which are
+// only worth their cardinality when asked for are reported only then.
+func (filter LabelFilter) Allows(label string) bool {
+	return filter.labels[label]
+}
+
+// Value returns the value to report for the given label.
+func (filter LabelFilter) Value(label string, value string) string {
+	if filter.labels != nil && !filter.labels[label] {
+		return ""
+	}
+
+	if allowed, found := filter.values[label]; found && !allowed[value] {
+		return OtherLabelValue
+	}
+
+	return value
+}
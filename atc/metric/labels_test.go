@@ -0,0 +1,91 @@
+package metric_test
+
+import (
+	. "github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelFilter", func() {
+	var (
+		labels []string
+		values []string
+
+		filter LabelFilter
+		err    error
+	)
+
+	BeforeEach(func() {
+		labels = nil
+		values = nil
+	})
+
+	JustBeforeEach(func() {
+		filter, err = NewLabelFilter(labels, values)
+	})
+
+	Context("when nothing is configured", func() {
+		It("reports every value as-is", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter.Value("team", "some-team")).To(Equal("some-team"))
+			Expect(filter.Value("worker", "some-worker")).To(Equal("some-worker"))
+		})
+
+		It("does not explicitly allow any label", func() {
+			Expect(filter.Allows("job")).To(BeFalse())
+		})
+	})
+
+	Context("when labels are allowed", func() {
+		BeforeEach(func() {
+			labels = []string{"team", "pipeline"}
+		})
+
+		It("reports allowed labels as-is", func() {
+			Expect(filter.Value("team", "some-team")).To(Equal("some-team"))
+			Expect(filter.Value("pipeline", "some-pipeline")).To(Equal("some-pipeline"))
+		})
+
+		It("reports other labels as empty", func() {
+			Expect(filter.Value("job", "some-job")).To(BeEmpty())
+			Expect(filter.Value("worker", "some-worker")).To(BeEmpty())
+		})
+
+		It("explicitly allows only those labels", func() {
+			Expect(filter.Allows("team")).To(BeTrue())
+			Expect(filter.Allows("job")).To(BeFalse())
+		})
+	})
+
+	Context("when label values are allowed", func() {
+		BeforeEach(func() {
+			values = []string{"team:main", "team:other-team", "resource_type:git"}
+		})
+
+		It("reports allowed values as-is", func() {
+			Expect(filter.Value("team", "main")).To(Equal("main"))
+			Expect(filter.Value("team", "other-team")).To(Equal("other-team"))
+			Expect(filter.Value("resource_type", "git")).To(Equal("git"))
+		})
+
+		It("collapses other values", func() {
+			Expect(filter.Value("team", "some-team")).To(Equal(OtherLabelValue))
+			Expect(filter.Value("resource_type", "s3")).To(Equal(OtherLabelValue))
+		})
+
+		It("does not restrict labels without an allow-list", func() {
+			Expect(filter.Value("pipeline", "some-pipeline")).To(Equal("some-pipeline"))
+		})
+	})
+
+	Context("when a label value is malformed", func() {
+		BeforeEach(func() {
+			values = []string{"team"}
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("invalid label value 'team', expected LABEL:VALUE"))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package metric
 
 import (
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/concourse/concourse/atc/db/lock"
@@ -22,6 +23,8 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 
+var ResourceVersionsDeleted = Meter(0)
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -354,6 +357,178 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type StepFinished struct {
+	PipelineName string
+	JobName      string
+	TeamName     string
+	StepType     string
+	Succeeded    bool
+	Duration     time.Duration
+}
+
+func (event StepFinished) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("step-finished"),
+		Event{
+			Name:  "step finished",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+				"team_name": event.TeamName,
+				"step_type": event.StepType,
+				"succeeded": strconv.FormatBool(event.Succeeded),
+			},
+		},
+	)
+}
+
+type PendingBuilds struct {
+	PipelineName string
+	JobName      string
+	TeamName     string
+	Builds       int
+}
+
+func (event PendingBuilds) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("pending-builds"),
+		Event{
+			Name:  "pending builds",
+			Value: event.Builds,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+				"team_name": event.TeamName,
+			},
+		},
+	)
+}
+
+type WorkerSelection struct {
+	WorkerName string
+	Duration   time.Duration
+}
+
+func (event WorkerSelection) Emit(logger lager.Logger) {
+	state := EventStateOK
+
+	if event.Duration > time.Second {
+		state = EventStateWarning
+	}
+
+	if event.Duration > 5*time.Second {
+		state = EventStateCritical
+	}
+
+	emit(
+		logger.Session("worker-selection"),
+		Event{
+			Name:  "worker selection duration (ms)",
+			Value: ms(event.Duration),
+			State: state,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+type VolumeStreamed struct {
+	WorkerName string
+	Bytes      int64
+	Duration   time.Duration
+}
+
+func (event VolumeStreamed) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("volume-streamed"),
+		Event{
+			Name:  "volume streamed bytes",
+			Value: int(event.Bytes),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+
+	emit(
+		logger.Session("volume-streamed"),
+		Event{
+			Name:  "volume streaming duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+// tasksRunning counts the tasks this ATC is running on each worker. Events
+// report the count rather than the change, so that a dropped event doesn't
+// leave the metric off for good.
+var tasksRunning = &workerTasks{tasks: map[string]int{}}
+
+type workerTasks struct {
+	tasks map[string]int
+	lock  sync.Mutex
+}
+
+func (tasks *workerTasks) add(workerName string, delta int) int {
+	tasks.lock.Lock()
+	defer tasks.lock.Unlock()
+
+	running := tasks.tasks[workerName] + delta
+	if running <= 0 {
+		delete(tasks.tasks, workerName)
+		return 0
+	}
+
+	tasks.tasks[workerName] = running
+
+	return running
+}
+
+type TaskStarted struct {
+	WorkerName string
+}
+
+func (event TaskStarted) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("task-started"),
+		Event{
+			Name:  "tasks running",
+			Value: tasksRunning.add(event.WorkerName, 1),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+type TaskExited struct {
+	WorkerName string
+}
+
+func (event TaskExited) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("task-exited"),
+		Event{
+			Name:  "tasks running",
+			Value: tasksRunning.add(event.WorkerName, -1),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -415,8 +590,10 @@ func (event HTTPResponseTime) Emit(logger lager.Logger) {
 type ResourceCheck struct {
 	PipelineName string
 	ResourceName string
+	ResourceType string
 	TeamName     string
 	Success      bool
+	Duration     time.Duration
 }
 
 func (event ResourceCheck) Emit(logger lager.Logger) {
@@ -424,17 +601,32 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 	if !event.Success {
 		state = EventStateWarning
 	}
+
+	attributes := map[string]string{
+		"pipeline":      event.PipelineName,
+		"resource":      event.ResourceName,
+		"resource_type": event.ResourceType,
+		"team":          event.TeamName,
+		"success":       strconv.FormatBool(event.Success),
+	}
+
 	emit(
 		logger.Session("resource-check"),
 		Event{
-			Name:  "resource checked",
-			Value: 1,
-			State: state,
-			Attributes: map[string]string{
-				"pipeline": event.PipelineName,
-				"resource": event.ResourceName,
-				"team":     event.TeamName,
-			},
+			Name:       "resource checked",
+			Value:      1,
+			State:      state,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("resource-check"),
+		Event{
+			Name:       "resource check duration (ms)",
+			Value:      ms(event.Duration),
+			State:      state,
+			Attributes: attributes,
 		},
 	)
 }
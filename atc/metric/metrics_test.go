@@ -0,0 +1,59 @@
+package metric_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics", func() {
+	var emitter *metricfakes.FakeEmitter
+
+	BeforeEach(func() {
+		emitterFactory := &metricfakes.FakeEmitterFactory{}
+		emitter = &metricfakes.FakeEmitter{}
+
+		metric.RegisterEmitter(emitterFactory)
+		emitterFactory.IsConfiguredReturns(true)
+		emitterFactory.NewEmitterReturns(emitter, nil)
+		metric.Initialize(nil, "test", map[string]string{})
+	})
+
+	AfterEach(func() {
+		metric.Deinitialize(nil)
+	})
+
+	Describe("TaskStarted and TaskExited", func() {
+		emitted := func() []interface{} {
+			values := []interface{}{}
+			for i := 0; i < emitter.EmitCallCount(); i++ {
+				_, event := emitter.EmitArgsForCall(i)
+				Expect(event.Name).To(Equal("tasks running"))
+				Expect(event.Attributes).To(Equal(map[string]string{"worker": "some-worker"}))
+				values = append(values, event.Value)
+			}
+			return values
+		}
+
+		It("report the number of tasks running on the worker", func() {
+			logger := lagertest.NewTestLogger("test")
+
+			metric.TaskStarted{WorkerName: "some-worker"}.Emit(logger)
+			Eventually(emitter.EmitCallCount).Should(Equal(1))
+
+			metric.TaskStarted{WorkerName: "some-worker"}.Emit(logger)
+			Eventually(emitter.EmitCallCount).Should(Equal(2))
+
+			metric.TaskExited{WorkerName: "some-worker"}.Emit(logger)
+			Eventually(emitter.EmitCallCount).Should(Equal(3))
+
+			metric.TaskExited{WorkerName: "some-worker"}.Emit(logger)
+			Eventually(emitter.EmitCallCount).Should(Equal(4))
+
+			Expect(emitted()).To(Equal([]interface{}{1, 2, 1, 0}))
+		})
+	})
+})
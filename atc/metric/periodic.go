@@ -71,6 +71,15 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("resource-versions-deleted"),
+		Event{
+			Name:  "resource versions deleted",
+			Value: ResourceVersionsDeleted.Delta(),
+			State: EventStateOK,
+		},
+	)
+
 	emit(
 		logger.Session("containers-created"),
 		Event{
@@ -1,14 +1,30 @@
 package atc
 
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	InstanceVars InstanceVars      `json:"instance_vars,omitempty"`
+	Paused       bool              `json:"paused"`
+	Public       bool              `json:"public"`
+	Groups       GroupConfigs      `json:"groups,omitempty"`
+	TeamName     string            `json:"team_name"`
+	Labels       map[string]string `json:"labels,omitempty"`
+}
+
+func (pipeline Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// PipelineBatchResult reports what happened to one of the pipelines given by
+// ID in a request to pause, unpause, expose, hide or destroy several at once.
+type PipelineBatchResult struct {
+	ID    int  `json:"id"`
+	Found bool `json:"found"`
+}
@@ -0,0 +1,7 @@
+package atc
+
+type PipelineConfigVersion struct {
+	Version   int    `json:"version"`
+	Author    string `json:"author,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
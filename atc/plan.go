@@ -26,6 +26,46 @@ type Plan struct {
 	DependentGet *DependentGetPlan `json:"dependent_get,omitempty"`
 }
 
+// Each calls f with the plan and then each of its nested plans, depth first
+// and in the order in which they run.
+func (plan *Plan) Each(f func(*Plan)) {
+	f(plan)
+
+	switch {
+	case plan.Aggregate != nil:
+		for i := range *plan.Aggregate {
+			(*plan.Aggregate)[i].Each(f)
+		}
+	case plan.Do != nil:
+		for i := range *plan.Do {
+			(*plan.Do)[i].Each(f)
+		}
+	case plan.Retry != nil:
+		for i := range *plan.Retry {
+			(*plan.Retry)[i].Each(f)
+		}
+	case plan.OnAbort != nil:
+		plan.OnAbort.Step.Each(f)
+		plan.OnAbort.Next.Each(f)
+	case plan.OnError != nil:
+		plan.OnError.Step.Each(f)
+		plan.OnError.Next.Each(f)
+	case plan.Ensure != nil:
+		plan.Ensure.Step.Each(f)
+		plan.Ensure.Next.Each(f)
+	case plan.OnSuccess != nil:
+		plan.OnSuccess.Step.Each(f)
+		plan.OnSuccess.Next.Each(f)
+	case plan.OnFailure != nil:
+		plan.OnFailure.Step.Each(f)
+		plan.OnFailure.Next.Each(f)
+	case plan.Try != nil:
+		plan.Try.Step.Each(f)
+	case plan.Timeout != nil:
+		plan.Timeout.Step.Each(f)
+	}
+}
+
 type PlanID string
 
 type ArtifactInputPlan struct {
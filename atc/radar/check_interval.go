@@ -0,0 +1,97 @@
+package radar
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// CheckIntervalPolicy determines how long to wait between checks of a
+// resource or resource type.
+//
+// When Adaptive is false the configured check_every (or the default interval)
+// is used as-is. Otherwise the interval is doubled for every consecutive check
+// failure, lengthened for every InactivityThreshold that passed without a new
+// version, capped at MaxInterval and finally spread out by up to Jitter of
+// itself so that checks of different resources don't line up.
+type CheckIntervalPolicy struct {
+	Adaptive            bool
+	Jitter              float64
+	MaxInterval         time.Duration
+	InactivityThreshold time.Duration
+}
+
+// Interval returns the time to wait between automatic checks of the
+// checkable. Checkables configured with check_every: never report the default
+// interval, as they are only ever checked when explicitly asked to.
+func (p CheckIntervalPolicy) Interval(checkable db.Checkable, defaultInterval time.Duration, now time.Time) (time.Duration, error) {
+	interval := defaultInterval
+
+	checkEvery := checkable.CheckEvery()
+	if checkEvery == atc.CheckEveryNever {
+		return interval, nil
+	}
+
+	if checkEvery != "" {
+		configured, err := time.ParseDuration(checkEvery)
+		if err != nil {
+			return 0, err
+		}
+
+		if configured <= 0 {
+			return 0, fmt.Errorf("non-positive check interval: %s", checkEvery)
+		}
+
+		interval = configured
+	}
+
+	if !p.Adaptive || interval <= 0 {
+		return interval, nil
+	}
+
+	maxInterval := p.MaxInterval
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	adapted := interval
+
+	for i := 0; i < checkable.CheckFailures() && adapted < maxInterval; i++ {
+		adapted *= 2
+	}
+
+	lastActivity := checkable.LastActivityTime()
+	if p.InactivityThreshold > 0 && !lastActivity.IsZero() {
+		periods := now.Sub(lastActivity) / p.InactivityThreshold
+		if periods > maxInterval/interval {
+			adapted = maxInterval
+		} else if periods > 0 {
+			adapted += interval * periods
+		}
+	}
+
+	if adapted > maxInterval {
+		adapted = maxInterval
+	}
+
+	return adapted + p.jitter(checkable, adapted), nil
+}
+
+// jitter is stable for a given checkable so that repeatedly evaluating the
+// interval doesn't make checks any more frequent.
+func (p CheckIntervalPolicy) jitter(checkable db.Checkable, interval time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(strconv.Itoa(checkable.PipelineID()) + "/" + checkable.Name()))
+
+	fraction := float64(hash.Sum32()) / float64(^uint32(0))
+
+	return time.Duration(float64(interval) * p.Jitter * fraction)
+}
@@ -0,0 +1,189 @@
+package radar_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/radar"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckIntervalPolicy", func() {
+	var (
+		policy        CheckIntervalPolicy
+		fakeResource  *dbfakes.FakeResource
+		now           time.Time
+		interval      time.Duration
+		intervalErr   error
+		defaultPeriod = time.Minute
+	)
+
+	BeforeEach(func() {
+		policy = CheckIntervalPolicy{}
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.NameReturns("some-resource")
+		fakeResource.PipelineIDReturns(1)
+
+		now = time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		interval, intervalErr = policy.Interval(fakeResource, defaultPeriod, now)
+	})
+
+	It("uses the default interval", func() {
+		Expect(intervalErr).ToNot(HaveOccurred())
+		Expect(interval).To(Equal(time.Minute))
+	})
+
+	Context("when check_every is configured", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns("10s")
+		})
+
+		It("uses it", func() {
+			Expect(interval).To(Equal(10 * time.Second))
+		})
+	})
+
+	Context("when check_every is 'never'", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns(atc.CheckEveryNever)
+		})
+
+		It("uses the default interval", func() {
+			Expect(intervalErr).ToNot(HaveOccurred())
+			Expect(interval).To(Equal(time.Minute))
+		})
+	})
+
+	Context("when check_every is invalid", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns("bogus")
+		})
+
+		It("returns an error", func() {
+			Expect(intervalErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when check_every is not positive", func() {
+		BeforeEach(func() {
+			fakeResource.CheckEveryReturns("-1m")
+		})
+
+		It("returns an error", func() {
+			Expect(intervalErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when not adaptive", func() {
+		BeforeEach(func() {
+			fakeResource.CheckFailuresReturns(5)
+			fakeResource.LastActivityTimeReturns(now.Add(-30 * 24 * time.Hour))
+		})
+
+		It("ignores failures and inactivity", func() {
+			Expect(interval).To(Equal(time.Minute))
+		})
+	})
+
+	Context("when adaptive", func() {
+		BeforeEach(func() {
+			policy = CheckIntervalPolicy{
+				Adaptive:            true,
+				MaxInterval:         time.Hour,
+				InactivityThreshold: 24 * time.Hour,
+			}
+
+			fakeResource.LastActivityTimeReturns(now.Add(-time.Hour))
+		})
+
+		It("uses the default interval for active resources", func() {
+			Expect(interval).To(Equal(time.Minute))
+		})
+
+		Context("when checks have been failing", func() {
+			BeforeEach(func() {
+				fakeResource.CheckFailuresReturns(3)
+			})
+
+			It("doubles the interval for each failure", func() {
+				Expect(interval).To(Equal(8 * time.Minute))
+			})
+
+			Context("for long enough to exceed the max interval", func() {
+				BeforeEach(func() {
+					fakeResource.CheckFailuresReturns(100)
+				})
+
+				It("caps the interval", func() {
+					Expect(interval).To(Equal(time.Hour))
+				})
+			})
+		})
+
+		Context("when no new versions have been found in a while", func() {
+			BeforeEach(func() {
+				fakeResource.LastActivityTimeReturns(now.Add(-72 * time.Hour))
+			})
+
+			It("lengthens the interval for each inactivity threshold passed", func() {
+				Expect(interval).To(Equal(4 * time.Minute))
+			})
+		})
+
+		Context("when the resource has never been active", func() {
+			BeforeEach(func() {
+				fakeResource.LastActivityTimeReturns(time.Time{})
+			})
+
+			It("uses the default interval", func() {
+				Expect(interval).To(Equal(time.Minute))
+			})
+		})
+
+		Context("when the configured interval exceeds the max interval", func() {
+			BeforeEach(func() {
+				fakeResource.CheckEveryReturns("2h")
+				fakeResource.CheckFailuresReturns(3)
+			})
+
+			It("does not shorten it", func() {
+				Expect(interval).To(Equal(2 * time.Hour))
+			})
+		})
+
+		Context("with jitter", func() {
+			BeforeEach(func() {
+				policy.Jitter = 0.5
+			})
+
+			It("lengthens the interval by up to the jitter fraction", func() {
+				Expect(interval).To(BeNumerically(">=", time.Minute))
+				Expect(interval).To(BeNumerically("<=", 90*time.Second))
+			})
+
+			It("is stable for the same resource", func() {
+				again, err := policy.Interval(fakeResource, defaultPeriod, now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(again).To(Equal(interval))
+			})
+
+			It("differs between resources", func() {
+				otherResource := new(dbfakes.FakeResource)
+				otherResource.NameReturns("some-other-resource")
+				otherResource.PipelineIDReturns(1)
+				otherResource.LastActivityTimeReturns(now.Add(-time.Hour))
+
+				other, err := policy.Interval(otherResource, defaultPeriod, now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(other).ToNot(Equal(interval))
+			})
+		})
+	})
+})
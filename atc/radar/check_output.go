@@ -0,0 +1,124 @@
+package radar
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/resource"
+)
+
+// CheckOutputFlushInterval is how often the output of a running check script
+// is saved, so that it can be followed without a write per chunk of output.
+const CheckOutputFlushInterval = 5 * time.Second
+
+// MaxCheckOutputSize is how much of the output of a check script is kept.
+// Anything beyond it is dropped.
+const MaxCheckOutputSize = 1024 * 1024
+
+const checkOutputTruncated = "\n(output truncated)\n"
+
+func setCheckResourceConfigScope(logger lager.Logger, check db.Check, scope db.ResourceConfigScope) {
+	if check == nil {
+		return
+	}
+
+	err := check.SetResourceConfigScope(scope)
+	if err != nil {
+		logger.Error("failed-to-set-resource-config-scope-on-check", err)
+	}
+}
+
+// checkOutput buffers the output of the check script for the queued check
+// being run, if any, so that it can be followed and kept in the check
+// history.
+type checkOutput struct {
+	logger lager.Logger
+	clock  clock.Clock
+	check  db.Check
+
+	lock      sync.Mutex
+	buffer    []byte
+	size      int
+	truncated bool
+	lastFlush time.Time
+}
+
+func newCheckOutput(logger lager.Logger, clock clock.Clock, check db.Check) *checkOutput {
+	return &checkOutput{
+		logger:    logger,
+		clock:     clock,
+		check:     check,
+		lastFlush: clock.Now(),
+	}
+}
+
+func (output *checkOutput) IOConfig() resource.IOConfig {
+	if output.check == nil {
+		return resource.IOConfig{}
+	}
+
+	return resource.IOConfig{
+		Stderr: output,
+	}
+}
+
+// Write never fails, as failing to record the output is no reason to fail
+// the check itself.
+func (output *checkOutput) Write(p []byte) (int, error) {
+	output.lock.Lock()
+	defer output.lock.Unlock()
+
+	if output.truncated {
+		return len(p), nil
+	}
+
+	kept := p
+	if output.size+len(kept) > MaxCheckOutputSize {
+		kept = kept[:MaxCheckOutputSize-output.size]
+		output.truncated = true
+	}
+
+	output.buffer = append(output.buffer, kept...)
+	output.size += len(kept)
+
+	if output.truncated {
+		output.buffer = append(output.buffer, checkOutputTruncated...)
+	}
+
+	if output.clock.Since(output.lastFlush) >= CheckOutputFlushInterval {
+		output.flush()
+	}
+
+	return len(p), nil
+}
+
+// Flush saves any output which has not been saved yet. It is called once
+// the check script has exited.
+func (output *checkOutput) Flush() {
+	if output.check == nil {
+		return
+	}
+
+	output.lock.Lock()
+	defer output.lock.Unlock()
+
+	output.flush()
+}
+
+func (output *checkOutput) flush() {
+	output.lastFlush = output.clock.Now()
+
+	if len(output.buffer) == 0 {
+		return
+	}
+
+	err := output.check.AppendOutput(string(output.buffer))
+	if err != nil {
+		output.logger.Error("failed-to-append-check-output", err)
+	}
+
+	output.buffer = nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/radar"
 )
 
@@ -25,6 +26,18 @@ type FakeScanner struct {
 		result1 time.Duration
 		result2 error
 	}
+	RunCheckStub        func(lager.Logger, db.Check) error
+	runCheckMutex       sync.RWMutex
+	runCheckArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.Check
+	}
+	runCheckReturns struct {
+		result1 error
+	}
+	runCheckReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScanStub        func(lager.Logger, int) error
 	scanMutex       sync.RWMutex
 	scanArgsForCall []struct {
@@ -118,6 +131,67 @@ func (fake *FakeScanner) RunReturnsOnCall(i int, result1 time.Duration, result2
 	}{result1, result2}
 }
 
+func (fake *FakeScanner) RunCheck(arg1 lager.Logger, arg2 db.Check) error {
+	fake.runCheckMutex.Lock()
+	ret, specificReturn := fake.runCheckReturnsOnCall[len(fake.runCheckArgsForCall)]
+	fake.runCheckArgsForCall = append(fake.runCheckArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.Check
+	}{arg1, arg2})
+	fake.recordInvocation("RunCheck", []interface{}{arg1, arg2})
+	fake.runCheckMutex.Unlock()
+	if fake.RunCheckStub != nil {
+		return fake.RunCheckStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runCheckReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeScanner) RunCheckCallCount() int {
+	fake.runCheckMutex.RLock()
+	defer fake.runCheckMutex.RUnlock()
+	return len(fake.runCheckArgsForCall)
+}
+
+func (fake *FakeScanner) RunCheckCalls(stub func(lager.Logger, db.Check) error) {
+	fake.runCheckMutex.Lock()
+	defer fake.runCheckMutex.Unlock()
+	fake.RunCheckStub = stub
+}
+
+func (fake *FakeScanner) RunCheckArgsForCall(i int) (lager.Logger, db.Check) {
+	fake.runCheckMutex.RLock()
+	defer fake.runCheckMutex.RUnlock()
+	argsForCall := fake.runCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScanner) RunCheckReturns(result1 error) {
+	fake.runCheckMutex.Lock()
+	defer fake.runCheckMutex.Unlock()
+	fake.RunCheckStub = nil
+	fake.runCheckReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScanner) RunCheckReturnsOnCall(i int, result1 error) {
+	fake.runCheckMutex.Lock()
+	defer fake.runCheckMutex.Unlock()
+	fake.RunCheckStub = nil
+	if fake.runCheckReturnsOnCall == nil {
+		fake.runCheckReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCheckReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeScanner) Scan(arg1 lager.Logger, arg2 int) error {
 	fake.scanMutex.Lock()
 	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
@@ -246,6 +320,8 @@ func (fake *FakeScanner) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
+	fake.runCheckMutex.RLock()
+	defer fake.runCheckMutex.RUnlock()
 	fake.scanMutex.RLock()
 	defer fake.scanMutex.RUnlock()
 	fake.scanFromVersionMutex.RLock()
@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -25,6 +26,7 @@ type resourceScanner struct {
 	resourceFactory       resource.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultInterval       time.Duration
+	intervalPolicy        CheckIntervalPolicy
 	dbPipeline            db.Pipeline
 	externalURL           string
 	variables             creds.Variables
@@ -37,6 +39,7 @@ func NewResourceScanner(
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultInterval time.Duration,
+	intervalPolicy CheckIntervalPolicy,
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables creds.Variables,
@@ -48,6 +51,7 @@ func NewResourceScanner(
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultInterval:       defaultInterval,
+		intervalPolicy:        intervalPolicy,
 		dbPipeline:            dbPipeline,
 		externalURL:           externalURL,
 		variables:             variables,
@@ -60,7 +64,7 @@ var ErrResourceTypeNotFound = errors.New("resource type not found")
 var ErrResourceTypeCheckError = errors.New("resource type failed to check")
 
 func (scanner *resourceScanner) Run(logger lager.Logger, resourceID int) (time.Duration, error) {
-	interval, err := scanner.scan(logger.Session("tick"), resourceID, nil, false, false)
+	interval, err := scanner.scan(logger.Session("tick"), resourceID, nil, false, false, nil)
 
 	err = swallowErrResourceScriptFailed(err)
 
@@ -68,20 +72,28 @@ func (scanner *resourceScanner) Run(logger lager.Logger, resourceID int) (time.D
 }
 
 func (scanner *resourceScanner) ScanFromVersion(logger lager.Logger, resourceID int, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceID, fromVersion, true, true)
+	_, err := scanner.scan(logger, resourceID, fromVersion, true, true, nil)
 
 	return err
 }
 
 func (scanner *resourceScanner) Scan(logger lager.Logger, resourceID int) error {
-	_, err := scanner.scan(logger, resourceID, nil, true, false)
+	_, err := scanner.scan(logger, resourceID, nil, true, false, nil)
 
 	err = swallowErrResourceScriptFailed(err)
 
 	return err
 }
 
-func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceScanner) RunCheck(logger lager.Logger, check db.Check) error {
+	fromVersion := check.Plan().FromVersion
+
+	_, err := scanner.scan(logger, check.ResourceID(), fromVersion, check.ManuallyTriggered(), fromVersion != nil, check)
+
+	return err
+}
+
+func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVersion atc.Version, mustComplete bool, saveGiven bool, queuedCheck db.Check) (time.Duration, error) {
 	savedResource, found, err := scanner.dbPipeline.ResourceByID(resourceID)
 	if err != nil {
 		return 0, err
@@ -103,13 +115,18 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		return 0, err
 	}
 
-	interval, err := scanner.checkInterval(savedResource.CheckEvery())
+	interval, err := scanner.checkInterval(savedResource)
 	if err != nil {
 		scanner.setResourceCheckError(logger, savedResource, err)
 		logger.Error("failed-to-read-check-interval", err)
 		return 0, err
 	}
 
+	if savedResource.CheckEvery() == atc.CheckEveryNever && !mustComplete {
+		logger.Debug("checking-disabled")
+		return interval, nil
+	}
+
 	resourceTypes, err := scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
@@ -154,12 +171,25 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		return 0, err
 	}
 
+	pipelineResourceTypes := resourceTypes.Deserialize()
+
 	versionedResourceTypes := creds.NewVersionedResourceTypes(
 		scanner.variables,
-		resourceTypes.Deserialize(),
+		pipelineResourceTypes,
 	)
 
-	source, err := creds.NewSource(scanner.variables, savedResource.Source()).Evaluate()
+	secretLookups := creds.NewSecretLookups()
+
+	source, err := creds.NewSource(
+		secretLookups.Variables(scanner.variables),
+		pipelineResourceTypes.SourceWithDefaults(savedResource.Type(), savedResource.Source()),
+	).Evaluate()
+
+	recordErr := secretLookups.Record(logger, savedResource)
+	if recordErr != nil {
+		logger.Error("failed-to-record-secret-lookups", recordErr)
+	}
+
 	if err != nil {
 		logger.Error("failed-to-evaluate-resource-source", err)
 		scanner.setResourceCheckError(logger, savedResource, err)
@@ -177,6 +207,8 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		return 0, err
 	}
 
+	setCheckResourceConfigScope(logger, queuedCheck, resourceConfigScope)
+
 	// Clear out check error on the resource
 	scanner.setResourceCheckError(logger, savedResource, nil)
 
@@ -210,7 +242,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 				"resource_name":   savedResource.Name(),
 				"resource_config": resourceConfigScope.ResourceConfig().ID(),
 			})
-			return interval, ErrFailedToAcquireLock
+			return interval, err
 		}
 
 		if !acquired {
@@ -248,7 +280,17 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		}
 	}
 
-	return interval, scanner.check(
+	output := newCheckOutput(logger, scanner.clock, queuedCheck)
+
+	ctx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":     scanner.dbPipeline.TeamName(),
+		"pipeline": scanner.dbPipeline.Name(),
+		"resource": savedResource.Name(),
+		"type":     savedResource.Type(),
+	})
+
+	err = scanner.check(
+		ctx,
 		logger,
 		savedResource,
 		resourceConfigScope,
@@ -257,10 +299,16 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceID int, fromVe
 		source,
 		saveGiven,
 		timeout,
+		output.IOConfig(),
 	)
+	output.Flush()
+	tracing.End(span, err)
+
+	return interval, err
 }
 
 func (scanner *resourceScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResource db.Resource,
 	resourceConfigScope db.ResourceConfigScope,
@@ -269,6 +317,7 @@ func (scanner *resourceScanner) check(
 	source atc.Source,
 	saveGiven bool,
 	timeout time.Duration,
+	ioConfig resource.IOConfig,
 ) error {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
@@ -321,7 +370,9 @@ func (scanner *resourceScanner) check(
 		Type: db.ContainerTypeCheck,
 	}
 
+	_, workerSpan := tracing.StartSpan(ctx, "choose-worker", nil)
 	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, scanner.strategy)
+	tracing.End(workerSpan, err)
 	if err != nil {
 		logger.Error("failed-to-choose-a-worker", err)
 		chkErr := resourceConfigScope.SetCheckError(err)
@@ -332,7 +383,7 @@ func (scanner *resourceScanner) check(
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
 		owner,
@@ -353,11 +404,13 @@ func (scanner *resourceScanner) check(
 		"from": fromVersion,
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(ctx, source, fromVersion)
+	checkStart := scanner.clock.Now()
+	newVersions, err := res.Check(checkCtx, ioConfig, source, fromVersion)
+	checkDuration := scanner.clock.Since(checkStart)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
@@ -366,8 +419,10 @@ func (scanner *resourceScanner) check(
 	metric.ResourceCheck{
 		PipelineName: scanner.dbPipeline.Name(),
 		ResourceName: savedResource.Name(),
+		ResourceType: savedResource.Type(),
 		TeamName:     scanner.dbPipeline.TeamName(),
 		Success:      err == nil,
+		Duration:     checkDuration,
 	}.Emit(logger)
 
 	if err != nil {
@@ -431,18 +486,8 @@ func (scanner *resourceScanner) parseResourceCheckTimeoutOrDefault(checkTimeout
 	return interval, nil
 }
 
-func (scanner *resourceScanner) checkInterval(checkEvery string) (time.Duration, error) {
-	interval := scanner.defaultInterval
-	if checkEvery != "" {
-		configuredInterval, err := time.ParseDuration(checkEvery)
-		if err != nil {
-			return 0, err
-		}
-
-		interval = configuredInterval
-	}
-
-	return interval, nil
+func (scanner *resourceScanner) checkInterval(checkable db.Checkable) (time.Duration, error) {
+	return scanner.intervalPolicy.Interval(checkable, scanner.defaultInterval, scanner.clock.Now())
 }
 
 func (scanner *resourceScanner) setResourceCheckError(logger lager.Logger, savedResource db.Resource, err error) {
@@ -1,6 +1,7 @@
 package radar_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"time"
@@ -127,6 +128,7 @@ var _ = Describe("ResourceScanner", func() {
 			fakeResourceFactory,
 			fakeResourceConfigFactory,
 			interval,
+			CheckIntervalPolicy{},
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
@@ -430,7 +432,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				Context("when there is no current version", func() {
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
 				})
@@ -445,7 +447,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from it", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -469,7 +471,7 @@ var _ = Describe("ResourceScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, _ resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(resourceConfig.Source))
@@ -668,6 +670,38 @@ var _ = Describe("ResourceScanner", func() {
 				Expect(scanErr).NotTo(HaveOccurred())
 			})
 
+			It("records the vars looked up for the resource's source at once", func() {
+				Expect(fakeDBResource.RecordSecretLookupsCallCount()).To(Equal(1))
+				Expect(fakeDBResource.RecordSecretLookupsArgsForCall(0)).To(Equal([]creds.SecretLookup{
+					{
+						Name:  "source-params",
+						Found: true,
+					},
+				}))
+			})
+
+			Context("when the resource's type has defaults", func() {
+				BeforeEach(func() {
+					fakeGitResourceType := new(dbfakes.FakeResourceType)
+					fakeGitResourceType.IDReturns(5)
+					fakeGitResourceType.NameReturns("git")
+					fakeGitResourceType.TypeReturns("registry-image")
+					fakeGitResourceType.VersionReturns(atc.Version{"version": "1"})
+					fakeGitResourceType.DefaultsReturns(atc.Source{"uri": "default-uri", "branch": "((source-params))"})
+
+					fakeDBPipeline.ResourceTypesReturns([]db.ResourceType{fakeGitResourceType}, nil)
+				})
+
+				It("fills them in, preferring the resource's source", func() {
+					Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(1))
+					_, resourceSource, _ := fakeDBResource.SetResourceConfigArgsForCall(0)
+					Expect(resourceSource).To(Equal(atc.Source{
+						"uri":    "some-secret-sauce",
+						"branch": "some-secret-sauce",
+					}))
+				})
+			})
+
 			It("constructs the resource of the correct type", func() {
 				Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(1))
 				_, resourceSource, resourceTypes := fakeDBResource.SetResourceConfigArgsForCall(0)
@@ -819,7 +853,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("times out after the specified timeout", func() {
 					now := time.Now()
-					ctx, _, _ := fakeResource.CheckArgsForCall(0)
+					ctx, _, _, _ := fakeResource.CheckArgsForCall(0)
 					deadline, _ := ctx.Deadline()
 					Expect(deadline).Should(BeTemporally("~", now.Add(10*time.Second), time.Second))
 				})
@@ -869,7 +903,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from the pinned version", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -901,7 +935,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -934,7 +968,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -968,7 +1002,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, _ resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -1009,7 +1043,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when the check does not return any new versions", func() {
 				BeforeEach(func() {
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, _ resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						return []atc.Version{}, nil
 					}
 				})
@@ -1092,7 +1126,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -1105,7 +1139,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -1146,4 +1180,231 @@ var _ = Describe("ResourceScanner", func() {
 			})
 		})
 	})
+
+	Describe("RunCheck", func() {
+		var (
+			fakeResource *rfakes.FakeResource
+			fakeCheck    *dbfakes.FakeCheck
+
+			checkErr error
+		)
+
+		BeforeEach(func() {
+			fakeWorker.NameReturns("some-worker")
+			fakePool.FindOrChooseWorkerForContainerReturns(fakeWorker, nil)
+
+			fakeContainer.HandleReturns("some-handle")
+			fakeWorker.FindOrCreateContainerReturns(fakeContainer, nil)
+
+			fakeResource = new(rfakes.FakeResource)
+			fakeResourceFactory.NewResourceForContainerReturns(fakeResource)
+
+			fakeResourceConfigScope.AcquireResourceCheckingLockReturns(fakeLock, true, nil)
+			fakeResourceConfigScope.UpdateLastCheckStartTimeReturns(true, nil)
+
+			fakeCheck = new(dbfakes.FakeCheck)
+			fakeCheck.ResourceIDReturns(39)
+		})
+
+		JustBeforeEach(func() {
+			checkErr = scanner.RunCheck(lagertest.NewTestLogger("test"), fakeCheck)
+		})
+
+		It("respects the check interval", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+
+			_, immediate := fakeResourceConfigScope.UpdateLastCheckStartTimeArgsForCall(0)
+			Expect(immediate).To(BeFalse())
+		})
+
+		It("records the resource config scope on the check", func() {
+			Expect(fakeCheck.SetResourceConfigScopeCallCount()).To(Equal(1))
+			Expect(fakeCheck.SetResourceConfigScopeArgsForCall(0)).To(Equal(fakeResourceConfigScope))
+		})
+
+		Context("when the resource config was checked elsewhere within the interval", func() {
+			BeforeEach(func() {
+				fakeResourceConfigScope.UpdateLastCheckStartTimeReturns(false, nil)
+			})
+
+			It("returns ErrFailedToAcquireLock", func() {
+				Expect(checkErr).To(Equal(ErrFailedToAcquireLock))
+			})
+		})
+
+		Context("when acquiring the checking lock fails", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeResourceConfigScope.AcquireResourceCheckingLockReturns(nil, false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(checkErr).To(Equal(disaster))
+			})
+		})
+
+		Context("when the resource is configured to check every 'never'", func() {
+			BeforeEach(func() {
+				fakeDBResource.CheckEveryReturns(atc.CheckEveryNever)
+			})
+
+			It("does not check", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(fakeResource.CheckCallCount()).To(BeZero())
+			})
+
+			Context("when the check was manually triggered", func() {
+				BeforeEach(func() {
+					fakeCheck.ManuallyTriggeredReturns(true)
+				})
+
+				It("checks", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(fakeResource.CheckCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the resource has been failing to check", func() {
+			BeforeEach(func() {
+				scanner = NewResourceScanner(
+					fakeClock,
+					fakePool,
+					fakeResourceFactory,
+					fakeResourceConfigFactory,
+					interval,
+					CheckIntervalPolicy{Adaptive: true, MaxInterval: time.Hour},
+					fakeDBPipeline,
+					"https://www.example.com",
+					variables,
+					fakeStrategy,
+				)
+
+				fakeDBResource.CheckFailuresReturns(3)
+			})
+
+			It("backs off the check interval", func() {
+				leaseInterval, _ := fakeResourceConfigScope.UpdateLastCheckStartTimeArgsForCall(0)
+				Expect(leaseInterval).To(Equal(8 * interval))
+			})
+		})
+
+		Context("when the check script writes to stderr", func() {
+			BeforeEach(func() {
+				fakeResource.CheckStub = func(_ context.Context, ioConfig resource.IOConfig, _ atc.Source, _ atc.Version) ([]atc.Version, error) {
+					_, err := ioConfig.Stderr.Write([]byte("some-output"))
+					return nil, err
+				}
+			})
+
+			It("appends it to the check's output", func() {
+				Expect(fakeCheck.AppendOutputCallCount()).To(Equal(1))
+				Expect(fakeCheck.AppendOutputArgsForCall(0)).To(Equal("some-output"))
+			})
+
+			Context("when the script writes more output before the flush interval", func() {
+				BeforeEach(func() {
+					fakeResource.CheckStub = func(_ context.Context, ioConfig resource.IOConfig, _ atc.Source, _ atc.Version) ([]atc.Version, error) {
+						ioConfig.Stderr.Write([]byte("some-output\n"))
+						ioConfig.Stderr.Write([]byte("more-output\n"))
+						return nil, nil
+					}
+				})
+
+				It("appends it all at once", func() {
+					Expect(fakeCheck.AppendOutputCallCount()).To(Equal(1))
+					Expect(fakeCheck.AppendOutputArgsForCall(0)).To(Equal("some-output\nmore-output\n"))
+				})
+			})
+
+			Context("when the script writes output after the flush interval", func() {
+				BeforeEach(func() {
+					fakeResource.CheckStub = func(_ context.Context, ioConfig resource.IOConfig, _ atc.Source, _ atc.Version) ([]atc.Version, error) {
+						ioConfig.Stderr.Write([]byte("some-output\n"))
+						fakeClock.Increment(CheckOutputFlushInterval)
+						ioConfig.Stderr.Write([]byte("more-output\n"))
+						ioConfig.Stderr.Write([]byte("last-output\n"))
+						return nil, nil
+					}
+				})
+
+				It("appends the output written so far, then the rest", func() {
+					Expect(fakeCheck.AppendOutputCallCount()).To(Equal(2))
+					Expect(fakeCheck.AppendOutputArgsForCall(0)).To(Equal("some-output\nmore-output\n"))
+					Expect(fakeCheck.AppendOutputArgsForCall(1)).To(Equal("last-output\n"))
+				})
+			})
+
+			Context("when the script writes more than the maximum output size", func() {
+				BeforeEach(func() {
+					fakeResource.CheckStub = func(_ context.Context, ioConfig resource.IOConfig, _ atc.Source, _ atc.Version) ([]atc.Version, error) {
+						ioConfig.Stderr.Write(bytes.Repeat([]byte("x"), MaxCheckOutputSize-1))
+						ioConfig.Stderr.Write([]byte("yz"))
+						ioConfig.Stderr.Write([]byte("dropped"))
+						return nil, nil
+					}
+				})
+
+				It("truncates the output", func() {
+					Expect(fakeCheck.AppendOutputCallCount()).To(Equal(1))
+
+					output := fakeCheck.AppendOutputArgsForCall(0)
+					Expect(output).To(HaveLen(MaxCheckOutputSize + len("\n(output truncated)\n")))
+					Expect(output).To(HaveSuffix("xy\n(output truncated)\n"))
+				})
+			})
+
+			Context("when appending the output fails", func() {
+				BeforeEach(func() {
+					fakeCheck.AppendOutputReturns(errors.New("nope"))
+				})
+
+				It("does not fail the check", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when the check was triggered manually", func() {
+			BeforeEach(func() {
+				fakeCheck.ManuallyTriggeredReturns(true)
+			})
+
+			It("checks immediately", func() {
+				_, immediate := fakeResourceConfigScope.UpdateLastCheckStartTimeArgsForCall(0)
+				Expect(immediate).To(BeTrue())
+			})
+		})
+
+		Context("when fromVersion is specified", func() {
+			var fromVersion atc.Version
+
+			BeforeEach(func() {
+				fromVersion = atc.Version{"version": "1"}
+				fakeCheck.PlanReturns(atc.CheckPlan{FromVersion: fromVersion})
+				fakeResource.CheckReturns([]atc.Version{fromVersion}, nil)
+			})
+
+			It("checks from it and saves it", func() {
+				_, _, _, version := fakeResource.CheckArgsForCall(0)
+				Expect(version).To(Equal(fromVersion))
+
+				Expect(fakeResourceConfigScope.SaveVersionsCallCount()).To(Equal(1))
+				Expect(fakeResourceConfigScope.SaveVersionsArgsForCall(0)).To(Equal([]atc.Version{fromVersion}))
+			})
+		})
+
+		Context("when checking fails with ErrResourceScriptFailed", func() {
+			scriptFail := resource.ErrResourceScriptFailed{}
+
+			BeforeEach(func() {
+				fakeResource.CheckReturns(nil, scriptFail)
+			})
+
+			It("returns the error", func() {
+				Expect(checkErr).To(Equal(scriptFail))
+			})
+		})
+	})
 })
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -20,6 +21,7 @@ type resourceTypeScanner struct {
 	resourceFactory       resource.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultInterval       time.Duration
+	intervalPolicy        CheckIntervalPolicy
 	dbPipeline            db.Pipeline
 	externalURL           string
 	variables             creds.Variables
@@ -32,6 +34,7 @@ func NewResourceTypeScanner(
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultInterval time.Duration,
+	intervalPolicy CheckIntervalPolicy,
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables creds.Variables,
@@ -43,6 +46,7 @@ func NewResourceTypeScanner(
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultInterval:       defaultInterval,
+		intervalPolicy:        intervalPolicy,
 		dbPipeline:            dbPipeline,
 		externalURL:           externalURL,
 		variables:             variables,
@@ -51,20 +55,27 @@ func NewResourceTypeScanner(
 }
 
 func (scanner *resourceTypeScanner) Run(logger lager.Logger, resourceTypeID int) (time.Duration, error) {
-	return scanner.scan(logger.Session("tick"), resourceTypeID, nil, false, false)
+	return scanner.scan(logger.Session("tick"), resourceTypeID, nil, false, false, nil)
 }
 
 func (scanner *resourceTypeScanner) ScanFromVersion(logger lager.Logger, resourceTypeID int, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceTypeID, fromVersion, true, true)
+	_, err := scanner.scan(logger, resourceTypeID, fromVersion, true, true, nil)
 	return err
 }
 
 func (scanner *resourceTypeScanner) Scan(logger lager.Logger, resourceTypeID int) error {
-	_, err := scanner.scan(logger, resourceTypeID, nil, true, false)
+	_, err := scanner.scan(logger, resourceTypeID, nil, true, false, nil)
 	return err
 }
 
-func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceTypeScanner) RunCheck(logger lager.Logger, check db.Check) error {
+	fromVersion := check.Plan().FromVersion
+
+	_, err := scanner.scan(logger, check.ResourceTypeID(), fromVersion, check.ManuallyTriggered(), fromVersion != nil, check)
+	return err
+}
+
+func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int, fromVersion atc.Version, mustComplete bool, saveGiven bool, queuedCheck db.Check) (time.Duration, error) {
 	savedResourceType, found, err := scanner.dbPipeline.ResourceTypeByID(resourceTypeID)
 	if err != nil {
 		logger.Error("failed-to-find-resource-type-in-db", err)
@@ -79,12 +90,17 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		"resource-type": savedResourceType.Name(),
 	})
 
-	interval, err := scanner.checkInterval(savedResourceType.CheckEvery())
+	interval, err := scanner.checkInterval(savedResourceType)
 	if err != nil {
 		scanner.setCheckError(logger, savedResourceType, err)
 		return 0, err
 	}
 
+	if savedResourceType.CheckEvery() == atc.CheckEveryNever && !mustComplete {
+		logger.Debug("checking-disabled")
+		return interval, nil
+	}
+
 	resourceTypes, err := scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
@@ -120,7 +136,15 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		resourceTypes.Deserialize(),
 	)
 
-	source, err := creds.NewSource(scanner.variables, savedResourceType.Source()).Evaluate()
+	secretLookups := creds.NewSecretLookups()
+
+	source, err := creds.NewSource(secretLookups.Variables(scanner.variables), savedResourceType.Source()).Evaluate()
+
+	recordErr := secretLookups.Record(logger, savedResourceType)
+	if recordErr != nil {
+		logger.Error("failed-to-record-secret-lookups", recordErr)
+	}
+
 	if err != nil {
 		logger.Error("failed-to-evaluate-resource-type-source", err)
 		scanner.setCheckError(logger, savedResourceType, err)
@@ -138,6 +162,8 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		return 0, err
 	}
 
+	setCheckResourceConfigScope(logger, queuedCheck, resourceConfigScope)
+
 	// Clear out the check error on the resource type
 	scanner.setCheckError(logger, savedResourceType, err)
 
@@ -153,7 +179,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 				"resource-type":      savedResourceType.Name(),
 				"resource-config-id": resourceConfigScope.ResourceConfig().ID(),
 			})
-			return interval, ErrFailedToAcquireLock
+			return interval, err
 		}
 
 		if !acquired {
@@ -174,7 +200,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 				"resource-type":      savedResourceType.Name(),
 				"resource-config-id": resourceConfigScope.ResourceConfig().ID(),
 			})
-			return interval, ErrFailedToAcquireLock
+			return interval, err
 		}
 
 		if !updated {
@@ -202,7 +228,17 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		}
 	}
 
-	return interval, scanner.check(
+	output := newCheckOutput(logger, scanner.clock, queuedCheck)
+
+	ctx, span := tracing.StartSpan(context.Background(), "check-resource-type", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource-type": savedResourceType.Name(),
+		"type":          savedResourceType.Type(),
+	})
+
+	err = scanner.check(
+		ctx,
 		logger,
 		savedResourceType,
 		resourceConfigScope,
@@ -210,10 +246,16 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeID int
 		versionedResourceTypes,
 		source,
 		saveGiven,
+		output.IOConfig(),
 	)
+	output.Flush()
+	tracing.End(span, err)
+
+	return interval, err
 }
 
 func (scanner *resourceTypeScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResourceType db.ResourceType,
 	resourceConfigScope db.ResourceConfigScope,
@@ -221,6 +263,7 @@ func (scanner *resourceTypeScanner) check(
 	versionedResourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
 	saveGiven bool,
+	ioConfig resource.IOConfig,
 ) error {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
@@ -253,7 +296,9 @@ func (scanner *resourceTypeScanner) check(
 
 	owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries)
 
+	_, workerSpan := tracing.StartSpan(ctx, "choose-worker", nil)
 	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, scanner.strategy)
+	tracing.End(workerSpan, err)
 	if err != nil {
 		chkErr := resourceConfigScope.SetCheckError(err)
 		if chkErr != nil {
@@ -264,7 +309,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries),
@@ -284,7 +329,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(ctx, ioConfig, source, fromVersion)
 	resourceConfigScope.SetCheckError(err)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
@@ -317,18 +362,8 @@ func (scanner *resourceTypeScanner) check(
 	return nil
 }
 
-func (scanner *resourceTypeScanner) checkInterval(checkEvery string) (time.Duration, error) {
-	interval := scanner.defaultInterval
-	if checkEvery != "" {
-		configuredInterval, err := time.ParseDuration(checkEvery)
-		if err != nil {
-			return 0, err
-		}
-
-		interval = configuredInterval
-	}
-
-	return interval, nil
+func (scanner *resourceTypeScanner) checkInterval(checkable db.Checkable) (time.Duration, error) {
+	return scanner.intervalPolicy.Interval(checkable, scanner.defaultInterval, scanner.clock.Now())
 }
 
 func (scanner *resourceTypeScanner) setCheckError(logger lager.Logger, savedResourceType db.ResourceType, err error) {
@@ -105,6 +105,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 			fakeResourceFactory,
 			fakeResourceConfigFactory,
 			interval,
+			CheckIntervalPolicy{},
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
@@ -334,7 +335,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					})
 
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
 				})
@@ -350,7 +351,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 					It("checks with it", func() {
 						Expect(fakeResource.CheckCallCount()).To(Equal(1))
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "42"}))
 					})
 				})
@@ -374,7 +375,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, _ resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -693,7 +694,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -709,7 +710,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				It("checks with it", func() {
 					Expect(fakeResource.CheckCallCount()).To(Equal(1))
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "42"}))
 				})
 			})
@@ -733,7 +734,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, _ resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -903,7 +904,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from the current version", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"custom": "version"}))
 				})
 			})
@@ -916,7 +917,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . Scanner
@@ -13,4 +14,9 @@ type Scanner interface {
 	Run(lager.Logger, int) (time.Duration, error)
 	Scan(lager.Logger, int) error
 	ScanFromVersion(lager.Logger, int, atc.Version) error
+
+	// RunCheck runs a queued check, recording the output of the check script
+	// on it. Unlike Run and Scan, a failing check script is returned as an
+	// error so that it can be recorded on the check.
+	RunCheck(lager.Logger, db.Check) error
 }
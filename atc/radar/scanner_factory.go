@@ -25,6 +25,7 @@ type scannerFactory struct {
 	resourceConfigFactory        db.ResourceConfigFactory
 	resourceTypeCheckingInterval time.Duration
 	resourceCheckingInterval     time.Duration
+	checkIntervalPolicy          CheckIntervalPolicy
 	externalURL                  string
 	variablesFactory             creds.VariablesFactory
 	strategy                     worker.ContainerPlacementStrategy
@@ -42,6 +43,7 @@ func NewScannerFactory(
 	resourceConfigFactory db.ResourceConfigFactory,
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
+	checkIntervalPolicy CheckIntervalPolicy,
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
 	strategy worker.ContainerPlacementStrategy,
@@ -52,6 +54,7 @@ func NewScannerFactory(
 		resourceConfigFactory:        resourceConfigFactory,
 		resourceCheckingInterval:     resourceCheckingInterval,
 		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+		checkIntervalPolicy:          checkIntervalPolicy,
 		externalURL:                  externalURL,
 		variablesFactory:             variablesFactory,
 		strategy:                     strategy,
@@ -67,6 +70,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceCheckingInterval,
+		f.checkIntervalPolicy,
 		dbPipeline,
 		f.externalURL,
 		variables,
@@ -83,6 +87,7 @@ func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceTypeCheckingInterval,
+		f.checkIntervalPolicy,
 		dbPipeline,
 		f.externalURL,
 		variables,
@@ -15,6 +15,9 @@ type Resource struct {
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
 	PinComment     string  `json:"pin_comment,omitempty"`
+	PinnedBy       string  `json:"pinned_by,omitempty"`
+	PinExpiresAt   int64   `json:"pin_expires_at,omitempty"`
+	UnpinnedBy     string  `json:"unpinned_by,omitempty"`
 }
 
 var EnableGlobalResources bool
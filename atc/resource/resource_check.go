@@ -1,7 +1,9 @@
 package resource
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	"github.com/concourse/concourse/atc"
 )
@@ -11,19 +13,33 @@ type checkRequest struct {
 	Version atc.Version `json:"version"`
 }
 
-func (resource *resource) Check(ctx context.Context, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
+func (resource *resource) Check(ctx context.Context, ioConfig IOConfig, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
 	var versions []atc.Version
 
+	// stderr is still needed for the error if the script fails, so tee it
+	// rather than only sending it to the given writer
+	stderr := new(bytes.Buffer)
+
+	logDest := io.Writer(stderr)
+	if ioConfig.Stderr != nil {
+		logDest = io.MultiWriter(stderr, ioConfig.Stderr)
+	}
+
 	err := resource.runScript(
 		ctx,
 		"/opt/resource/check",
 		nil,
 		checkRequest{source, fromVersion},
 		&versions,
-		nil,
+		logDest,
 		false,
 	)
 	if err != nil {
+		if scriptErr, ok := err.(ErrResourceScriptFailed); ok {
+			scriptErr.Stderr = stderr.String()
+			return nil, scriptErr
+		}
+
 		return nil, err
 	}
 
@@ -8,9 +8,11 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/resource"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Resource Check", func() {
@@ -25,6 +27,7 @@ var _ = Describe("Resource Check", func() {
 
 		checkScriptProcess *gardenfakes.FakeProcess
 
+		ioConfig    resource.IOConfig
 		checkResult []atc.Version
 		checkErr    error
 	)
@@ -43,6 +46,7 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptExitStatus, nil
 		}
 
+		ioConfig = resource.IOConfig{}
 		checkResult = nil
 		checkErr = nil
 	})
@@ -62,7 +66,7 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptProcess, nil
 		}
 
-		checkResult, checkErr = resourceForContainer.Check(context.TODO(), source, version)
+		checkResult, checkErr = resourceForContainer.Check(context.TODO(), ioConfig, source, version)
 	})
 
 	It("runs /opt/resource/check the request on stdin", func() {
@@ -119,6 +123,23 @@ var _ = Describe("Resource Check", func() {
 			Expect(checkErr.Error()).To(ContainSubstring("exit status 9"))
 			Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
 		})
+
+		Context("when a stderr writer is given", func() {
+			var stderrBuf *gbytes.Buffer
+
+			BeforeEach(func() {
+				stderrBuf = gbytes.NewBuffer()
+				ioConfig.Stderr = stderrBuf
+			})
+
+			It("writes stderr to the writer", func() {
+				Expect(stderrBuf).To(gbytes.Say("some-stderr"))
+			})
+
+			It("still returns an error containing stderr of the process", func() {
+				Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
+			})
+		})
 	})
 
 	Context("when the output of /opt/resource/check is malformed", func() {
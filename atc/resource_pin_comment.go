@@ -3,3 +3,9 @@ package atc
 type SetPinCommentRequestBody struct {
 	PinComment string `json:"pin_comment"`
 }
+
+type PinResourceRequestBody struct {
+	Version    Version `json:"version"`
+	PinComment string  `json:"pin_comment,omitempty"`
+	ExpiresIn  string  `json:"expires_in,omitempty"`
+}
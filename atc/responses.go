@@ -5,8 +5,9 @@ type ClearTaskCacheResponse struct {
 }
 
 type SaveConfigResponse struct {
-	Errors   []string        `json:"errors,omitempty"`
-	Warnings []ConfigWarning `json:"warnings,omitempty"`
+	Errors           []string          `json:"errors,omitempty"`
+	Warnings         []ConfigWarning   `json:"warnings,omitempty"`
+	CredentialChecks []CredentialCheck `json:"credential_checks,omitempty"`
 }
 
 type ConfigResponse struct {
@@ -3,8 +3,10 @@ package atc
 import "github.com/tedsuo/rata"
 
 const (
-	SaveConfig = "SaveConfig"
-	GetConfig  = "GetConfig"
+	SaveConfig         = "SaveConfig"
+	GetConfig          = "GetConfig"
+	ListConfigVersions = "ListConfigVersions"
+	GetConfigVersion   = "GetConfigVersion"
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
@@ -30,6 +32,11 @@ const (
 
 	ClearTaskCache = "ClearTaskCache"
 
+	DiffJobBuildInputs    = "DiffJobBuildInputs"
+	GetJobBuildProvenance = "GetJobBuildProvenance"
+	RerunJobBuild         = "RerunJobBuild"
+	GetJobBuildStepPlan   = "GetJobBuildStepPlan"
+
 	ListAllResources     = "ListAllResources"
 	ListResources        = "ListResources"
 	ListResourceTypes    = "ListResourceTypes"
@@ -40,11 +47,18 @@ const (
 	CheckResourceWebHook = "CheckResourceWebHook"
 	CheckResourceType    = "CheckResourceType"
 
+	ListChecks              = "ListChecks"
+	ListResourceChecks      = "ListResourceChecks"
+	CreateResourceCheck     = "CreateResourceCheck"
+	CreateResourceTypeCheck = "CreateResourceTypeCheck"
+	GetCheck                = "GetCheck"
+
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
 	EnableResourceVersion         = "EnableResourceVersion"
 	DisableResourceVersion        = "DisableResourceVersion"
 	PinResourceVersion            = "PinResourceVersion"
+	PinResource                   = "PinResource"
 	UnpinResource                 = "UnpinResource"
 	SetPinCommentOnResource       = "SetPinCommentOnResource"
 	ListBuildsWithVersionAsInput  = "ListBuildsWithVersionAsInput"
@@ -66,6 +80,12 @@ const (
 	ListPipelineBuilds  = "ListPipelineBuilds"
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
+	ListSecretLookups   = "ListSecretLookups"
+	PausePipelines      = "PausePipelines"
+	UnpausePipelines    = "UnpausePipelines"
+	ExposePipelines     = "ExposePipelines"
+	HidePipelines       = "HidePipelines"
+	DeletePipelines     = "DeletePipelines"
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
@@ -98,6 +118,12 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	GetTeamResourceTypes = "GetTeamResourceTypes"
+	SetTeamResourceTypes = "SetTeamResourceTypes"
+
+	ReceiveWebhook        = "ReceiveWebhook"
+	ListWebhookDeliveries = "ListWebhookDeliveries"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -106,11 +132,14 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	SaveConfigDryRun        = "dry_run"
 )
 
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", Method: "GET", Name: GetConfigVersion},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
@@ -130,6 +159,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/input_diff", Method: "GET", Name: DiffJobBuildInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/provenance", Method: "GET", Name: GetJobBuildProvenance},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/rerun", Method: "POST", Name: RerunJobBuild},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name/step_plan", Method: "GET", Name: GetJobBuildStepPlan},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: JobBadge},
@@ -142,6 +175,11 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name", Method: "GET", Name: GetPipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name", Method: "DELETE", Name: DeletePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/ordering", Method: "PUT", Name: OrderPipelines},
+	{Path: "/api/v1/teams/:team_name/pipelines/pause", Method: "PUT", Name: PausePipelines},
+	{Path: "/api/v1/teams/:team_name/pipelines/unpause", Method: "PUT", Name: UnpausePipelines},
+	{Path: "/api/v1/teams/:team_name/pipelines/expose", Method: "PUT", Name: ExposePipelines},
+	{Path: "/api/v1/teams/:team_name/pipelines/hide", Method: "PUT", Name: HidePipelines},
+	{Path: "/api/v1/teams/:team_name/pipelines", Method: "DELETE", Name: DeletePipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/pause", Method: "PUT", Name: PausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unpause", Method: "PUT", Name: UnpausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
@@ -151,6 +189,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/secret-lookups", Method: "GET", Name: ListSecretLookups},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -160,11 +199,18 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/checks", Method: "GET", Name: ListChecks},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", Method: "GET", Name: ListResourceChecks},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", Method: "POST", Name: CreateResourceCheck},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/checks", Method: "POST", Name: CreateResourceTypeCheck},
+	{Path: "/api/v1/teams/:team_name/checks/:check_id", Method: "GET", Name: GetCheck},
+
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id", Method: "GET", Name: GetResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/disable", Method: "PUT", Name: DisableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/pin", Method: "PUT", Name: PinResourceVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin", Method: "PUT", Name: PinResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", Method: "PUT", Name: UnpinResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin_comment", Method: "PUT", Name: SetPinCommentOnResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/input_to", Method: "GET", Name: ListBuildsWithVersionAsInput},
@@ -203,6 +249,11 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/resource_types", Method: "GET", Name: GetTeamResourceTypes},
+	{Path: "/api/v1/teams/:team_name/resource_types", Method: "PUT", Name: SetTeamResourceTypes},
+
+	{Path: "/api/v1/teams/:team_name/webhooks/deliveries", Method: "GET", Name: ListWebhookDeliveries},
+	{Path: "/api/v1/teams/:team_name/webhooks/:provider", Method: "POST", Name: ReceiveWebhook},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
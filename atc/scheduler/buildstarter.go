@@ -92,6 +92,10 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	if nextPendingBuild.RerunOf() != 0 {
+		return s.startBuild(logger, nextPendingBuild, job, resources, resourceTypes, nil)
+	}
+
 	if nextPendingBuild.IsManuallyTriggered() {
 		for _, input := range job.Config().Inputs() {
 			resource, found := resources.Lookup(input.Resource)
@@ -137,6 +141,19 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	return s.startBuild(logger, nextPendingBuild, job, resources, resourceTypes, buildInputs)
+}
+
+// startBuild schedules the build and starts running it with the given inputs.
+// A nil set of inputs runs the build with the inputs already saved on it.
+func (s *buildStarter) startBuild(
+	logger lager.Logger,
+	nextPendingBuild db.Build,
+	job db.Job,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+	buildInputs []db.BuildInput,
+) (bool, error) {
 	pipelinePaused, err := s.pipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-is-paused", err)
@@ -161,9 +178,17 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
-	err = nextPendingBuild.UseInputs(buildInputs)
-	if err != nil {
-		return false, err
+	if buildInputs == nil {
+		buildInputs, _, err = nextPendingBuild.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-build-inputs", err)
+			return false, err
+		}
+	} else {
+		err = nextPendingBuild.UseInputs(buildInputs)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	resourceConfigs := atc.ResourceConfigs{}
@@ -171,7 +196,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		resourceConfigs = append(resourceConfigs, atc.ResourceConfig{
 			Name:   v.Name(),
 			Type:   v.Type(),
-			Source: v.Source(),
+			Source: resourceTypes.SourceWithDefaults(v.Type(), v.Source()),
 			Tags:   v.Tags(),
 		})
 	}
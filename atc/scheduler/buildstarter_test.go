@@ -285,6 +285,69 @@ var _ = Describe("BuildStarter", func() {
 			})
 		})
 
+		Context("when rerunning a build", func() {
+			var rerunInputs []db.BuildInput
+
+			BeforeEach(func() {
+				job = new(dbfakes.FakeJob)
+				job.NameReturns("some-job")
+				job.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1", Resource: "some-resource"}}})
+
+				resources = db.Resources{resource}
+
+				createdBuild.RerunOfReturns(42)
+				createdBuild.CreateTimeReturns(time.Now())
+				resource.LastCheckEndTimeReturns(time.Now().Add(-time.Minute))
+
+				rerunInputs = []db.BuildInput{{Name: "input-1", Version: atc.Version{"version": "1"}}}
+				createdBuild.ResourcesReturns(rerunInputs, nil, nil)
+				createdBuild.ScheduleReturns(true, nil)
+
+				fakeUpdater.UpdateMaxInFlightReachedReturns(false, nil)
+				fakeFactory.CreateReturns(atc.Plan{}, nil)
+				fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+			})
+
+			JustBeforeEach(func() {
+				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					lagertest.NewTestLogger("test"),
+					job,
+					resources,
+					versionedResourceTypes,
+					pendingBuilds,
+				)
+			})
+
+			It("does not wait for checks or resolve the next inputs", func() {
+				Expect(tryStartErr).NotTo(HaveOccurred())
+				Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(BeZero())
+				Expect(job.GetNextBuildInputsCallCount()).To(BeZero())
+			})
+
+			It("creates the build plan with the inputs of the build being rerun", func() {
+				Expect(createdBuild.UseInputsCallCount()).To(BeZero())
+				Expect(fakeFactory.CreateCallCount()).To(Equal(1))
+
+				_, _, actualResourceTypes, actualInputs := fakeFactory.CreateArgsForCall(0)
+				Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
+				Expect(actualInputs).To(Equal(rerunInputs))
+			})
+
+			It("starts the build", func() {
+				Expect(fakeEngine.CreateBuildCallCount()).To(Equal(1))
+			})
+
+			Context("when getting the build's inputs fails", func() {
+				BeforeEach(func() {
+					createdBuild.ResourcesReturns(nil, nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(tryStartErr).To(Equal(disaster))
+				})
+			})
+		})
+
 		Context("when not manually triggered", func() {
 			BeforeEach(func() {
 				job = new(dbfakes.FakeJob)
@@ -0,0 +1,168 @@
+package factory
+
+import "github.com/concourse/concourse/atc"
+
+// artifactUses is the set of artifacts consumed by the steps which run after
+// a given point in a job.
+type artifactUses struct {
+	all   bool
+	names map[string]bool
+}
+
+func (uses artifactUses) has(name string) bool {
+	return uses.all || uses.names[name]
+}
+
+func (uses artifactUses) union(other artifactUses) artifactUses {
+	merged := artifactUses{
+		all:   uses.all || other.all,
+		names: map[string]bool{},
+	}
+
+	for name := range uses.names {
+		merged.names[name] = true
+	}
+
+	for name := range other.names {
+		merged.names[name] = true
+	}
+
+	return merged
+}
+
+func (uses artifactUses) with(names ...string) artifactUses {
+	return uses.union(artifactUses{names: namesSet(names)})
+}
+
+func namesSet(names []string) map[string]bool {
+	set := map[string]bool{}
+	for _, name := range names {
+		set[name] = true
+	}
+
+	return set
+}
+
+// skipUnusedGets returns a copy of the job with no_get set on every put whose
+// artifact is not consumed by any step that can run after it. Steps whose
+// inputs can't be known up front, e.g. tasks configured from a file, are
+// assumed to consume every artifact.
+func skipUnusedGets(job atc.JobConfig) atc.JobConfig {
+	hooks, after := skipUnusedGetsInHooks(job.Hooks(), artifactUses{})
+
+	job.Abort = hooks.Abort
+	job.Error = hooks.Error
+	job.Failure = hooks.Failure
+	job.Success = hooks.Success
+	job.Ensure = hooks.Ensure
+
+	job.Plan, _ = skipUnusedGetsInSequence(job.Plan, after)
+
+	return job
+}
+
+func skipUnusedGetsInSequence(seq atc.PlanSequence, after artifactUses) (atc.PlanSequence, artifactUses) {
+	if seq == nil {
+		return nil, after
+	}
+
+	rewritten := make(atc.PlanSequence, len(seq))
+	for i := len(seq) - 1; i >= 0; i-- {
+		rewritten[i], after = skipUnusedGetsInStep(seq[i], after)
+	}
+
+	return rewritten, after
+}
+
+func skipUnusedGetsInHooks(hooks atc.Hooks, after artifactUses) (atc.Hooks, artifactUses) {
+	// only some of the hooks will run, but any of them may, so each sees the
+	// steps after the hooked step and the hooked step sees all of them
+	uses := after
+
+	rewrite := func(hook *atc.PlanConfig) *atc.PlanConfig {
+		if hook == nil {
+			return nil
+		}
+
+		rewritten, hookUses := skipUnusedGetsInStep(*hook, after)
+		uses = uses.union(hookUses)
+
+		return &rewritten
+	}
+
+	hooks.Abort = rewrite(hooks.Abort)
+	hooks.Error = rewrite(hooks.Error)
+	hooks.Failure = rewrite(hooks.Failure)
+	hooks.Success = rewrite(hooks.Success)
+	hooks.Ensure = rewrite(hooks.Ensure)
+
+	return hooks, uses
+}
+
+func skipUnusedGetsInStep(step atc.PlanConfig, after artifactUses) (atc.PlanConfig, artifactUses) {
+	hooks, uses := skipUnusedGetsInHooks(step.Hooks(), after)
+
+	step.Abort = hooks.Abort
+	step.Error = hooks.Error
+	step.Failure = hooks.Failure
+	step.Success = hooks.Success
+	step.Ensure = hooks.Ensure
+
+	switch {
+	case step.Do != nil:
+		do, doUses := skipUnusedGetsInSequence(*step.Do, uses)
+		step.Do = &do
+		uses = doUses
+
+	case step.Aggregate != nil:
+		// aggregated steps run in parallel, so they can't consume each other's
+		// artifacts
+		aggregate := make(atc.PlanSequence, len(*step.Aggregate))
+		aggregateUses := uses
+		for i, config := range *step.Aggregate {
+			var stepUses artifactUses
+			aggregate[i], stepUses = skipUnusedGetsInStep(config, uses)
+			aggregateUses = aggregateUses.union(stepUses)
+		}
+
+		step.Aggregate = &aggregate
+		uses = aggregateUses
+
+	case step.Try != nil:
+		try, tryUses := skipUnusedGetsInStep(*step.Try, uses)
+		step.Try = &try
+		uses = tryUses
+
+	case step.Put != "":
+		if !uses.has(step.Put) {
+			step.NoGet = true
+		}
+
+		if step.Inputs == nil || step.Inputs.All {
+			uses = uses.union(artifactUses{all: true})
+		} else {
+			uses = uses.with(step.Inputs.Specified...)
+		}
+
+	case step.Task != "":
+		if step.TaskConfig == nil || step.TaskConfigPath != "" {
+			uses = uses.union(artifactUses{all: true})
+			break
+		}
+
+		for _, input := range step.TaskConfig.Inputs {
+			name := input.Name
+			if mapped, found := step.InputMapping[name]; found {
+				name = mapped
+			}
+
+			uses = uses.with(name)
+		}
+
+		if step.ImageArtifactName != "" {
+			uses = uses.with(step.ImageArtifactName)
+		}
+	}
+
+	return step, uses
+}
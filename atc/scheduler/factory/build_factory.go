@@ -33,6 +33,8 @@ func (factory *buildFactory) Create(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	job = skipUnusedGets(job)
+
 	plan, err := factory.constructPlanFromJob(job, resources, resourceTypes, inputs)
 	if err != nil {
 		return atc.Plan{}, err
@@ -179,6 +181,13 @@ func (factory *buildFactory) constructUnhookedPlan(
 
 		putPlan := factory.planFactory.NewPlan(atcPutPlan)
 
+		// the put step saves the version it produced, so only fetch it when
+		// the artifact is needed
+		if planConfig.NoGet {
+			plan = putPlan
+			break
+		}
+
 		dependentGetPlan := factory.planFactory.NewPlan(atc.GetPlan{
 			Type:        resource.Type,
 			Name:        logicalName,
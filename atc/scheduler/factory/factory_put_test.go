@@ -71,20 +71,7 @@ var _ = Describe("Factory Put", func() {
 					VersionedResourceTypes: resourceTypes,
 				})
 
-				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-					Step: putPlan,
-					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-						Type:     "git",
-						Name:     "some-put",
-						Resource: "some-resource",
-						Source: atc.Source{
-							"uri": "git://some-resource",
-						},
-						VersionFrom:            &putPlan.ID,
-						VersionedResourceTypes: resourceTypes,
-					}),
-				})
-				Expect(actual).To(testhelpers.MatchPlan(expected))
+				Expect(actual).To(testhelpers.MatchPlan(putPlan))
 			})
 		})
 
@@ -168,20 +155,7 @@ var _ = Describe("Factory Put", func() {
 					},
 					VersionedResourceTypes: resourceTypes,
 				})
-				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-					Step: putPlan,
-					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-						Type:     "git",
-						Name:     "some-put",
-						Resource: "some-resource",
-						Source: atc.Source{
-							"uri": "git://some-resource",
-						},
-						VersionFrom:            &putPlan.ID,
-						VersionedResourceTypes: resourceTypes,
-					}),
-				})
-				Expect(actual).To(testhelpers.MatchPlan(expected))
+				Expect(actual).To(testhelpers.MatchPlan(putPlan))
 			})
 		})
 
@@ -219,19 +193,7 @@ var _ = Describe("Factory Put", func() {
 						VersionedResourceTypes: resourceTypes,
 					}),
 
-					Next: expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-						Step: putPlan,
-						Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-							Type:     "git",
-							Name:     "some-resource",
-							Resource: "some-resource",
-							Source: atc.Source{
-								"uri": "git://some-resource",
-							},
-							VersionFrom:            &putPlan.ID,
-							VersionedResourceTypes: resourceTypes,
-						}),
-					}),
+					Next: putPlan,
 				})
 				Expect(actual).To(testhelpers.MatchPlan(expected))
 			})
@@ -274,19 +236,7 @@ var _ = Describe("Factory Put", func() {
 						Name:                   "some thing",
 						VersionedResourceTypes: resourceTypes,
 					}),
-					expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-						Step: putPlan,
-						Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-							Type:     "git",
-							Name:     "some-resource",
-							Resource: "some-resource",
-							Source: atc.Source{
-								"uri": "git://some-resource",
-							},
-							VersionFrom:            &putPlan.ID,
-							VersionedResourceTypes: resourceTypes,
-						}),
-					}),
+					putPlan,
 				})
 				Expect(actual).To(testhelpers.MatchPlan(expected))
 			})
@@ -326,19 +276,7 @@ var _ = Describe("Factory Put", func() {
 						Name:                   "some-task",
 						VersionedResourceTypes: resourceTypes,
 					}),
-					expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-						Step: putPlan,
-						Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-							Type:     "git",
-							Name:     "money",
-							Resource: "some-resource",
-							Source: atc.Source{
-								"uri": "git://some-resource",
-							},
-							VersionFrom:            &putPlan.ID,
-							VersionedResourceTypes: resourceTypes,
-						}),
-					}),
+					putPlan,
 				})
 
 				Expect(actual).To(testhelpers.MatchPlan(expected))
@@ -405,6 +343,144 @@ var _ = Describe("Factory Put", func() {
 			})
 		})
 
+		Context("when a later task uses the put's artifact", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Put:       "some-resource",
+							GetParams: atc.Params{"some": "get-param"},
+						},
+						{
+							Task: "some-task",
+							TaskConfig: &atc.TaskConfig{
+								Inputs: []atc.TaskInputConfig{{Name: "some-input"}},
+							},
+							InputMapping: map[string]string{"some-input": "some-resource"},
+						},
+					},
+				}
+			})
+
+			It("fetches the put version with the get params", func() {
+				putPlan := expectedPlanFactory.NewPlan(atc.PutPlan{
+					Type:     "git",
+					Name:     "some-resource",
+					Resource: "some-resource",
+					Source: atc.Source{
+						"uri": "git://some-resource",
+					},
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				expectedPlan := expectedPlanFactory.NewPlan(atc.DoPlan{
+					expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+						Step: putPlan,
+						Next: expectedPlanFactory.NewPlan(atc.GetPlan{
+							Type:     "git",
+							Name:     "some-resource",
+							Resource: "some-resource",
+							Source: atc.Source{
+								"uri": "git://some-resource",
+							},
+							Params:                 atc.Params{"some": "get-param"},
+							VersionFrom:            &putPlan.ID,
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name: "some-task",
+						Config: &atc.TaskConfig{
+							Inputs: []atc.TaskInputConfig{{Name: "some-input"}},
+						},
+						InputMapping:           map[string]string{"some-input": "some-resource"},
+						VersionedResourceTypes: resourceTypes,
+					}),
+				})
+
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actual).To(testhelpers.MatchPlan(expectedPlan))
+			})
+
+			Context("when the put has no_get set", func() {
+				BeforeEach(func() {
+					input.Plan[0].GetParams = nil
+					input.Plan[0].NoGet = true
+				})
+
+				It("does not fetch the put version", func() {
+					actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(actual.Do).NotTo(BeNil())
+					Expect((*actual.Do)[0].Put).NotTo(BeNil())
+					Expect((*actual.Do)[0].OnSuccess).To(BeNil())
+				})
+			})
+		})
+
+		Context("when only a later task uses a different artifact", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Put: "some-resource",
+						},
+						{
+							Task: "some-task",
+							TaskConfig: &atc.TaskConfig{
+								Inputs: []atc.TaskInputConfig{{Name: "some-other-input"}},
+							},
+						},
+					},
+				}
+			})
+
+			It("does not fetch the put version", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actual.Do).NotTo(BeNil())
+				Expect((*actual.Do)[0].Put).NotTo(BeNil())
+				Expect((*actual.Do)[0].OnSuccess).To(BeNil())
+			})
+		})
+
+		Context("when a job hook uses the put's artifact", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Put: "some-resource",
+						},
+					},
+					Ensure: &atc.PlanConfig{
+						Put:    "some-other-put",
+						Inputs: &atc.InputsConfig{Specified: []string{"some-resource"}},
+					},
+				}
+
+				resources = append(resources, atc.ResourceConfig{
+					Name: "some-other-put",
+					Type: "git",
+				})
+			})
+
+			It("fetches the put version", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actual.Ensure).NotTo(BeNil())
+				Expect(actual.Ensure.Step.OnSuccess).NotTo(BeNil())
+				Expect(actual.Ensure.Step.OnSuccess.Next.Get).NotTo(BeNil())
+				Expect(actual.Ensure.Step.OnSuccess.Next.Get.VersionFrom).To(Equal(&actual.Ensure.Step.OnSuccess.Step.ID))
+
+				Expect(actual.Ensure.Next.Put).NotTo(BeNil())
+			})
+		})
+
 		Context("when I have a put specifying inputs", func() {
 			BeforeEach(func() {
 				input = atc.JobConfig{
@@ -432,20 +508,7 @@ var _ = Describe("Factory Put", func() {
 					},
 					VersionedResourceTypes: resourceTypes,
 				})
-				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-					Step: putPlan,
-					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-						Type:     "git",
-						Name:     "some-put",
-						Resource: "some-resource",
-						Source: atc.Source{
-							"uri": "git://some-resource",
-						},
-						VersionFrom:            &putPlan.ID,
-						VersionedResourceTypes: resourceTypes,
-					}),
-				})
-				Expect(actual).To(testhelpers.MatchPlan(expected))
+				Expect(actual).To(testhelpers.MatchPlan(putPlan))
 			})
 		})
 
@@ -476,20 +539,7 @@ var _ = Describe("Factory Put", func() {
 					},
 					VersionedResourceTypes: resourceTypes,
 				})
-				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-					Step: putPlan,
-					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-						Type:     "git",
-						Name:     "some-put",
-						Resource: "some-resource",
-						Source: atc.Source{
-							"uri": "git://some-resource",
-						},
-						VersionFrom:            &putPlan.ID,
-						VersionedResourceTypes: resourceTypes,
-					}),
-				})
-				Expect(actual).To(testhelpers.MatchPlan(expected))
+				Expect(actual).To(testhelpers.MatchPlan(putPlan))
 			})
 		})
 
@@ -518,20 +568,7 @@ var _ = Describe("Factory Put", func() {
 					},
 					VersionedResourceTypes: resourceTypes,
 				})
-				expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
-					Step: putPlan,
-					Next: expectedPlanFactory.NewPlan(atc.GetPlan{
-						Type:     "git",
-						Name:     "some-put",
-						Resource: "some-resource",
-						Source: atc.Source{
-							"uri": "git://some-resource",
-						},
-						VersionFrom:            &putPlan.ID,
-						VersionedResourceTypes: resourceTypes,
-					}),
-				})
-				Expect(actual).To(testhelpers.MatchPlan(expected))
+				Expect(actual).To(testhelpers.MatchPlan(putPlan))
 			})
 		})
 	})
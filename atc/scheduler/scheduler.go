@@ -1,13 +1,16 @@
 package scheduler
 
 import (
+	"context"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type Scheduler struct {
@@ -22,12 +25,33 @@ func (s *Scheduler) Schedule(
 	jobs []db.Job,
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
+) (map[string]time.Duration, error) {
+	ctx, span := tracing.StartSpan(context.Background(), "schedule", tracing.Attrs{
+		"team":     s.Pipeline.TeamName(),
+		"pipeline": s.Pipeline.Name(),
+	})
+
+	jobSchedulingTime, err := s.schedule(ctx, logger, versions, jobs, resources, resourceTypes)
+	tracing.End(span, err)
+
+	return jobSchedulingTime, err
+}
+
+func (s *Scheduler) schedule(
+	ctx context.Context,
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	jobs []db.Job,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
 ) (map[string]time.Duration, error) {
 	jobSchedulingTime := map[string]time.Duration{}
 
 	for _, job := range jobs {
 		jStart := time.Now()
+		_, jobSpan := tracing.StartSpan(ctx, "resolve-inputs", tracing.Attrs{"job": job.Name()})
 		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		tracing.End(jobSpan, err)
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -44,11 +68,21 @@ func (s *Scheduler) Schedule(
 	for _, job := range jobs {
 		jStart := time.Now()
 		nextPendingBuildsForJob, ok := nextPendingBuilds[job.Name()]
+
+		metric.PendingBuilds{
+			PipelineName: s.Pipeline.Name(),
+			JobName:      job.Name(),
+			TeamName:     s.Pipeline.TeamName(),
+			Builds:       len(nextPendingBuildsForJob),
+		}.Emit(logger)
+
 		if !ok {
 			continue
 		}
 
+		_, jobSpan := tracing.StartSpan(ctx, "start-pending-builds", tracing.Attrs{"job": job.Name()})
 		err := s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuildsForJob)
+		tracing.End(jobSpan, err)
 		jobSchedulingTime[job.Name()] = jobSchedulingTime[job.Name()] + time.Since(jStart)
 
 		if err != nil {
@@ -85,5 +119,63 @@ func (s *Scheduler) ensurePendingBuildExists(
 		}
 	}
 
+	return s.ensureCronBuildExists(logger, job)
+}
+
+// ensureCronBuildExists creates a pending build if any of the job's cron
+// triggers fired since the job was last triggered. Fire times missed while no
+// scheduler was running only trigger a single build.
+func (s *Scheduler) ensureCronBuildExists(logger lager.Logger, job db.Job) error {
+	now := time.Now()
+
+	var (
+		firedAt   time.Time
+		firedCron string
+	)
+
+	for _, trigger := range job.Config().Triggers {
+		schedule, err := trigger.Schedule()
+		if err != nil {
+			logger.Error("failed-to-parse-cron-trigger", err, lager.Data{"cron": trigger.Cron})
+			continue
+		}
+
+		location, err := trigger.Location()
+		if err != nil {
+			logger.Error("failed-to-load-cron-trigger-timezone", err, lager.Data{"timezone": trigger.Timezone})
+			continue
+		}
+
+		next, err := schedule.Next(job.CronTriggeredAt().In(location))
+		if err != nil || next.After(now) {
+			continue
+		}
+
+		if firedAt.IsZero() || next.Before(firedAt) {
+			firedAt = next
+			firedCron = trigger.Cron
+		}
+	}
+
+	if firedAt.IsZero() {
+		return nil
+	}
+
+	triggered, err := job.EnsureCronBuildExists(now, db.BuildInput{
+		Name: "cron",
+		Version: atc.Version{
+			"cron": firedCron,
+			"time": firedAt.Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		logger.Error("failed-to-ensure-cron-build-exists", err)
+		return err
+	}
+
+	if triggered {
+		logger.Info("cron-triggered", lager.Data{"job": job.Name(), "cron": firedCron, "time": firedAt})
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package scheduler_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
@@ -249,5 +250,73 @@ var _ = Describe("Scheduler", func() {
 				})
 			})
 		})
+
+		Context("when the job has a cron trigger", func() {
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("some-job")
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Triggers: atc.JobTriggers{
+						{Cron: "0 * * * *", Timezone: "UTC"},
+					},
+				})
+
+				fakeJobs = []db.Job{fakeJob}
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+			})
+
+			Context("when the trigger has not fired since the job was last triggered", func() {
+				BeforeEach(func() {
+					fakeJob.CronTriggeredAtReturns(time.Now())
+				})
+
+				It("does not create a pending build", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.EnsureCronBuildExistsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the trigger has fired since the job was last triggered", func() {
+				var lastTriggered time.Time
+
+				BeforeEach(func() {
+					lastTriggered = time.Now().Add(-2 * time.Hour)
+					fakeJob.CronTriggeredAtReturns(lastTriggered)
+					fakeJob.EnsureCronBuildExistsReturns(true, nil)
+				})
+
+				It("creates a pending build with the fire time as its input", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.EnsureCronBuildExistsCallCount()).To(Equal(1))
+
+					triggeredAt, input := fakeJob.EnsureCronBuildExistsArgsForCall(0)
+					Expect(triggeredAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+					firedAt := lastTriggered.Truncate(time.Hour).Add(time.Hour)
+					Expect(input).To(Equal(db.BuildInput{
+						Name: "cron",
+						Version: atc.Version{
+							"cron": "0 * * * *",
+							"time": firedAt.UTC().Format(time.RFC3339),
+						},
+					}))
+				})
+
+				It("starts all pending builds", func() {
+					Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				})
+
+				Context("when creating the pending build fails", func() {
+					BeforeEach(func() {
+						fakeJob.EnsureCronBuildExistsReturns(false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+					})
+				})
+			})
+		})
 	})
 })
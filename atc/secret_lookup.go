@@ -0,0 +1,11 @@
+package atc
+
+type SecretLookup struct {
+	UsedByType   string `json:"used_by_type"`
+	UsedByName   string `json:"used_by_name"`
+	Name         string `json:"name"`
+	Backend      string `json:"backend,omitempty"`
+	Path         string `json:"path,omitempty"`
+	Found        bool   `json:"found"`
+	LastLookedUp int64  `json:"last_looked_up"`
+}
@@ -0,0 +1,111 @@
+package tracing
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// batchProcessor buffers finished spans in a bounded queue and exports them
+// in batches, so that a slow collector never blocks the code being traced.
+type batchProcessor struct {
+	logger   lager.Logger
+	exporter Exporter
+
+	batchSize     int
+	flushInterval time.Duration
+
+	queue chan SpanData
+	done  chan struct{}
+	wg    *sync.WaitGroup
+}
+
+func newBatchProcessor(logger lager.Logger, exporter Exporter, batchSize int, queueSize int, flushInterval time.Duration) *batchProcessor {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	if queueSize < batchSize {
+		queueSize = batchSize
+	}
+
+	p := &batchProcessor{
+		logger:   logger,
+		exporter: exporter,
+
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+
+		queue: make(chan SpanData, queueSize),
+		done:  make(chan struct{}),
+		wg:    new(sync.WaitGroup),
+	}
+
+	p.wg.Add(1)
+	go p.run()
+
+	return p
+}
+
+func (p *batchProcessor) enqueue(data SpanData) {
+	select {
+	case p.queue <- data:
+	default:
+		p.logger.Debug("dropped-span", lager.Data{"span": data.Name})
+	}
+}
+
+func (p *batchProcessor) stop() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+func (p *batchProcessor) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]SpanData, 0, p.batchSize)
+
+	for {
+		select {
+		case data := <-p.queue:
+			batch = append(batch, data)
+			if len(batch) >= p.batchSize {
+				batch = p.export(batch)
+			}
+
+		case <-ticker.C:
+			batch = p.export(batch)
+
+		case <-p.done:
+			for {
+				select {
+				case data := <-p.queue:
+					batch = append(batch, data)
+					if len(batch) >= p.batchSize {
+						batch = p.export(batch)
+					}
+				default:
+					p.export(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *batchProcessor) export(batch []SpanData) []SpanData {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	err := p.exporter.Export(batch)
+	if err != nil {
+		p.logger.Error("failed-to-export-spans", err, lager.Data{"spans": len(batch)})
+	}
+
+	return make([]SpanData, 0, p.batchSize)
+}
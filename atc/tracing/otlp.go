@@ -0,0 +1,183 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const otlpTracesPath = "/v1/traces"
+
+// OTLPExporter sends spans to a collector using the JSON encoding of the
+// OTLP/HTTP protocol.
+type OTLPExporter struct {
+	url         string
+	headers     map[string]string
+	serviceName string
+
+	client *http.Client
+}
+
+func NewOTLPExporter(address string, headers map[string]string, serviceName string) *OTLPExporter {
+	return &OTLPExporter{
+		url:         strings.TrimSuffix(address, "/") + otlpTracesPath,
+		headers:     headers,
+		serviceName: serviceName,
+
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (exporter *OTLPExporter) Export(spans []SpanData) error {
+	payload, err := json.Marshal(exporter.request(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", exporter.url, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range exporter.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := exporter.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("collector responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+func (exporter *OTLPExporter) request(spans []SpanData) otlpRequest {
+	otlpSpans := []otlpSpan{}
+	for _, data := range spans {
+		s := otlpSpan{
+			TraceID:           data.TraceID.String(),
+			SpanID:            data.SpanID.String(),
+			Name:              data.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(data.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(data.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(data.Attrs),
+			Status:            otlpStatus{Code: otlpStatusCodeOK},
+		}
+
+		if data.ParentSpanID != (SpanID{}) {
+			s.ParentSpanID = data.ParentSpanID.String()
+		}
+
+		if data.Error != "" {
+			s.Status = otlpStatus{Code: otlpStatusCodeError, Message: data.Error}
+		}
+
+		otlpSpans = append(otlpSpans, s)
+	}
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes(Attrs{"service.name": exporter.serviceName}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "concourse"},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpAttributes(attrs Attrs) []otlpAttribute {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	otlpAttrs := []otlpAttribute{}
+	for _, k := range keys {
+		otlpAttrs = append(otlpAttrs, otlpAttribute{
+			Key:   k,
+			Value: otlpValue{StringValue: attrs[k]},
+		})
+	}
+
+	return otlpAttrs
+}
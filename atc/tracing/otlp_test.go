@@ -0,0 +1,88 @@
+package tracing_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("OTLPExporter", func() {
+	var (
+		collector *ghttp.Server
+		exporter  *tracing.OTLPExporter
+	)
+
+	BeforeEach(func() {
+		collector = ghttp.NewServer()
+		exporter = tracing.NewOTLPExporter(collector.URL()+"/", map[string]string{"Authorization": "Bearer some-token"}, "concourse-web")
+	})
+
+	AfterEach(func() {
+		collector.Close()
+	})
+
+	It("posts spans as OTLP JSON", func() {
+		collector.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/traces"),
+				ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.VerifyJSON(`{
+					"resourceSpans": [{
+						"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "concourse-web"}}]},
+						"scopeSpans": [{
+							"scope": {"name": "concourse"},
+							"spans": [{
+								"traceId": "0af7651916cd43dd8448eb211c80319c",
+								"spanId": "b7ad6b7169203331",
+								"parentSpanId": "00f067aa0ba902b7",
+								"name": "task",
+								"kind": 1,
+								"startTimeUnixNano": "1000000000",
+								"endTimeUnixNano": "3000000000",
+								"attributes": [
+									{"key": "job", "value": {"stringValue": "some-job"}},
+									{"key": "team", "value": {"stringValue": "main"}}
+								],
+								"status": {"code": 2, "message": "exit status 1"}
+							}]
+						}]
+					}]
+				}`),
+				ghttp.RespondWith(http.StatusOK, "{}"),
+			),
+		)
+
+		parent, err := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-00f067aa0ba902b7-01")
+		Expect(err).ToNot(HaveOccurred())
+
+		child, err := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = exporter.Export([]tracing.SpanData{
+			{
+				TraceID:      child.TraceID,
+				SpanID:       child.SpanID,
+				ParentSpanID: parent.SpanID,
+				Name:         "task",
+				Start:        time.Unix(1, 0),
+				End:          time.Unix(3, 0),
+				Attrs:        tracing.Attrs{"team": "main", "job": "some-job"},
+				Error:        "exit status 1",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(collector.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("returns an error when the collector rejects the spans", func() {
+		collector.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, ""))
+
+		err := exporter.Export([]tracing.SpanData{{Name: "task"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,213 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+type Config struct {
+	ServiceName string            `long:"service-name" default:"concourse-web" description:"Service name to attach to exported spans."`
+	OTLPAddress string            `long:"otlp-address"                         description:"OTLP/HTTP endpoint of a trace collector (e.g. an OpenTelemetry Collector or Jaeger) to export spans to, e.g. http://127.0.0.1:4318."`
+	OTLPHeaders map[string]string `long:"otlp-header"                          description:"A header to send along with exported spans, e.g. for authentication. Can be specified multiple times." value-name:"NAME:VALUE"`
+
+	BatchSize     int           `long:"batch-size"     default:"512"  description:"Maximum number of spans to export at once."`
+	QueueSize     int           `long:"queue-size"     default:"2048" description:"Maximum number of spans to buffer before dropping them."`
+	FlushInterval time.Duration `long:"flush-interval" default:"5s"   description:"Interval on which buffered spans are exported."`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.OTLPAddress != ""
+}
+
+// Attrs are string attributes attached to a span.
+type Attrs map[string]string
+
+// Span is a unit of work within a trace. Spans must be ended exactly once.
+type Span interface {
+	SetAttribute(key string, value string)
+	SetError(error)
+	End()
+}
+
+// SpanData is a finished span, as handed to an Exporter.
+type SpanData struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+
+	Name  string
+	Start time.Time
+	End   time.Time
+
+	Attrs Attrs
+	Error string
+}
+
+//go:generate counterfeiter . Exporter
+
+type Exporter interface {
+	Export([]SpanData) error
+}
+
+var (
+	processor   *batchProcessor
+	processorL  sync.RWMutex
+	serviceName string
+)
+
+// Initialize configures the exporter that spans are sent to. Until it is
+// called, StartSpan returns spans which record nothing.
+func Initialize(logger lager.Logger, config Config) error {
+	if !config.IsConfigured() {
+		return nil
+	}
+
+	exporter := NewOTLPExporter(config.OTLPAddress, config.OTLPHeaders, config.ServiceName)
+
+	ConfigureExporter(logger, exporter, config.BatchSize, config.QueueSize, config.FlushInterval)
+
+	return nil
+}
+
+// ConfigureExporter starts batching finished spans to the given exporter.
+func ConfigureExporter(logger lager.Logger, exporter Exporter, batchSize int, queueSize int, flushInterval time.Duration) {
+	processorL.Lock()
+	defer processorL.Unlock()
+
+	if processor != nil {
+		processor.stop()
+	}
+
+	processor = newBatchProcessor(logger, exporter, batchSize, queueSize, flushInterval)
+}
+
+// Deinitialize flushes any buffered spans and stops recording new ones.
+func Deinitialize() {
+	processorL.Lock()
+	defer processorL.Unlock()
+
+	if processor != nil {
+		processor.stop()
+		processor = nil
+	}
+}
+
+// Configured returns whether spans are being recorded.
+func Configured() bool {
+	processorL.RLock()
+	defer processorL.RUnlock()
+
+	return processor != nil
+}
+
+type spanKey struct{}
+
+// StartSpan starts a span as a child of the span in ctx, or as the root of a
+// new trace if ctx has none. The returned context carries the new span.
+func StartSpan(ctx context.Context, name string, attrs Attrs) (context.Context, Span) {
+	processorL.RLock()
+	p := processor
+	processorL.RUnlock()
+
+	if p == nil {
+		return ctx, noopSpan{}
+	}
+
+	parent := SpanContextFromContext(ctx)
+
+	spanCtx := SpanContext{
+		TraceID: parent.TraceID,
+		SpanID:  newSpanID(),
+	}
+
+	if !parent.IsValid() {
+		spanCtx.TraceID = newTraceID()
+	}
+
+	recorded := &span{
+		processor: p,
+		context:   spanCtx,
+		data: SpanData{
+			TraceID:      spanCtx.TraceID,
+			SpanID:       spanCtx.SpanID,
+			ParentSpanID: parent.SpanID,
+
+			Name:  name,
+			Start: time.Now(),
+			Attrs: Attrs{},
+		},
+	}
+
+	for k, v := range attrs {
+		recorded.data.Attrs[k] = v
+	}
+
+	return context.WithValue(ctx, spanKey{}, spanCtx), recorded
+}
+
+// End records err on the span, if any, and ends it.
+func End(span Span, err error) {
+	if err != nil {
+		span.SetError(err)
+	}
+
+	span.End()
+}
+
+type span struct {
+	processor *batchProcessor
+	context   SpanContext
+
+	dataL sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+func (s *span) SetAttribute(key string, value string) {
+	s.dataL.Lock()
+	s.data.Attrs[key] = value
+	s.dataL.Unlock()
+}
+
+func (s *span) SetError(err error) {
+	s.dataL.Lock()
+	s.data.Error = err.Error()
+	s.dataL.Unlock()
+}
+
+func (s *span) End() {
+	s.dataL.Lock()
+	if s.ended {
+		s.dataL.Unlock()
+		return
+	}
+
+	s.ended = true
+	s.data.End = time.Now()
+	data := s.data
+	s.dataL.Unlock()
+
+	s.processor.enqueue(data)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, string) {}
+func (noopSpan) SetError(error)              {}
+func (noopSpan) End()                        {}
+
+func newTraceID() TraceID {
+	var id TraceID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
@@ -0,0 +1,147 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracingfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracing", func() {
+	var fakeExporter *tracingfakes.FakeExporter
+
+	exported := func() []tracing.SpanData {
+		spans := []tracing.SpanData{}
+		for i := 0; i < fakeExporter.ExportCallCount(); i++ {
+			spans = append(spans, fakeExporter.ExportArgsForCall(i)...)
+		}
+		return spans
+	}
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+	})
+
+	AfterEach(func() {
+		tracing.Deinitialize()
+	})
+
+	Context("when no exporter is configured", func() {
+		It("records nothing and does not propagate", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			span.SetAttribute("some", "attr")
+			tracing.End(span, errors.New("nope"))
+
+			Expect(tracing.Configured()).To(BeFalse())
+			Expect(tracing.Env(ctx)).To(BeNil())
+		})
+	})
+
+	Context("when an exporter is configured", func() {
+		BeforeEach(func() {
+			tracing.ConfigureExporter(lagertest.NewTestLogger("test"), fakeExporter, 10, 100, time.Hour)
+		})
+
+		It("exports ended spans when flushed", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", tracing.Attrs{"team": "main"})
+			span.SetAttribute("pipeline", "some-pipeline")
+			span.End()
+
+			tracing.Deinitialize()
+
+			spans := exported()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("some-span"))
+			Expect(spans[0].Attrs).To(Equal(tracing.Attrs{
+				"team":     "main",
+				"pipeline": "some-pipeline",
+			}))
+			Expect(spans[0].End).ToNot(BeTemporally("<", spans[0].Start))
+			Expect(spans[0].ParentSpanID).To(BeZero())
+		})
+
+		It("exports only once per span", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			span.End()
+			span.End()
+
+			tracing.Deinitialize()
+
+			Expect(exported()).To(HaveLen(1))
+		})
+
+		It("records errors", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			tracing.End(span, errors.New("nope"))
+
+			tracing.Deinitialize()
+
+			Expect(exported()[0].Error).To(Equal("nope"))
+		})
+
+		It("starts child spans within the same trace", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+			_, child := tracing.StartSpan(ctx, "child", nil)
+			child.End()
+			parent.End()
+
+			tracing.Deinitialize()
+
+			spans := exported()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].TraceID).To(Equal(spans[1].TraceID))
+			Expect(spans[0].ParentSpanID).To(Equal(spans[1].SpanID))
+		})
+
+		It("exports in batches", func() {
+			for i := 0; i < 25; i++ {
+				_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+				span.End()
+			}
+
+			Eventually(fakeExporter.ExportCallCount).Should(Equal(2))
+
+			tracing.Deinitialize()
+
+			Expect(fakeExporter.ExportCallCount()).To(Equal(3))
+			Expect(exported()).To(HaveLen(25))
+		})
+
+		It("propagates the current span through the environment", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			defer span.End()
+
+			sc := tracing.SpanContextFromContext(ctx)
+
+			Expect(tracing.Env(ctx)).To(Equal([]string{
+				"TRACEPARENT=00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-01",
+			}))
+		})
+	})
+
+	Describe("ParseTraceParent", func() {
+		It("parses what TraceParent formats", func() {
+			sc, err := tracing.ParseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sc.TraceParent()).To(Equal("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"))
+		})
+
+		It("rejects malformed values", func() {
+			for _, value := range []string{
+				"",
+				"01-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+				"00-0af7651916cd43dd-b7ad6b7169203331-01",
+				"00-00000000000000000000000000000000-b7ad6b7169203331-01",
+				"00-0af7651916cd43dd8448eb211c80319c-zzzzzzzzzzzzzzzz-01",
+			} {
+				_, err := tracing.ParseTraceParent(value)
+				Expect(err).To(Equal(tracing.ErrInvalidTraceParent), value)
+			}
+		})
+	})
+})
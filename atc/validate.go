@@ -25,25 +25,36 @@ type ConfigWarning struct {
 }
 
 func (c Config) Validate() ([]ConfigWarning, []string) {
+	return c.ValidateWithSources(nil)
+}
+
+// ValidateWithSources validates the config like Validate, prefixing each error
+// with the file and line the part of the config it is about was loaded from.
+func (c Config) ValidateWithSources(sources ConfigSources) ([]ConfigWarning, []string) {
 	warnings := []ConfigWarning{}
 	errorMessages := []string{}
 
-	groupsErr := validateGroups(c)
+	groupsErr := validateGroups(c, sources)
 	if groupsErr != nil {
 		errorMessages = append(errorMessages, formatErr("groups", groupsErr))
 	}
 
-	resourcesErr := validateResources(c)
+	labelsErr := validateLabels(c, sources)
+	if labelsErr != nil {
+		errorMessages = append(errorMessages, formatErr("labels", labelsErr))
+	}
+
+	resourcesErr := validateResources(c, sources)
 	if resourcesErr != nil {
 		errorMessages = append(errorMessages, formatErr("resources", resourcesErr))
 	}
 
-	resourceTypesErr := validateResourceTypes(c)
+	resourceTypesErr := validateResourceTypes(c, sources)
 	if resourceTypesErr != nil {
 		errorMessages = append(errorMessages, formatErr("resource types", resourceTypesErr))
 	}
 
-	jobWarnings, jobsErr := validateJobs(c)
+	jobWarnings, jobsErr := validateJobs(c, sources)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
 	}
@@ -52,30 +63,70 @@ func (c Config) Validate() ([]ConfigWarning, []string) {
 	return warnings, errorMessages
 }
 
-func validateGroups(c Config) error {
+// ValidateTeamResourceTypes reports which resource types used by the pipeline
+// resolve from the team's resource types, and which of the team's resource
+// types are shadowed by one declared in the pipeline.
+func (c Config) ValidateTeamResourceTypes(teamTypes ResourceTypes) []ConfigWarning {
+	warnings := []ConfigWarning{}
+
+	for _, name := range c.teamResourceTypeNames(teamTypes) {
+		warnings = append(warnings, ConfigWarning{
+			Type:    "resource_type",
+			Message: fmt.Sprintf("resource type '%s' resolves from the team's resource types", name),
+		})
+	}
+
+	for _, resourceType := range c.ResourceTypes {
+		if _, found := teamTypes.Lookup(resourceType.Name); found {
+			warnings = append(warnings, ConfigWarning{
+				Type:    "resource_type",
+				Message: fmt.Sprintf("resource type '%s' is declared by both the pipeline and the team; using the pipeline's", resourceType.Name),
+			})
+		}
+	}
+
+	return warnings
+}
+
+// Validate checks resource types defined outside of a pipeline, i.e. the
+// team's resource types.
+func (types ResourceTypes) Validate() []string {
+	err := validateResourceTypes(Config{ResourceTypes: types}, nil)
+	if err != nil {
+		return []string{formatErr("resource types", err)}
+	}
+
+	return nil
+}
+
+func validateGroups(c Config, sources ConfigSources) error {
 	errorMessages := []string{}
 
 	jobsGrouped := make(map[string]bool)
-	for _, job := range c.Jobs {
+	jobPaths := make(map[string]string)
+	for i, job := range c.Jobs {
 		jobsGrouped[job.Name] = false
+		jobPaths[job.Name] = fmt.Sprintf("jobs[%d]", i)
 	}
 
-	for _, group := range c.Groups {
-		for _, job := range group.Jobs {
+	for i, group := range c.Groups {
+		for j, job := range group.Jobs {
 			_, exists := c.Jobs.Lookup(job)
 			if !exists {
-				errorMessages = append(errorMessages,
-					fmt.Sprintf("group '%s' has unknown job '%s'", group.Name, job))
+				errorMessages = append(errorMessages, sources.Annotate(
+					fmt.Sprintf("groups[%d].jobs[%d]", i, j),
+					fmt.Sprintf("group '%s' has unknown job '%s'", group.Name, job)))
 			} else {
 				jobsGrouped[job] = true
 			}
 		}
 
-		for _, resource := range group.Resources {
+		for j, resource := range group.Resources {
 			_, exists := c.Resources.Lookup(resource)
 			if !exists {
-				errorMessages = append(errorMessages,
-					fmt.Sprintf("group '%s' has unknown resource '%s'", group.Name, resource))
+				errorMessages = append(errorMessages, sources.Annotate(
+					fmt.Sprintf("groups[%d].resources[%d]", i, j),
+					fmt.Sprintf("group '%s' has unknown resource '%s'", group.Name, resource)))
 			}
 		}
 	}
@@ -83,7 +134,7 @@ func validateGroups(c Config) error {
 	if len(c.Groups) != 0 {
 		for job, grouped := range jobsGrouped {
 			if !grouped {
-				errorMessages = append(errorMessages, fmt.Sprintf("job '%s' belongs to no group", job))
+				errorMessages = append(errorMessages, sources.Annotate(jobPaths[job], fmt.Sprintf("job '%s' belongs to no group", job)))
 			}
 		}
 	}
@@ -91,84 +142,128 @@ func validateGroups(c Config) error {
 	return compositeErr(errorMessages)
 }
 
-func validateResources(c Config) error {
+func validateLabels(c Config, sources ConfigSources) error {
+	errorMessages := []string{}
+
+	for key := range c.Labels {
+		if err := ValidateLabelKey(key); err != nil {
+			errorMessages = append(errorMessages, sources.Annotate("labels."+key, err.Error()))
+		}
+	}
+
+	sort.Strings(errorMessages)
+
+	return compositeErr(errorMessages)
+}
+
+func validateResources(c Config, sources ConfigSources) error {
 	errorMessages := []string{}
 
 	names := map[string]int{}
 
 	for i, resource := range c.Resources {
+		path := fmt.Sprintf("resources[%d]", i)
+
 		var identifier string
 		if resource.Name == "" {
-			identifier = fmt.Sprintf("resources[%d]", i)
+			identifier = path
 		} else {
 			identifier = fmt.Sprintf("resources.%s", resource.Name)
 		}
 
 		if other, exists := names[resource.Name]; exists {
-			errorMessages = append(errorMessages,
+			errorMessages = append(errorMessages, sources.Annotate(path,
 				fmt.Sprintf(
 					"resources[%d] and resources[%d] have the same name ('%s')",
-					other, i, resource.Name))
+					other, i, resource.Name)))
 		} else if resource.Name != "" {
 			names[resource.Name] = i
 		}
 
 		if resource.Name == "" {
-			errorMessages = append(errorMessages, identifier+" has no name")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" has no name"))
 		}
 
 		if resource.Type == "" {
-			errorMessages = append(errorMessages, identifier+" has no type")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" has no type"))
+		}
+
+		errorMessages = append(errorMessages, sources.annotateAll(path+".check_every", validateCheckEvery(identifier, resource.CheckEvery))...)
+
+		if resource.VersionHistory < 0 {
+			errorMessages = append(errorMessages, sources.Annotate(path+".version_history",
+				identifier+fmt.Sprintf(" has negative version_history: %d", resource.VersionHistory)))
 		}
 	}
 
-	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
+	errorMessages = append(errorMessages, validateResourcesUnused(c, sources)...)
 
 	return compositeErr(errorMessages)
 }
 
-func validateResourceTypes(c Config) error {
+func validateResourceTypes(c Config, sources ConfigSources) error {
 	errorMessages := []string{}
 
 	names := map[string]int{}
 
 	for i, resourceType := range c.ResourceTypes {
+		path := fmt.Sprintf("resource_types[%d]", i)
+
 		var identifier string
 		if resourceType.Name == "" {
-			identifier = fmt.Sprintf("resource_types[%d]", i)
+			identifier = path
 		} else {
 			identifier = fmt.Sprintf("resource_types.%s", resourceType.Name)
 		}
 
 		if other, exists := names[resourceType.Name]; exists {
-			errorMessages = append(errorMessages,
+			errorMessages = append(errorMessages, sources.Annotate(path,
 				fmt.Sprintf(
 					"resource_types[%d] and resource_types[%d] have the same name ('%s')",
-					other, i, resourceType.Name))
+					other, i, resourceType.Name)))
 		} else if resourceType.Name != "" {
 			names[resourceType.Name] = i
 		}
 
 		if resourceType.Name == "" {
-			errorMessages = append(errorMessages, identifier+" has no name")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" has no name"))
 		}
 
 		if resourceType.Type == "" {
-			errorMessages = append(errorMessages, identifier+" has no type")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" has no type"))
 		}
+
+		errorMessages = append(errorMessages, sources.annotateAll(path+".check_every", validateCheckEvery(identifier, resourceType.CheckEvery))...)
 	}
 
 	return compositeErr(errorMessages)
 }
 
-func validateResourcesUnused(c Config) []string {
+func validateCheckEvery(identifier string, checkEvery string) []string {
+	if checkEvery == "" || checkEvery == CheckEveryNever {
+		return nil
+	}
+
+	interval, err := time.ParseDuration(checkEvery)
+	if err != nil {
+		return []string{fmt.Sprintf("%s has invalid check_every '%s' (must be a duration or '%s')", identifier, checkEvery, CheckEveryNever)}
+	}
+
+	if interval <= 0 {
+		return []string{fmt.Sprintf("%s has non-positive check_every '%s'", identifier, checkEvery)}
+	}
+
+	return nil
+}
+
+func validateResourcesUnused(c Config, sources ConfigSources) []string {
 	usedResources := usedResources(c)
 
 	var errorMessages []string
-	for _, resource := range c.Resources {
+	for i, resource := range c.Resources {
 		if _, used := usedResources[resource.Name]; !used {
 			message := fmt.Sprintf("resource '%s' is not used", resource.Name)
-			errorMessages = append(errorMessages, message)
+			errorMessages = append(errorMessages, sources.Annotate(fmt.Sprintf("resources[%d]", i), message))
 		}
 	}
 
@@ -190,75 +285,98 @@ func usedResources(c Config) map[string]bool {
 	return usedResources
 }
 
-func validateJobs(c Config) ([]ConfigWarning, error) {
+func validateJobs(c Config, sources ConfigSources) ([]ConfigWarning, error) {
 	errorMessages := []string{}
 	warnings := []ConfigWarning{}
 
 	names := map[string]int{}
 
 	for i, job := range c.Jobs {
+		path := fmt.Sprintf("jobs[%d]", i)
+
 		var identifier string
 		if job.Name == "" {
-			identifier = fmt.Sprintf("jobs[%d]", i)
+			identifier = path
 		} else {
 			identifier = fmt.Sprintf("jobs.%s", job.Name)
 		}
 
 		if other, exists := names[job.Name]; exists {
-			errorMessages = append(errorMessages,
+			errorMessages = append(errorMessages, sources.Annotate(path,
 				fmt.Sprintf(
 					"jobs[%d] and jobs[%d] have the same name ('%s')",
-					other, i, job.Name))
+					other, i, job.Name)))
 		} else if job.Name != "" {
 			names[job.Name] = i
 		}
 
 		if job.Name == "" {
-			errorMessages = append(errorMessages, identifier+" has no name")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" has no name"))
 		}
 
 		if job.BuildLogsToRetain < 0 {
 			errorMessages = append(
 				errorMessages,
-				identifier+fmt.Sprintf(" has negative build_logs_to_retain: %d", job.BuildLogsToRetain),
+				sources.Annotate(path+".build_logs_to_retain", identifier+fmt.Sprintf(" has negative build_logs_to_retain: %d", job.BuildLogsToRetain)),
 			)
 		}
 
-		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
+		for j, trigger := range job.Triggers {
+			triggerIdentifier := fmt.Sprintf("%s.triggers[%d]", identifier, j)
+			triggerPath := fmt.Sprintf("%s.triggers[%d]", path, j)
+
+			if trigger.Cron == "" {
+				errorMessages = append(errorMessages, sources.Annotate(triggerPath, triggerIdentifier+" has no cron expression"))
+			} else if _, err := trigger.Schedule(); err != nil {
+				errorMessages = append(
+					errorMessages,
+					sources.Annotate(triggerPath+".cron", triggerIdentifier+fmt.Sprintf(" has invalid cron expression '%s': %s", trigger.Cron, err)),
+				)
+			}
+
+			if _, err := trigger.Location(); err != nil {
+				errorMessages = append(
+					errorMessages,
+					sources.Annotate(triggerPath+".timezone", triggerIdentifier+fmt.Sprintf(" has unknown timezone '%s'", trigger.Timezone)),
+				)
+			}
+		}
+
+		planWarnings, planErrMessages := validatePlan(c, sources, identifier+".plan", path+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 
 		if job.Abort != nil {
 			subIdentifier := fmt.Sprintf("%s.abort", identifier)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, *job.Abort)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_abort", *job.Abort)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
 		if job.Error != nil {
 			subIdentifier := fmt.Sprintf("%s.error", identifier)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, *job.Error)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_error", *job.Error)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
 		if job.Failure != nil {
 			subIdentifier := fmt.Sprintf("%s.failure", identifier)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, *job.Failure)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_failure", *job.Failure)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
 		if job.Ensure != nil {
 			subIdentifier := fmt.Sprintf("%s.ensure", identifier)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, *job.Ensure)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".ensure", *job.Ensure)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
 		if job.Success != nil {
 			subIdentifier := fmt.Sprintf("%s.success", identifier)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, *job.Success)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_success", *job.Success)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
@@ -270,7 +388,7 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			if encountered[input.Name] == 2 {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf("%s has get steps with the same name: %s", identifier, input.Name),
+					sources.Annotate(path, fmt.Sprintf("%s has get steps with the same name: %s", identifier, input.Name)),
 				)
 			}
 		}
@@ -308,7 +426,7 @@ func (ft foundTypes) IsValid() (bool, string) {
 	return true, ""
 }
 
-func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning, []string) {
+func validatePlan(c Config, sources ConfigSources, identifier string, path string, plan PlanConfig) ([]ConfigWarning, []string) {
 	foundTypes := foundTypes{
 		identifier: identifier,
 		found:      make(map[string]bool),
@@ -339,7 +457,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	}
 
 	if valid, message := foundTypes.IsValid(); !valid {
-		return []ConfigWarning{}, []string{message}
+		return []ConfigWarning{}, []string{sources.Annotate(path, message)}
 	}
 
 	errorMessages := []string{}
@@ -349,7 +467,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	case plan.Do != nil:
 		for i, plan := range *plan.Do {
 			subIdentifier := fmt.Sprintf("%s[%d]", identifier, i)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, plan)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, fmt.Sprintf("%s[%d]", path, i), plan)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
@@ -357,7 +475,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	case plan.Aggregate != nil:
 		for i, plan := range *plan.Aggregate {
 			subIdentifier := fmt.Sprintf("%s.aggregate[%d]", identifier, i)
-			planWarnings, planErrMessages := validatePlan(c, subIdentifier, plan)
+			planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, fmt.Sprintf("%s.aggregate[%d]", path, i), plan)
 			warnings = append(warnings, planWarnings...)
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
@@ -365,9 +483,9 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	case plan.Get != "":
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
-		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
-			plan, identifier)...,
+		errorMessages = append(errorMessages, sources.annotateAll(path, validateInapplicableFields(
+			[]string{"privileged", "config", "file", "get_params", "no_get"},
+			plan, identifier))...,
 		)
 
 		if plan.Resource != "" {
@@ -375,11 +493,11 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			if !found {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf(
+					sources.Annotate(path+".resource", fmt.Sprintf(
 						"%s refers to a resource that does not exist ('%s')",
 						identifier,
 						plan.Resource,
-					),
+					)),
 				)
 			}
 		} else {
@@ -387,10 +505,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			if !found {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf(
+					sources.Annotate(path+".get", fmt.Sprintf(
 						"%s refers to a resource that does not exist",
 						identifier,
-					),
+					)),
 				)
 			}
 		}
@@ -400,11 +518,11 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			if !found {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf(
+					sources.Annotate(path+".passed", fmt.Sprintf(
 						"%s.passed references an unknown job ('%s')",
 						identifier,
 						job,
-					),
+					)),
 				)
 			} else {
 				foundResource := false
@@ -426,12 +544,12 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 				if !foundResource {
 					errorMessages = append(
 						errorMessages,
-						fmt.Sprintf(
+						sources.Annotate(path+".passed", fmt.Sprintf(
 							"%s.passed references a job ('%s') which doesn't interact with the resource ('%s')",
 							identifier,
 							job,
 							plan.Get,
-						),
+						)),
 					)
 				}
 			}
@@ -440,21 +558,25 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	case plan.Put != "":
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
-		errorMessages = append(errorMessages, validateInapplicableFields(
+		errorMessages = append(errorMessages, sources.annotateAll(path, validateInapplicableFields(
 			[]string{"passed", "trigger", "privileged", "config", "file"},
-			plan, identifier)...,
+			plan, identifier))...,
 		)
 
+		if plan.NoGet && len(plan.GetParams) > 0 {
+			errorMessages = append(errorMessages, sources.Annotate(path+".get_params", identifier+" has get_params but no_get is set"))
+		}
+
 		if plan.Resource != "" {
 			_, found := c.Resources.Lookup(plan.Resource)
 			if !found {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf(
+					sources.Annotate(path+".resource", fmt.Sprintf(
 						"%s refers to a resource that does not exist ('%s')",
 						identifier,
 						plan.Resource,
-					),
+					)),
 				)
 			}
 		} else {
@@ -462,10 +584,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			if !found {
 				errorMessages = append(
 					errorMessages,
-					fmt.Sprintf(
+					sources.Annotate(path+".put", fmt.Sprintf(
 						"%s refers to a resource that does not exist",
 						identifier,
-					),
+					)),
 				)
 			}
 		}
@@ -474,7 +596,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.task.%s", identifier, plan.Task)
 
 		if plan.TaskConfig == nil && plan.TaskConfigPath == "" {
-			errorMessages = append(errorMessages, identifier+" does not specify any task configuration")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" does not specify any task configuration"))
 		}
 
 		if plan.TaskConfig != nil && (plan.TaskConfig.RootfsURI != "" || plan.TaskConfig.ImageResource != nil) && plan.ImageArtifactName != "" {
@@ -485,61 +607,61 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 
 		if plan.TaskConfig != nil && plan.TaskConfigPath != "" {
-			errorMessages = append(errorMessages, identifier+" specifies both `file` and `config` in a task step")
+			errorMessages = append(errorMessages, sources.Annotate(path, identifier+" specifies both `file` and `config` in a task step"))
 		}
 
 		if plan.TaskConfig != nil {
 			if err := plan.TaskConfig.Validate(); err != nil {
 				messages := strings.Split(err.Error(), "\n")
 				for _, message := range messages {
-					errorMessages = append(errorMessages, fmt.Sprintf("%s %s", identifier, strings.TrimSpace(message)))
+					errorMessages = append(errorMessages, sources.Annotate(path+".config", fmt.Sprintf("%s %s", identifier, strings.TrimSpace(message))))
 				}
 			}
 		}
 
-		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"resource", "passed", "trigger"},
-			plan, identifier)...,
+		errorMessages = append(errorMessages, sources.annotateAll(path, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "get_params", "no_get"},
+			plan, identifier))...,
 		)
 
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".try", *plan.Try)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
 	if plan.Abort != nil {
 		subIdentifier := fmt.Sprintf("%s.abort", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Abort)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_abort", *plan.Abort)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
 	if plan.Error != nil {
 		subIdentifier := fmt.Sprintf("%s.error", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Error)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_error", *plan.Error)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
 	if plan.Ensure != nil {
 		subIdentifier := fmt.Sprintf("%s.ensure", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Ensure)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".ensure", *plan.Ensure)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
 	if plan.Success != nil {
 		subIdentifier := fmt.Sprintf("%s.success", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Success)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_success", *plan.Success)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
 
 	if plan.Failure != nil {
 		subIdentifier := fmt.Sprintf("%s.failure", identifier)
-		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Failure)
+		planWarnings, planErrMessages := validatePlan(c, sources, subIdentifier, path+".on_failure", *plan.Failure)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
 	}
@@ -548,13 +670,13 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		_, err := time.ParseDuration(plan.Timeout)
 		if err != nil {
 			subIdentifier := fmt.Sprintf("%s.timeout", identifier)
-			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" refers to a duration that could not be parsed ('%s')", plan.Timeout))
+			errorMessages = append(errorMessages, sources.Annotate(path+".timeout", subIdentifier+fmt.Sprintf(" refers to a duration that could not be parsed ('%s')", plan.Timeout)))
 		}
 	}
 
 	if plan.Attempts < 0 {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
-		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
+		errorMessages = append(errorMessages, sources.Annotate(path+".attempts", subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts)))
 	}
 
 	return warnings, errorMessages
@@ -590,6 +712,14 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.TaskConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "get_params":
+			if len(plan.GetParams) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "no_get":
+			if plan.NoGet {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
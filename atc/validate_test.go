@@ -144,6 +144,34 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid labels", func() {
+		Context("when a label key contains '='", func() {
+			BeforeEach(func() {
+				config.Labels = map[string]string{
+					"env":      "prod",
+					"bogus=id": "1",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid labels:"))
+				Expect(errorMessages[0]).To(ContainSubstring("label key 'bogus=id' cannot contain '=' or ','"))
+			})
+		})
+
+		Context("when a label key is empty", func() {
+			BeforeEach(func() {
+				config.Labels = map[string]string{"": "prod"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("label key cannot be empty"))
+			})
+		})
+	})
+
 	Describe("invalid resources", func() {
 		Context("when a resource has no name", func() {
 			BeforeEach(func() {
@@ -174,6 +202,52 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource checks every 'never'", func() {
+			BeforeEach(func() {
+				config.Resources[0].CheckEvery = CheckEveryNever
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a resource has an invalid check_every", func() {
+			BeforeEach(func() {
+				config.Resources[0].CheckEvery = "sometimes"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has invalid check_every 'sometimes'"))
+			})
+		})
+
+		Context("when a resource has a non-positive check_every", func() {
+			BeforeEach(func() {
+				config.Resources[0].CheckEvery = "0s"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has non-positive check_every '0s'"))
+			})
+		})
+
+		Context("when a resource has a negative version_history", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionHistory = -1
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has negative version_history: -1"))
+			})
+		})
+
 		Context("when a resource has no name or type", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, ResourceConfig{
@@ -405,6 +479,22 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource type has an invalid check_every", func() {
+			BeforeEach(func() {
+				config.ResourceTypes = append(config.ResourceTypes, ResourceType{
+					Name:       "some-other-resource-type",
+					Type:       "some-type",
+					CheckEvery: "sometimes",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resource types:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resource_types.some-other-resource-type has invalid check_every 'sometimes'"))
+			})
+		})
+
 		Context("when a resource has no name or type", func() {
 			BeforeEach(func() {
 				config.ResourceTypes = append(config.ResourceTypes, ResourceType{
@@ -434,6 +524,68 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("team resource types", func() {
+		var (
+			teamTypes ResourceTypes
+			warnings  []ConfigWarning
+		)
+
+		BeforeEach(func() {
+			config.Resources = append(config.Resources, ResourceConfig{
+				Name: "some-notification",
+				Type: "slack",
+			})
+
+			teamTypes = ResourceTypes{
+				{Name: "slack", Type: "registry-image"},
+				{Name: "semver", Type: "registry-image"},
+				{Name: "some-resource-type", Type: "registry-image"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings = config.ValidateTeamResourceTypes(teamTypes)
+		})
+
+		It("reports the used types which resolve from the team", func() {
+			Expect(warnings).To(ContainElement(ConfigWarning{
+				Type:    "resource_type",
+				Message: "resource type 'slack' resolves from the team's resource types",
+			}))
+		})
+
+		It("does not report unused team types", func() {
+			for _, warning := range warnings {
+				Expect(warning.Message).ToNot(ContainSubstring("semver"))
+			}
+		})
+
+		It("reports pipeline types shadowing team types", func() {
+			Expect(warnings).To(ContainElement(ConfigWarning{
+				Type:    "resource_type",
+				Message: "resource type 'some-resource-type' is declared by both the pipeline and the team; using the pipeline's",
+			}))
+		})
+
+		Describe("validating the team's resource types themselves", func() {
+			It("returns no errors when they are valid", func() {
+				Expect(teamTypes.Validate()).To(BeEmpty())
+			})
+
+			Context("when two have the same name", func() {
+				BeforeEach(func() {
+					teamTypes = append(teamTypes, teamTypes[0])
+				})
+
+				It("returns an error", func() {
+					errs := teamTypes.Validate()
+					Expect(errs).To(HaveLen(1))
+					Expect(errs[0]).To(ContainSubstring("resource_types[0] and resource_types[3] have the same name ('slack')"))
+				})
+			})
+		})
+	})
+
 	Describe("validating a job", func() {
 		var job JobConfig
 
@@ -470,6 +622,51 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a cron trigger", func() {
+			BeforeEach(func() {
+				job.Triggers = JobTriggers{{Cron: "0 3 * * 1-5", Timezone: "UTC"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when the cron expression is invalid", func() {
+				BeforeEach(func() {
+					config.Jobs[len(config.Jobs)-1].Triggers[0].Cron = "0 25 * * *"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.triggers[0] has invalid cron expression '0 25 * * *': hour 25 is out of range 0-23"))
+				})
+			})
+
+			Context("when the cron expression is missing", func() {
+				BeforeEach(func() {
+					config.Jobs[len(config.Jobs)-1].Triggers[0].Cron = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.triggers[0] has no cron expression"))
+				})
+			})
+
+			Context("when the timezone is unknown", func() {
+				BeforeEach(func() {
+					config.Jobs[len(config.Jobs)-1].Triggers[0].Timezone = "Mars/Olympus_Mons"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.triggers[0] has unknown timezone 'Mars/Olympus_Mons'"))
+				})
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -727,6 +924,42 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a get plan has put-only fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:       "lol",
+						GetParams: Params{"some": "param"},
+						NoGet:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.lol has invalid fields specified (get_params, no_get)"))
+				})
+			})
+
+			Context("when a put plan has get_params with no_get set", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:       "some-resource",
+						GetParams: Params{"some": "param"},
+						NoGet:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has get_params but no_get is set"))
+				})
+			})
+
 			Context("when a put plan has refers to a resource that does exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1238,4 +1471,61 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 	})
+
+	Describe("with sources", func() {
+		BeforeEach(func() {
+			config.Resources[0].Type = ""
+			config.Jobs[0].BuildLogsToRetain = -1
+			config.Jobs[0].Plan[0].Timeout = "nope"
+			config.Groups[0].Jobs = append(config.Groups[0].Jobs, "some-unknown-job")
+			config.Jobs = append(config.Jobs, JobConfig{Name: "some-ungrouped-job"})
+		})
+
+		JustBeforeEach(func() {
+			_, errorMessages = config.ValidateWithSources(ConfigSources{
+				"groups[0]":                    {File: "groups.yml", Line: 1},
+				"groups[0].jobs[1]":            {File: "groups.yml", Line: 4},
+				"resources[0]":                 {File: "resources/some-resource.yml", Line: 1},
+				"jobs[0]":                      {File: "jobs/some-job.yml", Line: 1},
+				"jobs[0].build_logs_to_retain": {File: "jobs/some-job.yml", Line: 3},
+				"jobs[0].plan[0]":              {File: "jobs/some-job.yml", Line: 6},
+				"jobs[2]":                      {File: "jobs/some-ungrouped-job.yml", Line: 1},
+			})
+		})
+
+		It("prefixes errors with where the part of the config they are about was loaded from", func() {
+			Expect(errorMessages).To(HaveLen(3))
+			Expect(errorMessages[0]).To(ContainSubstring("groups.yml:4: group 'some-group' has unknown job 'some-unknown-job'"))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs/some-ungrouped-job.yml:1: job 'some-ungrouped-job' belongs to no group"))
+			Expect(errorMessages[1]).To(ContainSubstring("resources/some-resource.yml:1: resources.some-resource has no type"))
+			Expect(errorMessages[2]).To(ContainSubstring("jobs/some-job.yml:3: jobs.some-job has negative build_logs_to_retain: -1"))
+		})
+
+		It("uses the location of the closest enclosing part when the part itself was not located", func() {
+			Expect(errorMessages[2]).To(MatchRegexp(`jobs/some-job\.yml:6: jobs\.some-job\.plan\[0\]\S* refers to a duration that could not be parsed \('nope'\)`))
+		})
+	})
+
+	Describe("UnknownKeys", func() {
+		It("reports the nested keys which are not part of a config, along with where they were loaded from", func() {
+			sources := ConfigSources{
+				"jobs[0]":         {File: "jobs/some-job.yml", Line: 1},
+				"jobs[0].plan[0]": {File: "jobs/some-job.yml", Line: 3},
+			}
+
+			unknown, err := sources.UnknownKeys(map[string]interface{}{
+				"some-top-level-key": "is allowed",
+				"jobs": []interface{}{
+					map[string]interface{}{
+						"name": "some-job",
+						"plan": []interface{}{
+							map[string]interface{}{"get": "some-resource", "bogus": true},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unknown).To(Equal([]string{"jobs/some-job.yml:3: unknown key 'jobs[0].plan[0].bogus'"}))
+		})
+	})
 })
@@ -28,3 +28,14 @@ func (types VersionedResourceTypes) Without(name string) VersionedResourceTypes
 
 	return newTypes
 }
+
+// SourceWithDefaults returns the source of a resource of the named type with
+// the type's defaults filled in.
+func (types VersionedResourceTypes) SourceWithDefaults(typeName string, source Source) Source {
+	resourceType, found := types.Lookup(typeName)
+	if !found {
+		return source
+	}
+
+	return resourceType.SourceWithDefaults(source)
+}
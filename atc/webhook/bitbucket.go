@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+)
+
+func init() {
+	Register("bitbucket", BitbucketProvider{})
+}
+
+// BitbucketProvider handles Bitbucket Cloud webhooks.
+type BitbucketProvider struct{}
+
+func (BitbucketProvider) Verify(r *http.Request, body []byte, secret string) error {
+	return verifyHMAC(sha256.New, "sha256=", r.Header.Get("X-Hub-Signature"), body, secret)
+}
+
+type bitbucketBranch struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type bitbucketPayload struct {
+	Repository struct {
+		FullName string `json:"full_name"`
+		Links    struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+		} `json:"links"`
+	} `json:"repository"`
+
+	Push struct {
+		Changes []struct {
+			New *bitbucketBranch `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+
+	PullRequest struct {
+		Destination struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+		} `json:"destination"`
+	} `json:"pullrequest"`
+}
+
+func (BitbucketProvider) Parse(r *http.Request, body []byte) (Event, error) {
+	var payload bitbucketPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	repo := payload.Repository
+
+	event := Event{
+		Provider:   "bitbucket",
+		Type:       r.Header.Get("X-Event-Key"),
+		DeliveryID: r.Header.Get("X-Request-UUID"),
+		Repository: repo.FullName,
+		URIs:       nonEmpty(repo.Links.HTML.Href),
+	}
+
+	switch event.Type {
+	case "repo:push":
+		for _, change := range payload.Push.Changes {
+			if change.New != nil && change.New.Type == "branch" {
+				event.Branches = append(event.Branches, change.New.Name)
+			}
+		}
+	case "pullrequest:created", "pullrequest:updated":
+		event.Branches = nonEmpty(payload.PullRequest.Destination.Branch.Name)
+	}
+
+	return event, nil
+}
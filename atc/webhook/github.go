@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func init() {
+	Register("github", GitHubProvider{})
+}
+
+// GitHubProvider handles GitHub (and GitHub Enterprise) webhooks.
+type GitHubProvider struct{}
+
+func (GitHubProvider) Verify(r *http.Request, body []byte, secret string) error {
+	if signature := r.Header.Get("X-Hub-Signature-256"); signature != "" {
+		return verifyHMAC(sha256.New, "sha256=", signature, body, secret)
+	}
+
+	return verifyHMAC(sha1.New, "sha1=", r.Header.Get("X-Hub-Signature"), body, secret)
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+	CloneURL string `json:"clone_url"`
+	SSHURL   string `json:"ssh_url"`
+	GitURL   string `json:"git_url"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type githubPayload struct {
+	Ref        string           `json:"ref"`
+	Repository githubRepository `json:"repository"`
+
+	PullRequest struct {
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	} `json:"pull_request"`
+}
+
+func (GitHubProvider) Parse(r *http.Request, body []byte) (Event, error) {
+	event := Event{
+		Provider:   "github",
+		Type:       r.Header.Get("X-GitHub-Event"),
+		DeliveryID: r.Header.Get("X-GitHub-Delivery"),
+	}
+
+	// hooks may be configured to send the payload as a form value rather
+	// than as the body itself
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return Event{}, err
+		}
+
+		body = []byte(values.Get("payload"))
+	}
+
+	var payload githubPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	repo := payload.Repository
+	event.Repository = repo.FullName
+	event.URIs = nonEmpty(repo.CloneURL, repo.SSHURL, repo.GitURL, repo.HTMLURL)
+
+	switch event.Type {
+	case "push":
+		if branch := strings.TrimPrefix(payload.Ref, "refs/heads/"); branch != payload.Ref {
+			event.Branches = []string{branch}
+		}
+	case "pull_request":
+		event.Branches = nonEmpty(payload.PullRequest.Base.Ref)
+	}
+
+	return event, nil
+}
+
+func verifyHMAC(h func() hash.Hash, prefix string, signature string, body []byte, secret string) error {
+	if !strings.HasPrefix(signature, prefix) {
+		return ErrInvalidSignature
+	}
+
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	_, _ = mac.Write(body)
+
+	if !hmac.Equal(given, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func nonEmpty(values ...string) []string {
+	nonEmpty := []string{}
+	for _, v := range values {
+		if v != "" {
+			nonEmpty = append(nonEmpty, v)
+		}
+	}
+
+	return nonEmpty
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func init() {
+	Register("gitlab", GitLabProvider{})
+}
+
+// GitLabProvider handles GitLab webhooks. GitLab does not sign payloads, and
+// instead sends the configured secret token along with each request.
+type GitLabProvider struct{}
+
+func (GitLabProvider) Verify(r *http.Request, body []byte, secret string) error {
+	token := r.Header.Get("X-Gitlab-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+type gitlabPayload struct {
+	ObjectKind string `json:"object_kind"`
+	Ref        string `json:"ref"`
+
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+		GitHTTPURL        string `json:"git_http_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		WebURL            string `json:"web_url"`
+	} `json:"project"`
+
+	ObjectAttributes struct {
+		TargetBranch string `json:"target_branch"`
+	} `json:"object_attributes"`
+}
+
+func (GitLabProvider) Parse(r *http.Request, body []byte) (Event, error) {
+	var payload gitlabPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	project := payload.Project
+
+	event := Event{
+		Provider:   "gitlab",
+		Type:       payload.ObjectKind,
+		DeliveryID: r.Header.Get("X-Gitlab-Event-UUID"),
+		Repository: project.PathWithNamespace,
+		URIs:       nonEmpty(project.GitHTTPURL, project.GitSSHURL, project.WebURL),
+	}
+
+	if event.Type == "" {
+		event.Type = r.Header.Get("X-Gitlab-Event")
+	}
+
+	switch event.Type {
+	case "push":
+		if branch := strings.TrimPrefix(payload.Ref, "refs/heads/"); branch != payload.Ref {
+			event.Branches = []string{branch}
+		}
+	case "merge_request":
+		event.Branches = nonEmpty(payload.ObjectAttributes.TargetBranch)
+	}
+
+	return event, nil
+}
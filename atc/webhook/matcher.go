@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . Matcher
+
+// Matcher decides whether an event concerns a resource, given the resource's
+// type and (uninterpolated) source.
+type Matcher interface {
+	Match(event Event, resourceType string, source atc.Source) bool
+}
+
+type MatcherFunc func(Event, string, atc.Source) bool
+
+func (f MatcherFunc) Match(event Event, resourceType string, source atc.Source) bool {
+	return f(event, resourceType, source)
+}
+
+// AnyMatcher matches a resource if any of its matchers do.
+type AnyMatcher []Matcher
+
+func (matchers AnyMatcher) Match(event Event, resourceType string, source atc.Source) bool {
+	for _, matcher := range matchers {
+		if matcher.Match(event, resourceType, source) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GitMatcher matches resources whose source refers to the event's repository,
+// either by `uri` (as used by the git resource) or by `repository` (as used by
+// most pull request resources). If the source configures a `branch`, it must
+// also be one of the event's branches.
+type GitMatcher struct{}
+
+func (GitMatcher) Match(event Event, resourceType string, source atc.Source) bool {
+	if !matchesRepository(event, source) {
+		return false
+	}
+
+	branch, _ := source["branch"].(string)
+	if branch == "" || len(event.Branches) == 0 {
+		return true
+	}
+
+	for _, b := range event.Branches {
+		if b == branch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesRepository(event Event, source atc.Source) bool {
+	if uri, ok := source["uri"].(string); ok && uri != "" {
+		normalized := NormalizeURI(uri)
+		for _, eventURI := range event.URIs {
+			if NormalizeURI(eventURI) == normalized {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if repository, ok := source["repository"].(string); ok && repository != "" {
+		return event.Repository != "" && strings.EqualFold(repository, event.Repository)
+	}
+
+	return false
+}
+
+// NormalizeURI reduces the different ways of referring to a repository, e.g.
+// https://github.com/owner/repo.git and git@github.com:owner/repo, to a
+// common form such as github.com/owner/repo.
+func NormalizeURI(uri string) string {
+	normalized := strings.TrimSpace(uri)
+
+	if i := strings.Index(normalized, "://"); i != -1 {
+		normalized = normalized[i+3:]
+	} else if i := strings.Index(normalized, ":"); i != -1 {
+		// scp-like syntax, e.g. git@github.com:owner/repo
+		normalized = normalized[:i] + "/" + normalized[i+1:]
+	}
+
+	if i := strings.Index(normalized, "@"); i != -1 && i < strings.Index(normalized+"/", "/") {
+		normalized = normalized[i+1:]
+	}
+
+	normalized = strings.TrimSuffix(normalized, "/")
+	normalized = strings.TrimSuffix(normalized, ".git")
+
+	parts := strings.SplitN(normalized, "/", 2)
+	host := strings.ToLower(parts[0])
+
+	// drop explicit ports, e.g. ssh://git@host:22/owner/repo
+	if i := strings.Index(host, ":"); i != -1 {
+		host = host[:i]
+	}
+
+	if len(parts) == 1 {
+		return host
+	}
+
+	return host + "/" + strings.ToLower(parts[1])
+}
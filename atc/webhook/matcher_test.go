@@ -0,0 +1,65 @@
+package webhook_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/webhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GitMatcher", func() {
+	var event Event
+
+	BeforeEach(func() {
+		event = Event{
+			Repository: "concourse/concourse",
+			URIs: []string{
+				"https://github.com/concourse/concourse.git",
+				"git@github.com:concourse/concourse.git",
+			},
+			Branches: []string{"master"},
+		}
+	})
+
+	DescribeTable("matching sources",
+		func(source atc.Source, matches bool) {
+			Expect(GitMatcher{}.Match(event, "git", source)).To(Equal(matches))
+		},
+		Entry("same https uri", atc.Source{"uri": "https://github.com/concourse/concourse.git"}, true),
+		Entry("uri without .git", atc.Source{"uri": "https://github.com/concourse/concourse"}, true),
+		Entry("ssh uri", atc.Source{"uri": "git@github.com:concourse/concourse"}, true),
+		Entry("ssh uri with scheme", atc.Source{"uri": "ssh://git@github.com/concourse/concourse.git"}, true),
+		Entry("different case", atc.Source{"uri": "https://GitHub.com/Concourse/Concourse"}, true),
+		Entry("different repository", atc.Source{"uri": "https://github.com/concourse/fly.git"}, false),
+		Entry("matching branch", atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "master"}, true),
+		Entry("other branch", atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "release/5.1.x"}, false),
+		Entry("repository name", atc.Source{"repository": "concourse/concourse"}, true),
+		Entry("other repository name", atc.Source{"repository": "concourse/fly"}, false),
+		Entry("no uri or repository", atc.Source{"branch": "master"}, false),
+	)
+
+	Context("when the event is not about a branch", func() {
+		BeforeEach(func() {
+			event.Branches = nil
+		})
+
+		It("matches regardless of the configured branch", func() {
+			Expect(GitMatcher{}.Match(event, "git", atc.Source{
+				"uri":    "https://github.com/concourse/concourse",
+				"branch": "release/5.1.x",
+			})).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("AnyMatcher", func() {
+	It("matches if any matcher matches", func() {
+		no := MatcherFunc(func(Event, string, atc.Source) bool { return false })
+		yes := MatcherFunc(func(Event, string, atc.Source) bool { return true })
+
+		Expect(AnyMatcher{no, no}.Match(Event{}, "git", atc.Source{})).To(BeFalse())
+		Expect(AnyMatcher{no, yes}.Match(Event{}, "git", atc.Source{})).To(BeTrue())
+	})
+})
@@ -0,0 +1,207 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"net/url"
+	"strings"
+
+	. "github.com/concourse/concourse/atc/webhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func sign(h func() hash.Hash, body string, secret string) string {
+	mac := hmac.New(h, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newRequest(body string, headers map[string]string) *http.Request {
+	r, err := http.NewRequest("POST", "http://example.com", strings.NewReader(body))
+	Expect(err).ToNot(HaveOccurred())
+
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+
+	return r
+}
+
+var _ = Describe("Providers", func() {
+	It("registers the built-in providers", func() {
+		Expect(ProviderNames()).To(Equal([]string{"bitbucket", "github", "gitlab"}))
+	})
+
+	Describe("GitHubProvider", func() {
+		var provider Provider
+
+		body := `{
+			"ref": "refs/heads/master",
+			"repository": {
+				"full_name": "concourse/concourse",
+				"clone_url": "https://github.com/concourse/concourse.git",
+				"ssh_url": "git@github.com:concourse/concourse.git"
+			}
+		}`
+
+		BeforeEach(func() {
+			var found bool
+			provider, found = LookupProvider("github")
+			Expect(found).To(BeTrue())
+		})
+
+		Describe("Verify", func() {
+			It("accepts a valid sha256 signature", func() {
+				r := newRequest(body, map[string]string{"X-Hub-Signature-256": "sha256=" + sign(sha256.New, body, "secret")})
+				Expect(provider.Verify(r, []byte(body), "secret")).To(Succeed())
+			})
+
+			It("accepts a valid sha1 signature", func() {
+				r := newRequest(body, map[string]string{"X-Hub-Signature": "sha1=" + sign(sha1.New, body, "secret")})
+				Expect(provider.Verify(r, []byte(body), "secret")).To(Succeed())
+			})
+
+			It("rejects a signature made with another secret", func() {
+				r := newRequest(body, map[string]string{"X-Hub-Signature-256": "sha256=" + sign(sha256.New, body, "other")})
+				Expect(provider.Verify(r, []byte(body), "secret")).To(Equal(ErrInvalidSignature))
+			})
+
+			It("rejects a missing signature", func() {
+				r := newRequest(body, nil)
+				Expect(provider.Verify(r, []byte(body), "secret")).To(Equal(ErrInvalidSignature))
+			})
+		})
+
+		Describe("Parse", func() {
+			It("parses push events", func() {
+				r := newRequest(body, map[string]string{
+					"X-GitHub-Event":    "push",
+					"X-GitHub-Delivery": "some-delivery",
+				})
+
+				event, err := provider.Parse(r, []byte(body))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event).To(Equal(Event{
+					Provider:   "github",
+					Type:       "push",
+					DeliveryID: "some-delivery",
+					Repository: "concourse/concourse",
+					URIs: []string{
+						"https://github.com/concourse/concourse.git",
+						"git@github.com:concourse/concourse.git",
+					},
+					Branches: []string{"master"},
+				}))
+			})
+
+			It("parses form-encoded payloads", func() {
+				form := "payload=" + url.QueryEscape(body)
+				r := newRequest(form, map[string]string{
+					"X-GitHub-Event": "push",
+					"Content-Type":   "application/x-www-form-urlencoded",
+				})
+
+				event, err := provider.Parse(r, []byte(form))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event.Repository).To(Equal("concourse/concourse"))
+			})
+
+			It("uses the base branch of pull requests", func() {
+				prBody := `{"repository": {"full_name": "concourse/concourse"}, "pull_request": {"base": {"ref": "release"}}}`
+				r := newRequest(prBody, map[string]string{"X-GitHub-Event": "pull_request"})
+
+				event, err := provider.Parse(r, []byte(prBody))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event.Branches).To(Equal([]string{"release"}))
+			})
+
+			It("does not report branches for tag pushes", func() {
+				tagBody := `{"ref": "refs/tags/v1.0.0", "repository": {"full_name": "concourse/concourse"}}`
+				r := newRequest(tagBody, map[string]string{"X-GitHub-Event": "push"})
+
+				event, err := provider.Parse(r, []byte(tagBody))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(event.Branches).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GitLabProvider", func() {
+		var provider Provider
+
+		body := `{
+			"object_kind": "push",
+			"ref": "refs/heads/master",
+			"project": {
+				"path_with_namespace": "group/project",
+				"git_http_url": "https://gitlab.com/group/project.git",
+				"git_ssh_url": "git@gitlab.com:group/project.git"
+			}
+		}`
+
+		BeforeEach(func() {
+			var found bool
+			provider, found = LookupProvider("gitlab")
+			Expect(found).To(BeTrue())
+		})
+
+		It("verifies the secret token", func() {
+			Expect(provider.Verify(newRequest(body, map[string]string{"X-Gitlab-Token": "secret"}), []byte(body), "secret")).To(Succeed())
+			Expect(provider.Verify(newRequest(body, map[string]string{"X-Gitlab-Token": "other"}), []byte(body), "secret")).To(Equal(ErrInvalidSignature))
+		})
+
+		It("parses push events", func() {
+			event, err := provider.Parse(newRequest(body, nil), []byte(body))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal("push"))
+			Expect(event.Repository).To(Equal("group/project"))
+			Expect(event.URIs).To(ConsistOf("https://gitlab.com/group/project.git", "git@gitlab.com:group/project.git"))
+			Expect(event.Branches).To(Equal([]string{"master"}))
+		})
+	})
+
+	Describe("BitbucketProvider", func() {
+		var provider Provider
+
+		body := `{
+			"repository": {
+				"full_name": "team/repo",
+				"links": {"html": {"href": "https://bitbucket.org/team/repo"}}
+			},
+			"push": {
+				"changes": [
+					{"new": {"type": "branch", "name": "master"}},
+					{"new": {"type": "tag", "name": "v1.0.0"}},
+					{"new": null}
+				]
+			}
+		}`
+
+		BeforeEach(func() {
+			var found bool
+			provider, found = LookupProvider("bitbucket")
+			Expect(found).To(BeTrue())
+		})
+
+		It("verifies the signature", func() {
+			r := newRequest(body, map[string]string{"X-Hub-Signature": "sha256=" + sign(sha256.New, body, "secret")})
+			Expect(provider.Verify(r, []byte(body), "secret")).To(Succeed())
+			Expect(provider.Verify(r, []byte(body), "other")).To(Equal(ErrInvalidSignature))
+		})
+
+		It("parses push events", func() {
+			event, err := provider.Parse(newRequest(body, map[string]string{"X-Event-Key": "repo:push"}), []byte(body))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal("repo:push"))
+			Expect(event.Repository).To(Equal("team/repo"))
+			Expect(event.URIs).To(Equal([]string{"https://bitbucket.org/team/repo"}))
+			Expect(event.Branches).To(Equal([]string{"master"}))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+)
+
+// ErrInvalidSignature is returned by a Provider when a payload was not signed
+// with the expected secret.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Event is the part of a provider's payload which is relevant for deciding
+// which resources should be checked.
+type Event struct {
+	Provider   string
+	Type       string
+	DeliveryID string
+
+	// Repository is the full name of the repository, e.g. "owner/repo".
+	Repository string
+
+	// URIs are the URLs through which the repository may be cloned.
+	URIs []string
+
+	// Branches are the branches which were pushed to, or the base branch of
+	// a pull request. It is empty for events which are not about a branch,
+	// e.g. tag pushes.
+	Branches []string
+}
+
+// Provider verifies and parses the webhook payloads sent by a code hosting
+// service.
+type Provider interface {
+	Verify(r *http.Request, body []byte, secret string) error
+	Parse(r *http.Request, body []byte) (Event, error)
+}
+
+var providers = map[string]Provider{}
+
+func Register(name string, provider Provider) {
+	providers[name] = provider
+}
+
+func LookupProvider(name string) (Provider, bool) {
+	provider, found := providers[name]
+	return provider, found
+}
+
+func ProviderNames() []string {
+	names := []string{}
+	for name := range providers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
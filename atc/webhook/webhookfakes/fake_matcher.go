@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package webhookfakes
+
+import (
+	sync "sync"
+
+	atc "github.com/concourse/concourse/atc"
+	webhook "github.com/concourse/concourse/atc/webhook"
+)
+
+type FakeMatcher struct {
+	MatchStub        func(webhook.Event, string, atc.Source) bool
+	matchMutex       sync.RWMutex
+	matchArgsForCall []struct {
+		arg1 webhook.Event
+		arg2 string
+		arg3 atc.Source
+	}
+	matchReturns struct {
+		result1 bool
+	}
+	matchReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMatcher) Match(arg1 webhook.Event, arg2 string, arg3 atc.Source) bool {
+	fake.matchMutex.Lock()
+	ret, specificReturn := fake.matchReturnsOnCall[len(fake.matchArgsForCall)]
+	fake.matchArgsForCall = append(fake.matchArgsForCall, struct {
+		arg1 webhook.Event
+		arg2 string
+		arg3 atc.Source
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Match", []interface{}{arg1, arg2, arg3})
+	fake.matchMutex.Unlock()
+	if fake.MatchStub != nil {
+		return fake.MatchStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.matchReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeMatcher) MatchCallCount() int {
+	fake.matchMutex.RLock()
+	defer fake.matchMutex.RUnlock()
+	return len(fake.matchArgsForCall)
+}
+
+func (fake *FakeMatcher) MatchCalls(stub func(webhook.Event, string, atc.Source) bool) {
+	fake.matchMutex.Lock()
+	defer fake.matchMutex.Unlock()
+	fake.MatchStub = stub
+}
+
+func (fake *FakeMatcher) MatchArgsForCall(i int) (webhook.Event, string, atc.Source) {
+	fake.matchMutex.RLock()
+	defer fake.matchMutex.RUnlock()
+	argsForCall := fake.matchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeMatcher) MatchReturns(result1 bool) {
+	fake.matchMutex.Lock()
+	defer fake.matchMutex.Unlock()
+	fake.MatchStub = nil
+	fake.matchReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMatcher) MatchReturnsOnCall(i int, result1 bool) {
+	fake.matchMutex.Lock()
+	defer fake.matchMutex.Unlock()
+	fake.MatchStub = nil
+	if fake.matchReturnsOnCall == nil {
+		fake.matchReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.matchReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMatcher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.matchMutex.RLock()
+	defer fake.matchMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMatcher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ webhook.Matcher = new(FakeMatcher)
@@ -0,0 +1,16 @@
+package atc
+
+type WebhookDelivery struct {
+	ID         int            `json:"id"`
+	Provider   string         `json:"provider"`
+	Event      string         `json:"event"`
+	DeliveryID string         `json:"delivery_id,omitempty"`
+	Matched    []WebhookMatch `json:"matched"`
+	Error      string         `json:"error,omitempty"`
+	ReceivedAt int64          `json:"received_at"`
+}
+
+type WebhookMatch struct {
+	PipelineName string `json:"pipeline_name"`
+	ResourceName string `json:"resource_name"`
+}
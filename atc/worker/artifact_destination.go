@@ -12,3 +12,30 @@ type ArtifactDestination interface {
 	// expand into the destination directory.
 	StreamIn(string, io.Reader) error
 }
+
+// countingDestination records how many bytes were streamed into the
+// destination.
+type countingDestination struct {
+	ArtifactDestination
+
+	bytes int64
+}
+
+func (dest *countingDestination) StreamIn(path string, tarStream io.Reader) error {
+	return dest.ArtifactDestination.StreamIn(path, &countingReader{
+		Reader: tarStream,
+		count:  &dest.bytes,
+	})
+}
+
+type countingReader struct {
+	io.Reader
+
+	count *int64
+}
+
+func (reader *countingReader) Read(p []byte) (int, error) {
+	n, err := reader.Reader.Read(p)
+	*reader.count += int64(n)
+	return n, err
+}
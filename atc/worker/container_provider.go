@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
@@ -174,7 +175,12 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("fetching-image")
 
-			fetchedImage, err := image.FetchForContainer(ctx, logger, creatingContainer)
+			imageCtx, imageSpan := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+				"worker": p.worker.Name(),
+			})
+
+			fetchedImage, err := image.FetchForContainer(imageCtx, logger, creatingContainer)
+			tracing.End(imageSpan, err)
 			if err != nil {
 				creatingContainer.Failed()
 				logger.Error("failed-to-fetch-image-for-container", err)
@@ -184,6 +190,7 @@ func (p *containerProvider) FindOrCreateContainer(
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
 				creatingContainer,
 				containerSpec,
@@ -298,6 +305,7 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
@@ -404,10 +412,27 @@ func (p *containerProvider) createGardenContainer(
 				"dest-volume": inputVolume.Handle(),
 				"dest-worker": inputVolume.WorkerName(),
 			}
-			err = inputSource.Source().StreamTo(logger.Session("stream-to", destData), inputVolume)
+
+			_, streamSpan := tracing.StartSpan(ctx, "stream-input", tracing.Attrs{
+				"path":        cleanedInputPath,
+				"dest-volume": inputVolume.Handle(),
+				"dest-worker": inputVolume.WorkerName(),
+			})
+
+			streamStart := time.Now()
+			dest := &countingDestination{ArtifactDestination: inputVolume}
+
+			err = inputSource.Source().StreamTo(logger.Session("stream-to", destData), dest)
+			tracing.End(streamSpan, err)
 			if err != nil {
 				return nil, err
 			}
+
+			metric.VolumeStreamed{
+				WorkerName: inputVolume.WorkerName(),
+				Bytes:      dest.bytes,
+				Duration:   time.Since(streamStart),
+			}.Emit(logger)
 		}
 
 		ioVolumeMounts = append(ioVolumeMounts, VolumeMount{
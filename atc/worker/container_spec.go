@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/exec/taskspec"
 )
 
 type WorkerSpec struct {
@@ -81,21 +82,8 @@ type ContainerLimits struct {
 	Memory *uint64
 }
 
-var GardenLimitDefault = uint64(0)
-
 func (cl ContainerLimits) ToGardenLimits() garden.Limits {
-	gardenLimits := garden.Limits{}
-	if cl.CPU == nil {
-		gardenLimits.CPU = garden.CPULimits{LimitInShares: GardenLimitDefault}
-	} else {
-		gardenLimits.CPU = garden.CPULimits{LimitInShares: *cl.CPU}
-	}
-	if cl.Memory == nil {
-		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: GardenLimitDefault}
-	} else {
-		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: *cl.Memory}
-	}
-	return gardenLimits
+	return taskspec.Limits(atc.ContainerLimits(cl))
 }
 
 func (spec WorkerSpec) Description() string {
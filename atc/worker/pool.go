@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 )
 
 //go:generate counterfeiter . WorkerProvider
@@ -125,6 +126,28 @@ func (pool *pool) FindOrChooseWorkerForContainer(
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
 	strategy ContainerPlacementStrategy,
+) (Worker, error) {
+	start := time.Now()
+
+	worker, err := pool.findOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	metric.WorkerSelection{
+		WorkerName: worker.Name(),
+		Duration:   time.Since(start),
+	}.Emit(logger)
+
+	return worker, nil
+}
+
+func (pool *pool) findOrChooseWorkerForContainer(
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	strategy ContainerPlacementStrategy,
 ) (Worker, error) {
 	workersWithContainer, err := pool.provider.FindWorkersForContainerByOwner(
 		logger.Session("find-worker"),
@@ -39,6 +39,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.ReceiveWebhook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -77,6 +78,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// pipeline is public or authorized
 		case atc.GetPipeline,
 			atc.GetJobBuild,
+			atc.DiffJobBuildInputs,
+			atc.GetJobBuildProvenance,
 			atc.PipelineBadge,
 			atc.JobBadge,
 			atc.ListJobs,
@@ -105,6 +108,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetTeam,
 			atc.ListTeamBuilds,
 			atc.RenameTeam,
+			atc.GetTeamResourceTypes,
+			atc.SetTeamResourceTypes,
 			atc.DestroyTeam,
 			atc.ListVolumes:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
@@ -117,19 +122,36 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// authorized (requested team matches resource team)
 		case atc.CheckResource,
 			atc.CheckResourceType,
+			atc.ListChecks,
+			atc.ListResourceChecks,
+			atc.CreateResourceCheck,
+			atc.CreateResourceTypeCheck,
+			atc.GetCheck,
 			atc.CreateJobBuild,
+			atc.RerunJobBuild,
+			atc.GetJobBuildStepPlan,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
 			atc.PinResourceVersion,
+			atc.PinResource,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListConfigVersions,
+			atc.GetConfigVersion,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListSecretLookups,
+			atc.ListWebhookDeliveries,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
+			atc.PausePipelines,
+			atc.UnpausePipelines,
+			atc.ExposePipelines,
+			atc.HidePipelines,
+			atc.DeletePipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
 			atc.RenamePipeline,
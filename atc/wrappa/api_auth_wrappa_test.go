@@ -143,6 +143,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetInfo:              unauthenticated(inputHandlers[atc.GetInfo]),
 				atc.DownloadCLI:          unauthenticated(inputHandlers[atc.DownloadCLI]),
 				atc.CheckResourceWebHook: unauthenticated(inputHandlers[atc.CheckResourceWebHook]),
+				atc.ReceiveWebhook:       unauthenticated(inputHandlers[atc.ReceiveWebhook]),
 				atc.ListAllPipelines:     unauthenticated(inputHandlers[atc.ListAllPipelines]),
 				atc.ListBuilds:           unauthenticated(inputHandlers[atc.ListBuilds]),
 				atc.ListPipelines:        unauthenticated(inputHandlers[atc.ListPipelines]),
@@ -176,6 +177,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				// belongs to public pipeline or authorized
 				atc.GetPipeline:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipeline]),
 				atc.GetJobBuild:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobBuild]),
+				atc.DiffJobBuildInputs:            openForPublicPipelineOrAuthorized(inputHandlers[atc.DiffJobBuildInputs]),
+				atc.GetJobBuildProvenance:         openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobBuildProvenance]),
 				atc.PipelineBadge:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.PipelineBadge]),
 				atc.JobBadge:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.JobBadge]),
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
@@ -206,6 +209,9 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 
+				atc.GetTeamResourceTypes: authenticated(inputHandlers[atc.GetTeamResourceTypes]),
+				atc.SetTeamResourceTypes: authenticated(inputHandlers[atc.SetTeamResourceTypes]),
+
 				// authenticated and is admin
 				atc.GetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
 				atc.SetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
@@ -214,18 +220,35 @@ var _ = Describe("APIAuthWrappa", func() {
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
+				atc.ListChecks:              authorized(inputHandlers[atc.ListChecks]),
+				atc.ListResourceChecks:      authorized(inputHandlers[atc.ListResourceChecks]),
+				atc.CreateResourceCheck:     authorized(inputHandlers[atc.CreateResourceCheck]),
+				atc.CreateResourceTypeCheck: authorized(inputHandlers[atc.CreateResourceTypeCheck]),
+				atc.GetCheck:                authorized(inputHandlers[atc.GetCheck]),
 				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:           authorized(inputHandlers[atc.RerunJobBuild]),
+				atc.GetJobBuildStepPlan:     authorized(inputHandlers[atc.GetJobBuildStepPlan]),
 				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
 				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
 				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
 				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.PinResource:             authorized(inputHandlers[atc.PinResource]),
 				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
 				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
 				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
+				atc.ListConfigVersions:      authorized(inputHandlers[atc.ListConfigVersions]),
+				atc.GetConfigVersion:        authorized(inputHandlers[atc.GetConfigVersion]),
 				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
 				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListSecretLookups:       authorized(inputHandlers[atc.ListSecretLookups]),
+				atc.ListWebhookDeliveries:   authorized(inputHandlers[atc.ListWebhookDeliveries]),
 				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
 				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PausePipelines:          authorized(inputHandlers[atc.PausePipelines]),
+				atc.UnpausePipelines:        authorized(inputHandlers[atc.UnpausePipelines]),
+				atc.ExposePipelines:         authorized(inputHandlers[atc.ExposePipelines]),
+				atc.HidePipelines:           authorized(inputHandlers[atc.HidePipelines]),
+				atc.DeletePipelines:         authorized(inputHandlers[atc.DeletePipelines]),
 				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
 				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
 				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
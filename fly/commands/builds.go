@@ -24,11 +24,12 @@ type BuildsCommand struct {
 	Count       int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
 	CurrentTeam bool                     `long:"current-team" description:"Show builds for the currently targeted team"`
 	Job         flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
-	Json        bool                     `long:"json" description:"Print command result as JSON"`
 	Pipeline    flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
 	Teams       []string                 `short:"t"  long:"team" description:"Show builds for these teams"`
 	Since       string                   `long:"since" description:"Start of the range to filter builds"`
 	Until       string                   `long:"until" description:"End of the range to filter builds"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -156,12 +157,8 @@ func (command *BuildsCommand) Execute([]string) error {
 		builds = append(builds, teamBuilds...)
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(builds)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, builds)
 	}
 
 	table := ui.Table{
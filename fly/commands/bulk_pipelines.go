@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// selectPipelines returns the team's pipelines selected by --all, --glob or
+// --label. Each instance of a pipeline is selected on its own.
+func selectPipelines(team concourse.Team, selector flaghelpers.PipelineSelectorFlags) ([]atc.Pipeline, error) {
+	pipelines, err := team.ListPipelines(selector.Labels...)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := selector.Filter(pipelines)
+	if len(selected) == 0 {
+		return nil, errors.New("no pipelines matched")
+	}
+
+	return selected, nil
+}
+
+func pipelineIDs(pipelines []atc.Pipeline) []int {
+	ids := make([]int, len(pipelines))
+	for i, pipeline := range pipelines {
+		ids[i] = pipeline.ID
+	}
+
+	return ids
+}
+
+// showBatchResults prints what was done to each of the selected pipelines,
+// failing if any of them were destroyed after being selected.
+func showBatchResults(pipelines []atc.Pipeline, results []atc.PipelineBatchResult, done string) {
+	refs := map[int]atc.PipelineRef{}
+	for _, pipeline := range pipelines {
+		refs[pipeline.ID] = pipeline.Ref()
+	}
+
+	missing := []string{}
+	for _, result := range results {
+		if result.Found {
+			fmt.Printf("%s '%s'\n", done, refs[result.ID])
+		} else {
+			missing = append(missing, refs[result.ID].String())
+		}
+	}
+
+	if len(missing) > 0 {
+		displayhelpers.Failf("pipelines not found: %s", strings.Join(missing, ", "))
+	}
+}
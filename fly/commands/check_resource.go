@@ -1,16 +1,25 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type CheckResourceCommand struct {
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to check version for"`
 	Version  *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Async    bool                     `short:"a" long:"async"                                                   description:"Return the check without waiting for its result"`
+	Watch    bool                     `short:"w" long:"watch"                                                   description:"Print the output of the check script as it runs"`
 }
 
 func (command *CheckResourceCommand) Execute(args []string) error {
@@ -24,12 +33,16 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Async && command.Watch {
+		return errors.New("--async and --watch cannot be used together")
+	}
+
 	var version atc.Version
 	if command.Version != nil {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResource(command.Resource.PipelineName, command.Resource.ResourceName, version)
+	check, found, err := target.Team().CheckResource(command.Resource.PipelineName, command.Resource.ResourceName, version)
 	if err != nil {
 		return err
 	}
@@ -38,6 +51,57 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
 	}
 
+	if command.Async {
+		fmt.Printf("queued check for '%s'\n", command.Resource.ResourceName)
+		return nil
+	}
+
+	var output io.Writer
+	if command.Watch {
+		output = os.Stdout
+	}
+
+	check, err = waitForCheck(target.Team(), check, output)
+	if err != nil {
+		return err
+	}
+
+	if check.Status == atc.CheckStatusErrored {
+		displayhelpers.Failf("check failed:\n%s", check.CheckError)
+	}
+
 	fmt.Printf("checked '%s'\n", command.Resource.ResourceName)
 	return nil
 }
+
+const checkPollInterval = time.Second
+
+// waitForCheck polls the check until it has finished, printing its output to
+// the given writer as it arrives, if any.
+func waitForCheck(team concourse.Team, check atc.Check, output io.Writer) (atc.Check, error) {
+	printed := 0
+
+	for {
+		if output != nil && len(check.Output) > printed {
+			fmt.Fprint(output, check.Output[printed:])
+			printed = len(check.Output)
+		}
+
+		if !check.IsRunning() {
+			return check, nil
+		}
+
+		time.Sleep(checkPollInterval)
+
+		var found bool
+		var err error
+		check, found, err = team.Check(strconv.Itoa(check.ID))
+		if err != nil {
+			return check, err
+		}
+
+		if !found {
+			return check, fmt.Errorf("check not found")
+		}
+	}
+}
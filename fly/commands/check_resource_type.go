@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 )
@@ -11,6 +12,7 @@ import (
 type CheckResourceTypeCommand struct {
 	ResourceType flaghelpers.ResourceFlag `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of a resource-type to check"`
 	Version      *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource type to check from, e.g. digest:sha256@..."`
+	Async        bool                     `short:"a" long:"async"                                                   description:"Return the check without waiting for its result"`
 }
 
 func (command *CheckResourceTypeCommand) Execute(args []string) error {
@@ -29,7 +31,7 @@ func (command *CheckResourceTypeCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResourceType(command.ResourceType.PipelineName, command.ResourceType.ResourceName, version)
+	check, found, err := target.Team().CheckResourceType(command.ResourceType.PipelineName, command.ResourceType.ResourceName, version)
 	if err != nil {
 		return err
 	}
@@ -38,6 +40,20 @@ func (command *CheckResourceTypeCommand) Execute(args []string) error {
 		return fmt.Errorf("pipeline '%s' or resource-type '%s' not found\n", command.ResourceType.PipelineName, command.ResourceType.ResourceName)
 	}
 
+	if command.Async {
+		fmt.Printf("queued check for '%s'\n", command.ResourceType.ResourceName)
+		return nil
+	}
+
+	check, err = waitForCheck(target.Team(), check, nil)
+	if err != nil {
+		return err
+	}
+
+	if check.Status == atc.CheckStatusErrored {
+		displayhelpers.Failf("check failed:\n%s", check.CheckError)
+	}
+
 	fmt.Printf("checked '%s'\n", command.ResourceType.ResourceName)
 	return nil
 }
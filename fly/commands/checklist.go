@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"sort"
 
 	"github.com/concourse/concourse/atc"
@@ -11,6 +12,18 @@ import (
 
 type ChecklistCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"The pipeline from which to generate the Checkfile"`
+
+	Output flaghelpers.OutputFlags
+}
+
+type checklistGroup struct {
+	Name string          `json:"name"`
+	Jobs []checklistItem `json:"jobs"`
+}
+
+type checklistItem struct {
+	Job   string `json:"job"`
+	Check string `json:"check"`
 }
 
 func (command *ChecklistCommand) Validate() error {
@@ -40,33 +53,55 @@ func (command *ChecklistCommand) Execute([]string) error {
 		return err
 	}
 
-	printCheckfile(target.Team().Name(), pipelineName, config, target.Client().URL())
+	checklist := buildChecklist(target.Team().Name(), pipelineName, config, target.Client().URL())
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, checklist)
+	}
+
+	printCheckfile(checklist)
 
 	return nil
 }
 
-func printCheckfile(teamName, pipelineName string, config atc.Config, url string) {
+func buildChecklist(teamName, pipelineName string, config atc.Config, url string) []checklistGroup {
 	orphanHeaderName := "misc"
 	if len(config.Groups) == 0 {
 		orphanHeaderName = pipelineName
 	}
 
-	for _, group := range config.Groups {
-		printGroup(teamName, pipelineName, group, url)
-	}
+	groups := config.Groups
 
 	miscJobs := orphanedJobs(config)
 	if len(miscJobs) > 0 {
-		printGroup(teamName, pipelineName, atc.GroupConfig{Name: orphanHeaderName, Jobs: miscJobs}, url)
+		groups = append(groups, atc.GroupConfig{Name: orphanHeaderName, Jobs: miscJobs})
 	}
+
+	checklist := []checklistGroup{}
+	for _, group := range groups {
+		checklistGroup := checklistGroup{Name: group.Name, Jobs: []checklistItem{}}
+
+		for _, job := range group.Jobs {
+			checklistGroup.Jobs = append(checklistGroup.Jobs, checklistItem{
+				Job:   job,
+				Check: fmt.Sprintf("concourse.check %s %s %s %s", url, teamName, pipelineName, job),
+			})
+		}
+
+		checklist = append(checklist, checklistGroup)
+	}
+
+	return checklist
 }
 
-func printGroup(teamName, pipelineName string, group atc.GroupConfig, url string) {
-	fmt.Printf("#- %s\n", group.Name)
-	for _, job := range group.Jobs {
-		fmt.Printf("%s: concourse.check %s %s %s %s\n", job, url, teamName, pipelineName, job)
+func printCheckfile(checklist []checklistGroup) {
+	for _, group := range checklist {
+		fmt.Printf("#- %s\n", group.Name)
+		for _, item := range group.Jobs {
+			fmt.Printf("%s: %s\n", item.Job, item.Check)
+		}
+		fmt.Println("")
 	}
-	fmt.Println("")
 }
 
 func orphanedJobs(config atc.Config) []string {
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ChecksCommand struct {
+	Pipeline string                   `short:"p" long:"pipeline" description:"Get checks in this pipeline"`
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" value-name:"PIPELINE/RESOURCE" description:"Get checks of this resource, along with their output"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of checks you want to limit the return to"`
+
+	Output flaghelpers.OutputFlags
+}
+
+func (command *ChecksCommand) Execute([]string) error {
+	if command.Pipeline != "" && command.Resource.ResourceName != "" {
+		return errors.New("Cannot specify both --pipeline and --resource")
+	}
+
+	if command.Pipeline == "" && command.Resource.ResourceName == "" {
+		return errors.New("Either --pipeline or --resource must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var checks []atc.Check
+	if command.Resource.ResourceName != "" {
+		var found bool
+		checks, found, err = target.Team().ResourceChecks(command.Resource.PipelineName, command.Resource.ResourceName, command.Count)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			displayhelpers.Failf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+		}
+	} else {
+		checks, err = target.Team().ListChecks(command.Pipeline, command.Count)
+		if err != nil {
+			return err
+		}
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, checks)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "end", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, check := range checks {
+		name := check.ResourceName
+		if name == "" {
+			name = check.ResourceTypeName
+		}
+
+		statusCell := ui.TableCell{Contents: string(check.Status)}
+		switch check.Status {
+		case atc.CheckStatusPending:
+			statusCell.Color = ui.PendingColor
+		case atc.CheckStatusStarted:
+			statusCell.Color = ui.StartedColor
+		case atc.CheckStatusSucceeded:
+			statusCell.Color = ui.SucceededColor
+		case atc.CheckStatusErrored:
+			statusCell.Color = ui.ErroredColor
+		case atc.CheckStatusSkipped:
+			statusCell.Color = ui.OffColor
+		}
+
+		errorCell := ui.TableCell{Contents: check.CheckError, Color: ui.ErroredColor}
+		if check.CheckError == "" {
+			errorCell.Contents = "n/a"
+			errorCell.Color = ui.OffColor
+		}
+
+		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(check.StartTime, 0), time.Unix(check.EndTime, 0))
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(check.ID)},
+			{Contents: name},
+			statusCell,
+			startTimeCell,
+			endTimeCell,
+			durationCell,
+			errorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -3,19 +3,29 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 	"github.com/vito/go-interact/interact"
 
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 )
 
 type DestroyPipelineCommand struct {
-	Pipeline        flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to destroy"`
-	SkipInteractive bool                     `short:"n"  long:"non-interactive"          description:"Destroy the pipeline without confirmation"`
+	Pipeline        flaghelpers.PipelineFlag `short:"p"  long:"pipeline"        description:"Pipeline to destroy"`
+	SkipInteractive bool                     `short:"n"  long:"non-interactive" description:"Destroy the pipeline without confirmation"`
+
+	Selector flaghelpers.PipelineSelectorFlags
+	Instance flaghelpers.InstanceVarFlags
 }
 
 func (command *DestroyPipelineCommand) Validate() error {
-	return command.Pipeline.Validate()
+	err := command.Selector.Validate(command.Pipeline)
+	if err != nil {
+		return err
+	}
+
+	return command.Instance.Validate(command.Selector)
 }
 
 func (command *DestroyPipelineCommand) Execute(args []string) error {
@@ -34,8 +44,12 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
-	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineName)
+	if command.Selector.Selected() {
+		return command.destroyPipelines(target.Team())
+	}
+
+	pipelineRef := command.Instance.Ref(command.Pipeline)
+	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineRef)
 
 	confirm := command.SkipInteractive
 	if !confirm {
@@ -46,15 +60,60 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		}
 	}
 
-	found, err := target.Team().DeletePipeline(pipelineName)
+	found, err := target.Team().ForInstance(pipelineRef.InstanceVars).DeletePipeline(pipelineRef.Name)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		fmt.Printf("`%s` does not exist\n", pipelineName)
+		fmt.Printf("`%s` does not exist\n", pipelineRef)
 	} else {
-		fmt.Printf("`%s` deleted\n", pipelineName)
+		fmt.Printf("`%s` deleted\n", pipelineRef)
+	}
+
+	return nil
+}
+
+func (command *DestroyPipelineCommand) destroyPipelines(team concourse.Team) error {
+	pipelines, err := selectPipelines(team, command.Selector)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("!!! this will remove all data for the pipelines:")
+	fmt.Println()
+
+	for _, pipeline := range pipelines {
+		fmt.Printf("  %s\n", pipeline.Ref())
+	}
+
+	fmt.Println()
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	results, err := team.DeletePipelines(pipelineIDs(pipelines))
+	if err != nil {
+		return err
+	}
+
+	refs := map[int]atc.PipelineRef{}
+	for _, pipeline := range pipelines {
+		refs[pipeline.ID] = pipeline.Ref()
+	}
+
+	for _, result := range results {
+		if !result.Found {
+			fmt.Printf("`%s` does not exist\n", refs[result.ID])
+		} else {
+			fmt.Printf("`%s` deleted\n", refs[result.ID])
+		}
 	}
 
 	return nil
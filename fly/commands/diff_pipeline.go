@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DiffPipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline whose configurations to compare"`
+
+	From int `long:"from" required:"true" value-name:"VERSION" description:"Saved configuration to compare from, as listed by pipeline-history"`
+	To   int `long:"to"                   value-name:"VERSION" description:"Saved configuration to compare to (default: the current configuration)"`
+}
+
+func (command *DiffPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *DiffPipelineCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+	pipelineName := string(command.Pipeline)
+
+	fromConfig, err := configAtVersion(team, pipelineName, command.From)
+	if err != nil {
+		return err
+	}
+
+	var toConfig atc.Config
+	if command.To != 0 {
+		toConfig, err = configAtVersion(team, pipelineName, command.To)
+	} else {
+		var found bool
+		toConfig, _, found, err = team.PipelineConfig(pipelineName)
+		if err == nil && !found {
+			err = fmt.Errorf("pipeline '%s' not found", pipelineName)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !setpipelinehelpers.DiffConfigs(fromConfig, toConfig) {
+		fmt.Println("no differences")
+	}
+
+	return nil
+}
+
+func configAtVersion(team concourse.Team, pipelineName string, version int) (atc.Config, error) {
+	config, found, err := team.PipelineConfigAtVersion(pipelineName, version)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if !found {
+		return atc.Config{}, fmt.Errorf("version %d of pipeline '%s' not found", version, pipelineName)
+	}
+
+	return config, nil
+}
@@ -1,18 +1,26 @@
 package commands
 
 import (
+	"context"
+	"crypto/sha1"
+	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
 
+	gclient "code.cloudfoundry.org/garden/client"
+	gconn "code.cloudfoundry.org/garden/client/connection"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/config"
 	"github.com/concourse/concourse/fly/eventstream"
@@ -24,76 +32,69 @@ import (
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	TaskConfig     atc.PathFlag                       `short:"c" long:"config"                                description:"The task config to execute"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
 	InputMappings  []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
 	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
+	FromBuild      flaghelpers.JobBuildFlag           `          long:"from-build"  value-name:"PIPELINE/JOB/BUILD" description:"A build to re-run a task from, using the task's config and input versions"`
+	Step           string                             `          long:"step"        value-name:"NAME"         description:"The task to re-run from the build given with --from-build, if it has more than one"`
 	Outputs        []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
 	Image          string                             `long:"image" description:"Image resource for the one-off build"`
 	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
+	Local          bool                               `          long:"local"                                 description:"Run the task in a container on a Garden server on this machine instead of on Concourse. Containerd is not supported, and image_resource may only use the base resource types published as concourse/<type>-resource"`
+	GardenURL      string                             `          long:"garden-url"  value-name:"URL"          description:"The Garden server to run the task on with --local. Only Garden is supported, not containerd" default:"http://127.0.0.1:7777"`
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
-	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
-	if err != nil {
-		return err
-	}
+	fromBuild := command.FromBuild.BuildName != ""
 
-	err = target.Validate()
-	if err != nil {
-		return err
+	if !fromBuild && command.TaskConfig == "" {
+		return errors.New("the required flag `-c, --config' was not specified")
 	}
 
-	taskConfig, err := command.CreateTaskConfig(args)
-	if err != nil {
-		return err
+	if fromBuild && (command.TaskConfig != "" || command.InputsFrom.PipelineName != "") {
+		return errors.New("--from-build cannot be used with --config or --inputs-from")
 	}
 
-	planFactory := atc.NewPlanFactory(time.Now().Unix())
+	if command.Local {
+		if fromBuild || command.InputsFrom.PipelineName != "" || command.Image != "" || len(command.Tags) != 0 {
+			return errors.New("--local cannot be used with --from-build, --inputs-from, --image or --tag")
+		}
 
-	inputs, inputMappings, imageResource, err := executehelpers.DetermineInputs(
-		planFactory,
-		target.Team(),
-		taskConfig.Inputs,
-		command.Inputs,
-		command.InputMappings,
-		command.Image,
-		command.InputsFrom,
-		command.IncludeIgnored,
-	)
-	if err != nil {
-		return err
+		return command.executeLocally(args)
 	}
 
-	if imageResource != nil {
-		taskConfig.ImageResource = imageResource
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
 	}
 
-	outputs, err := executehelpers.DetermineOutputs(
-		planFactory,
-		taskConfig.Outputs,
-		command.Outputs,
-	)
+	err = target.Validate()
 	if err != nil {
 		return err
 	}
 
-	plan, err := executehelpers.CreateBuildPlan(
-		planFactory,
-		target,
-		command.Privileged,
-		inputs,
-		inputMappings,
-		outputs,
-		taskConfig,
-		command.Tags,
+	planFactory := atc.NewPlanFactory(time.Now().Unix())
+
+	var (
+		plan         atc.Plan
+		outputs      []executehelpers.Output
+		pipelineName string
 	)
 
+	if fromBuild {
+		plan, outputs, err = command.createStepBuildPlan(planFactory, target.Team())
+		pipelineName = command.FromBuild.PipelineName
+	} else {
+		plan, outputs, err = command.createBuildPlan(planFactory, target, args)
+		pipelineName = command.InputsFrom.PipelineName
+	}
+
 	if err != nil {
 		return err
 	}
@@ -107,8 +108,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+	if pipelineName != "" {
+		build, err = target.Team().CreatePipelineBuild(pipelineName, plan)
 		if err != nil {
 			return err
 		}
@@ -180,6 +181,273 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+// executeLocally runs the task on a local Garden server rather than creating a
+// build, so that it can be iterated on without a Concourse.
+func (command *ExecuteCommand) executeLocally(args []string) error {
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return err
+	}
+
+	err = taskConfig.Validate()
+	if err != nil {
+		return err
+	}
+
+	inputs, err := command.localInputs(taskConfig)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	task := localexec.Task{
+		Config:     taskConfig,
+		Privileged: command.Privileged,
+		Inputs:     inputs,
+		Outputs:    map[string]string{},
+	}
+
+	for _, output := range outputs {
+		task.Outputs[output.Name] = output.Path
+	}
+
+	task.CacheDir, err = command.localCacheDir()
+	if err != nil {
+		return err
+	}
+
+	gardenURL, err := url.Parse(command.GardenURL)
+	if err != nil {
+		return fmt.Errorf("invalid garden url: %s", err)
+	}
+
+	runner := localexec.NewRunner(
+		gclient.New(gconn.New("tcp", gardenURL.Host)),
+		os.Stdout,
+		ui.Stderr,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	terminate := make(chan os.Signal, 1)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\nstopping...\n")
+		cancel()
+	}()
+
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	exitCode, err := runner.Run(ctx, task)
+	if _, ok := err.(net.Error); ok {
+		return fmt.Errorf("could not reach the Garden server at %s: %s", command.GardenURL, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	os.Exit(exitCode)
+
+	return nil
+}
+
+// localInputs maps each of the task's inputs to the directory given for it
+// with -i, defaulting to the current directory as with a build.
+func (command *ExecuteCommand) localInputs(taskConfig atc.TaskConfig) (map[string]localexec.Input, error) {
+	err := executehelpers.CheckForUnknownInputMappings(command.Inputs, taskConfig.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	inputMappings := command.Inputs
+	if len(inputMappings) == 0 {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		inputMappings = []flaghelpers.InputPairFlag{{
+			Name: filepath.Base(wd),
+			Path: ".",
+		}}
+	}
+
+	inputs := map[string]localexec.Input{}
+	for _, mapping := range inputMappings {
+		inputs[mapping.Name] = localexec.Input{
+			Path:  mapping.Path,
+			Files: executehelpers.GetFiles(mapping.Path, command.IncludeIgnored),
+		}
+	}
+
+	return inputs, nil
+}
+
+// localCacheDir is where the task's caches are kept between local runs, one
+// directory per task config file.
+func (command *ExecuteCommand) localCacheDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	configPath, err := filepath.Abs(string(command.TaskConfig))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum([]byte(configPath))
+
+	return filepath.Join(userCacheDir, "concourse", "fly", "task-caches", fmt.Sprintf("%x", sum)), nil
+}
+
+func (command *ExecuteCommand) createBuildPlan(
+	planFactory atc.PlanFactory,
+	target rc.Target,
+	args []string,
+) (atc.Plan, []executehelpers.Output, error) {
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	inputs, inputMappings, imageResource, err := executehelpers.DetermineInputs(
+		planFactory,
+		target.Team(),
+		taskConfig.Inputs,
+		command.Inputs,
+		command.InputMappings,
+		command.Image,
+		command.InputsFrom,
+		command.IncludeIgnored,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	if imageResource != nil {
+		taskConfig.ImageResource = imageResource
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		planFactory,
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	plan, err := executehelpers.CreateBuildPlan(
+		planFactory,
+		target,
+		command.Privileged,
+		inputs,
+		inputMappings,
+		outputs,
+		taskConfig,
+		command.Tags,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	return plan, outputs, nil
+}
+
+func (command *ExecuteCommand) createStepBuildPlan(
+	planFactory atc.PlanFactory,
+	team concourse.Team,
+) (atc.Plan, []executehelpers.Output, error) {
+	stepPlan, found, err := team.JobBuildStepPlan(
+		command.FromBuild.PipelineName,
+		command.FromBuild.JobName,
+		command.FromBuild.BuildName,
+		command.Step,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	if !found {
+		if command.Step != "" {
+			return atc.Plan{}, nil, fmt.Errorf("task '%s' not found in build %s/%s #%s", command.Step, command.FromBuild.PipelineName, command.FromBuild.JobName, command.FromBuild.BuildName)
+		}
+
+		return atc.Plan{}, nil, fmt.Errorf("no task found in build %s/%s #%s", command.FromBuild.PipelineName, command.FromBuild.JobName, command.FromBuild.BuildName)
+	}
+
+	task := *stepPlan.Step.Task
+
+	task.Vars = command.taskVars(task.Vars)
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	localInputs, err := executehelpers.GenerateLocalInputs(planFactory, team, command.Inputs, command.IncludeIgnored)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	inputs := executehelpers.DetermineStepInputs(planFactory, stepPlan, localInputs, command.Inputs)
+
+	outputs, err := executehelpers.DetermineStepOutputs(planFactory, task, command.Outputs)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	plan := executehelpers.CreateStepBuildPlan(
+		planFactory,
+		task,
+		command.Privileged,
+		inputs,
+		outputs,
+		command.Tags,
+	)
+
+	return plan, outputs, nil
+}
+
+// taskVars overrides the vars the task ran with in the build with any given
+// with -v or -y.
+func (command *ExecuteCommand) taskVars(vars atc.Params) atc.Params {
+	if len(command.Var) == 0 && len(command.YAMLVar) == 0 {
+		return vars
+	}
+
+	overridden := atc.Params{}
+	for name, value := range vars {
+		overridden[name] = value
+	}
+
+	for _, v := range command.Var {
+		overridden[v.Name] = v.Value
+	}
+
+	for _, v := range command.YAMLVar {
+		overridden[v.Name] = v.Value
+	}
+
+	return overridden
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
@@ -9,11 +9,12 @@ import (
 )
 
 type ExposePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to expose"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Pipeline to expose"`
+	Selector flaghelpers.PipelineSelectorFlags
 }
 
 func (command *ExposePipelineCommand) Validate() error {
-	return command.Pipeline.Validate()
+	return command.Selector.Validate(command.Pipeline)
 }
 
 func (command *ExposePipelineCommand) Execute(args []string) error {
@@ -34,6 +35,21 @@ func (command *ExposePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Selector.Selected() {
+		pipelines, err := selectPipelines(target.Team(), command.Selector)
+		if err != nil {
+			return err
+		}
+
+		results, err := target.Team().ExposePipelines(pipelineIDs(pipelines))
+		if err != nil {
+			return err
+		}
+
+		showBatchResults(pipelines, results, "exposed")
+		return nil
+	}
+
 	found, err := target.Team().ExposePipeline(pipelineName)
 	if err != nil {
 		return err
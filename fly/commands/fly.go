@@ -28,6 +28,9 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	GetResourceTypes GetResourceTypesCommand `command:"get-resource-types" alias:"grt" description:"Get the resource types shared by the team's pipelines"`
+	SetResourceTypes SetResourceTypesCommand `command:"set-resource-types" alias:"srt" description:"Set the resource types shared by the team's pipelines"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -52,12 +55,19 @@ type FlyCommand struct {
 	ValidatePipeline ValidatePipelineCommand `command:"validate-pipeline"   alias:"vp"   description:"Validate a pipeline config"`
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
+	PipelineSecrets  PipelineSecretsCommand  `command:"pipeline-secrets"    alias:"psec" description:"List the vars a pipeline looks up and where they were found"`
+	PipelineHistory  PipelineHistoryCommand  `command:"pipeline-history"    alias:"ph"   description:"List the configurations a pipeline has been saved with"`
+	DiffPipeline     DiffPipelineCommand     `command:"diff-pipeline"       alias:"dfp"  description:"Show the changes between two saved configurations of a pipeline"`
 
 	Resources        ResourcesCommand        `command:"resources"           alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions ResourceVersionsCommand `command:"resource-versions"   alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource    CheckResourceCommand    `command:"check-resource"      alias:"cr"   description:"Check a resource"`
+	PinResource      PinResourceCommand      `command:"pin-resource"        alias:"pr"   description:"Pin a resource to a version"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
+	Checks            ChecksCommand            `command:"checks"              alias:"cks"  description:"List the recent checks of a pipeline or resource"`
+
+	WebhookDeliveries WebhookDeliveriesCommand `command:"webhook-deliveries" alias:"whd" description:"List the webhook payloads received by the team and the resources they checked"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
@@ -65,6 +75,7 @@ type FlyCommand struct {
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
+	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rrb" description:"Start a build of a job with the same input versions as a previous build"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
@@ -19,6 +19,10 @@ import (
 type GetPipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
 	JSON     bool                     `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
+
+	AtVersion int `long:"at-version" value-name:"VERSION" description:"Get a previously saved configuration, as listed by pipeline-history"`
+
+	Instance flaghelpers.InstanceVarFlags
 }
 
 func (command *GetPipelineCommand) Validate() error {
@@ -44,7 +48,18 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	config, _, found, err := target.Team().PipelineConfig(pipelineName)
+	team := target.Team().ForInstance(command.Instance.InstanceVars())
+
+	if command.AtVersion != 0 {
+		config, err := configAtVersion(team, pipelineName, command.AtVersion)
+		if err != nil {
+			return err
+		}
+
+		return dump(config, asJSON)
+	}
+
+	config, _, found, err := team.PipelineConfig(pipelineName)
 	if err != nil {
 		return err
 	}
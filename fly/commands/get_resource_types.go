@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"gopkg.in/yaml.v2"
+)
+
+type GetResourceTypesCommand struct {
+	Output flaghelpers.OutputFlags
+}
+
+func (command *GetResourceTypesCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	resourceTypes, err := target.Team().ResourceTypes()
+	if err != nil {
+		return err
+	}
+
+	config := struct {
+		ResourceTypes atc.ResourceTypes `yaml:"resource_types" json:"resource_types"`
+	}{resourceTypes}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, config)
+	}
+
+	// printed as the file set-resource-types takes
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Printf("%s", payload)
+
+	return err
+}
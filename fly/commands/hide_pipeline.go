@@ -9,11 +9,12 @@ import (
 )
 
 type HidePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to hide"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Pipeline to hide"`
+	Selector flaghelpers.PipelineSelectorFlags
 }
 
 func (command *HidePipelineCommand) Validate() error {
-	return command.Pipeline.Validate()
+	return command.Selector.Validate(command.Pipeline)
 }
 
 func (command *HidePipelineCommand) Execute(args []string) error {
@@ -34,6 +35,21 @@ func (command *HidePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Selector.Selected() {
+		pipelines, err := selectPipelines(target.Team(), command.Selector)
+		if err != nil {
+			return err
+		}
+
+		results, err := target.Team().HidePipelines(pipelineIDs(pipelines))
+		if err != nil {
+			return err
+		}
+
+		showBatchResults(pipelines, results, "hid")
+		return nil
+	}
+
 	found, err := target.Team().HidePipeline(pipelineName)
 	if err != nil {
 		return err
@@ -0,0 +1,61 @@
+package displayhelpers
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+func ShowCredentialChecks(checks []atc.CredentialCheck, printHeaders bool) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "var", Color: color.New(color.Bold)},
+			{Contents: "backend", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "paths", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, check := range checks {
+		backendCell := ui.TableCell{Contents: check.Backend}
+		if check.Backend == "" {
+			backendCell.Contents = "n/a"
+			backendCell.Color = ui.OffColor
+		}
+
+		statusCell := ui.TableCell{Contents: string(check.Status)}
+		switch check.Status {
+		case atc.CredentialCheckFound:
+			statusCell.Color = ui.SucceededColor
+		case atc.CredentialCheckNotFound:
+			statusCell.Color = ui.FailedColor
+		case atc.CredentialCheckErrored:
+			statusCell.Color = ui.ErroredColor
+		}
+
+		var pathsCell ui.TableCell
+		switch {
+		case check.Status == atc.CredentialCheckErrored:
+			pathsCell.Contents = check.Error
+		case check.Path != "":
+			pathsCell.Contents = check.Path
+		case len(check.Searched) > 0:
+			pathsCell.Contents = strings.Join(check.Searched, ", ")
+		default:
+			pathsCell.Contents = "n/a"
+			pathsCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: check.Name},
+			backendCell,
+			statusCell,
+			pathsCell,
+		})
+	}
+
+	return table.Render(os.Stdout, printHeaders)
+}
@@ -19,11 +19,6 @@ func CreateBuildPlan(
 		return atc.Plan{}, err
 	}
 
-	buildInputs := atc.AggregatePlan{}
-	for _, input := range inputs {
-		buildInputs = append(buildInputs, input.Plan)
-	}
-
 	taskPlan := fact.NewPlan(atc.TaskPlan{
 		Name:         "one-off",
 		Privileged:   privileged,
@@ -35,6 +30,20 @@ func CreateBuildPlan(
 		taskPlan.Task.Tags = tags
 	}
 
+	return assembleBuildPlan(fact, inputs, taskPlan, outputs), nil
+}
+
+func assembleBuildPlan(
+	fact atc.PlanFactory,
+	inputs []Input,
+	taskPlan atc.Plan,
+	outputs []Output,
+) atc.Plan {
+	buildInputs := atc.AggregatePlan{}
+	for _, input := range inputs {
+		buildInputs = append(buildInputs, input.Plan)
+	}
+
 	buildOutputs := atc.AggregatePlan{}
 	for _, output := range outputs {
 		buildOutputs = append(buildOutputs, output.Plan)
@@ -56,5 +65,5 @@ func CreateBuildPlan(
 		})
 	}
 
-	return plan, nil
+	return plan
 }
@@ -0,0 +1,92 @@
+package executehelpers
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+)
+
+// DetermineStepInputs returns the inputs for re-running a step taken from a
+// build, replacing the step's gets with any local inputs of the same name.
+// Local inputs which don't replace a get are included too, so that artifacts
+// produced by earlier steps of the build can be provided.
+func DetermineStepInputs(
+	fact atc.PlanFactory,
+	stepPlan atc.BuildStepPlan,
+	localInputs map[string]Input,
+	localInputMappings []flaghelpers.InputPairFlag,
+) []Input {
+	inputs := []Input{}
+
+	replaced := map[string]bool{}
+	for _, plan := range stepPlan.Inputs {
+		name := plan.Get.Name
+
+		if input, found := localInputs[name]; found {
+			inputs = append(inputs, input)
+			replaced[name] = true
+			continue
+		}
+
+		inputs = append(inputs, Input{
+			Name: name,
+			Plan: fact.NewPlan(*plan.Get),
+		})
+	}
+
+	for _, mapping := range localInputMappings {
+		if !replaced[mapping.Name] {
+			inputs = append(inputs, localInputs[mapping.Name])
+		}
+	}
+
+	return inputs
+}
+
+// DetermineStepOutputs returns the outputs to fetch from re-running a step
+// taken from a build. Outputs can only be checked against the task's config
+// if it was configured inline rather than from a file.
+func DetermineStepOutputs(
+	fact atc.PlanFactory,
+	task atc.TaskPlan,
+	outputMappings []flaghelpers.OutputPairFlag,
+) ([]Output, error) {
+	taskOutputs := []atc.TaskOutputConfig{}
+
+	if task.Config != nil && task.ConfigPath == "" {
+		for _, output := range task.Config.Outputs {
+			name := output.Name
+			if mapped, found := task.OutputMapping[name]; found {
+				name = mapped
+			}
+
+			taskOutputs = append(taskOutputs, atc.TaskOutputConfig{Name: name})
+		}
+	} else {
+		for _, mapping := range outputMappings {
+			taskOutputs = append(taskOutputs, atc.TaskOutputConfig{Name: mapping.Name})
+		}
+	}
+
+	return DetermineOutputs(fact, taskOutputs, outputMappings)
+}
+
+// CreateStepBuildPlan creates a one-off build plan which runs the task taken
+// from a build with the given inputs.
+func CreateStepBuildPlan(
+	fact atc.PlanFactory,
+	task atc.TaskPlan,
+	privileged bool,
+	inputs []Input,
+	outputs []Output,
+	tags []string,
+) atc.Plan {
+	if privileged {
+		task.Privileged = true
+	}
+
+	if len(tags) != 0 {
+		task.Tags = tags
+	}
+
+	return assembleBuildPlan(fact, inputs, fact.NewPlan(task), outputs)
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func Upload(bar *mpb.Bar, team concourse.Team, path string, includeIgnored bool) (atc.WorkerArtifact, error) {
-	files := getFiles(path, includeIgnored)
+	files := GetFiles(path, includeIgnored)
 
 	archiveStream, archiveWriter := io.Pipe()
 
@@ -24,7 +24,10 @@ func Upload(bar *mpb.Bar, team concourse.Team, path string, includeIgnored bool)
 	return team.CreateArtifact(bar.ProxyReader(archiveStream))
 }
 
-func getFiles(dir string, includeIgnored bool) []string {
+// GetFiles returns the paths under dir which make up an input: the files git
+// knows about, or everything if ignored files are included or dir is not in a
+// git repository.
+func GetFiles(dir string, includeIgnored bool) []string {
 	var files []string
 	var err error
 
@@ -0,0 +1,44 @@
+package flaghelpers
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// InstanceVarFlags address one of the instances of the pipeline given with
+// --pipeline, rather than the pipeline which isn't instanced.
+type InstanceVarFlags struct {
+	Vars []VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying an instance of the pipeline (can be specified multiple times)"`
+}
+
+func (flags InstanceVarFlags) InstanceVars() atc.InstanceVars {
+	if len(flags.Vars) == 0 {
+		return nil
+	}
+
+	instanceVars := atc.InstanceVars{}
+	for _, pair := range flags.Vars {
+		instanceVars[pair.Name] = pair.Value
+	}
+
+	return instanceVars
+}
+
+// Ref returns the reference to the given pipeline, or its instance.
+func (flags InstanceVarFlags) Ref(pipeline PipelineFlag) atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         string(pipeline),
+		InstanceVars: flags.InstanceVars(),
+	}
+}
+
+// Validate checks that the instance vars are only given for a single
+// pipeline, rather than alongside the selector's flags.
+func (flags InstanceVarFlags) Validate(selector PipelineSelectorFlags) error {
+	if len(flags.Vars) > 0 && selector.Selected() {
+		return errors.New("--instance-var can only be specified with --pipeline")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceVarFlags", func() {
+	It("refers to the pipeline which isn't instanced without any vars", func() {
+		Expect(InstanceVarFlags{}.Ref("release")).To(Equal(atc.PipelineRef{Name: "release"}))
+	})
+
+	It("refers to the instance with the vars", func() {
+		flags := InstanceVarFlags{Vars: []VariablePairFlag{
+			{Name: "branch", Value: "1.2"},
+			{Name: "os", Value: "linux"},
+		}}
+
+		Expect(flags.Ref("release")).To(Equal(atc.PipelineRef{
+			Name:         "release",
+			InstanceVars: atc.InstanceVars{"branch": "1.2", "os": "linux"},
+		}))
+	})
+
+	It("can not be combined with selecting several pipelines", func() {
+		flags := InstanceVarFlags{Vars: []VariablePairFlag{{Name: "branch", Value: "1.2"}}}
+
+		err := flags.Validate(PipelineSelectorFlags{All: true})
+		Expect(err).To(MatchError("--instance-var can only be specified with --pipeline"))
+	})
+})
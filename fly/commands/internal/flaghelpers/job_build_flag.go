@@ -0,0 +1,26 @@
+package flaghelpers
+
+import (
+	"errors"
+	"strings"
+)
+
+type JobBuildFlag struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+}
+
+func (flag *JobBuildFlag) UnmarshalFlag(value string) error {
+	vs := strings.Split(value, "/")
+
+	if len(vs) != 3 || vs[0] == "" || vs[1] == "" || vs[2] == "" {
+		return errors.New("argument format should be <pipeline>/<job>/<build>")
+	}
+
+	flag.PipelineName = vs[0]
+	flag.JobName = vs[1]
+	flag.BuildName = vs[2]
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package flaghelpers_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobBuildFlag", func() {
+	It("parses the pipeline, job and build", func() {
+		flag := &JobBuildFlag{}
+
+		err := flag.UnmarshalFlag("some-pipeline/some-job/42")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*flag).To(Equal(JobBuildFlag{
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			BuildName:    "42",
+		}))
+	})
+
+	Context("when the build is not specified", func() {
+		It("displays an error message", func() {
+			flag := &JobBuildFlag{}
+
+			err := flag.UnmarshalFlag("some-pipeline/some-job")
+			Expect(err).To(MatchError("argument format should be <pipeline>/<job>/<build>"))
+		})
+	})
+})
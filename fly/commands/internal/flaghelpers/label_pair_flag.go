@@ -0,0 +1,30 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type LabelPairFlag struct {
+	Key   string
+	Value string
+}
+
+func (pair *LabelPairFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "=", 2)
+	if len(vs) != 2 {
+		return fmt.Errorf("invalid label '%s' (must be key=value)", value)
+	}
+
+	err := atc.ValidateLabelKey(vs[0])
+	if err != nil {
+		return fmt.Errorf("invalid label '%s': %s", value, err)
+	}
+
+	pair.Key = vs[0]
+	pair.Value = vs[1]
+
+	return nil
+}
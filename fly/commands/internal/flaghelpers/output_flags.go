@@ -0,0 +1,45 @@
+package flaghelpers
+
+import (
+	"errors"
+	"io"
+
+	"github.com/concourse/concourse/fly/ui"
+)
+
+// OutputFlags are the flags accepted by every command which lists or inspects
+// things, to print the result for scripts instead of as a table.
+type OutputFlags struct {
+	JSON   bool     `long:"json"                        description:"Print command result as JSON"`
+	Format string   `long:"output" value-name:"FORMAT" choice:"table" choice:"json" choice:"yaml" description:"Print command result as a table, JSON or YAML"`
+	Fields []string `long:"field"  value-name:"PATH"   description:"Print only the value at the given path of each result, e.g. '.name' (can be specified multiple times)"`
+}
+
+// ErrJSONWithOutputFormat is returned when a result is rendered with both
+// --json and --output given, as they would contradict each other.
+var ErrJSONWithOutputFormat = errors.New("--json and --output are mutually exclusive; use --output json instead")
+
+func (flags OutputFlags) Structured() bool {
+	return flags.output().Structured()
+}
+
+func (flags OutputFlags) Render(dst io.Writer, value interface{}) error {
+	if flags.JSON && flags.Format != "" {
+		return ErrJSONWithOutputFormat
+	}
+
+	return flags.output().Render(dst, value)
+}
+
+func (flags OutputFlags) output() ui.Output {
+	output := ui.Output{
+		Format: flags.Format,
+		Fields: flags.Fields,
+	}
+
+	if flags.JSON {
+		output.Format = ui.OutputJSON
+	}
+
+	return output
+}
@@ -0,0 +1,60 @@
+package flaghelpers_test
+
+import (
+	"bytes"
+
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFlags", func() {
+	var (
+		flags OutputFlags
+		out   *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		flags = OutputFlags{}
+		out = new(bytes.Buffer)
+	})
+
+	It("is not structured without any flags", func() {
+		Expect(flags.Structured()).To(BeFalse())
+	})
+
+	It("renders as JSON when --json is given", func() {
+		flags.JSON = true
+
+		Expect(flags.Structured()).To(BeTrue())
+		Expect(flags.Render(out, map[string]string{"name": "some-name"})).To(Succeed())
+		Expect(out).To(MatchJSON(`{"name":"some-name"}`))
+	})
+
+	It("renders in the format given with --output", func() {
+		flags.Format = "yaml"
+
+		Expect(flags.Structured()).To(BeTrue())
+		Expect(flags.Render(out, map[string]string{"name": "some-name"})).To(Succeed())
+		Expect(out.String()).To(Equal("name: some-name\n"))
+	})
+
+	Context("when both --json and --output are given", func() {
+		BeforeEach(func() {
+			flags.JSON = true
+			flags.Format = "yaml"
+		})
+
+		It("refuses to render", func() {
+			Expect(flags.Render(out, map[string]string{"name": "some-name"})).To(MatchError(ErrJSONWithOutputFormat))
+			Expect(out.String()).To(BeEmpty())
+		})
+
+		It("refuses to render even if they agree", func() {
+			flags.Format = "json"
+
+			Expect(flags.Render(out, map[string]string{"name": "some-name"})).To(MatchError(ErrJSONWithOutputFormat))
+		})
+	})
+})
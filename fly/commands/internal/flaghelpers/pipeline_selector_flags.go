@@ -0,0 +1,75 @@
+package flaghelpers
+
+import (
+	"errors"
+	"path"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// PipelineSelectorFlags are accepted alongside --pipeline by the commands
+// which can act on many of a team's pipelines at once.
+type PipelineSelectorFlags struct {
+	All    bool     `long:"all"                            description:"Apply to all of the team's pipelines"`
+	Glob   string   `long:"glob" value-name:"PATTERN"      description:"Apply to the team's pipelines whose names match the pattern, e.g. 'pr-*'"`
+	Labels []string `long:"label" value-name:"KEY[=VALUE]" description:"Apply to the team's pipelines with the label, optionally set to the value (can be specified multiple times)"`
+}
+
+// Selected returns whether the flags were given, rather than a single
+// pipeline.
+func (flags PipelineSelectorFlags) Selected() bool {
+	return flags.All || flags.Glob != "" || len(flags.Labels) > 0
+}
+
+// Validate checks that exactly one of the given pipeline, --all, or
+// --glob and --label says which pipelines to act on.
+func (flags PipelineSelectorFlags) Validate(pipeline PipelineFlag) error {
+	filtered := flags.Glob != "" || len(flags.Labels) > 0
+
+	selectors := 0
+	for _, given := range []bool{pipeline != "", flags.All, filtered} {
+		if given {
+			selectors++
+		}
+	}
+
+	if selectors == 0 {
+		return errors.New("one of --pipeline, --all, --glob or --label must be specified")
+	}
+
+	if selectors > 1 {
+		return errors.New("only one of --pipeline, --all or --glob/--label can be specified")
+	}
+
+	if flags.Glob != "" {
+		if _, err := path.Match(flags.Glob, ""); err != nil {
+			return errors.New("invalid --glob pattern: " + err.Error())
+		}
+	}
+
+	if _, err := atc.ParseLabelSelectors(flags.Labels); err != nil {
+		return errors.New("invalid --label: " + err.Error())
+	}
+
+	return pipeline.Validate()
+}
+
+// Filter returns the pipelines selected by --glob, in the order they are
+// given. Each instance of a pipeline is returned on its own, so that acting
+// on the result leaves instances which were not listed alone. Pipelines are
+// selected by --label when they are listed, as the labels are matched by the
+// server.
+func (flags PipelineSelectorFlags) Filter(pipelines []atc.Pipeline) []atc.Pipeline {
+	selected := []atc.Pipeline{}
+	for _, pipeline := range pipelines {
+		if flags.Glob != "" {
+			if matched, _ := path.Match(flags.Glob, pipeline.Name); !matched {
+				continue
+			}
+		}
+
+		selected = append(selected, pipeline)
+	}
+
+	return selected
+}
@@ -0,0 +1,73 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineSelectorFlags", func() {
+	Describe("Validate", func() {
+		It("requires a pipeline or a selector", func() {
+			err := PipelineSelectorFlags{}.Validate("")
+			Expect(err).To(MatchError("one of --pipeline, --all, --glob or --label must be specified"))
+		})
+
+		It("does not allow a pipeline and a selector together", func() {
+			err := PipelineSelectorFlags{All: true}.Validate("some-pipeline")
+			Expect(err).To(MatchError("only one of --pipeline, --all or --glob/--label can be specified"))
+		})
+
+		It("does not allow --all with --label", func() {
+			err := PipelineSelectorFlags{All: true, Labels: []string{"env=prod"}}.Validate("")
+			Expect(err).To(MatchError("only one of --pipeline, --all or --glob/--label can be specified"))
+		})
+
+		It("allows --glob and --label together", func() {
+			Expect(PipelineSelectorFlags{Glob: "pr-*", Labels: []string{"env=prod"}}.Validate("")).To(Succeed())
+		})
+
+		It("rejects malformed labels", func() {
+			err := PipelineSelectorFlags{Labels: []string{"=prod"}}.Validate("")
+			Expect(err).To(MatchError("invalid --label: label key cannot be empty"))
+		})
+
+		It("rejects malformed patterns", func() {
+			err := PipelineSelectorFlags{Glob: "pr-["}.Validate("")
+			Expect(err).To(MatchError(HavePrefix("invalid --glob pattern")))
+		})
+
+		It("accepts a single pipeline", func() {
+			Expect(PipelineSelectorFlags{}.Validate("some-pipeline")).To(Succeed())
+		})
+	})
+
+	Describe("Filter", func() {
+		var pipelines []atc.Pipeline
+
+		BeforeEach(func() {
+			pipelines = []atc.Pipeline{
+				{ID: 1, Name: "pr-1"},
+				{ID: 2, Name: "main"},
+				{ID: 3, Name: "pr-2"},
+			}
+		})
+
+		It("selects every pipeline with --all", func() {
+			Expect(PipelineSelectorFlags{All: true}.Filter(pipelines)).To(Equal(pipelines))
+		})
+
+		It("selects the pipelines matching --glob", func() {
+			Expect(PipelineSelectorFlags{Glob: "pr-*"}.Filter(pipelines)).To(Equal([]atc.Pipeline{pipelines[0], pipelines[2]}))
+		})
+
+		It("selects each instance of a pipeline on its own", func() {
+			releaseOne := atc.Pipeline{ID: 4, Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.2"}}
+			releaseTwo := atc.Pipeline{ID: 5, Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.3"}}
+
+			Expect(PipelineSelectorFlags{Glob: "release"}.Filter(append(pipelines, releaseOne, releaseTwo))).To(Equal([]atc.Pipeline{releaseOne, releaseTwo}))
+		})
+	})
+})
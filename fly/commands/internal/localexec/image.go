@@ -0,0 +1,223 @@
+package localexec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+const imageMetadataFile = "metadata.json"
+
+// resourceDir is where the image resource's 'in' script fetches the image
+// to, as on a worker.
+const resourceDir = "/tmp/build/get"
+
+var ErrNoImageVersions = errors.New("no versions of the image resource were found")
+
+type ErrResourceScriptFailed struct {
+	Path       string
+	Args       []string
+	ExitStatus int
+}
+
+func (err ErrResourceScriptFailed) Error() string {
+	return fmt.Sprintf(
+		"resource script '%s %v' failed: exit status %d",
+		err.Path,
+		err.Args,
+		err.ExitStatus,
+	)
+}
+
+type imageMetadata struct {
+	Env  []string `json:"env"`
+	User string   `json:"user"`
+}
+
+type fetchedImage struct {
+	url      string
+	metadata imageMetadata
+	release  func()
+}
+
+// fetchImage returns the image to run the task in. An image resource is
+// fetched by running its type's 'check' and 'in' scripts in a container of
+// the type's published image, concourse/<type>-resource, and the fetched
+// rootfs is handed to Garden as a raw:// path.
+func (runner *Runner) fetchImage(ctx context.Context, config atc.TaskConfig) (fetchedImage, error) {
+	if config.RootfsURI != "" {
+		return fetchedImage{
+			url:     config.RootfsURI,
+			release: func() {},
+		}, nil
+	}
+
+	if config.ImageResource == nil {
+		return fetchedImage{}, errors.New("the task config must specify either rootfs_uri or image_resource")
+	}
+
+	imageResource := *config.ImageResource
+
+	container, err := runner.client.Create(garden.ContainerSpec{
+		RootFSPath: fmt.Sprintf("docker:///concourse/%s-resource", imageResource.Type),
+
+		// docker-image needs to run a docker daemon, so it is the one base
+		// resource type which is privileged on the workers
+		Privileged: imageResource.Type == "docker-image",
+	})
+	if err != nil {
+		return fetchedImage{}, err
+	}
+
+	defer runner.client.Destroy(container.Handle())
+
+	var version atc.Version
+	if imageResource.Version != nil {
+		version = *imageResource.Version
+	} else {
+		var versions []atc.Version
+
+		err := runner.runScript(ctx, container, "/opt/resource/check", nil, checkRequest{
+			Source: imageResource.Source,
+		}, &versions)
+		if err != nil {
+			return fetchedImage{}, err
+		}
+
+		if len(versions) == 0 {
+			return fetchedImage{}, ErrNoImageVersions
+		}
+
+		version = versions[len(versions)-1]
+	}
+
+	var params atc.Params
+	if imageResource.Params != nil {
+		params = *imageResource.Params
+	}
+
+	err = runner.runScript(ctx, container, "/opt/resource/in", []string{resourceDir}, getRequest{
+		Source:  imageResource.Source,
+		Params:  params,
+		Version: version,
+	}, &struct{}{})
+	if err != nil {
+		return fetchedImage{}, err
+	}
+
+	imageDir, err := ioutil.TempDir("", "fly-image")
+	if err != nil {
+		return fetchedImage{}, err
+	}
+
+	release := func() { os.RemoveAll(imageDir) }
+
+	err = streamOut(container, resourceDir+"/", imageDir)
+	if err != nil {
+		release()
+		return fetchedImage{}, fmt.Errorf("failed to fetch image: %s", err)
+	}
+
+	metadata, err := loadMetadata(filepath.Join(imageDir, imageMetadataFile))
+	if err != nil {
+		release()
+		return fetchedImage{}, err
+	}
+
+	imageURL := url.URL{
+		Scheme: "raw",
+		Path:   filepath.Join(imageDir, "rootfs"),
+	}
+
+	return fetchedImage{
+		url:      imageURL.String(),
+		metadata: metadata,
+		release:  release,
+	}, nil
+}
+
+type checkRequest struct {
+	Source atc.Source `json:"source"`
+}
+
+type getRequest struct {
+	Source  atc.Source  `json:"source"`
+	Params  atc.Params  `json:"params,omitempty"`
+	Version atc.Version `json:"version,omitempty"`
+}
+
+// runScript runs one of the resource's scripts with the request on stdin,
+// decoding its stdout into output. The script's stderr is shown as the
+// task's would be.
+func (runner *Runner) runScript(
+	ctx context.Context,
+	container garden.Container,
+	path string,
+	args []string,
+	request interface{},
+	output interface{},
+) error {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	stdout := new(bytes.Buffer)
+
+	process, err := container.Run(
+		garden.ProcessSpec{
+			Path: path,
+			Args: args,
+			User: "root",
+		},
+		garden.ProcessIO{
+			Stdin:  bytes.NewBuffer(payload),
+			Stdout: stdout,
+			Stderr: runner.stderr,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	processStatus, err := runner.wait(ctx, container, process)
+	if err != nil {
+		return err
+	}
+
+	if processStatus != 0 {
+		return ErrResourceScriptFailed{
+			Path:       path,
+			Args:       args,
+			ExitStatus: processStatus,
+		}
+	}
+
+	return json.Unmarshal(stdout.Bytes(), output)
+}
+
+func loadMetadata(path string) (imageMetadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return imageMetadata{}, fmt.Errorf("could not read file \"%s\" from the image: %s", imageMetadataFile, err)
+	}
+
+	defer file.Close()
+
+	var metadata imageMetadata
+	err = json.NewDecoder(file).Decode(&metadata)
+	if err != nil {
+		return imageMetadata{}, fmt.Errorf("malformed image metadata: %s", err)
+	}
+
+	return metadata, nil
+}
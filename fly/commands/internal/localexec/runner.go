@@ -0,0 +1,308 @@
+package localexec
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec/taskspec"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/go-archive/tarfs"
+)
+
+// stepName is the name fly execute gives the task in the build plan. The
+// task's working directory is derived from it the same way as on a worker,
+// so the task sees the same paths whether it is run locally or not.
+const stepName = "one-off"
+
+type MissingInputsError = taskspec.MissingInputsError
+
+// Task is a task config to run along with the local directories it reads its
+// inputs from and writes its outputs to, keyed by input and output name.
+type Task struct {
+	Config     atc.TaskConfig
+	Privileged bool
+
+	Inputs  map[string]Input
+	Outputs map[string]string
+
+	// CacheDir is the local directory the task's caches are kept in between
+	// runs. If it is empty, the caches start out empty every time.
+	CacheDir string
+}
+
+// Input is a local directory to copy into the task as one of its inputs.
+// Only the given files under it are copied, or all of it if none are given.
+type Input struct {
+	Path  string
+	Files []string
+}
+
+// Runner runs tasks in containers on a Garden server on the same machine,
+// without an ATC. The container is laid out by the taskspec package, the same
+// as the task step's: inputs are copied into the task's working directory,
+// outputs and caches start out as empty directories next to them, and params
+// are set as environment variables. The image is either the task's rootfs_uri
+// or fetched by running its image_resource's 'check' and 'in' scripts.
+//
+// The Garden server must share the local filesystem, as fetched images and
+// caches are handed to it by path.
+type Runner struct {
+	client garden.Client
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func NewRunner(client garden.Client, stdout io.Writer, stderr io.Writer) *Runner {
+	return &Runner{
+		client: client,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// Run runs the task to completion, returning its exit status. If the context
+// is cancelled, the task is stopped and the context's error is returned.
+func (runner *Runner) Run(ctx context.Context, task Task) (int, error) {
+	config := task.Config
+
+	missing := []string{}
+	for _, input := range config.Inputs {
+		if _, found := task.Inputs[input.Name]; !found && !input.Optional {
+			missing = append(missing, input.Name)
+		}
+	}
+
+	if len(missing) != 0 {
+		return 0, MissingInputsError{Inputs: missing}
+	}
+
+	fmt.Fprintln(runner.stdout, ui.Embolden("initializing"))
+
+	image, err := runner.fetchImage(ctx, config)
+	if err != nil {
+		return 0, err
+	}
+
+	defer image.release()
+
+	artifactsRoot := taskspec.WorkingDirectory(stepName)
+
+	env := append([]string{}, image.metadata.Env...)
+	env = append(env, taskspec.Env(config.Params)...)
+
+	user := config.Run.User
+	if user == "" {
+		user = image.metadata.User
+	}
+
+	bindMounts := []garden.BindMount{}
+	emptyDirs := []string{artifactsRoot}
+
+	for _, output := range config.Outputs {
+		emptyDirs = append(emptyDirs, taskspec.OutputPath(artifactsRoot, output))
+	}
+
+	for _, cache := range config.Caches {
+		cachePath := taskspec.CachePath(artifactsRoot, cache)
+
+		if task.CacheDir == "" {
+			emptyDirs = append(emptyDirs, cachePath)
+			continue
+		}
+
+		hostPath := filepath.Join(task.CacheDir, filepath.FromSlash(cache.Path))
+
+		err := os.MkdirAll(hostPath, 0755)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create cache '%s': %s", cache.Path, err)
+		}
+
+		bindMounts = append(bindMounts, garden.BindMount{
+			SrcPath: hostPath,
+			DstPath: cachePath,
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	container, err := runner.client.Create(garden.ContainerSpec{
+		RootFSPath: image.url,
+		Privileged: task.Privileged,
+		BindMounts: bindMounts,
+		Limits:     taskspec.Limits(config.Limits),
+		Env:        env,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer runner.client.Destroy(container.Handle())
+
+	err = container.StreamIn(garden.StreamInSpec{
+		Path:      "/",
+		User:      "root",
+		TarStream: dirsTar(emptyDirs),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, input := range config.Inputs {
+		localInput, found := task.Inputs[input.Name]
+		if !found {
+			continue
+		}
+
+		err := streamIn(container, localInput, taskspec.InputPath(artifactsRoot, input))
+		if err != nil {
+			return 0, fmt.Errorf("failed to provide input '%s': %s", input.Name, err)
+		}
+	}
+
+	fmt.Fprintln(runner.stdout, ui.Embolden("running %s", strings.Join(append([]string{config.Run.Path}, config.Run.Args...), " ")))
+
+	process, err := container.Run(
+		garden.ProcessSpec{
+			Path: config.Run.Path,
+			Args: config.Run.Args,
+			Dir:  taskspec.RunDir(artifactsRoot, config),
+			User: user,
+
+			// Guardian sets the default TTY window size to width: 80, height: 24,
+			// which creates ANSI control sequences that do not work with other window sizes
+			TTY: &garden.TTYSpec{
+				WindowSize: &garden.WindowSize{Columns: 500, Rows: 500},
+			},
+		},
+		garden.ProcessIO{
+			Stdout: runner.stdout,
+			Stderr: runner.stderr,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	processStatus, err := runner.wait(ctx, container, process)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, output := range config.Outputs {
+		localPath, found := task.Outputs[output.Name]
+		if !found {
+			continue
+		}
+
+		err := streamOut(container, taskspec.OutputPath(artifactsRoot, output), localPath)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch output '%s': %s", output.Name, err)
+		}
+	}
+
+	return processStatus, nil
+}
+
+// wait waits for the process to exit, stopping the container if the context
+// is cancelled first.
+func (runner *Runner) wait(ctx context.Context, container garden.Container, process garden.Process) (int, error) {
+	exited := make(chan struct{})
+	var processStatus int
+	var processErr error
+
+	go func() {
+		processStatus, processErr = process.Wait()
+		close(exited)
+	}()
+
+	select {
+	case <-ctx.Done():
+		err := container.Stop(false)
+		if err != nil {
+			fmt.Fprintln(runner.stderr, "failed to stop container:", err)
+		}
+
+		<-exited
+
+		return 0, ctx.Err()
+
+	case <-exited:
+		return processStatus, processErr
+	}
+}
+
+// dirsTar returns a tar stream which creates the given directories, so that
+// the task can write to its outputs and caches as any user.
+func dirsTar(dirs []string) io.Reader {
+	reader, writer := io.Pipe()
+
+	go func() {
+		tw := tar.NewWriter(writer)
+
+		for _, dir := range dirs {
+			err := tw.WriteHeader(&tar.Header{
+				Name:     strings.TrimPrefix(path.Clean(dir), "/") + "/",
+				Typeflag: tar.TypeDir,
+				Mode:     0777,
+			})
+			if err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+
+		writer.CloseWithError(tw.Close())
+	}()
+
+	return reader
+}
+
+func streamIn(container garden.Container, input Input, dst string) error {
+	files := input.Files
+	if len(files) == 0 {
+		files = []string{"."}
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(tarfs.Compress(writer, input.Path, files...))
+	}()
+
+	err := container.StreamIn(garden.StreamInSpec{
+		Path:      dst,
+		User:      "root",
+		TarStream: reader,
+	})
+
+	reader.CloseWithError(err)
+
+	return err
+}
+
+func streamOut(container garden.Container, src string, localPath string) error {
+	stream, err := container.StreamOut(garden.StreamOutSpec{
+		Path: src,
+		User: "root",
+	})
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	err = os.MkdirAll(localPath, 0755)
+	if err != nil {
+		return err
+	}
+
+	return tarfs.Extract(stream, localPath)
+}
@@ -0,0 +1,350 @@
+package localexec_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		fakeClient    *gardenfakes.FakeClient
+		fakeContainer *gardenfakes.FakeContainer
+		fakeProcess   *gardenfakes.FakeProcess
+
+		stdout *bytes.Buffer
+		stderr *bytes.Buffer
+
+		inputDir  string
+		outputDir string
+		cacheDir  string
+
+		streamedIn map[string][]string
+
+		task localexec.Task
+		ctx  context.Context
+
+		exitStatus int
+		runErr     error
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(gardenfakes.FakeClient)
+		fakeContainer = new(gardenfakes.FakeContainer)
+		fakeProcess = new(gardenfakes.FakeProcess)
+
+		fakeClient.CreateReturns(fakeContainer, nil)
+		fakeContainer.HandleReturns("some-handle")
+		fakeContainer.RunReturns(fakeProcess, nil)
+		fakeProcess.WaitReturns(3, nil)
+
+		streamedIn = map[string][]string{}
+		fakeContainer.StreamInStub = func(spec garden.StreamInSpec) error {
+			streamedIn[spec.Path] = tarEntries(spec.TarStream)
+			return nil
+		}
+
+		fakeContainer.StreamOutStub = func(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+			return ioutil.NopCloser(tarStream(map[string]string{"result": "done"})), nil
+		}
+
+		stdout = new(bytes.Buffer)
+		stderr = new(bytes.Buffer)
+
+		var err error
+		inputDir, err = ioutil.TempDir("", "localexec-input")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(inputDir, "script"), []byte("echo hi"), 0755)
+		Expect(err).NotTo(HaveOccurred())
+
+		outputDir, err = ioutil.TempDir("", "localexec-output")
+		Expect(err).NotTo(HaveOccurred())
+
+		cacheDir, err = ioutil.TempDir("", "localexec-cache")
+		Expect(err).NotTo(HaveOccurred())
+
+		cpu := uint64(512)
+
+		task = localexec.Task{
+			Config: atc.TaskConfig{
+				Platform:  "linux",
+				RootfsURI: "docker:///busybox",
+				Params:    map[string]string{"SOME_PARAM": "some-value"},
+				Limits:    atc.ContainerLimits{CPU: &cpu},
+				Inputs:    []atc.TaskInputConfig{{Name: "source"}},
+				Outputs:   []atc.TaskOutputConfig{{Name: "result", Path: "out"}},
+				Run: atc.TaskRunConfig{
+					Path: "source/script",
+					Args: []string{"some-arg"},
+					Dir:  "source",
+				},
+			},
+			Privileged: true,
+			Inputs: map[string]localexec.Input{
+				"source": {Path: inputDir},
+			},
+			Outputs: map[string]string{
+				"result": filepath.Join(outputDir, "result"),
+			},
+		}
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(inputDir)
+		os.RemoveAll(outputDir)
+		os.RemoveAll(cacheDir)
+	})
+
+	JustBeforeEach(func() {
+		exitStatus, runErr = localexec.NewRunner(fakeClient, stdout, stderr).Run(ctx, task)
+	})
+
+	It("runs the task in a container with its image, params and limits", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(exitStatus).To(Equal(3))
+
+		Expect(fakeClient.CreateCallCount()).To(Equal(1))
+		spec := fakeClient.CreateArgsForCall(0)
+		Expect(spec.RootFSPath).To(Equal("docker:///busybox"))
+		Expect(spec.Privileged).To(BeTrue())
+		Expect(spec.Env).To(Equal([]string{"SOME_PARAM=some-value"}))
+		Expect(spec.Limits.CPU.LimitInShares).To(Equal(uint64(512)))
+
+		Expect(fakeContainer.RunCallCount()).To(Equal(1))
+		processSpec, _ := fakeContainer.RunArgsForCall(0)
+		Expect(processSpec.Path).To(Equal("source/script"))
+		Expect(processSpec.Args).To(Equal([]string{"some-arg"}))
+		Expect(processSpec.Dir).To(Equal("/tmp/build/e55deab7/source"))
+
+		Expect(stdout.String()).To(ContainSubstring("running source/script some-arg"))
+	})
+
+	It("copies the inputs into the task's working directory", func() {
+		Expect(streamedIn).To(HaveKeyWithValue("/tmp/build/e55deab7/source", ContainElement("./script")))
+	})
+
+	It("creates the outputs and fetches them once the task exits", func() {
+		Expect(streamedIn).To(HaveKeyWithValue("/", ContainElement("tmp/build/e55deab7/out/")))
+
+		Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+		Expect(fakeContainer.StreamOutArgsForCall(0).Path).To(Equal("/tmp/build/e55deab7/out/"))
+
+		content, err := ioutil.ReadFile(filepath.Join(outputDir, "result", "result"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("done"))
+	})
+
+	It("destroys the container", func() {
+		Expect(fakeClient.DestroyCallCount()).To(Equal(1))
+		Expect(fakeClient.DestroyArgsForCall(0)).To(Equal("some-handle"))
+	})
+
+	Context("when an input is not given", func() {
+		BeforeEach(func() {
+			task.Inputs = nil
+		})
+
+		It("errors without creating a container", func() {
+			Expect(runErr).To(Equal(localexec.MissingInputsError{Inputs: []string{"source"}}))
+			Expect(fakeClient.CreateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the task has caches", func() {
+		BeforeEach(func() {
+			task.Config.Caches = []atc.CacheConfig{{Path: "cache/deps"}}
+			task.CacheDir = cacheDir
+		})
+
+		It("mounts them from the cache directory", func() {
+			spec := fakeClient.CreateArgsForCall(0)
+			Expect(spec.BindMounts).To(ConsistOf(garden.BindMount{
+				SrcPath: filepath.Join(cacheDir, "cache", "deps"),
+				DstPath: "/tmp/build/e55deab7/cache/deps",
+				Mode:    garden.BindMountModeRW,
+				Origin:  garden.BindMountOriginHost,
+			}))
+
+			Expect(filepath.Join(cacheDir, "cache", "deps")).To(BeADirectory())
+		})
+	})
+
+	Context("when the task uses an image resource", func() {
+		var fakeResourceContainer *gardenfakes.FakeContainer
+
+		BeforeEach(func() {
+			task.Config.RootfsURI = ""
+			task.Config.ImageResource = &atc.ImageResource{
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "busybox"},
+			}
+
+			fakeResourceContainer = new(gardenfakes.FakeContainer)
+			fakeResourceContainer.HandleReturns("resource-handle")
+			fakeClient.CreateReturnsOnCall(0, fakeResourceContainer, nil)
+			fakeClient.CreateReturnsOnCall(1, fakeContainer, nil)
+
+			fakeResourceContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+				request, err := ioutil.ReadAll(io.Stdin)
+				Expect(err).NotTo(HaveOccurred())
+
+				process := new(gardenfakes.FakeProcess)
+
+				switch spec.Path {
+				case "/opt/resource/check":
+					Expect(string(request)).To(MatchJSON(`{"source":{"repository":"busybox"}}`))
+					json.NewEncoder(io.Stdout).Encode([]atc.Version{{"digest": "a"}, {"digest": "b"}})
+				case "/opt/resource/in":
+					Expect(string(request)).To(MatchJSON(`{"source":{"repository":"busybox"},"version":{"digest":"b"}}`))
+					Expect(spec.Args).To(Equal([]string{"/tmp/build/get"}))
+					json.NewEncoder(io.Stdout).Encode(map[string]interface{}{"version": atc.Version{"digest": "b"}})
+				}
+
+				return process, nil
+			}
+
+			fakeResourceContainer.StreamOutStub = func(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+				Expect(spec.Path).To(Equal("/tmp/build/get/"))
+
+				return ioutil.NopCloser(tarStream(map[string]string{
+					"metadata.json": `{"env":["PATH=/bin"],"user":"someone"}`,
+					"rootfs/bin/sh": "",
+				})), nil
+			}
+		})
+
+		It("fetches the latest version with the resource type's image", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			Expect(fakeClient.CreateArgsForCall(0).RootFSPath).To(Equal("docker:///concourse/registry-image-resource"))
+			Expect(fakeResourceContainer.RunCallCount()).To(Equal(2))
+		})
+
+		It("runs the task in the fetched rootfs with the image's env and user", func() {
+			spec := fakeClient.CreateArgsForCall(1)
+			Expect(spec.RootFSPath).To(HavePrefix("raw://"))
+			Expect(spec.RootFSPath).To(HaveSuffix("/rootfs"))
+			Expect(spec.Env).To(Equal([]string{"PATH=/bin", "SOME_PARAM=some-value"}))
+
+			processSpec, _ := fakeContainer.RunArgsForCall(0)
+			Expect(processSpec.User).To(Equal("someone"))
+		})
+
+		It("removes the fetched image afterwards", func() {
+			rootfs := strings.TrimPrefix(fakeClient.CreateArgsForCall(1).RootFSPath, "raw://")
+			Expect(filepath.Dir(rootfs)).NotTo(BeAnExistingFile())
+		})
+
+		Context("when the resource has no versions", func() {
+			BeforeEach(func() {
+				fakeResourceContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+					io.Stdout.Write([]byte("[]"))
+					return new(gardenfakes.FakeProcess), nil
+				}
+			})
+
+			It("errors", func() {
+				Expect(runErr).To(Equal(localexec.ErrNoImageVersions))
+			})
+		})
+
+		Context("when the script fails", func() {
+			BeforeEach(func() {
+				task.Config.ImageResource.Version = &atc.Version{"digest": "a"}
+
+				fakeResourceContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+					process := new(gardenfakes.FakeProcess)
+					process.WaitReturns(1, nil)
+					return process, nil
+				}
+			})
+
+			It("errors with the script's exit status", func() {
+				Expect(runErr).To(Equal(localexec.ErrResourceScriptFailed{
+					Path:       "/opt/resource/in",
+					Args:       []string{"/tmp/build/get"},
+					ExitStatus: 1,
+				}))
+			})
+		})
+	})
+
+	Context("when the context is cancelled", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+
+			stopped := make(chan struct{})
+			fakeContainer.StopStub = func(bool) error {
+				close(stopped)
+				return nil
+			}
+
+			fakeProcess.WaitStub = func() (int, error) {
+				cancel()
+				<-stopped
+				return 137, nil
+			}
+		})
+
+		It("stops the task", func() {
+			Expect(runErr).To(Equal(context.Canceled))
+			Expect(fakeContainer.StopCallCount()).To(Equal(1))
+		})
+	})
+})
+
+func tarEntries(stream io.Reader) []string {
+	entries := []string{}
+
+	reader := tar.NewReader(stream)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return entries
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+
+		entries = append(entries, header.Name)
+	}
+}
+
+func tarStream(files map[string]string) io.Reader {
+	buffer := new(bytes.Buffer)
+	writer := tar.NewWriter(buffer)
+
+	for name, content := range files {
+		err := writer.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = writer.Write([]byte(content))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(writer.Close()).To(Succeed())
+
+	return buffer
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/concourse/concourse/fly/rc"
 	"net/url"
 	"os"
+	"sort"
 
 	"gopkg.in/yaml.v2"
 
@@ -19,11 +20,17 @@ import (
 
 type ATCConfig struct {
 	PipelineName     string
+	InstanceVars     atc.InstanceVars
 	Team             concourse.Team
 	TargetName       rc.TargetName
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	DryRun           bool
+
+	// Labels are set on the pipeline in addition to those in its config,
+	// replacing any with the same key.
+	Labels map[string]string
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -41,23 +48,116 @@ func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
 }
 
 func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTemplateWithParams) error {
-	evaluatedTemplate, err := yamlTemplateWithParams.Evaluate(false, false)
+	evaluatedTemplate, sources, err := yamlTemplateWithParams.EvaluateWithSources(false, false)
 	if err != nil {
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	var newConfig atc.Config
+	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
 	if err != nil {
 		return err
 	}
 
-	var newConfig atc.Config
-	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
+	if len(atcConfig.Labels) > 0 {
+		evaluatedTemplate, err = atcConfig.addLabels(evaluatedTemplate, &newConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the server can't know which fragment each part of the config came from,
+	// so validate locally first to report errors with their locations
+	if sources != nil {
+		_, errorMessages := newConfig.ValidateWithSources(sources)
+
+		var raw interface{}
+		err = yaml.Unmarshal(evaluatedTemplate, &raw)
+		if err != nil {
+			return err
+		}
+
+		unknownKeys, err := sources.UnknownKeys(raw)
+		if err != nil {
+			return err
+		}
+
+		errorMessages = append(errorMessages, unknownKeys...)
+
+		if len(errorMessages) > 0 {
+			return concourse.InvalidConfigError{Errors: errorMessages}
+		}
+	}
+
+	return atcConfig.apply(evaluatedTemplate, newConfig)
+}
+
+// Rollback sets the pipeline's config back to the one saved at the given
+// version, showing the changes and asking for confirmation as Set does.
+func (atcConfig ATCConfig) Rollback(version int) error {
+	config, found, err := atcConfig.Team.PipelineConfigAtVersion(atcConfig.PipelineName, version)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("version %d of pipeline '%s' not found", version, atcConfig.PipelineName)
+	}
+
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return atcConfig.apply(payload, config)
+}
+
+// addLabels merges the labels into the config's, rewriting the top-level
+// 'labels' of the template so that the rest of it is sent as written.
+func (atcConfig ATCConfig) addLabels(template []byte, config *atc.Config) ([]byte, error) {
+	labels := map[string]string{}
+	for key, value := range config.Labels {
+		labels[key] = value
+	}
+
+	for key, value := range atcConfig.Labels {
+		labels[key] = value
+	}
+
+	config.Labels = labels
+
+	var document yaml.MapSlice
+	err := yaml.Unmarshal(template, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for i, item := range document {
+		if item.Key == "labels" {
+			document[i].Value = labels
+			found = true
+		}
+	}
+
+	if !found {
+		document = append(document, yaml.MapItem{Key: "labels", Value: labels})
+	}
+
+	return yaml.Marshal(document)
+}
+
+func (atcConfig ATCConfig) apply(payload []byte, newConfig atc.Config) error {
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
 	if err != nil {
 		return err
 	}
 
-	diffExists := diff(existingConfig, newConfig)
+	diffExists := DiffConfigs(existingConfig, newConfig)
+
+	if atcConfig.DryRun {
+		return atcConfig.validate(payload)
+	}
 
 	if !diffExists {
 		fmt.Println("no changes to apply")
@@ -72,10 +172,11 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
 		atcConfig.PipelineName,
 		existingConfigVersion,
-		evaluatedTemplate,
+		payload,
 		atcConfig.CheckCredentials,
 	)
 	if err != nil {
+		atcConfig.showCredentialChecks(err)
 		return err
 	}
 
@@ -87,8 +188,55 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	return nil
 }
 
+func (atcConfig ATCConfig) validate(evaluatedTemplate []byte) error {
+	warnings, checks, err := atcConfig.Team.ValidatePipelineConfig(
+		atcConfig.PipelineName,
+		evaluatedTemplate,
+		atcConfig.CheckCredentials,
+	)
+	if err != nil {
+		atcConfig.showCredentialChecks(err)
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if len(checks) > 0 {
+		err = displayhelpers.ShowCredentialChecks(checks, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("dry run: configuration is valid and was not saved")
+	return nil
+}
+
+func (atcConfig ATCConfig) showCredentialChecks(err error) {
+	invalidConfigErr, ok := err.(concourse.InvalidConfigError)
+	if !ok || len(invalidConfigErr.CredentialChecks) == 0 {
+		return
+	}
+
+	_ = displayhelpers.ShowCredentialChecks(invalidConfigErr.CredentialChecks, true)
+}
+
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
-	return fmt.Sprintf("fly -t %s unpause-pipeline -p %s", atcConfig.TargetName, atcConfig.PipelineName)
+	command := fmt.Sprintf("fly -t %s unpause-pipeline -p %s", atcConfig.TargetName, atcConfig.PipelineName)
+
+	names := make([]string, 0, len(atcConfig.InstanceVars))
+	for name := range atcConfig.InstanceVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		command += fmt.Sprintf(" --instance-var %s=%s", name, atcConfig.InstanceVars[name])
+	}
+
+	return command
 }
 
 func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool) {
@@ -106,6 +254,10 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 			fmt.Println("Could not parse pipelineURL")
 		}
 
+		if pipelineURL != nil && len(atcConfig.InstanceVars) > 0 {
+			pipelineURL.RawQuery = atcConfig.InstanceVars.QueryParams().Encode()
+		}
+
 		fmt.Println("pipeline created!")
 		fmt.Printf("you can view your pipeline here: %s\n", targetURL.ResolveReference(pipelineURL))
 		fmt.Println("")
@@ -118,7 +270,9 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 	}
 }
 
-func diff(existingConfig atc.Config, newConfig atc.Config) bool {
+// DiffConfigs prints the changes between the two configs, returning whether
+// there were any.
+func DiffConfigs(existingConfig atc.Config, newConfig atc.Config) bool {
 	var diffExists bool
 
 	stdout, _ := ui.ForTTY(os.Stdout)
@@ -155,6 +309,13 @@ func diff(existingConfig atc.Config, newConfig atc.Config) bool {
 		}
 	}
 
+	if labelsDiffer(existingConfig.Labels, newConfig.Labels) {
+		diffExists = true
+		fmt.Println("labels:")
+
+		renderLabelsDiff(indent, existingConfig.Labels, newConfig.Labels)
+	}
+
 	jobDiffs := diffIndices(JobIndex(existingConfig.Jobs), JobIndex(newConfig.Jobs))
 	if len(jobDiffs) > 0 {
 		diffExists = true
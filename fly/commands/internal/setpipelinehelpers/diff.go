@@ -191,6 +191,27 @@ func diffIndices(oldIndex Index, newIndex Index) Diffs {
 	return diffs
 }
 
+func labelsDiffer(before, after map[string]string) bool {
+	if len(before) == 0 && len(after) == 0 {
+		return false
+	}
+
+	return !reflect.DeepEqual(before, after)
+}
+
+func renderLabelsDiff(to io.Writer, before, after map[string]string) {
+	var payloadA, payloadB []byte
+	if len(before) > 0 {
+		payloadA, _ = yaml.Marshal(before)
+	}
+
+	if len(after) > 0 {
+		payloadB, _ = yaml.Marshal(after)
+	}
+
+	renderDiff(to, string(payloadA), string(payloadB))
+}
+
 func renderDiff(to io.Writer, a, b string) {
 	diffs := difflib.Diff(strings.Split(a, "\n"), strings.Split(b, "\n"))
 	indent := gexec.NewPrefixedWriter("\b\b", to)
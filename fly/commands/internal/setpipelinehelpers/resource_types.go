@@ -0,0 +1,31 @@
+package setpipelinehelpers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/onsi/gomega/gexec"
+)
+
+// DiffResourceTypes prints the changes between two sets of resource types,
+// returning whether there were any.
+func DiffResourceTypes(existingTypes atc.ResourceTypes, newTypes atc.ResourceTypes) bool {
+	stdout, _ := ui.ForTTY(os.Stdout)
+
+	indent := gexec.NewPrefixedWriter("  ", stdout)
+
+	resourceTypeDiffs := diffIndices(ResourceTypeIndex(existingTypes), ResourceTypeIndex(newTypes))
+	if len(resourceTypeDiffs) == 0 {
+		return false
+	}
+
+	fmt.Println("resource types:")
+
+	for _, diff := range resourceTypeDiffs {
+		diff.Render(indent, "resource type")
+	}
+
+	return true
+}
@@ -0,0 +1,322 @@
+package templatehelpers
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+)
+
+// fragmentSections are the sections of a pipeline config which may be split
+// across files, in the order they are assembled.
+var fragmentSections = []string{"groups", "resource_types", "resources", "jobs"}
+
+// fragmentLabelsKey is the key of the pipeline's labels, which are merged
+// from every file at the top of the directory which sets any.
+const fragmentLabelsKey = "labels"
+
+type fragmentItem struct {
+	value    interface{}
+	location atc.SourceLocation
+
+	// lines holds the line each part of the item is declared on, keyed by
+	// its path relative to the item, e.g. '.plan[0]'
+	lines map[string]int
+}
+
+type fragmentLabel struct {
+	key      string
+	value    interface{}
+	location atc.SourceLocation
+}
+
+// loadFragments assembles a pipeline config from a directory of YAML files.
+// Files at the top of the directory may set any of the config's sections and
+// some of its labels, and files under a directory named after a section, e.g.
+// jobs/, hold either one item of that section or a list of them. Files are read in lexical order, so
+// the assembled config is the same wherever it is set from.
+//
+// Each fragment must be valid YAML on its own, so fragments may only use
+// ((var)) style template variables.
+func loadFragments(dir string, strict bool) ([]byte, atc.ConfigSources, error) {
+	items := map[string][]fragmentItem{}
+	labels := []fragmentLabel{}
+	defined := map[string]atc.SourceLocation{}
+	errorMessages := []string{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if info.IsDir() || !isYAMLFile(path) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		fileItems, fileLabels, fileErrors := loadFragment(path, rel, strict)
+		errorMessages = append(errorMessages, fileErrors...)
+
+		for _, label := range fileLabels {
+			identifier := fragmentLabelsKey + "." + label.key
+
+			if existing, found := defined[identifier]; found {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s: %s is already defined at %s", label.location, identifier, existing))
+				continue
+			}
+
+			defined[identifier] = label.location
+			labels = append(labels, label)
+		}
+
+		for _, section := range fragmentSections {
+			for _, item := range fileItems[section] {
+				name, _ := itemName(item.value)
+				if name != "" {
+					identifier := section + "." + name
+
+					if existing, found := defined[identifier]; found {
+						errorMessages = append(errorMessages, fmt.Sprintf("%s: %s is already defined at %s", item.location, identifier, existing))
+						continue
+					}
+
+					defined[identifier] = item.location
+				}
+
+				items[section] = append(items[section], item)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read directory: %s", err.Error())
+	}
+
+	if len(errorMessages) != 0 {
+		return nil, nil, errors.New(strings.Join(errorMessages, "\n"))
+	}
+
+	config := yaml.MapSlice{}
+	sources := atc.ConfigSources{}
+	for _, section := range fragmentSections {
+		if len(items[section]) == 0 {
+			continue
+		}
+
+		values := make([]interface{}, len(items[section]))
+		for i, item := range items[section] {
+			values[i] = item.value
+
+			path := fmt.Sprintf("%s[%d]", section, i)
+			sources[path] = item.location
+
+			for subpath, line := range item.lines {
+				sources[path+subpath] = atc.SourceLocation{File: item.location.File, Line: line}
+			}
+		}
+
+		config = append(config, yaml.MapItem{Key: section, Value: values})
+	}
+
+	if len(labels) > 0 {
+		values := yaml.MapSlice{}
+		for _, label := range labels {
+			values = append(values, yaml.MapItem{Key: label.key, Value: label.value})
+			sources[fragmentLabelsKey+"."+label.key] = label.location
+		}
+
+		config = append(config, yaml.MapItem{Key: fragmentLabelsKey, Value: values})
+	}
+
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payload, sources, nil
+}
+
+// loadFragment reads the items of each section and the labels set by a
+// single file.
+func loadFragment(path string, rel string, strict bool) (map[string][]fragmentItem, []fragmentLabel, []string) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, []string{fmt.Sprintf("%s: could not read file: %s", path, err.Error())}
+	}
+
+	unmarshal := yaml.Unmarshal
+	if strict {
+		unmarshal = yaml.UnmarshalStrict
+	}
+
+	values := map[string]interface{}{}
+
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(rel)), "/")
+	switch {
+	case dirs[0] == ".":
+		err = unmarshal(content, &values)
+
+	case isFragmentSection(dirs[0]):
+		var value interface{}
+		err = unmarshal(content, &value)
+		values[dirs[0]] = value
+
+	default:
+		return nil, nil, []string{fmt.Sprintf("%s: fragments must be at the top of the directory or under one of %s", path, strings.Join(fragmentSections, "/, ")+"/")}
+	}
+
+	if err != nil {
+		return nil, nil, []string{fmt.Sprintf("%s: %s", path, err.Error())}
+	}
+
+	positions := yamlPositions(content)
+
+	items := map[string][]fragmentItem{}
+	errorMessages := []string{}
+
+	for _, section := range fragmentSections {
+		value, found := values[section]
+		if !found {
+			continue
+		}
+
+		delete(values, section)
+
+		// the path of the section's value within the file
+		sectionPath := section
+		if dirs[0] != "." {
+			sectionPath = ""
+		}
+
+		var sectionItems []interface{}
+		var itemPaths []string
+		switch v := value.(type) {
+		case nil:
+		case []interface{}:
+			sectionItems = v
+			for i := range v {
+				itemPaths = append(itemPaths, fmt.Sprintf("%s[%d]", sectionPath, i))
+			}
+		case map[interface{}]interface{}:
+			sectionItems = []interface{}{v}
+			itemPaths = []string{sectionPath}
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s: %s must be a list or a single item", path, section))
+			continue
+		}
+
+		for i, item := range sectionItems {
+			items[section] = append(items[section], fragmentItem{
+				value:    item,
+				location: atc.SourceLocation{File: path, Line: positions[itemPaths[i]]},
+				lines:    itemLines(positions, itemPaths[i]),
+			})
+		}
+	}
+
+	labels := []fragmentLabel{}
+	if value, found := values[fragmentLabelsKey]; found {
+		delete(values, fragmentLabelsKey)
+
+		switch v := value.(type) {
+		case nil:
+		case map[interface{}]interface{}:
+			for key, value := range v {
+				// keys such as 'true' or '1' are not unmarshalled as strings
+				name := fmt.Sprintf("%v", key)
+
+				labels = append(labels, fragmentLabel{
+					key:      name,
+					value:    value,
+					location: atc.SourceLocation{File: path, Line: positions[fragmentLabelsKey+"."+name]},
+				})
+			}
+
+			sort.Slice(labels, func(i, j int) bool {
+				return labels[i].key < labels[j].key
+			})
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s: %s must be a map", path, fragmentLabelsKey))
+		}
+	}
+
+	unknown := []string{}
+	for key := range values {
+		unknown = append(unknown, key)
+	}
+
+	sort.Strings(unknown)
+
+	for _, key := range unknown {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s: unknown section '%s'", path, key))
+	}
+
+	return items, labels, errorMessages
+}
+
+func itemName(item interface{}) (string, bool) {
+	fields, ok := item.(map[interface{}]interface{})
+	if !ok {
+		return "", false
+	}
+
+	name, ok := fields["name"].(string)
+	return name, ok
+}
+
+func isFragmentSection(name string) bool {
+	for _, section := range fragmentSections {
+		if name == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isYAMLFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+// itemLines picks the positions of the parts of the item at the path out of
+// the positions of a whole file, keyed by their path relative to the item.
+func itemLines(positions map[string]int, itemPath string) map[string]int {
+	lines := map[string]int{}
+
+	for path, line := range positions {
+		switch {
+		case path == itemPath:
+			continue
+
+		case itemPath == "":
+			if !strings.HasPrefix(path, "[") {
+				lines["."+path] = line
+			}
+
+		case strings.HasPrefix(path, itemPath+".") || strings.HasPrefix(path, itemPath+"["):
+			lines[path[len(itemPath):]] = line
+		}
+	}
+
+	return lines
+}
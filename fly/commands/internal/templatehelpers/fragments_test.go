@@ -0,0 +1,129 @@
+package templatehelpers_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fragments", func() {
+	var dir string
+
+	write := func(path string, content string) {
+		path = filepath.Join(dir, path)
+
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = ioutil.WriteFile(path, []byte(content), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	evaluate := func() (atc.Config, atc.ConfigSources, error) {
+		template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, nil, nil)
+
+		payload, sources, err := template.EvaluateWithSources(false, false)
+		if err != nil {
+			return atc.Config{}, nil, err
+		}
+
+		var config atc.Config
+		err = yaml.Unmarshal(payload, &config)
+		Expect(err).NotTo(HaveOccurred())
+
+		return config, sources, nil
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = ioutil.TempDir("", "fragments-test")
+		Expect(err).NotTo(HaveOccurred())
+
+		write("jobs/build.yml", `name: build
+plan: []
+`)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("labels", func() {
+		BeforeEach(func() {
+			write("pipeline.yml", `labels:
+  team: core
+  env: prod
+`)
+
+			write("ownership.yml", `# who to ask
+labels:
+  owner: some-owner
+`)
+		})
+
+		It("merges the labels of every file at the top of the directory", func() {
+			config, sources, err := evaluate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.Labels).To(Equal(map[string]string{
+				"team":  "core",
+				"env":   "prod",
+				"owner": "some-owner",
+			}))
+
+			Expect(sources).To(HaveKeyWithValue("labels.team", atc.SourceLocation{File: filepath.Join(dir, "pipeline.yml"), Line: 2}))
+			Expect(sources).To(HaveKeyWithValue("labels.env", atc.SourceLocation{File: filepath.Join(dir, "pipeline.yml"), Line: 3}))
+			Expect(sources).To(HaveKeyWithValue("labels.owner", atc.SourceLocation{File: filepath.Join(dir, "ownership.yml"), Line: 3}))
+		})
+
+		Context("when a label is set in more than one file", func() {
+			BeforeEach(func() {
+				write("release.yml", `labels:
+  env: staging
+`)
+			})
+
+			It("returns an error with both locations", func() {
+				_, _, err := evaluate()
+				Expect(err).To(MatchError(fmt.Sprintf(
+					"%s:2: labels.env is already defined at %s:3",
+					filepath.Join(dir, "release.yml"),
+					filepath.Join(dir, "pipeline.yml"),
+				)))
+			})
+		})
+
+		Context("when the labels are not a map", func() {
+			BeforeEach(func() {
+				write("release.yml", `labels: [env]
+`)
+			})
+
+			It("returns an error naming the file", func() {
+				_, _, err := evaluate()
+				Expect(err).To(MatchError(filepath.Join(dir, "release.yml") + ": labels must be a map"))
+			})
+		})
+	})
+
+	Context("when a fragment sets an unknown section", func() {
+		BeforeEach(func() {
+			write("pipeline.yml", `display: {}
+`)
+		})
+
+		It("returns an error naming the section", func() {
+			_, _, err := evaluate()
+			Expect(err).To(MatchError(filepath.Join(dir, "pipeline.yml") + ": unknown section 'display'"))
+		})
+	})
+})
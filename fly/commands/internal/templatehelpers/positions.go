@@ -0,0 +1,141 @@
+package templatehelpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// yamlKeyPattern matches the key of a block mapping entry, which may be
+// quoted, along with whatever follows it on the line.
+var yamlKeyPattern = regexp.MustCompile(`^(?:"([^"]*)"|'([^']*)'|([^\s"'#{\[][^:#]*?))\s*:(?:\s+(.*))?$`)
+
+// yamlFrame is a block mapping or sequence which positions are being tracked
+// in, along with the column its entries start at.
+type yamlFrame struct {
+	column   int
+	path     string
+	sequence bool
+	index    int
+}
+
+// yamlPositions finds the line each key and sequence item of a YAML document
+// is declared on, keyed by its path in the form the config's keys are
+// reported in when it is decoded, e.g. 'jobs[0].plan[1].get'. The document
+// itself is keyed by the empty path.
+//
+// Only block style is followed; the contents of flow style collections and of
+// multi-line scalars are located at the key they are the value of.
+func yamlPositions(content []byte) map[string]int {
+	positions := map[string]int{}
+
+	var (
+		stack         []*yamlFrame
+		pending       string
+		scalarColumn  = -1
+		documentFound bool
+	)
+
+	for i, line := range strings.Split(string(content), "\n") {
+		lineNumber := i + 1
+
+		trimmed := strings.TrimSpace(line)
+		column := len(line) - len(strings.TrimLeft(line, " "))
+
+		if scalarColumn != -1 {
+			if trimmed == "" || column > scalarColumn {
+				continue
+			}
+
+			scalarColumn = -1
+		}
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		if !documentFound {
+			positions[""] = lineNumber
+			documentFound = true
+		}
+
+		text := trimmed
+		for text != "" {
+			isItem := text == "-" || strings.HasPrefix(text, "- ")
+
+			for len(stack) > 0 && stack[len(stack)-1].column > column {
+				stack = stack[:len(stack)-1]
+			}
+
+			if len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if top.column == column && top.sequence && !isItem {
+					// a sequence which is the value of a key at the same
+					// column has ended
+					stack = stack[:len(stack)-1]
+				} else if top.column == column && !top.sequence && isItem {
+					stack = append(stack, &yamlFrame{column: column, path: pending, sequence: true, index: -1})
+				}
+			}
+
+			if len(stack) == 0 || stack[len(stack)-1].column < column {
+				stack = append(stack, &yamlFrame{column: column, path: pending, sequence: isItem, index: -1})
+			}
+
+			top := stack[len(stack)-1]
+
+			if isItem {
+				top.index++
+
+				path := fmt.Sprintf("%s[%d]", top.path, top.index)
+				positions[path] = lineNumber
+				pending = path
+
+				rest := strings.TrimLeft(strings.TrimPrefix(text, "-"), " ")
+
+				if isBlockScalar(rest) {
+					scalarColumn = column
+					break
+				}
+
+				column += len(text) - len(rest)
+				text = rest
+
+				continue
+			}
+
+			match := yamlKeyPattern.FindStringSubmatch(text)
+			if match == nil {
+				// a scalar, or a continuation of one
+				break
+			}
+
+			key := match[1] + match[2] + match[3]
+
+			path := key
+			if top.path != "" {
+				path = top.path + "." + key
+			}
+
+			if _, found := positions[path]; !found {
+				positions[path] = lineNumber
+			}
+
+			pending = path
+
+			if isBlockScalar(match[4]) {
+				scalarColumn = column
+			}
+
+			break
+		}
+	}
+
+	return positions
+}
+
+// isBlockScalar reports whether a value starts a literal or folded scalar,
+// whose lines are more indented than the key.
+func isBlockScalar(value string) bool {
+	return strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">")
+}
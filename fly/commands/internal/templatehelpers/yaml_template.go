@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
+	"os"
 )
 
 type YamlTemplateWithParams struct {
@@ -30,21 +31,20 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 	allowEmpty bool,
 	strict bool,
 ) ([]byte, error) {
-	config, err := ioutil.ReadFile(string(yamlTemplate.filePath))
-	if err != nil {
-		return nil, fmt.Errorf("could not read file: %s", err.Error())
-	}
+	config, _, err := yamlTemplate.EvaluateWithSources(allowEmpty, strict)
+	return config, err
+}
 
-	if strict {
-		// We use a generic map here, since templates are not evaluated yet.
-		// (else a template string may cause an error when a struct is expected)
-		// If we don't check Strict now, then the subsequent steps will mask any
-		// duplicate key errors.
-		// We should consider being strict throughout the entire stack by default.
-		err = yaml.UnmarshalStrict(config, make(map[string]interface{}))
-		if err != nil {
-			return nil, fmt.Errorf("error parsing yaml before applying templates: %s", err.Error())
-		}
+// EvaluateWithSources evaluates the template like Evaluate. If the template is
+// a directory of fragments, it also returns where each job, resource,
+// resource type and group was loaded from.
+func (yamlTemplate YamlTemplateWithParams) EvaluateWithSources(
+	allowEmpty bool,
+	strict bool,
+) ([]byte, atc.ConfigSources, error) {
+	config, sources, err := yamlTemplate.read(strict)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var params []boshtemplate.Variables
@@ -65,13 +65,13 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		path := yamlTemplate.templateVariablesFiles[i]
 		templateVars, err := ioutil.ReadFile(string(path))
 		if err != nil {
-			return nil, fmt.Errorf("could not read template variables file (%s): %s", string(path), err.Error())
+			return nil, nil, fmt.Errorf("could not read template variables file (%s): %s", string(path), err.Error())
 		}
 
 		var staticVars boshtemplate.StaticVariables
 		err = yaml.Unmarshal(templateVars, &staticVars)
 		if err != nil {
-			return nil, fmt.Errorf("could not unmarshal template variables (%s): %s", string(path), err.Error())
+			return nil, nil, fmt.Errorf("could not unmarshal template variables (%s): %s", string(path), err.Error())
 		}
 
 		params = append(params, staticVars)
@@ -79,8 +79,34 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 
 	evaluatedConfig, err := template.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	return evaluatedConfig, sources, nil
+}
+
+func (yamlTemplate YamlTemplateWithParams) read(strict bool) ([]byte, atc.ConfigSources, error) {
+	info, err := os.Stat(string(yamlTemplate.filePath))
+	if err == nil && info.IsDir() {
+		return loadFragments(string(yamlTemplate.filePath), strict)
+	}
+
+	config, err := ioutil.ReadFile(string(yamlTemplate.filePath))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read file: %s", err.Error())
+	}
+
+	if strict {
+		// We use a generic map here, since templates are not evaluated yet.
+		// (else a template string may cause an error when a struct is expected)
+		// If we don't check Strict now, then the subsequent steps will mask any
+		// duplicate key errors.
+		// We should consider being strict throughout the entire stack by default.
+		err = yaml.UnmarshalStrict(config, make(map[string]interface{}))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing yaml before applying templates: %s", err.Error())
+		}
 	}
 
-	return evaluatedConfig, nil
+	return config, nil, nil
 }
@@ -1,6 +1,7 @@
 package templatehelpers_test
 
 import (
+	"fmt"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"io/ioutil"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 
 	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -74,4 +76,193 @@ var _ = Describe("YAML Template With Params", func() {
 `))
 		})
 	})
+
+	Describe("resolving a directory of fragments", func() {
+		var dir string
+
+		write := func(path string, content string) {
+			path = filepath.Join(dir, path)
+
+			err := os.MkdirAll(filepath.Dir(path), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(path, []byte(content), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			var err error
+
+			dir, err = ioutil.TempDir("", "yaml-template-fragments-test")
+			Expect(err).NotTo(HaveOccurred())
+
+			write("pipeline.yml", `groups:
+- name: all
+  jobs: [build, test]
+`)
+
+			write("resources/repo.yml", `name: repo
+type: git
+source: {uri: ((uri))}
+`)
+
+			write("jobs/b.yml", `- name: test
+  plan:
+  - get: repo
+- name: build
+  plan:
+  - get: repo
+`)
+
+			write("jobs/a.yml", `name: unit
+plan:
+- get: repo
+`)
+
+			write("jobs/README.md", `not yaml`)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("merges the fragments in lexical order and resolves variables", func() {
+			vars := []flaghelpers.VariablePairFlag{{Name: "uri", Value: "https://example.com"}}
+			template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, vars, nil)
+
+			result, sources, err := template.EvaluateWithSources(false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			var config atc.Config
+			err = yaml.Unmarshal(result, &config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.Groups).To(HaveLen(1))
+			Expect(config.Resources).To(HaveLen(1))
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "https://example.com"}))
+
+			Expect(config.Jobs).To(HaveLen(3))
+			Expect(config.Jobs[0].Name).To(Equal("unit"))
+			Expect(config.Jobs[1].Name).To(Equal("test"))
+			Expect(config.Jobs[2].Name).To(Equal("build"))
+
+			pipeline := filepath.Join(dir, "pipeline.yml")
+			repo := filepath.Join(dir, "resources", "repo.yml")
+			a := filepath.Join(dir, "jobs", "a.yml")
+			b := filepath.Join(dir, "jobs", "b.yml")
+
+			Expect(sources).To(Equal(atc.ConfigSources{
+				"groups[0]":      {File: pipeline, Line: 2},
+				"groups[0].name": {File: pipeline, Line: 2},
+				"groups[0].jobs": {File: pipeline, Line: 3},
+
+				"resources[0]":        {File: repo, Line: 1},
+				"resources[0].name":   {File: repo, Line: 1},
+				"resources[0].type":   {File: repo, Line: 2},
+				"resources[0].source": {File: repo, Line: 3},
+
+				"jobs[0]":             {File: a, Line: 1},
+				"jobs[0].name":        {File: a, Line: 1},
+				"jobs[0].plan":        {File: a, Line: 2},
+				"jobs[0].plan[0]":     {File: a, Line: 3},
+				"jobs[0].plan[0].get": {File: a, Line: 3},
+
+				"jobs[1]":             {File: b, Line: 1},
+				"jobs[1].name":        {File: b, Line: 1},
+				"jobs[1].plan":        {File: b, Line: 2},
+				"jobs[1].plan[0]":     {File: b, Line: 3},
+				"jobs[1].plan[0].get": {File: b, Line: 3},
+
+				"jobs[2]":             {File: b, Line: 4},
+				"jobs[2].name":        {File: b, Line: 4},
+				"jobs[2].plan":        {File: b, Line: 5},
+				"jobs[2].plan[0]":     {File: b, Line: 6},
+				"jobs[2].plan[0].get": {File: b, Line: 6},
+			}))
+		})
+
+		It("locates the parts of nested steps, skipping over multi-line strings", func() {
+			write("jobs/c.yml", `# the deploy job
+name: deploy
+plan:
+- task: deploy
+  config:
+    run:
+      path: sh
+      args:
+      - -c
+      - |
+        echo deploying
+        name: not-a-key
+  on_failure:
+    do:
+    - put: repo
+      params: {force: true}
+`)
+
+			template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, nil, nil)
+
+			_, sources, err := template.EvaluateWithSources(true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			c := filepath.Join(dir, "jobs", "c.yml")
+
+			Expect(sources["jobs[3]"]).To(Equal(atc.SourceLocation{File: c, Line: 2}))
+			Expect(sources["jobs[3].plan[0].config.run.args[1]"]).To(Equal(atc.SourceLocation{File: c, Line: 10}))
+			Expect(sources["jobs[3].plan[0].on_failure"]).To(Equal(atc.SourceLocation{File: c, Line: 13}))
+			Expect(sources["jobs[3].plan[0].on_failure.do[0].put"]).To(Equal(atc.SourceLocation{File: c, Line: 15}))
+			Expect(sources["jobs[3].plan[0].on_failure.do[0].params"]).To(Equal(atc.SourceLocation{File: c, Line: 16}))
+			Expect(sources).ToNot(HaveKey("jobs[3].plan[0].config.run.args[1].name"))
+			Expect(sources).ToNot(HaveKey("jobs[3].plan[0].config.run.name"))
+		})
+
+		Context("when a name is defined in more than one file", func() {
+			BeforeEach(func() {
+				write("jobs/c.yml", `
+- name: build
+  plan: []
+`)
+			})
+
+			It("returns an error with both locations", func() {
+				template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, nil, nil)
+
+				_, err := template.Evaluate(false, false)
+				Expect(err).To(MatchError(fmt.Sprintf(
+					"%s:2: jobs.build is already defined at %s:4",
+					filepath.Join(dir, "jobs", "c.yml"),
+					filepath.Join(dir, "jobs", "b.yml"),
+				)))
+			})
+		})
+
+		Context("when a fragment is invalid", func() {
+			BeforeEach(func() {
+				write("resources/bad.yml", `name: bad
+type: [
+`)
+			})
+
+			It("returns an error naming the file", func() {
+				template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, nil, nil)
+
+				_, err := template.Evaluate(false, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(HavePrefix(filepath.Join(dir, "resources", "bad.yml") + ": yaml: line"))
+			})
+		})
+
+		Context("when a fragment is in an unknown directory", func() {
+			BeforeEach(func() {
+				write("tasks/build.yml", `platform: linux`)
+			})
+
+			It("returns an error", func() {
+				template := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(dir), nil, nil, nil)
+
+				_, err := template.Evaluate(false, false)
+				Expect(err).To(MatchError(filepath.Join(dir, "tasks", "build.yml") + ": fragments must be at the top of the directory or under one of groups/, resource_types/, resources/, jobs/"))
+			})
+		})
+	})
 })
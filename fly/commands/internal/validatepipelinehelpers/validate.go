@@ -10,7 +10,7 @@ import (
 )
 
 func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool) error {
-	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
+	evaluatedTemplate, sources, err := yamlTemplate.EvaluateWithSources(true, strict)
 	if err != nil {
 		return err
 	}
@@ -28,7 +28,16 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 		}
 	}
 
-	warnings, errorMessages := unmarshalledTemplate.Validate()
+	warnings, errorMessages := unmarshalledTemplate.ValidateWithSources(sources)
+
+	if sources != nil {
+		unknownKeys, err := unknownKeys(evaluatedTemplate, sources)
+		if err != nil {
+			return err
+		}
+
+		errorMessages = append(errorMessages, unknownKeys...)
+	}
 
 	if len(warnings) > 0 {
 		configWarnings := make([]concourse.ConfigWarning, len(warnings))
@@ -54,3 +63,14 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 
 	return nil
 }
+
+// unknownKeys reports the keys the ATC would refuse the config for, along
+// with the fragment they were declared in.
+func unknownKeys(evaluatedTemplate []byte, sources atc.ConfigSources) ([]string, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(evaluatedTemplate, &raw); err != nil {
+		return nil, err
+	}
+
+	return sources.UnknownKeys(raw)
+}
@@ -4,7 +4,7 @@ import (
 	"os"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -12,7 +12,8 @@ import (
 
 type JobsCommand struct {
 	Pipeline string `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool   `long:"json" description:"Print command result as JSON"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -36,12 +37,8 @@ func (command *JobsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(jobs)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, jobs)
 	}
 
 	headers = []string{"name", "paused", "status", "next"}
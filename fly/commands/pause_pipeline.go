@@ -9,11 +9,18 @@ import (
 )
 
 type PausePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to pause"`
+	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" description:"Pipeline to pause"`
+	Selector flaghelpers.PipelineSelectorFlags
+	Instance flaghelpers.InstanceVarFlags
 }
 
 func (command *PausePipelineCommand) Validate() error {
-	return command.Pipeline.Validate()
+	err := command.Selector.Validate(command.Pipeline)
+	if err != nil {
+		return err
+	}
+
+	return command.Instance.Validate(command.Selector)
 }
 
 func (command *PausePipelineCommand) Execute(args []string) error {
@@ -22,7 +29,7 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Instance.Ref(command.Pipeline)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -34,15 +41,30 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().PausePipeline(pipelineName)
+	if command.Selector.Selected() {
+		pipelines, err := selectPipelines(target.Team(), command.Selector)
+		if err != nil {
+			return err
+		}
+
+		results, err := target.Team().PausePipelines(pipelineIDs(pipelines))
+		if err != nil {
+			return err
+		}
+
+		showBatchResults(pipelines, results, "paused")
+		return nil
+	}
+
+	found, err := target.Team().ForInstance(pipelineRef.InstanceVars).PausePipeline(pipelineRef.Name)
 	if err != nil {
 		return err
 	}
 
 	if found {
-		fmt.Printf("paused '%s'\n", pipelineName)
+		fmt.Printf("paused '%s'\n", pipelineRef)
 	} else {
-		displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 	}
 
 	return nil
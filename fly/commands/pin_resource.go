@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type PinResourceCommand struct {
+	Resource  flaghelpers.ResourceFlag `short:"r" long:"resource"   required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource to pin"`
+	Version   atc.Version              `short:"v" long:"version"    required:"true" value-name:"KEY:VALUE"         description:"Version fields to pin to, e.g. ref:abcd. The most recent version containing all of them is pinned. Can be specified multiple times."`
+	Comment   string                   `short:"c" long:"comment"                                                   description:"Comment explaining why the resource is pinned"`
+	ExpiresIn time.Duration            `long:"expires-in"                                                          description:"Automatically unpin the resource after this long, e.g. 24h"`
+}
+
+func (command *PinResourceCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.ExpiresIn < 0 {
+		return fmt.Errorf("invalid --expires-in: %s", command.ExpiresIn)
+	}
+
+	pin := atc.PinResourceRequestBody{
+		Version:    command.Version,
+		PinComment: command.Comment,
+	}
+
+	if command.ExpiresIn > 0 {
+		pin.ExpiresIn = command.ExpiresIn.String()
+	}
+
+	pinned, err := target.Team().PinResource(command.Resource.PipelineName, command.Resource.ResourceName, pin)
+	if err != nil {
+		return err
+	}
+
+	if !pinned {
+		return fmt.Errorf("pipeline '%s', resource '%s' or a version matching '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName, ui.PresentVersion(command.Version))
+	}
+
+	if command.ExpiresIn > 0 {
+		fmt.Printf("pinned '%s' with version %s for %s\n", command.Resource.ResourceName, ui.PresentVersion(command.Version), command.ExpiresIn)
+	} else {
+		fmt.Printf("pinned '%s' with version %s\n", command.Resource.ResourceName, ui.PresentVersion(command.Version))
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline whose saved configurations to list"`
+
+	Output flaghelpers.OutputFlags
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	versions, found, err := target.Team().PipelineConfigVersions(string(command.Pipeline))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, versions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "author", Color: color.New(color.Bold)},
+			{Contents: "saved at", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		authorCell := ui.TableCell{Contents: version.Author}
+		if version.Author == "" {
+			authorCell.Contents = "n/a"
+			authorCell.Color = ui.OffColor
+		}
+
+		savedAtCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if version.CreatedAt != 0 {
+			savedAtCell.Contents = time.Unix(version.CreatedAt, 0).Local().Format(timeDateLayout)
+			savedAtCell.Color = nil
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			authorCell,
+			savedAtCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"errors"
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PipelineSecretsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline whose secret lookups to list"`
+
+	Output flaghelpers.OutputFlags
+}
+
+func (command *PipelineSecretsCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *PipelineSecretsCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	lookups, found, err := target.Team().SecretLookups(string(command.Pipeline))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, lookups)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "used by", Color: color.New(color.Bold)},
+			{Contents: "type", Color: color.New(color.Bold)},
+			{Contents: "var", Color: color.New(color.Bold)},
+			{Contents: "backend", Color: color.New(color.Bold)},
+			{Contents: "path", Color: color.New(color.Bold)},
+			{Contents: "found", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, lookup := range lookups {
+		var foundCell ui.TableCell
+		if lookup.Found {
+			foundCell.Contents = "yes"
+		} else {
+			foundCell.Contents = "no"
+			foundCell.Color = ui.FailedColor
+		}
+
+		backendCell := ui.TableCell{Contents: lookup.Backend}
+		if lookup.Backend == "" {
+			backendCell.Contents = "n/a"
+			backendCell.Color = ui.OffColor
+		}
+
+		pathCell := ui.TableCell{Contents: lookup.Path}
+		if lookup.Path == "" {
+			pathCell.Contents = "n/a"
+			pathCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: lookup.UsedByName},
+			{Contents: lookup.UsedByType},
+			{Contents: lookup.Name},
+			backendCell,
+			pathCell,
+			foundCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
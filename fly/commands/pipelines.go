@@ -1,18 +1,21 @@
 package commands
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type PipelinesCommand struct {
-	All  bool `short:"a"  long:"all" description:"Show all pipelines"`
-	Json bool `long:"json" description:"Print command result as JSON"`
+	All    bool     `short:"a"  long:"all"                 description:"Show all pipelines"`
+	Labels []string `long:"label" value-name:"KEY[=VALUE]" description:"Only show pipelines with the label, optionally set to the value (can be specified multiple times)"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -26,26 +29,27 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return err
 	}
 
+	_, err = atc.ParseLabelSelectors(command.Labels)
+	if err != nil {
+		return fmt.Errorf("invalid --label: %s", err)
+	}
+
 	var headers []string
 	var pipelines []atc.Pipeline
 
 	if command.All {
-		pipelines, err = target.Client().ListPipelines()
+		pipelines, err = target.Client().ListPipelines(command.Labels...)
 		headers = []string{"name", "team", "paused", "public"}
 	} else {
-		pipelines, err = target.Team().ListPipelines()
+		pipelines, err = target.Team().ListPipelines(command.Labels...)
 		headers = []string{"name", "paused", "public"}
 	}
 	if err != nil {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(pipelines)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, pipelines)
 	}
 
 	table := ui.Table{Headers: ui.TableRow{}}
@@ -71,7 +75,7 @@ func (command *PipelinesCommand) Execute([]string) error {
 		}
 
 		row := ui.TableRow{}
-		row = append(row, ui.TableCell{Contents: p.Name})
+		row = append(row, ui.TableCell{Contents: p.Ref().String()})
 		if command.All {
 			row = append(row, ui.TableCell{Contents: p.TeamName})
 		}
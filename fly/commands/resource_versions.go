@@ -1,11 +1,13 @@
 package commands
 
 import (
+	"errors"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -15,12 +17,21 @@ import (
 )
 
 type ResourceVersionsCommand struct {
-	Count    int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
-	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Count      int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	Resource   flaghelpers.ResourceFlag `short:"r" long:"resource" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
+	Job        flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job whose builds to compare (with --diff) or trace (with --provenance)"`
+	Diff       string                   `long:"diff" value-name:"FROM..TO" description:"Show the inputs whose versions changed between two builds of the job"`
+	Provenance string                   `long:"provenance" value-name:"BUILD" description:"Show every version which flowed into a build of the job, including through passed constraints"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
+	err := command.validate()
+	if err != nil {
+		return err
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -31,21 +42,25 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
-	page := concourse.Page{Limit: command.Count}
-
 	team := target.Team()
 
+	if command.Diff != "" {
+		return command.diff(team)
+	}
+
+	if command.Provenance != "" {
+		return command.provenance(team)
+	}
+
+	page := concourse.Page{Limit: command.Count}
+
 	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page)
 	if err != nil {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(versions)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, versions)
 	}
 
 	table := ui.Table{
@@ -72,19 +87,158 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 			enabledCell.Contents = "no"
 		}
 
-		fields := []string{}
-		for k, v := range version.Version {
-			fields = append(fields, k+":"+v)
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(version.ID)},
+			{Contents: formatVersion(version.Version)},
+			enabledCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *ResourceVersionsCommand) validate() error {
+	if command.Diff != "" && command.Provenance != "" {
+		return errors.New("Cannot specify both --diff and --provenance")
+	}
+
+	if command.Resource.ResourceName != "" && command.Job.JobName != "" {
+		return errors.New("Cannot specify both --resource and --job")
+	}
+
+	if command.Diff != "" || command.Provenance != "" {
+		if command.Job.JobName == "" {
+			return errors.New("--diff and --provenance require --job")
 		}
 
-		sort.Strings(fields)
+		return nil
+	}
+
+	if command.Job.JobName != "" {
+		return errors.New("--job requires --diff or --provenance")
+	}
+
+	if command.Resource.ResourceName == "" {
+		return errors.New("Either --resource or --job must be specified")
+	}
+
+	return nil
+}
+
+func (command *ResourceVersionsCommand) diff(team concourse.Team) error {
+	builds := strings.SplitN(command.Diff, "..", 2)
+	if len(builds) != 2 || builds[0] == "" || builds[1] == "" {
+		return errors.New("--diff must be of the form FROM..TO, e.g. 41..42")
+	}
+
+	diff, found, err := team.BuildInputDiff(command.Job.PipelineName, command.Job.JobName, builds[0], builds[1])
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("job or build not found")
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, diff)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "resource", Color: color.New(color.Bold)},
+			{Contents: "from", Color: color.New(color.Bold)},
+			{Contents: "to", Color: color.New(color.Bold)},
+			{Contents: "metadata", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, change := range diff.Changes {
+		metadataCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if change.To != nil {
+			metadataCell = formatMetadataCell(change.To.Metadata)
+		}
 
 		table.Data = append(table.Data, []ui.TableCell{
-			{Contents: strconv.Itoa(version.ID)},
-			{Contents: strings.Join(fields, ",")},
-			enabledCell,
+			{Contents: change.Name},
+			{Contents: change.Resource},
+			resourceVersionCell(change.From),
+			resourceVersionCell(change.To),
+			metadataCell,
 		})
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func (command *ResourceVersionsCommand) provenance(team concourse.Team) error {
+	provenance, found, err := team.BuildProvenance(command.Job.PipelineName, command.Job.JobName, command.Provenance)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("job or build not found")
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, provenance)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "depth", Color: color.New(color.Bold)},
+			{Contents: "job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "resource", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "metadata", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, p := range provenance {
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(p.Depth)},
+			{Contents: p.JobName},
+			{Contents: p.Build},
+			{Contents: p.Resource},
+			{Contents: formatVersion(p.Version.Version)},
+			formatMetadataCell(p.Version.Metadata),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func resourceVersionCell(version *atc.ResourceVersion) ui.TableCell {
+	if version == nil {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: formatVersion(version.Version)}
+}
+
+func formatMetadataCell(metadata []atc.MetadataField) ui.TableCell {
+	if len(metadata) == 0 {
+		return ui.TableCell{Contents: "none", Color: ui.OffColor}
+	}
+
+	fields := []string{}
+	for _, field := range metadata {
+		fields = append(fields, field.Name+":"+field.Value)
+	}
+
+	return ui.TableCell{Contents: strings.Join(fields, ",")}
+}
+
+func formatVersion(version atc.Version) string {
+	fields := []string{}
+	for k, v := range version {
+		fields = append(fields, k+":"+v)
+	}
+
+	sort.Strings(fields)
+
+	return strings.Join(fields, ",")
+}
@@ -2,9 +2,10 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -12,7 +13,8 @@ import (
 
 type ResourcesCommand struct {
 	Pipeline string `short:"p" long:"pipeline" required:"true" description:"Get resources in this pipeline"`
-	Json     bool   `long:"json" description:"Print command result as JSON"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *ResourcesCommand) Execute([]string) error {
@@ -36,15 +38,11 @@ func (command *ResourcesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(resources)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, resources)
 	}
 
-	headers = []string{"name", "type", "pinned"}
+	headers = []string{"name", "type", "pinned", "pinned by"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -63,9 +61,31 @@ func (command *ResourcesCommand) Execute([]string) error {
 		}
 
 		row = append(row, pinnedColumn)
+		row = append(row, pinnedByCell(p))
 
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func pinnedByCell(resource atc.Resource) ui.TableCell {
+	if resource.PinnedVersion == nil {
+		return ui.TableCell{Contents: "n/a"}
+	}
+
+	if resource.PinnedInConfig {
+		return ui.TableCell{Contents: "pipeline config"}
+	}
+
+	pinnedBy := resource.PinnedBy
+	if pinnedBy == "" {
+		pinnedBy = "unknown"
+	}
+
+	if resource.PinExpiresAt != 0 {
+		pinnedBy += " until " + time.Unix(resource.PinExpiresAt, 0).Local().Format(timeDateLayout)
+	}
+
+	return ui.TableCell{Contents: pinnedBy}
+}
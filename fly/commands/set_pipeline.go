@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
@@ -14,17 +16,36 @@ type SetPipelineCommand struct {
 	DisableAnsiColor bool `long:"no-color"               description:"Disable color output"`
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+	DryRun           bool `long:"dry-run"      description:"Validate the configuration against the ATC without saving it"`
 
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
-	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
+	Config   atc.PathFlag             `short:"c"  long:"config"                     description:"Pipeline configuration file, or a directory of fragments to assemble it from"`
+
+	RollbackTo int `long:"rollback-to"  value-name:"VERSION"  description:"Set the pipeline's configuration back to a previously saved version, as listed by pipeline-history"`
 
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Labels []flaghelpers.LabelPairFlag `long:"label"  value-name:"KEY=VALUE"  description:"Set a label on the pipeline, overriding the config's labels (can be specified multiple times)"`
+
+	Instance flaghelpers.InstanceVarFlags
 }
 
 func (command *SetPipelineCommand) Validate() error {
+	if command.Config == "" && command.RollbackTo == 0 {
+		return errors.New("either --config or --rollback-to must be specified")
+	}
+
+	if command.Config != "" && command.RollbackTo != 0 {
+		return errors.New("--config and --rollback-to cannot be specified together")
+	}
+
+	if len(command.Labels) > 0 && command.RollbackTo != 0 {
+		return errors.New("--label and --rollback-to cannot be specified together")
+	}
+
 	return command.Pipeline.Validate()
 }
 
@@ -50,14 +71,31 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	ansi.DisableColors(command.DisableAnsiColor)
 
 	atcConfig := setpipelinehelpers.ATCConfig{
-		Team:             target.Team(),
+		Team:             target.Team().ForInstance(command.Instance.InstanceVars()),
 		PipelineName:     pipelineName,
+		InstanceVars:     command.Instance.InstanceVars(),
 		TargetName:       Fly.Target,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
+		DryRun:           command.DryRun,
+	}
+
+	if len(command.Labels) > 0 {
+		atcConfig.Labels = map[string]string{}
+		for _, label := range command.Labels {
+			atcConfig.Labels[label.Key] = label.Value
+		}
 	}
 
-	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	if command.RollbackTo != 0 {
+		return atcConfig.Rollback(command.RollbackTo)
+	}
+
+	// instance vars are also available to the config, taking precedence over
+	// any --var of the same name
+	templateVariables := append(command.Var[:len(command.Var):len(command.Var)], command.Instance.Vars...)
+
+	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, templateVariables, command.YAMLVar)
 	return atcConfig.Set(yamlTemplateWithParams)
 }
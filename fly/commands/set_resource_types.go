@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/mitchellh/mapstructure"
+	"github.com/vito/go-interact/interact"
+	"gopkg.in/yaml.v2"
+)
+
+type SetResourceTypesCommand struct {
+	SkipInteractive bool         `short:"n" long:"non-interactive" description:"Skips interactions, uses default values"`
+	Config          atc.PathFlag `short:"c" long:"config" required:"true" description:"File listing the team's resource types under resource_types"`
+}
+
+func (command *SetResourceTypesCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	resourceTypes, err := command.loadResourceTypes()
+	if err != nil {
+		return err
+	}
+
+	existingTypes, err := target.Team().ResourceTypes()
+	if err != nil {
+		return err
+	}
+
+	if !setpipelinehelpers.DiffResourceTypes(existingTypes, resourceTypes) {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	if !command.SkipInteractive {
+		confirm := false
+		err = interact.NewInteraction("apply resource types?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return nil
+		}
+	}
+
+	err = target.Team().SetResourceTypes(resourceTypes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("resource types of team %s updated\n", ui.Embolden("%s", target.Team().Name()))
+
+	return nil
+}
+
+func (command *SetResourceTypesCommand) loadResourceTypes() (atc.ResourceTypes, error) {
+	evaluatedTemplate, err := templatehelpers.NewYamlTemplateWithParams(command.Config, nil, nil, nil).Evaluate(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var configStructure interface{}
+	err = yaml.Unmarshal(evaluatedTemplate, &configStructure)
+	if err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		ResourceTypes atc.ResourceTypes `mapstructure:"resource_types"`
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           &config,
+		WeaklyTypedInput: true,
+		DecodeHook:       atc.SanitizeDecodeHook,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = decoder.Decode(configStructure)
+	if err != nil {
+		return nil, errors.New("failed to decode resource types: " + err.Error())
+	}
+
+	if config.ResourceTypes == nil {
+		config.ResourceTypes = atc.ResourceTypes{}
+	}
+
+	return config.ResourceTypes, nil
+}
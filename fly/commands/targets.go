@@ -6,13 +6,23 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/fatih/color"
 )
 
-type TargetsCommand struct{}
+type TargetsCommand struct {
+	Output flaghelpers.OutputFlags
+}
+
+type targetDetails struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Team   string `json:"team"`
+	Expiry string `json:"expiry"`
+}
 
 func (command *TargetsCommand) Execute([]string) error {
 	flyYAML, err := rc.LoadTargets()
@@ -20,6 +30,24 @@ func (command *TargetsCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.Output.Structured() {
+		targets := []targetDetails{}
+		for targetName, targetValues := range flyYAML.Targets {
+			targets = append(targets, targetDetails{
+				Name:   string(targetName),
+				URL:    targetValues.API,
+				Team:   targetValues.TeamName,
+				Expiry: GetExpirationFromString(targetValues.Token),
+			})
+		}
+
+		sort.Slice(targets, func(i, j int) bool {
+			return targets[i].Name < targets[j].Name
+		})
+
+		return command.Output.Render(os.Stdout, targets)
+	}
+
 	table := ui.Table{
 		Headers: ui.TableRow{
 			{Contents: "name", Color: color.New(color.Bold)},
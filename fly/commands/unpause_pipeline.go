@@ -9,11 +9,18 @@ import (
 )
 
 type UnpausePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to unpause"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Pipeline to unpause"`
+	Selector flaghelpers.PipelineSelectorFlags
+	Instance flaghelpers.InstanceVarFlags
 }
 
 func (command *UnpausePipelineCommand) Validate() error {
-	return command.Pipeline.Validate()
+	err := command.Selector.Validate(command.Pipeline)
+	if err != nil {
+		return err
+	}
+
+	return command.Instance.Validate(command.Selector)
 }
 
 func (command *UnpausePipelineCommand) Execute(args []string) error {
@@ -22,7 +29,7 @@ func (command *UnpausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Instance.Ref(command.Pipeline)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -34,15 +41,30 @@ func (command *UnpausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().UnpausePipeline(pipelineName)
+	if command.Selector.Selected() {
+		pipelines, err := selectPipelines(target.Team(), command.Selector)
+		if err != nil {
+			return err
+		}
+
+		results, err := target.Team().UnpausePipelines(pipelineIDs(pipelines))
+		if err != nil {
+			return err
+		}
+
+		showBatchResults(pipelines, results, "unpaused")
+		return nil
+	}
+
+	found, err := target.Team().ForInstance(pipelineRef.InstanceVars).UnpausePipeline(pipelineRef.Name)
 	if err != nil {
 		return err
 	}
 
 	if found {
-		fmt.Printf("unpaused '%s'\n", pipelineName)
+		fmt.Printf("unpaused '%s'\n", pipelineRef)
 	} else {
-		displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 	}
 
 	return nil
@@ -5,14 +5,14 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type UserinfoCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	Output flaghelpers.OutputFlags
 }
 
 func (command *UserinfoCommand) Execute([]string) error {
@@ -31,12 +31,8 @@ func (command *UserinfoCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(userinfo)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, userinfo)
 	}
 
 	headers := ui.TableRow{
@@ -1,24 +1,84 @@
 package commands
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/validatepipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type ValidatePipelineCommand struct {
-	Config atc.PathFlag `short:"c" long:"config" required:"true"        description:"Pipeline configuration file"`
+	Config atc.PathFlag `short:"c" long:"config" required:"true"        description:"Pipeline configuration file, or a directory of fragments to assemble it from"`
 	Strict bool         `short:"s" long:"strict"                        description:"Fail on warnings"`
 	Output bool         `short:"o" long:"output"                        description:"Output templated pipeline to stdout"`
 
+	CheckCredentials bool                     `long:"check-creds"                      description:"Validate credential variables against the target's credential manager"`
+	Pipeline         flaghelpers.PipelineFlag `short:"p" long:"pipeline"               description:"Pipeline whose credentials to check (required with --check-creds)"`
+
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
 }
 
+func (command *ValidatePipelineCommand) Validate() error {
+	if command.CheckCredentials && command.Pipeline == "" {
+		return errors.New("the --pipeline flag is required when checking credentials")
+	}
+
+	return command.Pipeline.Validate()
+}
+
 func (command *ValidatePipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
 	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar)
+
+	if command.CheckCredentials {
+		err = command.checkCredentials(yamlTemplate)
+		if err != nil {
+			return err
+		}
+	}
+
 	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output)
 }
+
+func (command *ValidatePipelineCommand) checkCredentials(yamlTemplate templatehelpers.YamlTemplateWithParams) error {
+	evaluatedTemplate, err := yamlTemplate.Evaluate(true, command.Strict)
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, checks, err := target.Team().ValidatePipelineConfig(string(command.Pipeline), evaluatedTemplate, true)
+	if invalidConfigErr, ok := err.(concourse.InvalidConfigError); ok {
+		checks = invalidConfigErr.CredentialChecks
+	}
+
+	if len(checks) > 0 {
+		renderErr := displayhelpers.ShowCredentialChecks(checks, true)
+		if renderErr != nil {
+			return renderErr
+		}
+	}
+
+	return err
+}
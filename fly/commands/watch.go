@@ -1,22 +1,42 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
+// followPollInterval is how often the job is checked for a new build while
+// following it.
+const followPollInterval = time.Second
+
 type WatchCommand struct {
-	Job       flaghelpers.JobFlag `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
-	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
-	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Job        flaghelpers.JobFlag `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
+	Build      string              `short:"b" long:"build"                                  description:"Watches a specific build"`
+	Timestamp  bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Follow     bool                `short:"f" long:"follow"                                 description:"Keep watching each new build of the job as it starts"`
+	OnlyFailed bool                `          long:"only-failed"                            description:"Only print the output of steps which fail"`
+	Step       string              `          long:"step"        value-name:"NAME"          description:"Only print the output of the steps with the given name"`
 }
 
 func (command *WatchCommand) Execute(args []string) error {
+	if command.Follow && command.Job.JobName == "" {
+		return errors.New("--follow requires --job")
+	}
+
+	if command.Follow && command.Build != "" {
+		return errors.New("--follow cannot be used with --build")
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -27,8 +47,13 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
-	var buildId int
 	client := target.Client()
+
+	if command.Follow {
+		return command.follow(client, target.Team())
+	}
+
+	var buildId int
 	if command.Job.JobName != "" || command.Build == "" {
 		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
 		if err != nil {
@@ -43,18 +68,94 @@ func (command *WatchCommand) Execute(args []string) error {
 		}
 	}
 
-	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
+	exitCode, err := command.watchBuild(client, buildId)
 	if err != nil {
 		return err
 	}
 
-	renderOptions := eventstream.RenderOptions{ShowTimestamp: command.Timestamp}
+	os.Exit(exitCode)
+
+	return nil
+}
+
+// follow watches each build of the job in turn, waiting for the next one to
+// start once a build finishes, until interrupted.
+func (command *WatchCommand) follow(client concourse.Client, team concourse.Team) error {
+	lastBuildID := 0
+
+	for {
+		build, err := command.waitForBuild(team, lastBuildID)
+		if err != nil {
+			return err
+		}
+
+		if lastBuildID != 0 {
+			fmt.Println("")
+		}
+
+		fmt.Println(ui.Embolden("watching %s/%s #%s", command.Job.PipelineName, command.Job.JobName, build.Name))
+		fmt.Println("")
+
+		_, err = command.watchBuild(client, build.ID)
+		if err != nil {
+			return err
+		}
+
+		lastBuildID = build.ID
+	}
+}
+
+// waitForBuild polls the job until it has a build newer than the given one,
+// preferring a build which is running or pending over one that has finished.
+func (command *WatchCommand) waitForBuild(team concourse.Team, lastBuildID int) (atc.Build, error) {
+	for {
+		job, found, err := team.Job(command.Job.PipelineName, command.Job.JobName)
+		if err != nil {
+			return atc.Build{}, fmt.Errorf("failed to get job %s", err)
+		}
+
+		if !found {
+			return atc.Build{}, errors.New("job not found")
+		}
+
+		if job.NextBuild != nil && job.NextBuild.ID > lastBuildID {
+			return *job.NextBuild, nil
+		}
+
+		if job.FinishedBuild != nil && job.FinishedBuild.ID > lastBuildID {
+			return *job.FinishedBuild, nil
+		}
+
+		time.Sleep(followPollInterval)
+	}
+}
+
+func (command *WatchCommand) watchBuild(client concourse.Client, buildID int) (int, error) {
+	renderOptions := eventstream.RenderOptions{
+		ShowTimestamp: command.Timestamp,
+		OnlyFailed:    command.OnlyFailed,
+		Step:          command.Step,
+	}
+
+	if command.Step != "" {
+		plan, found, err := client.BuildPlan(buildID)
+		if err != nil {
+			return 0, err
+		}
+
+		if found {
+			renderOptions.StepNames = eventstream.StepNames(plan)
+		}
+	}
+
+	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildID))
+	if err != nil {
+		return 0, err
+	}
 
 	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
 
 	eventSource.Close()
 
-	os.Exit(exitCode)
-
-	return nil
+	return exitCode, nil
 }
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhookDeliveriesCommand struct {
+	Count int `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+
+	Output flaghelpers.OutputFlags
+}
+
+func (command *WebhookDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := target.Team().WebhookDeliveries(command.Count)
+	if err != nil {
+		return err
+	}
+
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "received", Color: color.New(color.Bold)},
+			{Contents: "provider", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "matched", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		eventCell := ui.TableCell{Contents: delivery.Event}
+		if delivery.Event == "" {
+			eventCell.Contents = "n/a"
+			eventCell.Color = ui.OffColor
+		}
+
+		var matchedCell ui.TableCell
+		switch {
+		case delivery.Error != "":
+			matchedCell.Contents = delivery.Error
+			matchedCell.Color = ui.ErroredColor
+		case len(delivery.Matched) == 0:
+			matchedCell.Contents = "none"
+			matchedCell.Color = ui.OffColor
+		default:
+			names := []string{}
+			for _, match := range delivery.Matched {
+				names = append(names, match.PipelineName+"/"+match.ResourceName)
+			}
+
+			matchedCell.Contents = strings.Join(names, ", ")
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: time.Unix(delivery.ReceivedAt, 0).Local().Format(timeDateLayout)},
+			{Contents: delivery.Provider},
+			eventCell,
+			matchedCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -8,7 +8,7 @@ import (
 	"strings"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -16,7 +16,8 @@ import (
 
 type WorkersCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each worker"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+
+	Output flaghelpers.OutputFlags
 }
 
 func (command *WorkersCommand) Execute([]string) error {
@@ -35,12 +36,8 @@ func (command *WorkersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(workers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Output.Structured() {
+		return command.Output.Render(os.Stdout, workers)
 	}
 
 	sort.Sort(byWorkerName(workers))
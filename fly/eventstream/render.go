@@ -1,6 +1,7 @@
 package eventstream
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"strings"
@@ -13,6 +14,15 @@ import (
 
 type RenderOptions struct {
 	ShowTimestamp bool
+
+	// OnlyFailed holds back the output of each step until it finishes, and
+	// only prints it if the step failed or errored.
+	OnlyFailed bool
+
+	// Step limits the output to the steps with the given name, as looked up
+	// in StepNames by the origin of each event.
+	Step      string
+	StepNames map[event.OriginID]string
 }
 
 func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) int {
@@ -20,6 +30,42 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 	exitStatus := 0
 
+	steps := map[event.OriginID]*bytes.Buffer{}
+	stepWriters := map[event.OriginID]*TimestampedWriter{}
+
+	writerFor := func(origin event.Origin) *TimestampedWriter {
+		if !options.OnlyFailed || origin.ID == "" {
+			return dstImpl
+		}
+
+		writer, found := stepWriters[origin.ID]
+		if !found {
+			steps[origin.ID] = new(bytes.Buffer)
+			writer = NewTimestampedWriter(steps[origin.ID], options.ShowTimestamp)
+			stepWriters[origin.ID] = writer
+		}
+
+		return writer
+	}
+
+	finishStep := func(origin event.Origin, failed bool) {
+		buf, found := steps[origin.ID]
+		if !found {
+			return
+		}
+
+		if failed {
+			dst.Write(buf.Bytes())
+		}
+
+		delete(steps, origin.ID)
+		delete(stepWriters, origin.ID)
+	}
+
+	hidden := func(origin event.Origin) bool {
+		return options.Step != "" && origin.ID != "" && options.StepNames[origin.ID] != options.Step
+	}
+
 	for {
 		ev, err := src.NextEvent()
 		if err != nil {
@@ -34,27 +80,55 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 		switch e := ev.(type) {
 		case event.Log:
-			dstImpl.SetTimestamp(e.Time)
-			fmt.Fprintf(dstImpl, "%s", e.Payload)
+			if hidden(e.Origin) {
+				continue
+			}
+
+			writer := writerFor(e.Origin)
+			writer.SetTimestamp(e.Time)
+			fmt.Fprintf(writer, "%s", e.Payload)
 
 		case event.InitializeTask:
-			dstImpl.SetTimestamp(e.Time)
-			fmt.Fprintf(dstImpl, "\x1b[1minitializing\x1b[0m\n")
+			if hidden(e.Origin) {
+				continue
+			}
+
+			writer := writerFor(e.Origin)
+			writer.SetTimestamp(e.Time)
+			fmt.Fprintf(writer, "\x1b[1minitializing\x1b[0m\n")
 
 		case event.StartTask:
+			if hidden(e.Origin) {
+				continue
+			}
+
 			buildConfig := e.TaskConfig
 
 			argv := strings.Join(append([]string{buildConfig.Run.Path}, buildConfig.Run.Args...), " ")
-			dstImpl.SetTimestamp(e.Time)
-			fmt.Fprintf(dstImpl, "\x1b[1mrunning %s\x1b[0m\n", argv)
+			writer := writerFor(e.Origin)
+			writer.SetTimestamp(e.Time)
+			fmt.Fprintf(writer, "\x1b[1mrunning %s\x1b[0m\n", argv)
 
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
+			finishStep(e.Origin, e.ExitStatus != 0)
+
+		case event.FinishGet:
+			finishStep(e.Origin, e.ExitStatus != 0)
+
+		case event.FinishPut:
+			finishStep(e.Origin, e.ExitStatus != 0)
 
 		case event.Error:
+			if hidden(e.Origin) {
+				continue
+			}
+
 			errCol := ui.ErroredColor.SprintFunc()
-			dstImpl.SetTimestamp(0)
-			fmt.Fprintf(dstImpl, "%s\n", errCol(e.Message))
+			writer := writerFor(e.Origin)
+			writer.SetTimestamp(0)
+			fmt.Fprintf(writer, "%s\n", errCol(e.Message))
+			finishStep(e.Origin, true)
 
 		case event.Status:
 			dstImpl.SetTimestamp(e.Time)
@@ -295,4 +295,88 @@ var _ = Describe("V1.0 Renderer", func() {
 			})
 		})
 	})
+
+	Context("when only failed steps are shown", func() {
+		BeforeEach(func() {
+			options.OnlyFailed = true
+
+			receivedEvents <- event.Log{Origin: event.Origin{ID: "passing"}, Payload: "passing output\n"}
+			receivedEvents <- event.Log{Origin: event.Origin{ID: "failing"}, Payload: "failing output\n", Time: time.Now().Unix()}
+			receivedEvents <- event.Log{Origin: event.Origin{ID: "erroring"}, Payload: "erroring output\n"}
+			receivedEvents <- event.FinishGet{Origin: event.Origin{ID: "passing"}, ExitStatus: 0}
+			receivedEvents <- event.FinishTask{Origin: event.Origin{ID: "failing"}, ExitStatus: 1}
+			receivedEvents <- event.Error{Origin: event.Origin{ID: "erroring"}, Message: "oh no"}
+			receivedEvents <- event.Status{Status: atc.StatusFailed}
+		})
+
+		It("prints the output of steps once they fail", func() {
+			Expect(out).To(gbytes.Say("failing output"))
+			Expect(out).To(gbytes.Say("erroring output"))
+			Expect(out).To(gbytes.Say("oh no"))
+			Expect(out).To(gbytes.Say("failed"))
+		})
+
+		It("does not print the output of steps which succeed", func() {
+			Expect(out.Contents()).NotTo(ContainSubstring("passing output"))
+		})
+
+		It("still exits with the status of the build", func() {
+			Expect(exitStatus).To(Equal(1))
+		})
+
+		Context("and time configuration is enabled", func() {
+			BeforeEach(func() {
+				options.ShowTimestamp = true
+			})
+
+			It("prints the output with the time it was logged", func() {
+				Expect(out).To(gbytes.Say(`\d{2}\:\d{2}\:\d{2}\s{2}failing output`))
+			})
+		})
+	})
+
+	Context("when a step is given", func() {
+		BeforeEach(func() {
+			options.Step = "unit"
+			options.StepNames = map[event.OriginID]string{
+				"1": "repo",
+				"2": "unit",
+			}
+
+			receivedEvents <- event.Log{Origin: event.Origin{ID: "1"}, Payload: "cloning\n"}
+			receivedEvents <- event.Log{Origin: event.Origin{ID: "2"}, Payload: "testing\n"}
+			receivedEvents <- event.FinishTask{Origin: event.Origin{ID: "2"}, ExitStatus: 0}
+			receivedEvents <- event.Status{Status: atc.StatusSucceeded}
+		})
+
+		It("only prints the output of that step", func() {
+			Expect(out).To(gbytes.Say("testing"))
+			Expect(out).To(gbytes.Say("succeeded"))
+			Expect(out.Contents()).NotTo(ContainSubstring("cloning"))
+		})
+	})
+})
+
+var _ = Describe("StepNames", func() {
+	It("returns the name of each step by its plan ID", func() {
+		factory := atc.NewPlanFactory(0)
+
+		plan := factory.NewPlan(atc.DoPlan{
+			factory.NewPlan(atc.AggregatePlan{
+				factory.NewPlan(atc.GetPlan{Name: "repo", Resource: "some-repo"}),
+			}),
+			factory.NewPlan(atc.OnFailurePlan{
+				Step: factory.NewPlan(atc.TaskPlan{Name: "unit"}),
+				Next: factory.NewPlan(atc.PutPlan{Name: "notify", Resource: "some-notifier"}),
+			}),
+		})
+
+		names := eventstream.StepNames(atc.PublicBuildPlan{Plan: plan.Public()})
+
+		Expect(names).To(Equal(map[event.OriginID]string{
+			event.OriginID((*(*plan.Do)[0].Aggregate)[0].ID): "repo",
+			event.OriginID((*plan.Do)[1].OnFailure.Step.ID):  "unit",
+			event.OriginID((*plan.Do)[1].OnFailure.Next.ID):  "notify",
+		}))
+	})
 })
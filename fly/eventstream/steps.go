@@ -0,0 +1,56 @@
+package eventstream
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// StepNames returns the name of each get, put and task in a build's plan,
+// keyed by the plan ID their events are sent with.
+func StepNames(plan atc.PublicBuildPlan) map[event.OriginID]string {
+	names := map[event.OriginID]string{}
+
+	if plan.Plan == nil {
+		return names
+	}
+
+	var public interface{}
+	err := json.Unmarshal(*plan.Plan, &public)
+	if err != nil {
+		return names
+	}
+
+	collectStepNames(public, names)
+
+	return names
+}
+
+func collectStepNames(value interface{}, names map[event.OriginID]string) {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, element := range v {
+			collectStepNames(element, names)
+		}
+
+	case map[string]interface{}:
+		id, _ := v["id"].(string)
+
+		for _, step := range []string{"get", "put", "task", "dependent_get"} {
+			config, ok := v[step].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _ := config["name"].(string)
+			if id != "" && name != "" {
+				names[event.OriginID(id)] = name
+			}
+		}
+
+		for _, field := range v {
+			collectStepNames(field, names)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -19,12 +21,12 @@ var _ = Describe("CheckResource", func() {
 
 	Context("when ATC request succeeds", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
 				),
 			)
 		})
@@ -47,12 +49,12 @@ var _ = Describe("CheckResource", func() {
 
 	Context("when version is omitted", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":null}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
 				),
 			)
 		})
@@ -75,12 +77,12 @@ var _ = Describe("CheckResource", func() {
 
 	Context("when specifying multiple versions", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref1":"fake-ref-1","ref2":"fake-ref-2"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
 				),
 			)
 		})
@@ -101,9 +103,126 @@ var _ = Describe("CheckResource", func() {
 		})
 	})
 
+	Context("when the check is still running", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusPending}),
+				),
+			)
+		})
+
+		Context("when the check succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Check{ID: 123, Status: atc.CheckStatusStarted}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
+					),
+				)
+			})
+
+			It("waits for the check to finish", func() {
+				Expect(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess, 5).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(gbytes.Say("checked 'myresource'"))
+
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(4))
+			})
+		})
+
+		Context("when the check errors", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Check{ID: 123, Status: atc.CheckStatusErrored, CheckError: "bad version"}),
+					),
+				)
+			})
+
+			It("prints the error and fails", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess, 5).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("check failed:\nbad version"))
+			})
+		})
+
+		Context("when --watch is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Check{ID: 123, Status: atc.CheckStatusStarted, Output: "fetching refs\n"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/checks/123"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded, Output: "fetching refs\nfound 2 versions\n"}),
+					),
+				)
+			})
+
+			It("prints the output of the check as it arrives", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "--watch")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess, 5).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("fetching refs\nfound 2 versions\nchecked 'myresource'"))
+			})
+		})
+
+		Context("when --async is given", func() {
+			It("returns without waiting for the check", func() {
+				Expect(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "--async")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(gbytes.Say("queued check for 'myresource'"))
+
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+
+			Context("when --watch is also given", func() {
+				It("fails", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "--async", "--watch")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+
+					Expect(sess.Err).To(gbytes.Say("--async and --watch cannot be used together"))
+				})
+			})
+		})
+	})
+
 	Context("when pipeline or resource is not found", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -125,7 +244,7 @@ var _ = Describe("CheckResource", func() {
 
 	Context("When resource check returns internal server error", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -19,12 +21,12 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("when version is specified", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
 				),
 			)
 		})
@@ -47,12 +49,12 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("when version is omitted", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":null}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Check{ID: 123, Status: atc.CheckStatusSucceeded}),
 				),
 			)
 		})
@@ -75,7 +77,7 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("when pipeline or resource-type is not found", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -97,7 +99,7 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("When resource-type check returns internal server error", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resource-types/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -112,6 +112,28 @@ some-orphaned-job: concourse.check %s main some-pipeline some-orphaned-job
 				})
 			})
 
+			Context("when --json is given", func() {
+				It("prints the checks of each group as JSON", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "checklist", "-p", "some-pipeline", "--json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					check := func(job string) string {
+						return fmt.Sprintf("concourse.check %s main some-pipeline %s", atcServer.URL(), job)
+					}
+
+					Expect(sess.Out.Contents()).To(MatchJSON(fmt.Sprintf(`[
+						{"name": "some-group", "jobs": [{"job": "job-1", "check": %q}, {"job": "job-2", "check": %q}]},
+						{"name": "some-other-group", "jobs": [{"job": "job-3", "check": %q}, {"job": "job-4", "check": %q}]},
+						{"name": "misc", "jobs": [{"job": "some-orphaned-job", "check": %q}]}
+					]`, check("job-1"), check("job-2"), check("job-3"), check("job-4"), check("some-orphaned-job"))))
+				})
+			})
+
 			Context("when there are no groups", func() {
 				BeforeEach(func() {
 					config = atc.Config{
@@ -0,0 +1,182 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("checks", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when a pipeline is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-p", "some-pipeline", "-c", "2")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/checks", "limit=2"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Check{
+							{
+								ID:           2,
+								PipelineName: "some-pipeline",
+								ResourceName: "some-repo",
+								Status:       atc.CheckStatusPending,
+								CreateTime:   200,
+							},
+							{
+								ID:               1,
+								PipelineName:     "some-pipeline",
+								ResourceTypeName: "some-type",
+								Status:           atc.CheckStatusErrored,
+								CreateTime:       100,
+								StartTime:        110,
+								EndTime:          120,
+								CheckError:       "image not found",
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 2,
+							"team_name": "",
+							"pipeline_name": "some-pipeline",
+							"resource_name": "some-repo",
+							"status": "pending",
+							"manually_triggered": false,
+							"plan": {"name": "", "type": ""},
+							"create_time": 200
+						},
+						{
+							"id": 1,
+							"team_name": "",
+							"pipeline_name": "some-pipeline",
+							"resource_type_name": "some-type",
+							"status": "errored",
+							"manually_triggered": false,
+							"plan": {"name": "", "type": ""},
+							"create_time": 100,
+							"start_time": 110,
+							"end_time": 120,
+							"check_error": "image not found"
+						}
+					]`))
+				})
+			})
+
+			It("shows the checks and their status", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "end", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: "some-repo"},
+							{Contents: "pending", Color: ui.PendingColor},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "n/a", Color: ui.OffColor},
+						},
+						{
+							{Contents: "1"},
+							{Contents: "some-type"},
+							{Contents: "errored", Color: ui.ErroredColor},
+							{Contents: time.Unix(110, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: time.Unix(120, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "10s"},
+							{Contents: "image not found", Color: ui.ErroredColor},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when a resource is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-repo", "-c", "2", "--json")
+
+				atcServer.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-repo/checks", "limit=2"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Check{
+						{
+							ID:           3,
+							PipelineName: "some-pipeline",
+							ResourceName: "some-repo",
+							Status:       atc.CheckStatusSucceeded,
+							Output:       "fetching refs\n",
+						},
+					}),
+				))
+			})
+
+			It("prints the resource's checks along with their output", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 3,
+						"team_name": "",
+						"pipeline_name": "some-pipeline",
+						"resource_name": "some-repo",
+						"status": "succeeded",
+						"manually_triggered": false,
+						"plan": {"name": "", "type": ""},
+						"output": "fetching refs\n"
+					}
+				]`))
+			})
+		})
+
+		Context("when neither a pipeline nor a resource is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks")
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Either --pipeline or --resource must be specified"))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -45,7 +46,7 @@ var _ = Describe("Fly CLI", func() {
 
 				Eventually(sess).Should(gexec.Exit(1))
 
-				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+				Expect(sess.Err).To(gbytes.Say("error: one of --pipeline, --all, --glob or --label must be specified"))
 			})
 		})
 
@@ -150,5 +151,62 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 		})
+
+		Context("when pipelines are selected with --glob", func() {
+			BeforeEach(func() {
+				args = append(args, "--glob", "pr-*")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{ID: 1, Name: "pr-1"},
+							{ID: 2, Name: "main"},
+							{ID: 3, Name: "pr-2", InstanceVars: atc.InstanceVars{"branch": "1.2"}},
+							{ID: 4, Name: "pr-2", InstanceVars: atc.InstanceVars{"branch": "1.3"}},
+						}),
+					),
+				)
+			})
+
+			It("lists each matching instance before confirming", func() {
+				Eventually(sess).Should(gbytes.Say("!!! this will remove all data for the pipelines:"))
+				Eventually(sess).Should(gbytes.Say("pr-1"))
+				Eventually(sess).Should(gbytes.Say("pr-2/branch:1.2"))
+				Eventually(sess).Should(gbytes.Say("pr-2/branch:1.3"))
+				Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("main"))
+
+				fmt.Fprintf(stdin, "n\n")
+				Eventually(sess).Should(gbytes.Say(`bailing out`))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when the user confirms", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/pipelines"),
+							ghttp.VerifyJSONRepresenting([]int{1, 3, 4}),
+							ghttp.RespondWithJSONEncoded(200, []atc.PipelineBatchResult{
+								{ID: 1, Found: true},
+								{ID: 3, Found: true},
+								{ID: 4, Found: false},
+							}),
+						),
+					)
+				})
+
+				It("destroys the matching pipelines at once", func() {
+					Eventually(sess).Should(gbytes.Say(`are you sure\? \[yN\]: `))
+					fmt.Fprintf(stdin, "y\n")
+
+					Eventually(sess).Should(gbytes.Say("`pr-1` deleted"))
+					Eventually(sess).Should(gbytes.Say("`pr-2/branch:1.2` deleted"))
+					Eventually(sess).Should(gbytes.Say("`pr-2/branch:1.3` does not exist"))
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
 	})
 })
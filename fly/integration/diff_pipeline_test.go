@@ -0,0 +1,125 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("diff-pipeline", func() {
+		var (
+			oldConfig atc.Config
+			newConfig atc.Config
+		)
+
+		BeforeEach(func() {
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+					{Name: "removed-job"},
+				},
+			}
+
+			newConfig = atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+					{Name: "added-job"},
+				},
+			}
+		})
+
+		Context("when not specifying a version to compare from", func() {
+			It("fails and says you should give a version", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `--from' was not specified"))
+			})
+		})
+
+		Context("when comparing two saved versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: oldConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: newConfig}),
+					),
+				)
+			})
+
+			It("prints the changes between them", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "some-pipeline", "--from", "1", "--to", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("jobs:"))
+				Expect(sess.Out).To(gbytes.Say("job removed-job has been removed"))
+				Expect(sess.Out).To(gbytes.Say("job added-job has been added"))
+			})
+		})
+
+		Context("when comparing a saved version to the current config", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: oldConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: oldConfig}, http.Header{atc.ConfigVersionHeader: {"2"}}),
+					),
+				)
+			})
+
+			It("says there are no differences", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "some-pipeline", "--from", "1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("no differences"))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "some-pipeline", "--from", "1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("error: version 1 of pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,221 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --from-build", func() {
+		var (
+			streaming chan struct{}
+			events    chan atc.Event
+
+			taskPlan     atc.TaskPlan
+			stepPlan     atc.BuildStepPlan
+			expectedPlan atc.Plan
+		)
+
+		BeforeEach(func() {
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+
+			taskPlan = atc.TaskPlan{
+				Name: "unit",
+				Config: &atc.TaskConfig{
+					Platform: "linux",
+					Inputs:   []atc.TaskInputConfig{{Name: "source"}},
+					Run:      atc.TaskRunConfig{Path: "make"},
+				},
+				Params:       atc.Params{"FOO": "bar"},
+				InputMapping: map[string]string{"source": "repo"},
+			}
+
+			getPlan := atc.GetPlan{
+				Name:     "repo",
+				Type:     "git",
+				Resource: "some-repo",
+				Source:   atc.Source{"uri": "https://example.com"},
+				Version:  &atc.Version{"ref": "abc"},
+			}
+
+			stepPlan = atc.BuildStepPlan{
+				Inputs: []atc.Plan{{ID: "2", Get: &getPlan}},
+				Step:   atc.Plan{ID: "6", Task: &taskPlan},
+			}
+
+			planFactory := atc.NewPlanFactory(0)
+
+			expectedPlan = planFactory.NewPlan(atc.DoPlan{
+				planFactory.NewPlan(atc.AggregatePlan{
+					planFactory.NewPlan(getPlan),
+				}),
+				planFactory.NewPlan(taskPlan),
+			})
+		})
+
+		JustBeforeEach(func() {
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds"),
+					VerifyPlan(expectedPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+					flusher.Flush()
+
+					close(streaming)
+
+					id := 0
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+						id++
+					}
+
+					err := sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/artifacts",
+				ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+			)
+		})
+
+		Context("when the build has the task", func() {
+			JustBeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/step_plan",
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/step_plan", "step=unit"),
+						ghttp.RespondWithJSONEncoded(200, stepPlan),
+					),
+				)
+			})
+
+			It("runs the task with the inputs it ran with in the build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--from-build", "some-pipeline/some-job/3", "--step", "unit")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(streaming).Should(BeClosed())
+
+				events <- event.Log{Payload: "sup"}
+				close(events)
+
+				Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(0))
+			})
+
+			Context("when vars are given", func() {
+				BeforeEach(func() {
+					taskPlan.Vars = atc.Params{"image_tag": "latest"}
+					stepPlan.Step.Task = &taskPlan
+
+					overridden := taskPlan
+					overridden.Vars = atc.Params{"image_tag": "test"}
+
+					planFactory := atc.NewPlanFactory(0)
+					expectedPlan = planFactory.NewPlan(atc.DoPlan{
+						planFactory.NewPlan(atc.AggregatePlan{
+							planFactory.NewPlan(*stepPlan.Inputs[0].Get),
+						}),
+						planFactory.NewPlan(overridden),
+					})
+				})
+
+				It("overrides the task's vars", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--from-build", "some-pipeline/some-job/3", "--step", "unit", "-v", "image_tag=test")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(streaming).Should(BeClosed())
+					close(events)
+
+					<-sess.Exited
+					Expect(sess).To(gexec.Exit(0))
+				})
+			})
+		})
+
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/step_plan",
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--from-build", "some-pipeline/some-job/3", "--step", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("task 'bogus' not found in build some-pipeline/some-job #3"))
+			})
+		})
+
+		Context("when the build has more than one task and none is given", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3/step_plan",
+					ghttp.RespondWith(http.StatusBadRequest, "build has more than one task, specify one of: integration, unit"),
+				)
+			})
+
+			It("lists the tasks to choose from", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--from-build", "some-pipeline/some-job/3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("specify one of: integration, unit"))
+			})
+		})
+
+		Context("when --inputs-from is also given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--from-build", "some-pipeline/some-job/3", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--from-build cannot be used with --config or --inputs-from"))
+			})
+		})
+	})
+})
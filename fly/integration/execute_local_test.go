@@ -0,0 +1,140 @@
+package integration_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	gclient "code.cloudfoundry.org/garden/client"
+	gconn "code.cloudfoundry.org/garden/client/connection"
+	gfakes "code.cloudfoundry.org/garden/gardenfakes"
+	gserver "code.cloudfoundry.org/garden/server"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --local", func() {
+		var (
+			buildDir       string
+			taskConfigPath string
+
+			gardenURL     string
+			gardenServer  *gserver.GardenServer
+			fakeBackend   *gfakes.FakeBackend
+			fakeContainer *gfakes.FakeContainer
+		)
+
+		BeforeEach(func() {
+			var err error
+			buildDir, err = ioutil.TempDir("", "fly-build-dir")
+			Expect(err).NotTo(HaveOccurred())
+
+			taskConfigPath = filepath.Join(buildDir, "task.yml")
+
+			err = ioutil.WriteFile(
+				taskConfigPath,
+				[]byte(`---
+platform: some-platform
+
+rootfs_uri: docker:///busybox
+
+inputs:
+- name: fixture
+
+params:
+  FOO: bar
+
+run:
+  path: /bin/sh
+  args:
+  - -c
+  - echo hello
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeContainer = new(gfakes.FakeContainer)
+			fakeContainer.HandleReturns("some-handle")
+			fakeContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+				fmt.Fprintln(io.Stdout, "hello from the task")
+
+				process := new(gfakes.FakeProcess)
+				process.IDReturns("some-process")
+				process.WaitReturns(3, nil)
+
+				return process, nil
+			}
+
+			fakeBackend = new(gfakes.FakeBackend)
+			fakeBackend.CreateReturns(fakeContainer, nil)
+			fakeBackend.LookupReturns(fakeContainer, nil)
+
+			gardenAddr := fmt.Sprintf("127.0.0.1:%d", 9200+GinkgoParallelNode())
+			gardenURL = "http://" + gardenAddr
+
+			gardenServer = gserver.New("tcp", gardenAddr, 0, fakeBackend, lagertest.NewTestLogger("garden"))
+			go func() {
+				defer GinkgoRecover()
+				err := gardenServer.ListenAndServe()
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			apiClient := gclient.New(gconn.New("tcp", gardenAddr))
+			Eventually(apiClient.Ping).Should(Succeed())
+
+			err = gardenServer.SetupBomberman()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			gardenServer.Stop()
+			os.RemoveAll(buildDir)
+		})
+
+		It("runs the task on the garden server without creating a build", func() {
+			requestsBefore := len(atcServer.ReceivedRequests())
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--local", "--garden-url", gardenURL, "-c", taskConfigPath, "-i", "fixture="+buildDir)
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("running /bin/sh -c echo hello"))
+			Eventually(sess).Should(gbytes.Say("hello from the task"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(3))
+
+			Expect(fakeBackend.CreateCallCount()).To(Equal(1))
+			spec := fakeBackend.CreateArgsForCall(0)
+			Expect(spec.RootFSPath).To(Equal("docker:///busybox"))
+			Expect(spec.Env).To(ContainElement("FOO=bar"))
+
+			Expect(fakeBackend.DestroyCallCount()).To(Equal(1))
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(requestsBefore))
+		})
+
+		Context("when --inputs-from is also given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--local", "-c", taskConfigPath, "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--local cannot be used with --from-build, --inputs-from, --image or --tag"))
+			})
+		})
+	})
+})
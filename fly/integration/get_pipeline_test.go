@@ -189,6 +189,56 @@ var _ = Describe("Fly CLI", func() {
 						})
 					})
 				})
+
+				Context("when --at-version is given", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/3"),
+								ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{Config: config}, http.Header{atc.ConfigVersionHeader: {"3"}}),
+							),
+						)
+					})
+
+					It("prints the config saved at that version", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "--at-version", "3")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+
+						var printedConfig atc.Config
+						err = yaml.Unmarshal(sess.Out.Contents(), &printedConfig)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(printedConfig).To(Equal(config))
+					})
+				})
+
+				Context("when --at-version is given and the version is not found", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/3"),
+								ghttp.RespondWith(http.StatusNotFound, ""),
+							),
+						)
+					})
+
+					It("fails and says the version was not found", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "--at-version", "3")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+
+						Expect(sess.Err).To(gbytes.Say("error: version 3 of pipeline 'some-pipeline' not found"))
+					})
+				})
 			})
 		})
 	})
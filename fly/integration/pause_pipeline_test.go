@@ -81,6 +81,220 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when an instance of the pipeline is specified", func() {
+			BeforeEach(func() {
+				path, err := atc.Routes.CreatePathForRoute(atc.PausePipeline, rata.Params{"pipeline_name": "release", "team_name": "main"})
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path, atc.InstanceVars{"branch": "1.2"}.QueryParams().Encode()),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("pauses the instance", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "-p", "release", "--instance-var", "branch=1.2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`paused 'release/branch:1.2'`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when --instance-var is specified with --all", func() {
+			It("fails and says it needs --pipeline", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--all", "--instance-var", "branch=1.2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: --instance-var can only be specified with --pipeline"))
+			})
+		})
+
+		Context("when selecting pipelines with --glob", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{ID: 1, Name: "pr-1"},
+							{ID: 2, Name: "main"},
+							{ID: 3, Name: "pr-2"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/pause"),
+						ghttp.VerifyJSONRepresenting([]int{1, 3}),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineBatchResult{
+							{ID: 1, Found: true},
+							{ID: 3, Found: true},
+						}),
+					),
+				)
+			})
+
+			It("pauses each matching pipeline at once", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--glob", "pr-*")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`paused 'pr-1'`))
+				Eventually(sess).Should(gbytes.Say(`paused 'pr-2'`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when selecting pipelines with --label and --glob", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines", "label=owner%3Dsome-owner"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{ID: 1, Name: "pr-1"},
+							{ID: 2, Name: "main"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/pause"),
+						ghttp.VerifyJSONRepresenting([]int{1}),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineBatchResult{
+							{ID: 1, Found: true},
+						}),
+					),
+				)
+			})
+
+			It("pauses the pipelines with the label whose names match", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--label", "owner=some-owner", "--glob", "pr-*")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`paused 'pr-1'`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when selecting with --label an instance whose other instances are not labelled", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines", "label=env%3Dprod"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{ID: 4, Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.2"}, Labels: map[string]string{"env": "prod"}},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/pause"),
+						ghttp.VerifyJSONRepresenting([]int{4}),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineBatchResult{
+							{ID: 4, Found: true},
+						}),
+					),
+				)
+			})
+
+			It("pauses only the labelled instance", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--label", "env=prod")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`paused 'release/branch:1.2'`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when selecting pipelines with --all and one has since been destroyed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{ID: 1, Name: "pr-1"},
+							{ID: 2, Name: "pr-2"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/pause"),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineBatchResult{
+							{ID: 1, Found: true},
+							{ID: 2, Found: false},
+						}),
+					),
+				)
+			})
+
+			It("reports the missing pipeline and fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--all")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`paused 'pr-1'`))
+				Eventually(sess.Err).Should(gbytes.Say(`pipelines not found: pr-2`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when no pipelines match --glob", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "main"},
+						}),
+					),
+				)
+			})
+
+			It("fails without pausing anything", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "--glob", "pr-*")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: no pipelines matched"))
+			})
+		})
+
+		Context("when both a pipeline name and --all are specified", func() {
+			It("fails and says only one can be given", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pause-pipeline", "-p", "awesome-pipeline", "--all")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: only one of --pipeline, --all or --glob/--label can be specified"))
+			})
+		})
+
 		Context("when the pipline name is not specified", func() {
 			It("errors", func() {
 				Expect(func() {
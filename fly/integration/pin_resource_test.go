@@ -0,0 +1,101 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("PinResource", func() {
+	var (
+		flyCmd      *exec.Cmd
+		expectedURL = "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/pin"
+	)
+
+	Context("when a matching version exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSON(`{"version":{"ref":"abc123"}}`),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("pins the resource by its version fields", func() {
+			Expect(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", "mypipeline/myresource", "-v", "ref:abc123")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("pinned 'myresource' with version ref:abc123"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(2))
+		})
+	})
+
+	Context("when an expiry and comment are given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSON(`{"version":{"ref":"abc123"},"pin_comment":"broken upstream","expires_in":"24h0m0s"}`),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("sends them along", func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", "mypipeline/myresource", "-v", "ref:abc123", "-c", "broken upstream", "--expires-in", "24h")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("pinned 'myresource' with version ref:abc123 for 24h0m0s"))
+		})
+	})
+
+	Context("when no matching version exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+		})
+
+		It("fails", func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", "mypipeline/myresource", "-v", "ref:abc123")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("pipeline 'mypipeline', resource 'myresource' or a version matching 'ref:abc123' not found"))
+		})
+	})
+
+	Context("when the version is not specified", func() {
+		It("fails and says the version is required", func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", "mypipeline/myresource")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("v", "version") + "' was not specified"))
+		})
+	})
+})
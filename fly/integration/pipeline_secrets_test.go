@@ -0,0 +1,138 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-secrets", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-secrets", "-p", "some-pipeline")
+		})
+
+		Context("when not specifying a pipeline name", func() {
+			It("fails and says you should give a pipeline name", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-secrets")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+			})
+		})
+
+		Context("when lookups are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/secret-lookups"),
+						ghttp.RespondWithJSONEncoded(200, []atc.SecretLookup{
+							{
+								UsedByType: "job",
+								UsedByName: "some-job",
+								Name:       "some-var",
+								Backend:    "vault",
+								Path:       "/concourse/main/some-var",
+								Found:      true,
+							},
+							{
+								UsedByType: "resource",
+								UsedByName: "some-resource",
+								Name:       "missing-var",
+								Backend:    "vault",
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"used_by_type": "job",
+							"used_by_name": "some-job",
+							"name": "some-var",
+							"backend": "vault",
+							"path": "/concourse/main/some-var",
+							"found": true,
+							"last_looked_up": 0
+						},
+						{
+							"used_by_type": "resource",
+							"used_by_name": "some-resource",
+							"name": "missing-var",
+							"backend": "vault",
+							"found": false,
+							"last_looked_up": 0
+						}
+					]`))
+				})
+			})
+
+			It("shows where each var was found", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "used by", Color: color.New(color.Bold)},
+						{Contents: "type", Color: color.New(color.Bold)},
+						{Contents: "var", Color: color.New(color.Bold)},
+						{Contents: "backend", Color: color.New(color.Bold)},
+						{Contents: "path", Color: color.New(color.Bold)},
+						{Contents: "found", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "some-job"}, {Contents: "job"}, {Contents: "some-var"}, {Contents: "vault"}, {Contents: "/concourse/main/some-var"}, {Contents: "yes"}},
+						{{Contents: "some-resource"}, {Contents: "resource"}, {Contents: "missing-var"}, {Contents: "vault"}, {Contents: "n/a", Color: ui.OffColor}, {Contents: "no", Color: ui.FailedColor}},
+					},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/secret-lookups"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline not found"))
+			})
+		})
+	})
+})
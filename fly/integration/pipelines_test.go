@@ -182,6 +182,82 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when --label is given", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines", "--label", "env=prod", "--label", "owner")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines", "label=env%3Dprod&label=owner"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", Labels: map[string]string{"env": "prod", "owner": "some-owner"}},
+							}),
+						),
+					)
+				})
+
+				It("asks the API for the pipelines with the labels", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}},
+						},
+					}))
+				})
+			})
+
+			Context("when instances of a pipeline are returned", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.2"}},
+								{Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.3"}, Paused: true},
+							}),
+						),
+					)
+				})
+
+				It("names each instance by its vars", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "release/branch:1.2"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "release/branch:1.3"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}},
+						},
+					}))
+				})
+			})
+
+			Context("when a malformed --label is given", func() {
+				It("fails without asking the API", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines", "--label", "=prod")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(1))
+
+					Expect(sess.Err).To(gbytes.Say("error: invalid --label: label key cannot be empty"))
+				})
+			})
+
 			Context("completion", func() {
 				BeforeEach(func() {
 					flyCmd = exec.Command(flyPath, "-t", targetName, "get-pipeline", "-p", "some-")
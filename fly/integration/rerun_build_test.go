@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rerun-build", func() {
+		var path string
+
+		BeforeEach(func() {
+			var err error
+			path, err = atc.Routes.CreatePathForRoute(atc.RerunJobBuild, rata.Params{
+				"pipeline_name": "awesome-pipeline",
+				"job_name":      "awesome-job",
+				"build_name":    "42",
+				"team_name":     "main",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", path),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{
+							ID:      58,
+							Name:    "43",
+							RerunOf: &atc.RerunOfBuild{ID: 57, Name: "42"},
+						}),
+					),
+				)
+			})
+
+			It("starts the rerun build", func() {
+				Expect(func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #43 \(rerun of #42\)`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", path),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("build not found"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when the build flag is not provided", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rerun-build", "-j", "awesome-pipeline/awesome-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("the required flag `-b, --build' was not specified"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,202 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var resourceTypes atc.ResourceTypes
+
+	BeforeEach(func() {
+		resourceTypes = atc.ResourceTypes{
+			{
+				Name:     "some-type",
+				Type:     "registry-image",
+				Source:   atc.Source{"repository": "some-repository"},
+				Defaults: atc.Source{"nested": map[string]interface{}{"key": "value"}},
+			},
+		}
+	})
+
+	Describe("get-resource-types", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/resource_types"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, resourceTypes),
+				),
+			)
+		})
+
+		It("prints the team's resource types as json when --json is given", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-resource-types", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var printed struct {
+				ResourceTypes atc.ResourceTypes `json:"resource_types"`
+			}
+			err = json.Unmarshal(sess.Out.Contents(), &printed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printed.ResourceTypes).To(Equal(resourceTypes))
+		})
+
+		It("prints only the given fields of the team's resource types", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-resource-types", "--field", ".resource_types[0].name")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(string(sess.Out.Contents())).To(Equal("some-type\n"))
+		})
+
+		It("fails when both --json and --output are given", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-resource-types", "--json", "--output", "yaml")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("--json and --output are mutually exclusive"))
+		})
+
+		It("prints the team's resource types as yaml", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-resource-types")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("resource_types:"))
+			Expect(sess.Out).To(gbytes.Say("name: some-type"))
+		})
+	})
+
+	Describe("set-resource-types", func() {
+		var (
+			tmpdir     string
+			configFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-resource-types")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(tmpdir, "resource-types.yml")
+			err = ioutil.WriteFile(configFile, []byte(`---
+resource_types:
+- name: some-type
+  type: registry-image
+  source:
+    repository: some-repository
+  defaults:
+    nested:
+      key: value
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when the resource types changed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/resource_types"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ResourceTypes{}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/resource_types"),
+						ghttp.VerifyJSONRepresenting(resourceTypes),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("shows the diff and sets them", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-resource-types", "-n", "-c", configFile)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say("resource types:"))
+				Expect(sess.Out).To(gbytes.Say("resource type some-type has been added"))
+				Expect(sess.Out).To(gbytes.Say("resource types of team main updated"))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(5))
+			})
+		})
+
+		Context("when nothing changed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/resource_types"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, resourceTypes),
+					),
+				)
+			})
+
+			It("does not set them", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-resource-types", "-n", "-c", configFile)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+			})
+		})
+
+		Context("when the resource types are rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/resource_types"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ResourceTypes{}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/resource_types"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["invalid resource types"]}`),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-resource-types", "-n", "-c", configFile)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
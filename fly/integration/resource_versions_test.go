@@ -107,5 +107,248 @@ var _ = Describe("Fly CLI", func() {
 				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
 			})
 		})
+
+		Context("when neither a resource nor a job is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions")
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Either --resource or --job must be specified"))
+			})
+		})
+
+		Context("when a job is given without --diff or --provenance", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions", "-j", "pipeline/some-job")
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--job requires --diff or --provenance"))
+			})
+		})
+
+		Context("when --diff is given without a job", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--diff", "41..42")
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--diff and --provenance require --job"))
+			})
+		})
+
+		Context("when diffing two builds of a job", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions", "-j", "pipeline/some-job", "--diff", "41..42")
+			})
+
+			Context("when the diff is malformed", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions", "-j", "pipeline/some-job", "--diff", "41")
+				})
+
+				It("fails", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("--diff must be of the form FROM..TO"))
+				})
+			})
+
+			Context("when the diff is returned from the API", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/some-job/builds/42/input_diff", "from=41"),
+							ghttp.RespondWithJSONEncoded(200, atc.BuildInputDiff{
+								FromBuild: "41",
+								ToBuild:   "42",
+								Changes: []atc.BuildInputChange{
+									{
+										Name:     "some-input",
+										Resource: "some-resource",
+										From:     &atc.ResourceVersion{ID: 1, Version: atc.Version{"ref": "a"}},
+										To: &atc.ResourceVersion{
+											ID:       2,
+											Version:  atc.Version{"ref": "b"},
+											Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+										},
+									},
+									{
+										Name:     "new-input",
+										Resource: "other-resource",
+										To:       &atc.ResourceVersion{ID: 3, Version: atc.Version{"ref": "c"}},
+									},
+								},
+							}),
+						),
+					)
+				})
+
+				It("lists the changed inputs", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "resource", Color: color.New(color.Bold)},
+							{Contents: "from", Color: color.New(color.Bold)},
+							{Contents: "to", Color: color.New(color.Bold)},
+							{Contents: "metadata", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "some-input"}, {Contents: "some-resource"}, {Contents: "ref:a"}, {Contents: "ref:b"}, {Contents: "author:someone"}},
+							{{Contents: "new-input"}, {Contents: "other-resource"}, {Contents: "n/a", Color: ui.OffColor}, {Contents: "ref:c"}, {Contents: "none", Color: ui.OffColor}},
+						},
+					}))
+				})
+
+				Context("when --json is given", func() {
+					BeforeEach(func() {
+						flyCmd.Args = append(flyCmd.Args, "--json")
+					})
+
+					It("prints the diff as json", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(0))
+
+						Expect(sess.Out.Contents()).To(MatchJSON(`{
+							"from_build": "41",
+							"to_build": "42",
+							"changes": [
+								{
+									"name": "some-input",
+									"resource": "some-resource",
+									"from": {"id": 1, "version": {"ref": "a"}, "enabled": false},
+									"to": {"id": 2, "version": {"ref": "b"}, "metadata": [{"name": "author", "value": "someone"}], "enabled": false}
+								},
+								{
+									"name": "new-input",
+									"resource": "other-resource",
+									"to": {"id": 3, "version": {"ref": "c"}, "enabled": false}
+								}
+							]
+						}`))
+					})
+				})
+			})
+
+			Context("when the build is not found", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/some-job/builds/42/input_diff"),
+							ghttp.RespondWith(404, ""),
+						),
+					)
+				})
+
+				It("fails", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("job or build not found"))
+				})
+			})
+		})
+
+		Context("when tracing the provenance of a build", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "resource-versions", "-j", "pipeline/some-job", "--provenance", "42")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/some-job/builds/42/provenance"),
+						ghttp.RespondWithJSONEncoded(200, []atc.VersionProvenance{
+							{
+								Resource: "some-resource",
+								Version:  atc.ResourceVersion{ID: 1, Version: atc.Version{"ref": "a"}},
+								JobName:  "some-job",
+								Build:    "42",
+							},
+							{
+								Resource: "other-resource",
+								Version: atc.ResourceVersion{
+									ID:       2,
+									Version:  atc.Version{"ref": "b"},
+									Metadata: []atc.MetadataField{{Name: "author", Value: "someone"}},
+								},
+								JobName: "upstream-job",
+								Build:   "7",
+								Depth:   1,
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the versions which flowed into the build", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "depth", Color: color.New(color.Bold)},
+						{Contents: "job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "resource", Color: color.New(color.Bold)},
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "metadata", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "0"}, {Contents: "some-job"}, {Contents: "42"}, {Contents: "some-resource"}, {Contents: "ref:a"}, {Contents: "none", Color: ui.OffColor}},
+						{{Contents: "1"}, {Contents: "upstream-job"}, {Contents: "7"}, {Contents: "other-resource"}, {Contents: "ref:b"}, {Contents: "author:someone"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the provenance as json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"resource": "some-resource",
+							"version": {"id": 1, "version": {"ref": "a"}, "enabled": false},
+							"job_name": "some-job",
+							"build_name": "42",
+							"depth": 0
+						},
+						{
+							"resource": "other-resource",
+							"version": {"id": 2, "version": {"ref": "b"}, "metadata": [{"name": "author", "value": "someone"}], "enabled": false},
+							"job_name": "upstream-job",
+							"build_name": "7",
+							"depth": 1
+						}
+					]`))
+				})
+			})
+		})
 	})
 })
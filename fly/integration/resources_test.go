@@ -52,6 +52,12 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.RespondWithJSONEncoded(200, []atc.Resource{
 							createResource(1, nil, "time"),
 							createResource(2, atc.Version{"some": "version"}, "custom"),
+							{
+								Name:          "resource-3",
+								Type:          "git",
+								PinnedVersion: atc.Version{"ref": "abc123"},
+								PinnedBy:      "some-user",
+							},
 						}),
 					),
 				)
@@ -80,6 +86,14 @@ var _ = Describe("Fly CLI", func() {
                 "team_name": "",
                 "type": "custom",
 								"pinned_version": {"some": "version"}
+              },
+              {
+                "name": "resource-3",
+                "pipeline_name": "",
+                "team_name": "",
+                "type": "git",
+                "pinned_version": {"ref": "abc123"},
+                "pinned_by": "some-user"
               }
             ]`))
 				})
@@ -92,8 +106,9 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "resource-1"}, {Contents: "time"}, {Contents: "n/a"}},
-						{{Contents: "resource-2"}, {Contents: "custom"}, {Contents: "some:version", Color: color.New(color.FgCyan)}},
+						{{Contents: "resource-1"}, {Contents: "time"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "resource-2"}, {Contents: "custom"}, {Contents: "some:version", Color: color.New(color.FgCyan)}, {Contents: "unknown"}},
+						{{Contents: "resource-3"}, {Contents: "git"}, {Contents: "ref:abc123", Color: color.New(color.FgCyan)}, {Contents: "some-user"}},
 					},
 				}))
 			})
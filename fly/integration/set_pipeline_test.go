@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"regexp"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -679,6 +680,98 @@ this is super secure
 							}).By(3))
 						})
 					})
+
+					Context("when the --dry-run option is also used", func() {
+						var saveConfigResponse atc.SaveConfigResponse
+						var saveConfigStatus int
+
+						BeforeEach(func() {
+							saveConfigStatus = http.StatusOK
+							saveConfigResponse = atc.SaveConfigResponse{
+								CredentialChecks: []atc.CredentialCheck{
+									{
+										Name:    "param-b",
+										Backend: "vault",
+										Status:  atc.CredentialCheckFound,
+										Path:    "/concourse/main/param-b",
+									},
+								},
+							}
+						})
+
+						JustBeforeEach(func() {
+							path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+							Expect(err).NotTo(HaveOccurred())
+
+							atcServer.RouteToHandler("PUT", path,
+								ghttp.CombineHandlers(
+									ghttp.VerifyRequest("PUT", path, atc.SaveConfigDryRun+"=&"+atc.SaveConfigCheckCreds+"="),
+									ghttp.RespondWithJSONEncodedPtr(&saveConfigStatus, &saveConfigResponse),
+								),
+							)
+						})
+
+						It("shows the credential checks without saving", func() {
+							flyCmd := exec.Command(
+								flyPath, "-t", targetName,
+								"set-pipeline",
+								"--pipeline", "awesome-pipeline",
+								"-c", "fixtures/vars-pipeline.yml",
+								"-l", "fixtures/vars-pipeline-params-a.yml",
+								"-l", "fixtures/vars-pipeline-params-types.yml",
+								"--check-creds",
+								"--dry-run",
+							)
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							Eventually(sess).Should(gbytes.Say(`param-b\s+vault\s+found\s+/concourse/main/param-b`))
+							Eventually(sess).Should(gbytes.Say(`dry run: configuration is valid and was not saved`))
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+						})
+
+						Context("when a credential is missing", func() {
+							BeforeEach(func() {
+								saveConfigStatus = http.StatusBadRequest
+								saveConfigResponse = atc.SaveConfigResponse{
+									Errors: []string{"Expected to find variables: param-b"},
+									CredentialChecks: []atc.CredentialCheck{
+										{
+											Name:     "param-b",
+											Backend:  "vault",
+											Status:   atc.CredentialCheckNotFound,
+											Searched: []string{"/concourse/main/awesome-pipeline/param-b", "/concourse/main/param-b"},
+										},
+									},
+								}
+							})
+
+							It("shows where it looked and fails", func() {
+								flyCmd := exec.Command(
+									flyPath, "-t", targetName,
+									"set-pipeline",
+									"--pipeline", "awesome-pipeline",
+									"-c", "fixtures/vars-pipeline.yml",
+									"-l", "fixtures/vars-pipeline-params-a.yml",
+									"-l", "fixtures/vars-pipeline-params-types.yml",
+									"--check-creds",
+									"--dry-run",
+								)
+
+								sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+								Expect(err).NotTo(HaveOccurred())
+
+								Eventually(sess).Should(gbytes.Say(`param-b\s+vault\s+not_found\s+/concourse/main/awesome-pipeline/param-b, /concourse/main/param-b`))
+								Eventually(sess.Err).Should(gbytes.Say(`Expected to find variables: param-b`))
+
+								<-sess.Exited
+								Expect(sess.ExitCode()).NotTo(Equal(0))
+							})
+						})
+					})
 				})
 
 			})
@@ -754,7 +847,7 @@ this is super secure
 				})
 			})
 
-			Context("when not specifying a config file", func() {
+			Context("when not specifying a config file or a version to roll back to", func() {
 				It("fails and says you should give a config file", func() {
 					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline")
 
@@ -764,7 +857,7 @@ this is super secure
 					<-sess.Exited
 					Expect(sess.ExitCode()).To(Equal(1))
 
-					Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("c", "config") + "' was not specified"))
+					Expect(sess.Err).To(gbytes.Say("error: either --config or --rollback-to must be specified"))
 				})
 			})
 
@@ -960,6 +1053,167 @@ this is super secure
 				})
 			})
 
+			Context("when rolling back to a saved version", func() {
+				BeforeEach(func() {
+					changedConfig.Jobs = changedConfig.Jobs[:1]
+
+					versionPath, err := atc.Routes.CreatePathForRoute(atc.GetConfigVersion, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main", "config_version": "3"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("GET", versionPath,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: changedConfig}, http.Header{atc.ConfigVersionHeader: {"3"}}),
+					)
+
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							func(w http.ResponseWriter, r *http.Request) {
+								config := getConfig(r)
+								Expect(config).To(MatchYAML(payload))
+							},
+							ghttp.RespondWith(http.StatusOK, "{}"),
+						),
+					)
+				})
+
+				It("shows the changes and saves the saved version's config", func() {
+					Expect(func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "--rollback-to", "3")
+
+						stdin, err := flyCmd.StdinPipe()
+						Expect(err).NotTo(HaveOccurred())
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say("job some-other-job has been removed"))
+
+						Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+						yes(stdin)
+
+						Eventually(sess).Should(gbytes.Say("configuration updated"))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(4))
+				})
+
+				Context("when the version is not found", func() {
+					BeforeEach(func() {
+						versionPath, err := atc.Routes.CreatePathForRoute(atc.GetConfigVersion, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main", "config_version": "3"})
+						Expect(err).NotTo(HaveOccurred())
+
+						atcServer.RouteToHandler("GET", versionPath,
+							ghttp.RespondWith(http.StatusNotFound, ""),
+						)
+					})
+
+					It("fails and says the version was not found", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "--rollback-to", "3")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+
+						Expect(sess.Err).To(gbytes.Say("error: version 3 of pipeline 'awesome-pipeline' not found"))
+					})
+				})
+
+				Context("when a config file is also given", func() {
+					It("fails and says they cannot be given together", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "--rollback-to", "3", "-c", configFile.Name())
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+
+						Expect(sess.Err).To(gbytes.Say("error: --config and --rollback-to cannot be specified together"))
+					})
+				})
+			})
+
+			Context("when labels are given with --label", func() {
+				var expectedPayload []byte
+
+				BeforeEach(func() {
+					changedConfig.Labels = map[string]string{"env": "staging", "owner": "some-owner"}
+
+					expectedConfig := changedConfig
+					expectedConfig.Labels = map[string]string{"env": "prod", "owner": "some-owner", "tier": "web"}
+
+					var err error
+					expectedPayload, err = yaml.Marshal(expectedConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							func(w http.ResponseWriter, r *http.Request) {
+								config := getConfig(r)
+								Expect(config).To(MatchYAML(expectedPayload))
+							},
+							ghttp.RespondWith(http.StatusOK, "{}"),
+						),
+					)
+				})
+
+				It("merges them into the config's labels and shows the changes", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-n", "-p", "awesome-pipeline", "-c", configFile.Name(), "--label", "env=prod", "--label", "tier=web")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say("labels:"))
+					Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("env: prod", "green")))
+					Eventually(sess.Out.Contents).Should(ContainSubstring(ansi.Color("tier: web", "green")))
+
+					Eventually(sess).Should(gbytes.Say("configuration updated"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+
+				Context("when a label is malformed", func() {
+					It("fails and says the label is invalid", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--label", "env")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+
+						Expect(sess.Err).To(gbytes.Say("invalid label 'env' \\(must be key=value\\)"))
+					})
+				})
+
+				Context("when rolling back", func() {
+					It("fails and says they cannot be given together", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "--rollback-to", "3", "--label", "env=prod")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+
+						Expect(sess.Err).To(gbytes.Say("error: --label and --rollback-to cannot be specified together"))
+					})
+				})
+			})
+
 			Context("when configuring fails", func() {
 				BeforeEach(func() {
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
@@ -1040,6 +1294,35 @@ this is super secure
 				})
 			})
 
+			Context("when the server says this is the first time it's creating an instance of the pipeline", func() {
+				BeforeEach(func() {
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path, atc.InstanceVars{"branch": "1.2"}.QueryParams().Encode()),
+						ghttp.RespondWith(http.StatusCreated, "{}"),
+					))
+					config.Resources[0].Name = "updated-name"
+				})
+
+				It("succeeds and tells the user how to unpause the instance", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-n", "-p", "awesome-pipeline", "-c", configFile.Name(), "--instance-var", "branch=1.2")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					pipelineURL := urljoiner.Join(atcServer.URL(), "teams/main/pipelines", "awesome-pipeline") + "?" + atc.InstanceVars{"branch": "1.2"}.QueryParams().Encode()
+
+					Eventually(sess).Should(gbytes.Say("pipeline created!"))
+					Eventually(sess).Should(gbytes.Say(regexp.QuoteMeta(fmt.Sprintf("you can view your pipeline here: %s", pipelineURL))))
+					Eventually(sess).Should(gbytes.Say("    fly -t " + targetName + " unpause-pipeline -p awesome-pipeline --instance-var branch=1.2"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
 			Context("when the server returns warnings", func() {
 				BeforeEach(func() {
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
@@ -70,6 +70,56 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the targets as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{"name": "another-test", "url": "https://example.com/another-test", "team": "test", "expiry": "Sat, 19 Mar 2016 01:54:30 UTC"},
+					{"name": "no-token", "url": "https://example.com/no-token", "team": "main", "expiry": "n/a"},
+					{"name": "omt", "url": "https://example.com/omt", "team": "main", "expiry": "Mon, 21 Mar 2016 01:54:30 UTC"},
+					{"name": "test", "url": "https://example.com/test", "team": "test", "expiry": "Fri, 25 Mar 2016 23:29:57 UTC"}
+				]`))
+			})
+		})
+
+		Context("when --output yaml and --field are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--output", "yaml", "--field", ".name")
+			})
+
+			It("prints the field of each target as YAML", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(string(sess.Out.Contents())).To(Equal("- another-test\n- no-token\n- omt\n- test\n"))
+			})
+		})
+
+		Context("when only --field is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--field", ".name", "--field", ".team")
+			})
+
+			It("prints the fields of each target on a line", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(string(sess.Out.Contents())).To(Equal("another-test\ttest\nno-token\tmain\nomt\tmain\ntest\ttest\n"))
+			})
+		})
+
 		Context("when no targets are available", func() {
 			BeforeEach(func() {
 				os.RemoveAll(flyrc)
@@ -89,7 +89,7 @@ var _ = Describe("Fly CLI", func() {
 					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 					Expect(err).NotTo(HaveOccurred())
 
-					Eventually(sess.Err).Should(gbytes.Say(`was not specified`))
+					Eventually(sess.Err).Should(gbytes.Say(`one of --pipeline, --all, --glob or --label must be specified`))
 
 					<-sess.Exited
 					Expect(sess.ExitCode()).To(Equal(1))
@@ -1,8 +1,12 @@
 package integration_test
 
 import (
+	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/onsi/gomega/ghttp"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -83,6 +87,26 @@ var _ = Describe("Fly CLI", func() {
 			Expect(sess.Err).To(gbytes.Say("configuration invalid"))
 		})
 
+		It("returns invalid with the location of each error in a directory of fragments", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/fragments",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("  - invalid jobs:"))
+			Eventually(sess.Err).Should(gbytes.Say(`fixtures/fragments/jobs/test.yml:6: jobs.test.plan\[1\].get.some-other-repo refers to a resource that does not exist`))
+			Eventually(sess.Err).Should(gbytes.Say(`fixtures/fragments/jobs/build.yml:8: unknown key 'jobs\[0\].plan\[1\].privilegd'`))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Err).To(gbytes.Say("configuration invalid"))
+		})
+
 		It("returns invalid on validation warning with strict", func() {
 			flyCmd := exec.Command(
 				flyPath,
@@ -102,5 +126,106 @@ var _ = Describe("Fly CLI", func() {
 
 			Expect(sess.Err).To(gbytes.Say("configuration invalid"))
 		})
+
+		Context("when checking credentials", func() {
+			var saveConfigStatus int
+			var saveConfigResponse atc.SaveConfigResponse
+
+			BeforeEach(func() {
+				saveConfigStatus = http.StatusOK
+				saveConfigResponse = atc.SaveConfigResponse{
+					CredentialChecks: []atc.CredentialCheck{
+						{
+							Name:    "param-a",
+							Backend: "credhub",
+							Status:  atc.CredentialCheckFound,
+							Path:    "/concourse/main/param-a",
+						},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config", atc.SaveConfigDryRun+"=&"+atc.SaveConfigCheckCreds+"="),
+						ghttp.RespondWithJSONEncodedPtr(&saveConfigStatus, &saveConfigResponse),
+					),
+				)
+			})
+
+			It("requires a pipeline name", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName,
+					"validate-pipeline",
+					"-c", "fixtures/vars-pipeline.yml",
+					"--check-creds",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("the --pipeline flag is required when checking credentials"))
+			})
+
+			It("shows the result of checking each credential", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName,
+					"validate-pipeline",
+					"-c", "fixtures/vars-pipeline.yml",
+					"-l", "fixtures/vars-pipeline-params-types.yml",
+					"-p", "some-pipeline",
+					"--check-creds",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`param-a\s+credhub\s+found\s+/concourse/main/param-a`))
+				Eventually(sess).Should(gbytes.Say("looks good"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+
+			Context("when a credential cannot be looked up", func() {
+				BeforeEach(func() {
+					saveConfigStatus = http.StatusBadRequest
+					saveConfigResponse = atc.SaveConfigResponse{
+						Errors: []string{"permission denied"},
+						CredentialChecks: []atc.CredentialCheck{
+							{
+								Name:    "param-a",
+								Backend: "credhub",
+								Status:  atc.CredentialCheckErrored,
+								Error:   "permission denied",
+							},
+						},
+					}
+				})
+
+				It("shows the error and fails", func() {
+					flyCmd := exec.Command(
+						flyPath, "-t", targetName,
+						"validate-pipeline",
+						"-c", "fixtures/vars-pipeline.yml",
+						"-l", "fixtures/vars-pipeline-params-types.yml",
+						"-p", "some-pipeline",
+						"--check-creds",
+					)
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`param-a\s+credhub\s+errored\s+permission denied`))
+					Eventually(sess.Err).Should(gbytes.Say("invalid pipeline config"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
 	})
 })
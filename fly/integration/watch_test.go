@@ -222,5 +222,80 @@ var _ = Describe("Watching", func() {
 				watch("--job", "main/some-job", "--build", "3")
 			})
 		})
+
+		Context("when following the job", func() {
+			var jobRequests int
+
+			BeforeEach(func() {
+				jobRequests = 0
+
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job",
+					func(w http.ResponseWriter, r *http.Request) {
+						jobRequests++
+
+						job := atc.Job{
+							NextBuild: &atc.Build{ID: 3, Name: "3", Status: "started", JobName: "some-job"},
+						}
+
+						if jobRequests > 1 {
+							job = atc.Job{
+								NextBuild:     &atc.Build{ID: 4, Name: "4", Status: "started", JobName: "some-job"},
+								FinishedBuild: &atc.Build{ID: 3, Name: "3", Status: "succeeded", JobName: "some-job"},
+							}
+						}
+
+						ghttp.RespondWithJSONEncoded(200, job)(w, r)
+					},
+				)
+
+				buildEvents := func(payload string) http.HandlerFunc {
+					return func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+						w.WriteHeader(http.StatusOK)
+
+						for i, e := range []atc.Event{
+							event.Log{Payload: payload + "\n"},
+							event.Status{Status: atc.StatusSucceeded},
+						} {
+							data, err := json.Marshal(event.Message{Event: e})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{ID: fmt.Sprintf("%d", i), Name: "event", Data: data}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+						}
+
+						err := sse.Event{Name: "end"}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+					}
+				}
+
+				atcServer.RouteToHandler("GET", "/api/v1/builds/3/events", buildEvents("first build"))
+				atcServer.RouteToHandler("GET", "/api/v1/builds/4/events", buildEvents("second build"))
+			})
+
+			It("watches each new build as it starts", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--job", "some-pipeline/some-job", "--follow")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("watching some-pipeline/some-job #3"))
+				Eventually(sess.Out).Should(gbytes.Say("first build"))
+				Eventually(sess.Out, 5).Should(gbytes.Say("watching some-pipeline/some-job #4"))
+				Eventually(sess.Out).Should(gbytes.Say("second build"))
+
+				sess.Kill()
+				<-sess.Exited
+			})
+
+			It("requires a job", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--follow")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("--follow requires --job"))
+			})
+		})
 	})
 })
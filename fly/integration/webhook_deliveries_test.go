@@ -0,0 +1,115 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("webhook-deliveries", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "webhook-deliveries", "-c", "2")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks/deliveries", "limit=2"),
+					ghttp.RespondWithJSONEncoded(200, []atc.WebhookDelivery{
+						{
+							ID:       2,
+							Provider: "github",
+							Event:    "push",
+							Matched: []atc.WebhookMatch{
+								{PipelineName: "some-pipeline", ResourceName: "some-repo"},
+								{PipelineName: "other-pipeline", ResourceName: "some-repo"},
+							},
+							ReceivedAt: 200,
+						},
+						{
+							ID:         1,
+							Provider:   "gitlab",
+							Error:      "invalid webhook signature",
+							ReceivedAt: 100,
+						},
+					}),
+				),
+			)
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints response in json as stdout", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 2,
+						"provider": "github",
+						"event": "push",
+						"matched": [
+							{"pipeline_name": "some-pipeline", "resource_name": "some-repo"},
+							{"pipeline_name": "other-pipeline", "resource_name": "some-repo"}
+						],
+						"received_at": 200
+					},
+					{
+						"id": 1,
+						"provider": "gitlab",
+						"event": "",
+						"matched": null,
+						"error": "invalid webhook signature",
+						"received_at": 100
+					}
+				]`))
+			})
+		})
+
+		It("shows the deliveries and what they matched", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "received", Color: color.New(color.Bold)},
+					{Contents: "provider", Color: color.New(color.Bold)},
+					{Contents: "event", Color: color.New(color.Bold)},
+					{Contents: "matched", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "2"},
+						{Contents: time.Unix(200, 0).Local().Format("2006-01-02@15:04:05-0700")},
+						{Contents: "github"},
+						{Contents: "push"},
+						{Contents: "some-pipeline/some-repo, other-pipeline/some-repo"},
+					},
+					{
+						{Contents: "1"},
+						{Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")},
+						{Contents: "gitlab"},
+						{Contents: "n/a", Color: ui.OffColor},
+						{Contents: "invalid webhook signature", Color: ui.ErroredColor},
+					},
+				},
+			}))
+		})
+	})
+})
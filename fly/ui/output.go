@@ -0,0 +1,230 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// Output is how a command prints its result: as a table for people, or as
+// JSON or YAML for scripts. Fields narrows each result down to the values at
+// the given paths, e.g. '.name' or '.config.public'.
+type Output struct {
+	Format string
+	Fields []string
+}
+
+// Structured returns whether the result should be rendered by Render rather
+// than as the command's table.
+func (output Output) Structured() bool {
+	return output.Format == OutputJSON || output.Format == OutputYAML || len(output.Fields) > 0
+}
+
+// Render prints the value as JSON or YAML, using its JSON field names either
+// way. If fields are given, they are looked up on each element of a list or
+// on the value itself. Without a format, the values of the fields are printed
+// one result per line, separated by tabs.
+func (output Output) Render(dst io.Writer, value interface{}) error {
+	if output.Format == OutputJSON && len(output.Fields) == 0 {
+		return printJSON(dst, value)
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(payload, &generic)
+	if err != nil {
+		return err
+	}
+
+	if len(output.Fields) > 0 {
+		generic, err = output.selectFields(generic)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch output.Format {
+	case OutputJSON:
+		return printJSON(dst, generic)
+
+	case OutputYAML:
+		payload, err = yaml.Marshal(generic)
+		if err != nil {
+			return err
+		}
+
+		_, err = dst.Write(payload)
+		return err
+
+	default:
+		return output.renderFields(dst, generic)
+	}
+}
+
+func printJSON(dst io.Writer, value interface{}) error {
+	payload, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(dst, string(payload))
+	return err
+}
+
+func (output Output) selectFields(value interface{}) (interface{}, error) {
+	elements, isList := value.([]interface{})
+	if !isList {
+		return output.selectElementFields(value)
+	}
+
+	selected := make([]interface{}, len(elements))
+	for i, element := range elements {
+		var err error
+		selected[i], err = output.selectElementFields(element)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return selected, nil
+}
+
+func (output Output) selectElementFields(element interface{}) (interface{}, error) {
+	if len(output.Fields) == 1 {
+		return lookupField(element, output.Fields[0])
+	}
+
+	values := map[string]interface{}{}
+	for _, field := range output.Fields {
+		value, err := lookupField(element, field)
+		if err != nil {
+			return nil, err
+		}
+
+		values[strings.TrimPrefix(field, ".")] = value
+	}
+
+	return values, nil
+}
+
+func (output Output) renderFields(dst io.Writer, value interface{}) error {
+	rows, isList := value.([]interface{})
+	if !isList {
+		rows = []interface{}{value}
+	}
+
+	for _, row := range rows {
+		columns := []string{}
+
+		if len(output.Fields) == 1 {
+			columns = append(columns, plainValue(row))
+		} else {
+			values, _ := row.(map[string]interface{})
+			for _, field := range output.Fields {
+				columns = append(columns, plainValue(values[strings.TrimPrefix(field, ".")]))
+			}
+		}
+
+		_, err := fmt.Fprintln(dst, strings.Join(columns, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookupField follows a path of object keys and list indices, e.g.
+// '.config.inputs[0].name'. Missing keys select null.
+func lookupField(value interface{}, path string) (interface{}, error) {
+	for _, segment := range splitFieldPath(path) {
+		if value == nil {
+			return nil, nil
+		}
+
+		if strings.HasPrefix(segment, "[") {
+			index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid field '%s': index must be a number", path)
+			}
+
+			list, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid field '%s': cannot index a non-list", path)
+			}
+
+			if index < 0 || index >= len(list) {
+				value = nil
+				continue
+			}
+
+			value = list[index]
+			continue
+		}
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid field '%s': cannot look up '%s' in a non-object", path, segment)
+		}
+
+		value = object[segment]
+	}
+
+	return value, nil
+}
+
+func splitFieldPath(path string) []string {
+	segments := []string{}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		for part != "" {
+			bracket := strings.Index(part, "[")
+			switch {
+			case bracket == -1:
+				segments = append(segments, part)
+				part = ""
+			case bracket > 0:
+				segments = append(segments, part[:bracket])
+				part = part[bracket:]
+			default:
+				end := strings.Index(part, "]")
+				if end == -1 {
+					end = len(part) - 1
+				}
+
+				segments = append(segments, part[:end+1])
+				part = part[end+1:]
+			}
+		}
+	}
+
+	return segments
+}
+
+// plainValue prints strings as they are and anything else as JSON, like
+// `jq -r`.
+func plainValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		payload, _ := json.Marshal(v)
+		return string(payload)
+	}
+}
@@ -0,0 +1,134 @@
+package ui_test
+
+import (
+	. "github.com/concourse/concourse/fly/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Output", func() {
+	type config struct {
+		Public bool     `json:"public"`
+		Tags   []string `json:"tags"`
+	}
+
+	type thing struct {
+		Name   string `json:"name"`
+		ID     int    `json:"id"`
+		Config config `json:"config"`
+	}
+
+	var (
+		output Output
+		value  interface{}
+
+		buf       *gbytes.Buffer
+		renderErr error
+	)
+
+	BeforeEach(func() {
+		output = Output{}
+
+		value = []thing{
+			{Name: "some-thing", ID: 1, Config: config{Public: true, Tags: []string{"a", "b"}}},
+			{Name: "some-other-thing", ID: 2},
+		}
+
+		buf = gbytes.NewBuffer()
+	})
+
+	JustBeforeEach(func() {
+		renderErr = output.Render(buf, value)
+	})
+
+	Describe("Structured", func() {
+		It("is false for tables without fields", func() {
+			Expect(Output{Format: OutputTable}.Structured()).To(BeFalse())
+			Expect(Output{}.Structured()).To(BeFalse())
+		})
+
+		It("is true for JSON, YAML or when fields are selected", func() {
+			Expect(Output{Format: OutputJSON}.Structured()).To(BeTrue())
+			Expect(Output{Format: OutputYAML}.Structured()).To(BeTrue())
+			Expect(Output{Fields: []string{".name"}}.Structured()).To(BeTrue())
+		})
+	})
+
+	Context("as JSON", func() {
+		BeforeEach(func() {
+			output.Format = OutputJSON
+		})
+
+		It("prints the value as indented JSON", func() {
+			Expect(renderErr).NotTo(HaveOccurred())
+			Expect(buf.Contents()).To(MatchJSON(`[
+				{"name": "some-thing", "id": 1, "config": {"public": true, "tags": ["a", "b"]}},
+				{"name": "some-other-thing", "id": 2, "config": {"public": false, "tags": null}}
+			]`))
+		})
+
+		Context("with fields", func() {
+			BeforeEach(func() {
+				output.Fields = []string{".name", ".config.tags[1]"}
+			})
+
+			It("prints the fields of each element", func() {
+				Expect(renderErr).NotTo(HaveOccurred())
+				Expect(buf.Contents()).To(MatchJSON(`[
+					{"name": "some-thing", "config.tags[1]": "b"},
+					{"name": "some-other-thing", "config.tags[1]": null}
+				]`))
+			})
+		})
+	})
+
+	Context("as YAML", func() {
+		BeforeEach(func() {
+			output.Format = OutputYAML
+			value = thing{Name: "some-thing", ID: 1}
+		})
+
+		It("prints the value as YAML using its JSON field names", func() {
+			Expect(renderErr).NotTo(HaveOccurred())
+			Expect(string(buf.Contents())).To(Equal(`config:
+  public: false
+  tags: null
+id: 1
+name: some-thing
+`))
+		})
+	})
+
+	Context("with fields but no format", func() {
+		BeforeEach(func() {
+			output.Fields = []string{"name", ".config.public"}
+		})
+
+		It("prints the values of each element on a line", func() {
+			Expect(renderErr).NotTo(HaveOccurred())
+			Expect(string(buf.Contents())).To(Equal("some-thing\ttrue\nsome-other-thing\tfalse\n"))
+		})
+
+		Context("when a field looks up a key in a non-object", func() {
+			BeforeEach(func() {
+				output.Fields = []string{".name.first"}
+			})
+
+			It("returns an error", func() {
+				Expect(renderErr).To(MatchError("invalid field '.name.first': cannot look up 'first' in a non-object"))
+			})
+		})
+
+		Context("when an index is not a number", func() {
+			BeforeEach(func() {
+				output.Fields = []string{".config.tags[x]"}
+			})
+
+			It("returns an error", func() {
+				Expect(renderErr).To(MatchError("invalid field '.config.tags[x]': index must be a number"))
+			})
+		})
+	})
+})
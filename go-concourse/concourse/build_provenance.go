@@ -0,0 +1,62 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) BuildInputDiff(pipelineName string, jobName string, fromBuildName string, toBuildName string) (atc.BuildInputDiff, bool, error) {
+	params := rata.Params{
+		"team_name":     team.name,
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"build_name":    toBuildName,
+	}
+
+	var diff atc.BuildInputDiff
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DiffJobBuildInputs,
+		Params:      params,
+		Query:       url.Values{"from": {fromBuildName}},
+	}, &internal.Response{
+		Result: &diff,
+	})
+
+	switch err.(type) {
+	case nil:
+		return diff, true, nil
+	case internal.ResourceNotFoundError:
+		return diff, false, nil
+	default:
+		return diff, false, err
+	}
+}
+
+func (team *team) BuildProvenance(pipelineName string, jobName string, buildName string) ([]atc.VersionProvenance, bool, error) {
+	params := rata.Params{
+		"team_name":     team.name,
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"build_name":    buildName,
+	}
+
+	var provenance []atc.VersionProvenance
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobBuildProvenance,
+		Params:      params,
+	}, &internal.Response{
+		Result: &provenance,
+	})
+
+	switch err.(type) {
+	case nil:
+		return provenance, true, nil
+	case internal.ResourceNotFoundError:
+		return provenance, false, nil
+	default:
+		return provenance, false, err
+	}
+}
@@ -0,0 +1,159 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Provenance", func() {
+	Describe("BuildInputDiff", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42/input_diff"
+
+		var (
+			expectedDiff atc.BuildInputDiff
+
+			actualDiff atc.BuildInputDiff
+			found      bool
+			clientErr  error
+		)
+
+		BeforeEach(func() {
+			expectedDiff = atc.BuildInputDiff{
+				FromBuild: "41",
+				ToBuild:   "42",
+				Changes: []atc.BuildInputChange{
+					{
+						Name:     "some-input",
+						Resource: "some-resource",
+						From:     &atc.ResourceVersion{ID: 1, Version: atc.Version{"ref": "a"}},
+						To:       &atc.ResourceVersion{ID: 2, Version: atc.Version{"ref": "b"}},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			actualDiff, found, clientErr = team.BuildInputDiff("some-pipeline", "some-job", "41", "42")
+		})
+
+		Context("when the server returns the diff", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "from=41"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDiff),
+					),
+				)
+			})
+
+			It("returns it", func() {
+				Expect(clientErr).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(actualDiff).To(Equal(expectedDiff))
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns found of false and no error", func() {
+				Expect(clientErr).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server returns a 500 error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("BuildProvenance", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42/provenance"
+
+		var (
+			expectedProvenance []atc.VersionProvenance
+
+			actualProvenance []atc.VersionProvenance
+			found            bool
+			clientErr        error
+		)
+
+		BeforeEach(func() {
+			expectedProvenance = []atc.VersionProvenance{
+				{
+					Resource: "some-resource",
+					Version:  atc.ResourceVersion{ID: 1, Version: atc.Version{"ref": "a"}},
+					JobName:  "some-job",
+					Build:    "42",
+				},
+				{
+					Resource: "other-resource",
+					Version:  atc.ResourceVersion{ID: 2, Version: atc.Version{"ref": "b"}},
+					JobName:  "upstream-job",
+					Build:    "7",
+					Depth:    1,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			actualProvenance, found, clientErr = team.BuildProvenance("some-pipeline", "some-job", "42")
+		})
+
+		Context("when the server returns the provenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedProvenance),
+					),
+				)
+			})
+
+			It("returns it", func() {
+				Expect(clientErr).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(actualProvenance).To(Equal(expectedProvenance))
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns found of false and no error", func() {
+				Expect(clientErr).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
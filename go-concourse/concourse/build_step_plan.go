@@ -0,0 +1,44 @@
+package concourse
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) JobBuildStepPlan(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildStepPlan, bool, error) {
+	params := rata.Params{
+		"team_name":     team.name,
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"build_name":    buildName,
+	}
+
+	var stepPlan atc.BuildStepPlan
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobBuildStepPlan,
+		Params:      params,
+		Query:       url.Values{"step": {stepName}},
+	}, &internal.Response{
+		Result: &stepPlan,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return stepPlan, true, nil
+	case internal.ResourceNotFoundError:
+		return stepPlan, false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest {
+			return stepPlan, false, errors.New(e.Body)
+		}
+
+		return stepPlan, false, err
+	default:
+		return stepPlan, false, err
+	}
+}
@@ -0,0 +1,83 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Step Plan", func() {
+	Describe("JobBuildStepPlan", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42/step_plan"
+
+		var (
+			actualStepPlan atc.BuildStepPlan
+			found          bool
+			clientErr      error
+		)
+
+		JustBeforeEach(func() {
+			actualStepPlan, found, clientErr = team.JobBuildStepPlan("some-pipeline", "some-job", "42", "unit")
+		})
+
+		Context("when the step exists", func() {
+			var expectedStepPlan atc.BuildStepPlan
+
+			BeforeEach(func() {
+				expectedStepPlan = atc.BuildStepPlan{
+					Inputs: []atc.Plan{
+						{ID: "2", Get: &atc.GetPlan{Name: "repo", Version: &atc.Version{"ref": "abc"}}},
+					},
+					Step: atc.Plan{ID: "3", Task: &atc.TaskPlan{Name: "unit", ConfigPath: "repo/ci/unit.yml"}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "step=unit"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStepPlan),
+					),
+				)
+			})
+
+			It("returns the step plan", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(actualStepPlan).To(Equal(expectedStepPlan))
+			})
+		})
+
+		Context("when the step does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "step=unit"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the step is ambiguous", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "step=unit"),
+						ghttp.RespondWith(http.StatusBadRequest, "build has more than one task, specify one of: a, b"),
+					),
+				)
+			})
+
+			It("returns the error from the response", func() {
+				Expect(clientErr).To(MatchError("build has more than one task, specify one of: a, b"))
+			})
+		})
+	})
+})
@@ -53,6 +53,32 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
+func (team *team) RerunJobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
+	params := rata.Params{
+		"job_name":      jobName,
+		"build_name":    buildName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	switch err.(type) {
+	case nil:
+		return build, true, nil
+	case internal.ResourceNotFoundError:
+		return build, false, nil
+	default:
+		return build, false, err
+	}
+}
+
 func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
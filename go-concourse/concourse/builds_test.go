@@ -96,6 +96,60 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("RerunJobBuild", func() {
+		var expectedURL string
+
+		BeforeEach(func() {
+			expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild/rerun"
+		})
+
+		Context("when the build exists", func() {
+			var expectedBuild atc.Build
+
+			BeforeEach(func() {
+				expectedBuild = atc.Build{
+					ID:      124,
+					Name:    "mybuild.1",
+					Status:  "pending",
+					JobName: "myjob",
+					APIURL:  "api/v1/builds/124",
+					RerunOf: &atc.RerunOfBuild{ID: 123, Name: "mybuild"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+					),
+				)
+			})
+
+			It("returns the new build", func() {
+				build, found, err := team.RerunJobBuild("mypipeline", "myjob", "mybuild")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build).To(Equal(expectedBuild))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.RerunJobBuild("mypipeline", "myjob", "mybuild")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
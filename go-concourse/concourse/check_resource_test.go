@@ -14,21 +14,30 @@ import (
 
 var _ = Describe("CheckResource", func() {
 	Context("when ATC request succeeds", func() {
+		var expectedCheck atc.Check
+
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+			expectedCheck = atc.Check{
+				ID:         123,
+				Status:     "pending",
+				CreateTime: 100000000000,
+			}
+
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedCheck),
 				),
 			)
 		})
 
 		It("sends check resource request to ATC", func() {
-			found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			check, found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
+			Expect(check).To(Equal(expectedCheck))
 
 			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
 		})
@@ -36,7 +45,7 @@ var _ = Describe("CheckResource", func() {
 
 	Context("when pipeline or resource does not exist", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -47,43 +56,15 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("returns a ResourceNotFoundError", func() {
-			found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
 	})
 
-	Context("when ATC responds with an error", func() {
-		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
-
-			atcResponse := atc.CheckResponseBody{
-				ExitStatus: 1,
-				Stderr:     "bad version",
-			}
-
-			atcServer.AppendHandlers(
-				ghttp.CombineHandlers(
-					ghttp.VerifyRequest("POST", expectedURL),
-					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atcResponse),
-				),
-			)
-		})
-
-		It("returns an error", func() {
-			_, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
-			Expect(err).To(HaveOccurred())
-
-			cre, ok := err.(concourse.CommandFailedError)
-			Expect(ok).To(BeTrue())
-			Expect(cre.Error()).To(Equal("check failed with exit status '1':\nbad version\n"))
-		})
-	})
-
 	Context("when ATC responds with an internal server error", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/checks"
 
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
@@ -95,7 +76,7 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("returns an error with body", func() {
-			_, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, _, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.GenericError)
@@ -13,22 +13,31 @@ import (
 
 var _ = Describe("CheckResourceType", func() {
 	Context("when ATC request succeeds", func() {
+		var expectedCheck atc.Check
+
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/check"
+			expectedCheck = atc.Check{
+				ID:         123,
+				Status:     "pending",
+				CreateTime: 100000000000,
+			}
+
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/checks"
 
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
 					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"}}`),
-					ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedCheck),
 				),
 			)
 		})
 
 		It("sends check resource request to ATC", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			check, found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
+			Expect(check).To(Equal(expectedCheck))
 
 			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
 		})
@@ -36,7 +45,7 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("when pipeline or resource-type does not exist", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/checks"
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL),
@@ -46,7 +55,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns a ResourceNotFoundError", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -54,7 +63,7 @@ var _ = Describe("CheckResourceType", func() {
 
 	Context("when ATC responds with an internal server error", func() {
 		BeforeEach(func() {
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/check"
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/myresource/checks"
 
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
@@ -65,7 +74,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns an error", func() {
-			_, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, _, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.GenericError)
@@ -0,0 +1,88 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) Check(checkID string) (atc.Check, bool, error) {
+	params := rata.Params{
+		"check_id":  checkID,
+		"team_name": team.name,
+	}
+
+	var check atc.Check
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetCheck,
+		Params:      params,
+	}, &internal.Response{
+		Result: &check,
+	})
+
+	switch err.(type) {
+	case nil:
+		return check, true, nil
+	case internal.ResourceNotFoundError:
+		return check, false, nil
+	default:
+		return check, false, err
+	}
+}
+
+func (team *team) ListChecks(pipelineName string, limit int) ([]atc.Check, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var checks []atc.Check
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListChecks,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &checks,
+	})
+
+	return checks, err
+}
+
+func (team *team) ResourceChecks(pipelineName string, resourceName string, limit int) ([]atc.Check, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var checks []atc.Check
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceChecks,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &checks,
+	})
+
+	switch err.(type) {
+	case nil:
+		return checks, true, nil
+	case internal.ResourceNotFoundError:
+		return checks, false, nil
+	default:
+		return checks, false, err
+	}
+}
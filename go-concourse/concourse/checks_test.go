@@ -0,0 +1,141 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Checks", func() {
+	Describe("Check", func() {
+		expectedURL := "/api/v1/teams/some-team/checks/123"
+
+		Context("when the check exists", func() {
+			var expectedCheck atc.Check
+
+			BeforeEach(func() {
+				expectedCheck = atc.Check{
+					ID:           123,
+					PipelineName: "some-pipeline",
+					ResourceName: "some-resource",
+					Status:       atc.CheckStatusErrored,
+					CheckError:   "some-error",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedCheck),
+					),
+				)
+			})
+
+			It("returns the check", func() {
+				check, found, err := team.Check("123")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check).To(Equal(expectedCheck))
+			})
+		})
+
+		Context("when the check does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.Check("123")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ListChecks", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/checks"
+
+		var expectedChecks []atc.Check
+
+		BeforeEach(func() {
+			expectedChecks = []atc.Check{
+				{
+					ID:           1,
+					PipelineName: "some-pipeline",
+					ResourceName: "some-resource",
+					Status:       atc.CheckStatusSucceeded,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedChecks),
+				),
+			)
+		})
+
+		It("returns the pipeline's checks", func() {
+			checks, err := team.ListChecks("some-pipeline", 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(Equal(expectedChecks))
+		})
+	})
+	Describe("ResourceChecks", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/checks"
+
+		Context("when the resource exists", func() {
+			var expectedChecks []atc.Check
+
+			BeforeEach(func() {
+				expectedChecks = []atc.Check{
+					{
+						ID:           1,
+						PipelineName: "some-pipeline",
+						ResourceName: "some-resource",
+						Status:       atc.CheckStatusSucceeded,
+						Output:       "some-output",
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedChecks),
+					),
+				)
+			})
+
+			It("returns the resource's checks", func() {
+				checks, found, err := team.ResourceChecks("some-pipeline", "some-resource", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(checks).To(Equal(expectedChecks))
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.ResourceChecks("some-pipeline", "some-resource", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
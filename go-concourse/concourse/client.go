@@ -27,7 +27,7 @@ type Client interface {
 	LandWorker(workerName string) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
-	ListPipelines() ([]atc.Pipeline, error)
+	ListPipelines(labelSelectors ...string) ([]atc.Pipeline, error)
 	ListTeams() ([]atc.Team, error)
 	Team(teamName string) Team
 	UserInfo() (map[string]interface{}, error)
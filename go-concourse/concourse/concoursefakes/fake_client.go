@@ -158,9 +158,10 @@ type FakeClient struct {
 		result1 []atc.WorkerArtifact
 		result2 error
 	}
-	ListPipelinesStub        func() ([]atc.Pipeline, error)
+	ListPipelinesStub        func(...string) ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
+		arg1 []string
 	}
 	listPipelinesReturns struct {
 		result1 []atc.Pipeline
@@ -940,15 +941,16 @@ func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.Wor
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
+func (fake *FakeClient) ListPipelines(arg1 ...string) ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
 	fake.listPipelinesArgsForCall = append(fake.listPipelinesArgsForCall, struct {
-	}{})
-	fake.recordInvocation("ListPipelines", []interface{}{})
+		arg1 []string
+	}{arg1})
+	fake.recordInvocation("ListPipelines", []interface{}{arg1})
 	fake.listPipelinesMutex.Unlock()
 	if fake.ListPipelinesStub != nil {
-		return fake.ListPipelinesStub()
+		return fake.ListPipelinesStub(arg1...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -963,12 +965,19 @@ func (fake *FakeClient) ListPipelinesCallCount() int {
 	return len(fake.listPipelinesArgsForCall)
 }
 
-func (fake *FakeClient) ListPipelinesCalls(stub func() ([]atc.Pipeline, error)) {
+func (fake *FakeClient) ListPipelinesCalls(stub func(...string) ([]atc.Pipeline, error)) {
 	fake.listPipelinesMutex.Lock()
 	defer fake.listPipelinesMutex.Unlock()
 	fake.ListPipelinesStub = stub
 }
 
+func (fake *FakeClient) ListPipelinesArgsForCall(i int) []string {
+	fake.listPipelinesMutex.RLock()
+	defer fake.listPipelinesMutex.RUnlock()
+	argsForCall := fake.listPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeClient) ListPipelinesReturns(result1 []atc.Pipeline, result2 error) {
 	fake.listPipelinesMutex.Lock()
 	defer fake.listPipelinesMutex.Unlock()
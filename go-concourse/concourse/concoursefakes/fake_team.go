@@ -10,6 +10,24 @@ import (
 )
 
 type FakeTeam struct {
+	BuildInputDiffStub        func(string, string, string, string) (atc.BuildInputDiff, bool, error)
+	buildInputDiffMutex       sync.RWMutex
+	buildInputDiffArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	buildInputDiffReturns struct {
+		result1 atc.BuildInputDiff
+		result2 bool
+		result3 error
+	}
+	buildInputDiffReturnsOnCall map[int]struct {
+		result1 atc.BuildInputDiff
+		result2 bool
+		result3 error
+	}
 	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
@@ -26,6 +44,23 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	BuildProvenanceStub        func(string, string, string) ([]atc.VersionProvenance, bool, error)
+	buildProvenanceMutex       sync.RWMutex
+	buildProvenanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	buildProvenanceReturns struct {
+		result1 []atc.VersionProvenance
+		result2 bool
+		result3 error
+	}
+	buildProvenanceReturnsOnCall map[int]struct {
+		result1 []atc.VersionProvenance
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -75,7 +110,22 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	CheckResourceStub        func(string, string, atc.Version) (bool, error)
+	CheckStub        func(string) (atc.Check, bool, error)
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		arg1 string
+	}
+	checkReturns struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}
+	CheckResourceStub        func(string, string, atc.Version) (atc.Check, bool, error)
 	checkResourceMutex       sync.RWMutex
 	checkResourceArgsForCall []struct {
 		arg1 string
@@ -83,14 +133,16 @@ type FakeTeam struct {
 		arg3 atc.Version
 	}
 	checkResourceReturns struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
 	checkResourceReturnsOnCall map[int]struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
-	CheckResourceTypeStub        func(string, string, atc.Version) (bool, error)
+	CheckResourceTypeStub        func(string, string, atc.Version) (atc.Check, bool, error)
 	checkResourceTypeMutex       sync.RWMutex
 	checkResourceTypeArgsForCall []struct {
 		arg1 string
@@ -98,12 +150,14 @@ type FakeTeam struct {
 		arg3 atc.Version
 	}
 	checkResourceTypeReturns struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
 	checkResourceTypeReturnsOnCall map[int]struct {
-		result1 bool
-		result2 error
+		result1 atc.Check
+		result2 bool
+		result3 error
 	}
 	ClearTaskCacheStub        func(string, string, string, string) (int64, error)
 	clearTaskCacheMutex       sync.RWMutex
@@ -225,6 +279,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DeletePipelinesStub        func([]int) ([]atc.PipelineBatchResult, error)
+	deletePipelinesMutex       sync.RWMutex
+	deletePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	deletePipelinesReturns struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	deletePipelinesReturnsOnCall map[int]struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -279,6 +346,30 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ExposePipelinesStub        func([]int) ([]atc.PipelineBatchResult, error)
+	exposePipelinesMutex       sync.RWMutex
+	exposePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	exposePipelinesReturns struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	exposePipelinesReturnsOnCall map[int]struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	ForInstanceStub        func(atc.InstanceVars) concourse.Team
+	forInstanceMutex       sync.RWMutex
+	forInstanceArgsForCall []struct {
+		arg1 atc.InstanceVars
+	}
+	forInstanceReturns struct {
+		result1 concourse.Team
+	}
+	forInstanceReturnsOnCall map[int]struct {
+		result1 concourse.Team
+	}
 	GetArtifactStub        func(int) (io.ReadCloser, error)
 	getArtifactMutex       sync.RWMutex
 	getArtifactArgsForCall []struct {
@@ -318,6 +409,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	HidePipelinesStub        func([]int) ([]atc.PipelineBatchResult, error)
+	hidePipelinesMutex       sync.RWMutex
+	hidePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	hidePipelinesReturns struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	hidePipelinesReturnsOnCall map[int]struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
 	JobStub        func(string, string) (atc.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -351,6 +455,24 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	JobBuildStepPlanStub        func(string, string, string, string) (atc.BuildStepPlan, bool, error)
+	jobBuildStepPlanMutex       sync.RWMutex
+	jobBuildStepPlanArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	jobBuildStepPlanReturns struct {
+		result1 atc.BuildStepPlan
+		result2 bool
+		result3 error
+	}
+	jobBuildStepPlanReturnsOnCall map[int]struct {
+		result1 atc.BuildStepPlan
+		result2 bool
+		result3 error
+	}
 	JobBuildsStub        func(string, string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	jobBuildsMutex       sync.RWMutex
 	jobBuildsArgsForCall []struct {
@@ -370,6 +492,20 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	ListChecksStub        func(string, int) ([]atc.Check, error)
+	listChecksMutex       sync.RWMutex
+	listChecksArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listChecksReturns struct {
+		result1 []atc.Check
+		result2 error
+	}
+	listChecksReturnsOnCall map[int]struct {
+		result1 []atc.Check
+		result2 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -396,9 +532,10 @@ type FakeTeam struct {
 		result1 []atc.Job
 		result2 error
 	}
-	ListPipelinesStub        func() ([]atc.Pipeline, error)
+	ListPipelinesStub        func(...string) ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
+		arg1 []string
 	}
 	listPipelinesReturns struct {
 		result1 []atc.Pipeline
@@ -481,6 +618,34 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	PausePipelinesStub        func([]int) ([]atc.PipelineBatchResult, error)
+	pausePipelinesMutex       sync.RWMutex
+	pausePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	pausePipelinesReturns struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	pausePipelinesReturnsOnCall map[int]struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	PinResourceStub        func(string, string, atc.PinResourceRequestBody) (bool, error)
+	pinResourceMutex       sync.RWMutex
+	pinResourceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.PinResourceRequestBody
+	}
+	pinResourceReturns struct {
+		result1 bool
+		result2 error
+	}
+	pinResourceReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PipelineStub        func(string) (atc.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -531,6 +696,37 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigAtVersionStub        func(string, int) (atc.Config, bool, error)
+	pipelineConfigAtVersionMutex       sync.RWMutex
+	pipelineConfigAtVersionArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	pipelineConfigAtVersionReturns struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	pipelineConfigAtVersionReturnsOnCall map[int]struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	PipelineConfigVersionsStub        func(string) ([]atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionsMutex       sync.RWMutex
+	pipelineConfigVersionsArgsForCall []struct {
+		arg1 string
+	}
+	pipelineConfigVersionsReturns struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
 	RenamePipelineStub        func(string, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -559,6 +755,23 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	RerunJobBuildStub        func(string, string, string) (atc.Build, bool, error)
+	rerunJobBuildMutex       sync.RWMutex
+	rerunJobBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	rerunJobBuildReturns struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}
+	rerunJobBuildReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}
 	ResourceStub        func(string, string) (atc.Resource, bool, error)
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
@@ -575,6 +788,35 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	ResourceChecksStub        func(string, string, int) ([]atc.Check, bool, error)
+	resourceChecksMutex       sync.RWMutex
+	resourceChecksArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	resourceChecksReturns struct {
+		result1 []atc.Check
+		result2 bool
+		result3 error
+	}
+	resourceChecksReturnsOnCall map[int]struct {
+		result1 []atc.Check
+		result2 bool
+		result3 error
+	}
+	ResourceTypesStub        func() (atc.ResourceTypes, error)
+	resourceTypesMutex       sync.RWMutex
+	resourceTypesArgsForCall []struct {
+	}
+	resourceTypesReturns struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}
+	resourceTypesReturnsOnCall map[int]struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}
 	ResourceVersionsStub        func(string, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
 	resourceVersionsMutex       sync.RWMutex
 	resourceVersionsArgsForCall []struct {
@@ -594,6 +836,32 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	SecretLookupsStub        func(string) ([]atc.SecretLookup, bool, error)
+	secretLookupsMutex       sync.RWMutex
+	secretLookupsArgsForCall []struct {
+		arg1 string
+	}
+	secretLookupsReturns struct {
+		result1 []atc.SecretLookup
+		result2 bool
+		result3 error
+	}
+	secretLookupsReturnsOnCall map[int]struct {
+		result1 []atc.SecretLookup
+		result2 bool
+		result3 error
+	}
+	SetResourceTypesStub        func(atc.ResourceTypes) error
+	setResourceTypesMutex       sync.RWMutex
+	setResourceTypesArgsForCall []struct {
+		arg1 atc.ResourceTypes
+	}
+	setResourceTypesReturns struct {
+		result1 error
+	}
+	setResourceTypesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -621,6 +889,36 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	UnpausePipelinesStub        func([]int) ([]atc.PipelineBatchResult, error)
+	unpausePipelinesMutex       sync.RWMutex
+	unpausePipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	unpausePipelinesReturns struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	unpausePipelinesReturnsOnCall map[int]struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}
+	ValidatePipelineConfigStub        func(string, []byte, bool) ([]concourse.ConfigWarning, []atc.CredentialCheck, error)
+	validatePipelineConfigMutex       sync.RWMutex
+	validatePipelineConfigArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}
+	validatePipelineConfigReturns struct {
+		result1 []concourse.ConfigWarning
+		result2 []atc.CredentialCheck
+		result3 error
+	}
+	validatePipelineConfigReturnsOnCall map[int]struct {
+		result1 []concourse.ConfigWarning
+		result2 []atc.CredentialCheck
+		result3 error
+	}
 	VersionedResourceTypesStub        func(string) (atc.VersionedResourceTypes, bool, error)
 	versionedResourceTypesMutex       sync.RWMutex
 	versionedResourceTypesArgsForCall []struct {
@@ -636,10 +934,92 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	WebhookDeliveriesStub        func(int) ([]atc.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) BuildInputDiff(arg1 string, arg2 string, arg3 string, arg4 string) (atc.BuildInputDiff, bool, error) {
+	fake.buildInputDiffMutex.Lock()
+	ret, specificReturn := fake.buildInputDiffReturnsOnCall[len(fake.buildInputDiffArgsForCall)]
+	fake.buildInputDiffArgsForCall = append(fake.buildInputDiffArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("BuildInputDiff", []interface{}{arg1, arg2, arg3, arg4})
+	fake.buildInputDiffMutex.Unlock()
+	if fake.BuildInputDiffStub != nil {
+		return fake.BuildInputDiffStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildInputDiffReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) BuildInputDiffCallCount() int {
+	fake.buildInputDiffMutex.RLock()
+	defer fake.buildInputDiffMutex.RUnlock()
+	return len(fake.buildInputDiffArgsForCall)
+}
+
+func (fake *FakeTeam) BuildInputDiffCalls(stub func(string, string, string, string) (atc.BuildInputDiff, bool, error)) {
+	fake.buildInputDiffMutex.Lock()
+	defer fake.buildInputDiffMutex.Unlock()
+	fake.BuildInputDiffStub = stub
+}
+
+func (fake *FakeTeam) BuildInputDiffArgsForCall(i int) (string, string, string, string) {
+	fake.buildInputDiffMutex.RLock()
+	defer fake.buildInputDiffMutex.RUnlock()
+	argsForCall := fake.buildInputDiffArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) BuildInputDiffReturns(result1 atc.BuildInputDiff, result2 bool, result3 error) {
+	fake.buildInputDiffMutex.Lock()
+	defer fake.buildInputDiffMutex.Unlock()
+	fake.BuildInputDiffStub = nil
+	fake.buildInputDiffReturns = struct {
+		result1 atc.BuildInputDiff
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) BuildInputDiffReturnsOnCall(i int, result1 atc.BuildInputDiff, result2 bool, result3 error) {
+	fake.buildInputDiffMutex.Lock()
+	defer fake.buildInputDiffMutex.Unlock()
+	fake.BuildInputDiffStub = nil
+	if fake.buildInputDiffReturnsOnCall == nil {
+		fake.buildInputDiffReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildInputDiff
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildInputDiffReturnsOnCall[i] = struct {
+		result1 atc.BuildInputDiff
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
@@ -707,6 +1087,74 @@ func (fake *FakeTeam) BuildInputsForJobReturnsOnCall(i int, result1 []atc.BuildI
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) BuildProvenance(arg1 string, arg2 string, arg3 string) ([]atc.VersionProvenance, bool, error) {
+	fake.buildProvenanceMutex.Lock()
+	ret, specificReturn := fake.buildProvenanceReturnsOnCall[len(fake.buildProvenanceArgsForCall)]
+	fake.buildProvenanceArgsForCall = append(fake.buildProvenanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("BuildProvenance", []interface{}{arg1, arg2, arg3})
+	fake.buildProvenanceMutex.Unlock()
+	if fake.BuildProvenanceStub != nil {
+		return fake.BuildProvenanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildProvenanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) BuildProvenanceCallCount() int {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	return len(fake.buildProvenanceArgsForCall)
+}
+
+func (fake *FakeTeam) BuildProvenanceCalls(stub func(string, string, string) ([]atc.VersionProvenance, bool, error)) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = stub
+}
+
+func (fake *FakeTeam) BuildProvenanceArgsForCall(i int) (string, string, string) {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	argsForCall := fake.buildProvenanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) BuildProvenanceReturns(result1 []atc.VersionProvenance, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	fake.buildProvenanceReturns = struct {
+		result1 []atc.VersionProvenance
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) BuildProvenanceReturnsOnCall(i int, result1 []atc.VersionProvenance, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	if fake.buildProvenanceReturnsOnCall == nil {
+		fake.buildProvenanceReturnsOnCall = make(map[int]struct {
+			result1 []atc.VersionProvenance
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildProvenanceReturnsOnCall[i] = struct {
+		result1 []atc.VersionProvenance
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -909,34 +1357,100 @@ func (fake *FakeTeam) BuildsWithVersionAsOutputReturnsOnCall(i int, result1 []at
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResource(arg1 string, arg2 string, arg3 atc.Version) (bool, error) {
-	fake.checkResourceMutex.Lock()
-	ret, specificReturn := fake.checkResourceReturnsOnCall[len(fake.checkResourceArgsForCall)]
-	fake.checkResourceArgsForCall = append(fake.checkResourceArgsForCall, struct {
+func (fake *FakeTeam) Check(arg1 string) (atc.Check, bool, error) {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
 		arg1 string
-		arg2 string
-		arg3 atc.Version
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("CheckResource", []interface{}{arg1, arg2, arg3})
-	fake.checkResourceMutex.Unlock()
-	if fake.CheckResourceStub != nil {
-		return fake.CheckResourceStub(arg1, arg2, arg3)
+	}{arg1})
+	fake.recordInvocation("Check", []interface{}{arg1})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.checkResourceReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.checkReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeTeam) CheckResourceCallCount() int {
-	fake.checkResourceMutex.RLock()
-	defer fake.checkResourceMutex.RUnlock()
-	return len(fake.checkResourceArgsForCall)
+func (fake *FakeTeam) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
 }
 
-func (fake *FakeTeam) CheckResourceCalls(stub func(string, string, atc.Version) (bool, error)) {
-	fake.checkResourceMutex.Lock()
+func (fake *FakeTeam) CheckCalls(stub func(string) (atc.Check, bool, error)) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakeTeam) CheckArgsForCall(i int) string {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	argsForCall := fake.checkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CheckReturns(result1 atc.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CheckReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 atc.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CheckResource(arg1 string, arg2 string, arg3 atc.Version) (atc.Check, bool, error) {
+	fake.checkResourceMutex.Lock()
+	ret, specificReturn := fake.checkResourceReturnsOnCall[len(fake.checkResourceArgsForCall)]
+	fake.checkResourceArgsForCall = append(fake.checkResourceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.Version
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckResource", []interface{}{arg1, arg2, arg3})
+	fake.checkResourceMutex.Unlock()
+	if fake.CheckResourceStub != nil {
+		return fake.CheckResourceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkResourceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CheckResourceCallCount() int {
+	fake.checkResourceMutex.RLock()
+	defer fake.checkResourceMutex.RUnlock()
+	return len(fake.checkResourceArgsForCall)
+}
+
+func (fake *FakeTeam) CheckResourceCalls(stub func(string, string, atc.Version) (atc.Check, bool, error)) {
+	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = stub
 }
@@ -948,33 +1462,36 @@ func (fake *FakeTeam) CheckResourceArgsForCall(i int) (string, string, atc.Versi
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeTeam) CheckResourceReturns(result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceReturns(result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = nil
 	fake.checkResourceReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceMutex.Lock()
 	defer fake.checkResourceMutex.Unlock()
 	fake.CheckResourceStub = nil
 	if fake.checkResourceReturnsOnCall == nil {
 		fake.checkResourceReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+			result1 atc.Check
+			result2 bool
+			result3 error
 		})
 	}
 	fake.checkResourceReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Version) (bool, error) {
+func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Version) (atc.Check, bool, error) {
 	fake.checkResourceTypeMutex.Lock()
 	ret, specificReturn := fake.checkResourceTypeReturnsOnCall[len(fake.checkResourceTypeArgsForCall)]
 	fake.checkResourceTypeArgsForCall = append(fake.checkResourceTypeArgsForCall, struct {
@@ -988,10 +1505,10 @@ func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Versi
 		return fake.CheckResourceTypeStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.checkResourceTypeReturns
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeTeam) CheckResourceTypeCallCount() int {
@@ -1000,7 +1517,7 @@ func (fake *FakeTeam) CheckResourceTypeCallCount() int {
 	return len(fake.checkResourceTypeArgsForCall)
 }
 
-func (fake *FakeTeam) CheckResourceTypeCalls(stub func(string, string, atc.Version) (bool, error)) {
+func (fake *FakeTeam) CheckResourceTypeCalls(stub func(string, string, atc.Version) (atc.Check, bool, error)) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = stub
@@ -1013,30 +1530,33 @@ func (fake *FakeTeam) CheckResourceTypeArgsForCall(i int) (string, string, atc.V
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeTeam) CheckResourceTypeReturns(result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceTypeReturns(result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = nil
 	fake.checkResourceTypeReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 atc.Check, result2 bool, result3 error) {
 	fake.checkResourceTypeMutex.Lock()
 	defer fake.checkResourceTypeMutex.Unlock()
 	fake.CheckResourceTypeStub = nil
 	if fake.checkResourceTypeReturnsOnCall == nil {
 		fake.checkResourceTypeReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+			result1 atc.Check
+			result2 bool
+			result3 error
 		})
 	}
 	fake.checkResourceTypeReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+		result1 atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeTeam) ClearTaskCache(arg1 string, arg2 string, arg3 string, arg4 string) (int64, error) {
@@ -1568,6 +2088,74 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeletePipelines(arg1 []int) ([]atc.PipelineBatchResult, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deletePipelinesMutex.Lock()
+	ret, specificReturn := fake.deletePipelinesReturnsOnCall[len(fake.deletePipelinesArgsForCall)]
+	fake.deletePipelinesArgsForCall = append(fake.deletePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("DeletePipelines", []interface{}{arg1Copy})
+	fake.deletePipelinesMutex.Unlock()
+	if fake.DeletePipelinesStub != nil {
+		return fake.DeletePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deletePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeletePipelinesCallCount() int {
+	fake.deletePipelinesMutex.RLock()
+	defer fake.deletePipelinesMutex.RUnlock()
+	return len(fake.deletePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) DeletePipelinesCalls(stub func([]int) ([]atc.PipelineBatchResult, error)) {
+	fake.deletePipelinesMutex.Lock()
+	defer fake.deletePipelinesMutex.Unlock()
+	fake.DeletePipelinesStub = stub
+}
+
+func (fake *FakeTeam) DeletePipelinesArgsForCall(i int) []int {
+	fake.deletePipelinesMutex.RLock()
+	defer fake.deletePipelinesMutex.RUnlock()
+	argsForCall := fake.deletePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeletePipelinesReturns(result1 []atc.PipelineBatchResult, result2 error) {
+	fake.deletePipelinesMutex.Lock()
+	defer fake.deletePipelinesMutex.Unlock()
+	fake.DeletePipelinesStub = nil
+	fake.deletePipelinesReturns = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeletePipelinesReturnsOnCall(i int, result1 []atc.PipelineBatchResult, result2 error) {
+	fake.deletePipelinesMutex.Lock()
+	defer fake.deletePipelinesMutex.Unlock()
+	fake.DeletePipelinesStub = nil
+	if fake.deletePipelinesReturnsOnCall == nil {
+		fake.deletePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineBatchResult
+			result2 error
+		})
+	}
+	fake.deletePipelinesReturnsOnCall[i] = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DestroyTeam(arg1 string) error {
 	fake.destroyTeamMutex.Lock()
 	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
@@ -1821,6 +2409,134 @@ func (fake *FakeTeam) ExposePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ExposePipelines(arg1 []int) ([]atc.PipelineBatchResult, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.exposePipelinesMutex.Lock()
+	ret, specificReturn := fake.exposePipelinesReturnsOnCall[len(fake.exposePipelinesArgsForCall)]
+	fake.exposePipelinesArgsForCall = append(fake.exposePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("ExposePipelines", []interface{}{arg1Copy})
+	fake.exposePipelinesMutex.Unlock()
+	if fake.ExposePipelinesStub != nil {
+		return fake.ExposePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.exposePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ExposePipelinesCallCount() int {
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
+	return len(fake.exposePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) ExposePipelinesCalls(stub func([]int) ([]atc.PipelineBatchResult, error)) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = stub
+}
+
+func (fake *FakeTeam) ExposePipelinesArgsForCall(i int) []int {
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
+	argsForCall := fake.exposePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ExposePipelinesReturns(result1 []atc.PipelineBatchResult, result2 error) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = nil
+	fake.exposePipelinesReturns = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExposePipelinesReturnsOnCall(i int, result1 []atc.PipelineBatchResult, result2 error) {
+	fake.exposePipelinesMutex.Lock()
+	defer fake.exposePipelinesMutex.Unlock()
+	fake.ExposePipelinesStub = nil
+	if fake.exposePipelinesReturnsOnCall == nil {
+		fake.exposePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineBatchResult
+			result2 error
+		})
+	}
+	fake.exposePipelinesReturnsOnCall[i] = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ForInstance(arg1 atc.InstanceVars) concourse.Team {
+	fake.forInstanceMutex.Lock()
+	ret, specificReturn := fake.forInstanceReturnsOnCall[len(fake.forInstanceArgsForCall)]
+	fake.forInstanceArgsForCall = append(fake.forInstanceArgsForCall, struct {
+		arg1 atc.InstanceVars
+	}{arg1})
+	fake.recordInvocation("ForInstance", []interface{}{arg1})
+	fake.forInstanceMutex.Unlock()
+	if fake.ForInstanceStub != nil {
+		return fake.ForInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.forInstanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) ForInstanceCallCount() int {
+	fake.forInstanceMutex.RLock()
+	defer fake.forInstanceMutex.RUnlock()
+	return len(fake.forInstanceArgsForCall)
+}
+
+func (fake *FakeTeam) ForInstanceCalls(stub func(atc.InstanceVars) concourse.Team) {
+	fake.forInstanceMutex.Lock()
+	defer fake.forInstanceMutex.Unlock()
+	fake.ForInstanceStub = stub
+}
+
+func (fake *FakeTeam) ForInstanceArgsForCall(i int) atc.InstanceVars {
+	fake.forInstanceMutex.RLock()
+	defer fake.forInstanceMutex.RUnlock()
+	argsForCall := fake.forInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ForInstanceReturns(result1 concourse.Team) {
+	fake.forInstanceMutex.Lock()
+	defer fake.forInstanceMutex.Unlock()
+	fake.ForInstanceStub = nil
+	fake.forInstanceReturns = struct {
+		result1 concourse.Team
+	}{result1}
+}
+
+func (fake *FakeTeam) ForInstanceReturnsOnCall(i int, result1 concourse.Team) {
+	fake.forInstanceMutex.Lock()
+	defer fake.forInstanceMutex.Unlock()
+	fake.ForInstanceStub = nil
+	if fake.forInstanceReturnsOnCall == nil {
+		fake.forInstanceReturnsOnCall = make(map[int]struct {
+			result1 concourse.Team
+		})
+	}
+	fake.forInstanceReturnsOnCall[i] = struct {
+		result1 concourse.Team
+	}{result1}
+}
+
 func (fake *FakeTeam) GetArtifact(arg1 int) (io.ReadCloser, error) {
 	fake.getArtifactMutex.Lock()
 	ret, specificReturn := fake.getArtifactReturnsOnCall[len(fake.getArtifactArgsForCall)]
@@ -2010,6 +2726,74 @@ func (fake *FakeTeam) HidePipelineReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) HidePipelines(arg1 []int) ([]atc.PipelineBatchResult, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.hidePipelinesMutex.Lock()
+	ret, specificReturn := fake.hidePipelinesReturnsOnCall[len(fake.hidePipelinesArgsForCall)]
+	fake.hidePipelinesArgsForCall = append(fake.hidePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("HidePipelines", []interface{}{arg1Copy})
+	fake.hidePipelinesMutex.Unlock()
+	if fake.HidePipelinesStub != nil {
+		return fake.HidePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hidePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) HidePipelinesCallCount() int {
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
+	return len(fake.hidePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) HidePipelinesCalls(stub func([]int) ([]atc.PipelineBatchResult, error)) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = stub
+}
+
+func (fake *FakeTeam) HidePipelinesArgsForCall(i int) []int {
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
+	argsForCall := fake.hidePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) HidePipelinesReturns(result1 []atc.PipelineBatchResult, result2 error) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = nil
+	fake.hidePipelinesReturns = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) HidePipelinesReturnsOnCall(i int, result1 []atc.PipelineBatchResult, result2 error) {
+	fake.hidePipelinesMutex.Lock()
+	defer fake.hidePipelinesMutex.Unlock()
+	fake.HidePipelinesStub = nil
+	if fake.hidePipelinesReturnsOnCall == nil {
+		fake.hidePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineBatchResult
+			result2 error
+		})
+	}
+	fake.hidePipelinesReturnsOnCall[i] = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Job(arg1 string, arg2 string) (atc.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -2145,6 +2929,75 @@ func (fake *FakeTeam) JobBuildReturnsOnCall(i int, result1 atc.Build, result2 bo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) JobBuildStepPlan(arg1 string, arg2 string, arg3 string, arg4 string) (atc.BuildStepPlan, bool, error) {
+	fake.jobBuildStepPlanMutex.Lock()
+	ret, specificReturn := fake.jobBuildStepPlanReturnsOnCall[len(fake.jobBuildStepPlanArgsForCall)]
+	fake.jobBuildStepPlanArgsForCall = append(fake.jobBuildStepPlanArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("JobBuildStepPlan", []interface{}{arg1, arg2, arg3, arg4})
+	fake.jobBuildStepPlanMutex.Unlock()
+	if fake.JobBuildStepPlanStub != nil {
+		return fake.JobBuildStepPlanStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.jobBuildStepPlanReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobBuildStepPlanCallCount() int {
+	fake.jobBuildStepPlanMutex.RLock()
+	defer fake.jobBuildStepPlanMutex.RUnlock()
+	return len(fake.jobBuildStepPlanArgsForCall)
+}
+
+func (fake *FakeTeam) JobBuildStepPlanCalls(stub func(string, string, string, string) (atc.BuildStepPlan, bool, error)) {
+	fake.jobBuildStepPlanMutex.Lock()
+	defer fake.jobBuildStepPlanMutex.Unlock()
+	fake.JobBuildStepPlanStub = stub
+}
+
+func (fake *FakeTeam) JobBuildStepPlanArgsForCall(i int) (string, string, string, string) {
+	fake.jobBuildStepPlanMutex.RLock()
+	defer fake.jobBuildStepPlanMutex.RUnlock()
+	argsForCall := fake.jobBuildStepPlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) JobBuildStepPlanReturns(result1 atc.BuildStepPlan, result2 bool, result3 error) {
+	fake.jobBuildStepPlanMutex.Lock()
+	defer fake.jobBuildStepPlanMutex.Unlock()
+	fake.JobBuildStepPlanStub = nil
+	fake.jobBuildStepPlanReturns = struct {
+		result1 atc.BuildStepPlan
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobBuildStepPlanReturnsOnCall(i int, result1 atc.BuildStepPlan, result2 bool, result3 error) {
+	fake.jobBuildStepPlanMutex.Lock()
+	defer fake.jobBuildStepPlanMutex.Unlock()
+	fake.JobBuildStepPlanStub = nil
+	if fake.jobBuildStepPlanReturnsOnCall == nil {
+		fake.jobBuildStepPlanReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildStepPlan
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobBuildStepPlanReturnsOnCall[i] = struct {
+		result1 atc.BuildStepPlan
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) JobBuilds(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.jobBuildsMutex.Lock()
 	ret, specificReturn := fake.jobBuildsReturnsOnCall[len(fake.jobBuildsArgsForCall)]
@@ -2216,6 +3069,70 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) ListChecks(arg1 string, arg2 int) ([]atc.Check, error) {
+	fake.listChecksMutex.Lock()
+	ret, specificReturn := fake.listChecksReturnsOnCall[len(fake.listChecksArgsForCall)]
+	fake.listChecksArgsForCall = append(fake.listChecksArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ListChecks", []interface{}{arg1, arg2})
+	fake.listChecksMutex.Unlock()
+	if fake.ListChecksStub != nil {
+		return fake.ListChecksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listChecksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListChecksCallCount() int {
+	fake.listChecksMutex.RLock()
+	defer fake.listChecksMutex.RUnlock()
+	return len(fake.listChecksArgsForCall)
+}
+
+func (fake *FakeTeam) ListChecksCalls(stub func(string, int) ([]atc.Check, error)) {
+	fake.listChecksMutex.Lock()
+	defer fake.listChecksMutex.Unlock()
+	fake.ListChecksStub = stub
+}
+
+func (fake *FakeTeam) ListChecksArgsForCall(i int) (string, int) {
+	fake.listChecksMutex.RLock()
+	defer fake.listChecksMutex.RUnlock()
+	argsForCall := fake.listChecksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ListChecksReturns(result1 []atc.Check, result2 error) {
+	fake.listChecksMutex.Lock()
+	defer fake.listChecksMutex.Unlock()
+	fake.ListChecksStub = nil
+	fake.listChecksReturns = struct {
+		result1 []atc.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListChecksReturnsOnCall(i int, result1 []atc.Check, result2 error) {
+	fake.listChecksMutex.Lock()
+	defer fake.listChecksMutex.Unlock()
+	fake.ListChecksStub = nil
+	if fake.listChecksReturnsOnCall == nil {
+		fake.listChecksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Check
+			result2 error
+		})
+	}
+	fake.listChecksReturnsOnCall[i] = struct {
+		result1 []atc.Check
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -2342,15 +3259,16 @@ func (fake *FakeTeam) ListJobsReturnsOnCall(i int, result1 []atc.Job, result2 er
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ListPipelines() ([]atc.Pipeline, error) {
+func (fake *FakeTeam) ListPipelines(arg1 ...string) ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
 	fake.listPipelinesArgsForCall = append(fake.listPipelinesArgsForCall, struct {
-	}{})
-	fake.recordInvocation("ListPipelines", []interface{}{})
+		arg1 []string
+	}{arg1})
+	fake.recordInvocation("ListPipelines", []interface{}{arg1})
 	fake.listPipelinesMutex.Unlock()
 	if fake.ListPipelinesStub != nil {
-		return fake.ListPipelinesStub()
+		return fake.ListPipelinesStub(arg1...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2365,14 +3283,21 @@ func (fake *FakeTeam) ListPipelinesCallCount() int {
 	return len(fake.listPipelinesArgsForCall)
 }
 
-func (fake *FakeTeam) ListPipelinesCalls(stub func() ([]atc.Pipeline, error)) {
+func (fake *FakeTeam) ListPipelinesCalls(stub func(...string) ([]atc.Pipeline, error)) {
 	fake.listPipelinesMutex.Lock()
 	defer fake.listPipelinesMutex.Unlock()
 	fake.ListPipelinesStub = stub
 }
 
-func (fake *FakeTeam) ListPipelinesReturns(result1 []atc.Pipeline, result2 error) {
-	fake.listPipelinesMutex.Lock()
+func (fake *FakeTeam) ListPipelinesArgsForCall(i int) []string {
+	fake.listPipelinesMutex.RLock()
+	defer fake.listPipelinesMutex.RUnlock()
+	argsForCall := fake.listPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ListPipelinesReturns(result1 []atc.Pipeline, result2 error) {
+	fake.listPipelinesMutex.Lock()
 	defer fake.listPipelinesMutex.Unlock()
 	fake.ListPipelinesStub = nil
 	fake.listPipelinesReturns = struct {
@@ -2759,6 +3684,139 @@ func (fake *FakeTeam) PausePipelineReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) PausePipelines(arg1 []int) ([]atc.PipelineBatchResult, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.pausePipelinesMutex.Lock()
+	ret, specificReturn := fake.pausePipelinesReturnsOnCall[len(fake.pausePipelinesArgsForCall)]
+	fake.pausePipelinesArgsForCall = append(fake.pausePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("PausePipelines", []interface{}{arg1Copy})
+	fake.pausePipelinesMutex.Unlock()
+	if fake.PausePipelinesStub != nil {
+		return fake.PausePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pausePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PausePipelinesCallCount() int {
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
+	return len(fake.pausePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) PausePipelinesCalls(stub func([]int) ([]atc.PipelineBatchResult, error)) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = stub
+}
+
+func (fake *FakeTeam) PausePipelinesArgsForCall(i int) []int {
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
+	argsForCall := fake.pausePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PausePipelinesReturns(result1 []atc.PipelineBatchResult, result2 error) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = nil
+	fake.pausePipelinesReturns = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PausePipelinesReturnsOnCall(i int, result1 []atc.PipelineBatchResult, result2 error) {
+	fake.pausePipelinesMutex.Lock()
+	defer fake.pausePipelinesMutex.Unlock()
+	fake.PausePipelinesStub = nil
+	if fake.pausePipelinesReturnsOnCall == nil {
+		fake.pausePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineBatchResult
+			result2 error
+		})
+	}
+	fake.pausePipelinesReturnsOnCall[i] = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PinResource(arg1 string, arg2 string, arg3 atc.PinResourceRequestBody) (bool, error) {
+	fake.pinResourceMutex.Lock()
+	ret, specificReturn := fake.pinResourceReturnsOnCall[len(fake.pinResourceArgsForCall)]
+	fake.pinResourceArgsForCall = append(fake.pinResourceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.PinResourceRequestBody
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PinResource", []interface{}{arg1, arg2, arg3})
+	fake.pinResourceMutex.Unlock()
+	if fake.PinResourceStub != nil {
+		return fake.PinResourceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pinResourceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PinResourceCallCount() int {
+	fake.pinResourceMutex.RLock()
+	defer fake.pinResourceMutex.RUnlock()
+	return len(fake.pinResourceArgsForCall)
+}
+
+func (fake *FakeTeam) PinResourceCalls(stub func(string, string, atc.PinResourceRequestBody) (bool, error)) {
+	fake.pinResourceMutex.Lock()
+	defer fake.pinResourceMutex.Unlock()
+	fake.PinResourceStub = stub
+}
+
+func (fake *FakeTeam) PinResourceArgsForCall(i int) (string, string, atc.PinResourceRequestBody) {
+	fake.pinResourceMutex.RLock()
+	defer fake.pinResourceMutex.RUnlock()
+	argsForCall := fake.pinResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) PinResourceReturns(result1 bool, result2 error) {
+	fake.pinResourceMutex.Lock()
+	defer fake.pinResourceMutex.Unlock()
+	fake.PinResourceStub = nil
+	fake.pinResourceReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PinResourceReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pinResourceMutex.Lock()
+	defer fake.pinResourceMutex.Unlock()
+	fake.PinResourceStub = nil
+	if fake.pinResourceReturnsOnCall == nil {
+		fake.pinResourceReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pinResourceReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Pipeline(arg1 string) (atc.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2964,6 +4022,139 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigAtVersion(arg1 string, arg2 int) (atc.Config, bool, error) {
+	fake.pipelineConfigAtVersionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigAtVersionReturnsOnCall[len(fake.pipelineConfigAtVersionArgsForCall)]
+	fake.pipelineConfigAtVersionArgsForCall = append(fake.pipelineConfigAtVersionArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineConfigAtVersion", []interface{}{arg1, arg2})
+	fake.pipelineConfigAtVersionMutex.Unlock()
+	if fake.PipelineConfigAtVersionStub != nil {
+		return fake.PipelineConfigAtVersionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigAtVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigAtVersionCallCount() int {
+	fake.pipelineConfigAtVersionMutex.RLock()
+	defer fake.pipelineConfigAtVersionMutex.RUnlock()
+	return len(fake.pipelineConfigAtVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigAtVersionCalls(stub func(string, int) (atc.Config, bool, error)) {
+	fake.pipelineConfigAtVersionMutex.Lock()
+	defer fake.pipelineConfigAtVersionMutex.Unlock()
+	fake.PipelineConfigAtVersionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigAtVersionArgsForCall(i int) (string, int) {
+	fake.pipelineConfigAtVersionMutex.RLock()
+	defer fake.pipelineConfigAtVersionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigAtVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigAtVersionReturns(result1 atc.Config, result2 bool, result3 error) {
+	fake.pipelineConfigAtVersionMutex.Lock()
+	defer fake.pipelineConfigAtVersionMutex.Unlock()
+	fake.PipelineConfigAtVersionStub = nil
+	fake.pipelineConfigAtVersionReturns = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigAtVersionReturnsOnCall(i int, result1 atc.Config, result2 bool, result3 error) {
+	fake.pipelineConfigAtVersionMutex.Lock()
+	defer fake.pipelineConfigAtVersionMutex.Unlock()
+	fake.PipelineConfigAtVersionStub = nil
+	if fake.pipelineConfigAtVersionReturnsOnCall == nil {
+		fake.pipelineConfigAtVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Config
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigAtVersionReturnsOnCall[i] = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersions(arg1 string) ([]atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionsReturnsOnCall[len(fake.pipelineConfigVersionsArgsForCall)]
+	fake.pipelineConfigVersionsArgsForCall = append(fake.pipelineConfigVersionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PipelineConfigVersions", []interface{}{arg1})
+	fake.pipelineConfigVersionsMutex.Unlock()
+	if fake.PipelineConfigVersionsStub != nil {
+		return fake.PipelineConfigVersionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCallCount() int {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	return len(fake.pipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCalls(stub func(string) ([]atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsArgsForCall(i int) string {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturns(result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	fake.pipelineConfigVersionsReturns = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturnsOnCall(i int, result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	if fake.pipelineConfigVersionsReturnsOnCall == nil {
+		fake.pipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3092,6 +4283,74 @@ func (fake *FakeTeam) RenameTeamReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) RerunJobBuild(arg1 string, arg2 string, arg3 string) (atc.Build, bool, error) {
+	fake.rerunJobBuildMutex.Lock()
+	ret, specificReturn := fake.rerunJobBuildReturnsOnCall[len(fake.rerunJobBuildArgsForCall)]
+	fake.rerunJobBuildArgsForCall = append(fake.rerunJobBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RerunJobBuild", []interface{}{arg1, arg2, arg3})
+	fake.rerunJobBuildMutex.Unlock()
+	if fake.RerunJobBuildStub != nil {
+		return fake.RerunJobBuildStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rerunJobBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) RerunJobBuildCallCount() int {
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
+	return len(fake.rerunJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) RerunJobBuildCalls(stub func(string, string, string) (atc.Build, bool, error)) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = stub
+}
+
+func (fake *FakeTeam) RerunJobBuildArgsForCall(i int) (string, string, string) {
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
+	argsForCall := fake.rerunJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) RerunJobBuildReturns(result1 atc.Build, result2 bool, result3 error) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = nil
+	fake.rerunJobBuildReturns = struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) RerunJobBuildReturnsOnCall(i int, result1 atc.Build, result2 bool, result3 error) {
+	fake.rerunJobBuildMutex.Lock()
+	defer fake.rerunJobBuildMutex.Unlock()
+	fake.RerunJobBuildStub = nil
+	if fake.rerunJobBuildReturnsOnCall == nil {
+		fake.rerunJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.rerunJobBuildReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Resource(arg1 string, arg2 string) (atc.Resource, bool, error) {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
@@ -3159,6 +4418,129 @@ func (fake *FakeTeam) ResourceReturnsOnCall(i int, result1 atc.Resource, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) ResourceChecks(arg1 string, arg2 string, arg3 int) ([]atc.Check, bool, error) {
+	fake.resourceChecksMutex.Lock()
+	ret, specificReturn := fake.resourceChecksReturnsOnCall[len(fake.resourceChecksArgsForCall)]
+	fake.resourceChecksArgsForCall = append(fake.resourceChecksArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ResourceChecks", []interface{}{arg1, arg2, arg3})
+	fake.resourceChecksMutex.Unlock()
+	if fake.ResourceChecksStub != nil {
+		return fake.ResourceChecksStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resourceChecksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ResourceChecksCallCount() int {
+	fake.resourceChecksMutex.RLock()
+	defer fake.resourceChecksMutex.RUnlock()
+	return len(fake.resourceChecksArgsForCall)
+}
+
+func (fake *FakeTeam) ResourceChecksCalls(stub func(string, string, int) ([]atc.Check, bool, error)) {
+	fake.resourceChecksMutex.Lock()
+	defer fake.resourceChecksMutex.Unlock()
+	fake.ResourceChecksStub = stub
+}
+
+func (fake *FakeTeam) ResourceChecksArgsForCall(i int) (string, string, int) {
+	fake.resourceChecksMutex.RLock()
+	defer fake.resourceChecksMutex.RUnlock()
+	argsForCall := fake.resourceChecksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ResourceChecksReturns(result1 []atc.Check, result2 bool, result3 error) {
+	fake.resourceChecksMutex.Lock()
+	defer fake.resourceChecksMutex.Unlock()
+	fake.ResourceChecksStub = nil
+	fake.resourceChecksReturns = struct {
+		result1 []atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ResourceChecksReturnsOnCall(i int, result1 []atc.Check, result2 bool, result3 error) {
+	fake.resourceChecksMutex.Lock()
+	defer fake.resourceChecksMutex.Unlock()
+	fake.ResourceChecksStub = nil
+	if fake.resourceChecksReturnsOnCall == nil {
+		fake.resourceChecksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Check
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resourceChecksReturnsOnCall[i] = struct {
+		result1 []atc.Check
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ResourceTypes() (atc.ResourceTypes, error) {
+	fake.resourceTypesMutex.Lock()
+	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
+	fake.resourceTypesArgsForCall = append(fake.resourceTypesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceTypes", []interface{}{})
+	fake.resourceTypesMutex.Unlock()
+	if fake.ResourceTypesStub != nil {
+		return fake.ResourceTypesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resourceTypesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ResourceTypesCallCount() int {
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
+	return len(fake.resourceTypesArgsForCall)
+}
+
+func (fake *FakeTeam) ResourceTypesCalls(stub func() (atc.ResourceTypes, error)) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = stub
+}
+
+func (fake *FakeTeam) ResourceTypesReturns(result1 atc.ResourceTypes, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	fake.resourceTypesReturns = struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ResourceTypesReturnsOnCall(i int, result1 atc.ResourceTypes, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	if fake.resourceTypesReturnsOnCall == nil {
+		fake.resourceTypesReturnsOnCall = make(map[int]struct {
+			result1 atc.ResourceTypes
+			result2 error
+		})
+	}
+	fake.resourceTypesReturnsOnCall[i] = struct {
+		result1 atc.ResourceTypes
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ResourceVersions(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
 	fake.resourceVersionsMutex.Lock()
 	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
@@ -3230,6 +4612,132 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) SecretLookups(arg1 string) ([]atc.SecretLookup, bool, error) {
+	fake.secretLookupsMutex.Lock()
+	ret, specificReturn := fake.secretLookupsReturnsOnCall[len(fake.secretLookupsArgsForCall)]
+	fake.secretLookupsArgsForCall = append(fake.secretLookupsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SecretLookups", []interface{}{arg1})
+	fake.secretLookupsMutex.Unlock()
+	if fake.SecretLookupsStub != nil {
+		return fake.SecretLookupsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.secretLookupsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SecretLookupsCallCount() int {
+	fake.secretLookupsMutex.RLock()
+	defer fake.secretLookupsMutex.RUnlock()
+	return len(fake.secretLookupsArgsForCall)
+}
+
+func (fake *FakeTeam) SecretLookupsCalls(stub func(string) ([]atc.SecretLookup, bool, error)) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = stub
+}
+
+func (fake *FakeTeam) SecretLookupsArgsForCall(i int) string {
+	fake.secretLookupsMutex.RLock()
+	defer fake.secretLookupsMutex.RUnlock()
+	argsForCall := fake.secretLookupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SecretLookupsReturns(result1 []atc.SecretLookup, result2 bool, result3 error) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = nil
+	fake.secretLookupsReturns = struct {
+		result1 []atc.SecretLookup
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SecretLookupsReturnsOnCall(i int, result1 []atc.SecretLookup, result2 bool, result3 error) {
+	fake.secretLookupsMutex.Lock()
+	defer fake.secretLookupsMutex.Unlock()
+	fake.SecretLookupsStub = nil
+	if fake.secretLookupsReturnsOnCall == nil {
+		fake.secretLookupsReturnsOnCall = make(map[int]struct {
+			result1 []atc.SecretLookup
+			result2 bool
+			result3 error
+		})
+	}
+	fake.secretLookupsReturnsOnCall[i] = struct {
+		result1 []atc.SecretLookup
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SetResourceTypes(arg1 atc.ResourceTypes) error {
+	fake.setResourceTypesMutex.Lock()
+	ret, specificReturn := fake.setResourceTypesReturnsOnCall[len(fake.setResourceTypesArgsForCall)]
+	fake.setResourceTypesArgsForCall = append(fake.setResourceTypesArgsForCall, struct {
+		arg1 atc.ResourceTypes
+	}{arg1})
+	fake.recordInvocation("SetResourceTypes", []interface{}{arg1})
+	fake.setResourceTypesMutex.Unlock()
+	if fake.SetResourceTypesStub != nil {
+		return fake.SetResourceTypesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setResourceTypesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetResourceTypesCallCount() int {
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
+	return len(fake.setResourceTypesArgsForCall)
+}
+
+func (fake *FakeTeam) SetResourceTypesCalls(stub func(atc.ResourceTypes) error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = stub
+}
+
+func (fake *FakeTeam) SetResourceTypesArgsForCall(i int) atc.ResourceTypes {
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
+	argsForCall := fake.setResourceTypesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetResourceTypesReturns(result1 error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = nil
+	fake.setResourceTypesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetResourceTypesReturnsOnCall(i int, result1 error) {
+	fake.setResourceTypesMutex.Lock()
+	defer fake.setResourceTypesMutex.Unlock()
+	fake.SetResourceTypesStub = nil
+	if fake.setResourceTypesReturnsOnCall == nil {
+		fake.setResourceTypesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setResourceTypesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3357,6 +4865,147 @@ func (fake *FakeTeam) UnpausePipelineReturnsOnCall(i int, result1 bool, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UnpausePipelines(arg1 []int) ([]atc.PipelineBatchResult, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.unpausePipelinesMutex.Lock()
+	ret, specificReturn := fake.unpausePipelinesReturnsOnCall[len(fake.unpausePipelinesArgsForCall)]
+	fake.unpausePipelinesArgsForCall = append(fake.unpausePipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("UnpausePipelines", []interface{}{arg1Copy})
+	fake.unpausePipelinesMutex.Unlock()
+	if fake.UnpausePipelinesStub != nil {
+		return fake.UnpausePipelinesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpausePipelinesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UnpausePipelinesCallCount() int {
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
+	return len(fake.unpausePipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) UnpausePipelinesCalls(stub func([]int) ([]atc.PipelineBatchResult, error)) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = stub
+}
+
+func (fake *FakeTeam) UnpausePipelinesArgsForCall(i int) []int {
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
+	argsForCall := fake.unpausePipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UnpausePipelinesReturns(result1 []atc.PipelineBatchResult, result2 error) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = nil
+	fake.unpausePipelinesReturns = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UnpausePipelinesReturnsOnCall(i int, result1 []atc.PipelineBatchResult, result2 error) {
+	fake.unpausePipelinesMutex.Lock()
+	defer fake.unpausePipelinesMutex.Unlock()
+	fake.UnpausePipelinesStub = nil
+	if fake.unpausePipelinesReturnsOnCall == nil {
+		fake.unpausePipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineBatchResult
+			result2 error
+		})
+	}
+	fake.unpausePipelinesReturnsOnCall[i] = struct {
+		result1 []atc.PipelineBatchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ValidatePipelineConfig(arg1 string, arg2 []byte, arg3 bool) ([]concourse.ConfigWarning, []atc.CredentialCheck, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.validatePipelineConfigMutex.Lock()
+	ret, specificReturn := fake.validatePipelineConfigReturnsOnCall[len(fake.validatePipelineConfigArgsForCall)]
+	fake.validatePipelineConfigArgsForCall = append(fake.validatePipelineConfigArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("ValidatePipelineConfig", []interface{}{arg1, arg2Copy, arg3})
+	fake.validatePipelineConfigMutex.Unlock()
+	if fake.ValidatePipelineConfigStub != nil {
+		return fake.ValidatePipelineConfigStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.validatePipelineConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ValidatePipelineConfigCallCount() int {
+	fake.validatePipelineConfigMutex.RLock()
+	defer fake.validatePipelineConfigMutex.RUnlock()
+	return len(fake.validatePipelineConfigArgsForCall)
+}
+
+func (fake *FakeTeam) ValidatePipelineConfigCalls(stub func(string, []byte, bool) ([]concourse.ConfigWarning, []atc.CredentialCheck, error)) {
+	fake.validatePipelineConfigMutex.Lock()
+	defer fake.validatePipelineConfigMutex.Unlock()
+	fake.ValidatePipelineConfigStub = stub
+}
+
+func (fake *FakeTeam) ValidatePipelineConfigArgsForCall(i int) (string, []byte, bool) {
+	fake.validatePipelineConfigMutex.RLock()
+	defer fake.validatePipelineConfigMutex.RUnlock()
+	argsForCall := fake.validatePipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ValidatePipelineConfigReturns(result1 []concourse.ConfigWarning, result2 []atc.CredentialCheck, result3 error) {
+	fake.validatePipelineConfigMutex.Lock()
+	defer fake.validatePipelineConfigMutex.Unlock()
+	fake.ValidatePipelineConfigStub = nil
+	fake.validatePipelineConfigReturns = struct {
+		result1 []concourse.ConfigWarning
+		result2 []atc.CredentialCheck
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ValidatePipelineConfigReturnsOnCall(i int, result1 []concourse.ConfigWarning, result2 []atc.CredentialCheck, result3 error) {
+	fake.validatePipelineConfigMutex.Lock()
+	defer fake.validatePipelineConfigMutex.Unlock()
+	fake.ValidatePipelineConfigStub = nil
+	if fake.validatePipelineConfigReturnsOnCall == nil {
+		fake.validatePipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 []concourse.ConfigWarning
+			result2 []atc.CredentialCheck
+			result3 error
+		})
+	}
+	fake.validatePipelineConfigReturnsOnCall[i] = struct {
+		result1 []concourse.ConfigWarning
+		result2 []atc.CredentialCheck
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) VersionedResourceTypes(arg1 string) (atc.VersionedResourceTypes, bool, error) {
 	fake.versionedResourceTypesMutex.Lock()
 	ret, specificReturn := fake.versionedResourceTypesReturnsOnCall[len(fake.versionedResourceTypesArgsForCall)]
@@ -3423,17 +5072,86 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 int) ([]atc.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(int) ([]atc.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.buildInputDiffMutex.RLock()
+	defer fake.buildInputDiffMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithVersionAsInputMutex.RLock()
 	defer fake.buildsWithVersionAsInputMutex.RUnlock()
 	fake.buildsWithVersionAsOutputMutex.RLock()
 	defer fake.buildsWithVersionAsOutputMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
 	fake.checkResourceMutex.RLock()
 	defer fake.checkResourceMutex.RUnlock()
 	fake.checkResourceTypeMutex.RLock()
@@ -3454,6 +5172,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createPipelineBuildMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.deletePipelinesMutex.RLock()
+	defer fake.deletePipelinesMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
@@ -3462,18 +5182,28 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.enableResourceVersionMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
+	fake.exposePipelinesMutex.RLock()
+	defer fake.exposePipelinesMutex.RUnlock()
+	fake.forInstanceMutex.RLock()
+	defer fake.forInstanceMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
 	defer fake.getArtifactMutex.RUnlock()
 	fake.getContainerMutex.RLock()
 	defer fake.getContainerMutex.RUnlock()
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
+	fake.hidePipelinesMutex.RLock()
+	defer fake.hidePipelinesMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobBuildMutex.RLock()
 	defer fake.jobBuildMutex.RUnlock()
+	fake.jobBuildStepPlanMutex.RLock()
+	defer fake.jobBuildStepPlanMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.listChecksMutex.RLock()
+	defer fake.listChecksMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -3492,26 +5222,50 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pauseJobMutex.RUnlock()
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
+	fake.pausePipelinesMutex.RLock()
+	defer fake.pausePipelinesMutex.RUnlock()
+	fake.pinResourceMutex.RLock()
+	defer fake.pinResourceMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigAtVersionMutex.RLock()
+	defer fake.pipelineConfigAtVersionMutex.RUnlock()
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
 	defer fake.renameTeamMutex.RUnlock()
+	fake.rerunJobBuildMutex.RLock()
+	defer fake.rerunJobBuildMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.resourceChecksMutex.RLock()
+	defer fake.resourceChecksMutex.RUnlock()
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.secretLookupsMutex.RLock()
+	defer fake.secretLookupsMutex.RUnlock()
+	fake.setResourceTypesMutex.RLock()
+	defer fake.setResourceTypesMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
+	fake.unpausePipelinesMutex.RLock()
+	defer fake.unpausePipelinesMutex.RUnlock()
+	fake.validatePipelineConfigMutex.RLock()
+	defer fake.validatePipelineConfigMutex.RUnlock()
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
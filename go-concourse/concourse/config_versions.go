@@ -0,0 +1,58 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PipelineConfigVersions(pipelineName string) ([]atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var versions []atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListConfigVersions,
+		Params:      params,
+	}, &internal.Response{
+		Result: &versions,
+	})
+
+	switch err.(type) {
+	case nil:
+		return versions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigAtVersion(pipelineName string, version int) (atc.Config, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineName,
+		"team_name":      team.name,
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configResponse atc.ConfigResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetConfigVersion,
+		Params:      params,
+	}, &internal.Response{
+		Result: &configResponse,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configResponse.Config, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.Config{}, false, nil
+	default:
+		return atc.Config{}, false, err
+	}
+}
@@ -0,0 +1,105 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Config Versions", func() {
+	Describe("PipelineConfigVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions"
+
+		Context("when the pipeline exists", func() {
+			var expectedVersions []atc.PipelineConfigVersion
+
+			BeforeEach(func() {
+				expectedVersions = []atc.PipelineConfigVersion{
+					{Version: 2, Author: "some-user", CreatedAt: 2},
+					{Version: 1, CreatedAt: 1},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedVersions),
+					),
+				)
+			})
+
+			It("returns the config versions of the pipeline", func() {
+				versions, found, err := team.PipelineConfigVersions("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expectedVersions))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.PipelineConfigVersions("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineConfigAtVersion", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/3"
+
+		Context("when the version exists", func() {
+			var expectedConfig atc.Config
+
+			BeforeEach(func() {
+				expectedConfig = atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "some-job"},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: expectedConfig}),
+					),
+				)
+			})
+
+			It("returns the config saved at that version", func() {
+				config, found, err := team.PipelineConfigAtVersion("mypipeline", 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(config).To(Equal(expectedConfig))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.PipelineConfigAtVersion("mypipeline", 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
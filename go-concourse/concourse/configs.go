@@ -51,8 +51,9 @@ type ConfigWarning struct {
 }
 
 type setConfigResponse struct {
-	Errors   []string        `json:"errors"`
-	Warnings []ConfigWarning `json:"warnings"`
+	Errors           []string              `json:"errors"`
+	Warnings         []ConfigWarning       `json:"warnings"`
+	CredentialChecks []atc.CredentialCheck `json:"credential_checks"`
 }
 
 func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
@@ -83,21 +84,7 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 	)
 
 	if err != nil {
-		if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
-			if unexpectedResponseError.StatusCode == http.StatusBadRequest {
-				var validationErr atc.SaveConfigResponse
-				err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
-				if err != nil {
-					return false, false, []ConfigWarning{}, err
-				}
-
-				return false, false, []ConfigWarning{}, InvalidConfigError{
-					Errors: validationErr.Errors,
-				}
-			}
-		}
-
-		return false, false, []ConfigWarning{}, err
+		return false, false, []ConfigWarning{}, saveConfigError(err)
 	}
 
 	configResponse := setConfigResponse{}
@@ -119,3 +106,52 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 
 	return response.Created, !response.Created, configResponse.Warnings, nil
 }
+
+func (team *team) ValidatePipelineConfig(pipelineName string, passedConfig []byte, checkCredentials bool) ([]ConfigWarning, []atc.CredentialCheck, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add(atc.SaveConfigDryRun, "")
+	if checkCredentials {
+		queryParams.Add(atc.SaveConfigCheckCreds, "")
+	}
+
+	var configResponse setConfigResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SaveConfig,
+		Params:      params,
+		Query:       queryParams,
+		Body:        bytes.NewBuffer(passedConfig),
+		Header: http.Header{
+			"Content-Type": {"application/x-yaml"},
+		},
+	}, &internal.Response{
+		Result: &configResponse,
+	})
+	if err != nil {
+		return []ConfigWarning{}, nil, saveConfigError(err)
+	}
+
+	return configResponse.Warnings, configResponse.CredentialChecks, nil
+}
+
+func saveConfigError(err error) error {
+	unexpectedResponseError, ok := err.(internal.UnexpectedResponseError)
+	if !ok || unexpectedResponseError.StatusCode != http.StatusBadRequest {
+		return err
+	}
+
+	var validationErr atc.SaveConfigResponse
+	err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
+	if err != nil {
+		return err
+	}
+
+	return InvalidConfigError{
+		Errors:           validationErr.Errors,
+		CredentialChecks: validationErr.CredentialChecks,
+	}
+}
@@ -324,6 +324,128 @@ var _ = Describe("ATC Handler Configs", func() {
 					Expect(err).To(HaveOccurred())
 				})
 			})
+
+			Context("when the response contains credential checks", func() {
+				BeforeEach(func() {
+					returnBody = []byte(`{
+						"errors":["Expected to find variables: BAR"],
+						"credential_checks":[{"name":"BAR","backend":"vault","status":"not_found","searched":["/concourse/some-team/BAR"]}]
+					}`)
+				})
+
+				It("includes them in the error", func() {
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					Expect(err).To(Equal(concourse.InvalidConfigError{
+						Errors: []string{"Expected to find variables: BAR"},
+						CredentialChecks: []atc.CredentialCheck{
+							{
+								Name:     "BAR",
+								Backend:  "vault",
+								Status:   atc.CredentialCheckNotFound,
+								Searched: []string{"/concourse/some-team/BAR"},
+							},
+						},
+					}))
+				})
+			})
+		})
+	})
+
+	Describe("ValidatePipelineConfig", func() {
+		var (
+			expectedConfig []byte
+
+			returnHeader int
+			returnBody   []byte
+
+			checkCredentials bool
+
+			warnings []concourse.ConfigWarning
+			checks   []atc.CredentialCheck
+			err      error
+		)
+
+		BeforeEach(func() {
+			expectedConfig = []byte("jobs: []")
+			checkCredentials = true
+
+			returnHeader = http.StatusOK
+			returnBody = []byte(`{
+				"warnings":[{"type": "warning-type", "message": "fake-warning"}],
+				"credential_checks":[{"name":"BAR","backend":"vault","status":"found","path":"/concourse/some-team/BAR"}]
+			}`)
+		})
+
+		JustBeforeEach(func() {
+			expectedQuery := atc.SaveConfigDryRun + "="
+			if checkCredentials {
+				expectedQuery += "&" + atc.SaveConfigCheckCreds + "="
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config", expectedQuery),
+					ghttp.VerifyBody(expectedConfig),
+					ghttp.RespondWith(returnHeader, returnBody),
+				),
+			)
+
+			warnings, checks, err = team.ValidatePipelineConfig("mypipeline", expectedConfig, checkCredentials)
+		})
+
+		It("returns the warnings and credential checks", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(Equal([]concourse.ConfigWarning{
+				{Type: "warning-type", Message: "fake-warning"},
+			}))
+			Expect(checks).To(Equal([]atc.CredentialCheck{
+				{
+					Name:    "BAR",
+					Backend: "vault",
+					Status:  atc.CredentialCheckFound,
+					Path:    "/concourse/some-team/BAR",
+				},
+			}))
+		})
+
+		Context("when not checking credentials", func() {
+			BeforeEach(func() {
+				checkCredentials = false
+			})
+
+			It("only asks for a dry run", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the config is invalid", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusBadRequest
+				returnBody = []byte(`{
+					"errors":["Expected to find variables: BAR"],
+					"credential_checks":[{"name":"BAR","status":"not_found"}]
+				}`)
+			})
+
+			It("returns an invalid config error with the checks", func() {
+				Expect(err).To(Equal(concourse.InvalidConfigError{
+					Errors: []string{"Expected to find variables: BAR"},
+					CredentialChecks: []atc.CredentialCheck{
+						{Name: "BAR", Status: atc.CredentialCheckNotFound},
+					},
+				}))
+			})
+		})
+
+		Context("when the server fails", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusInternalServerError
+				returnBody = []byte(`oops`)
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 })
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 )
 
@@ -42,7 +43,8 @@ func (err CommandFailedError) Error() string {
 // InvalidConfigError is returned when saving a pipeline returns errors (i.e.
 // validation failures).
 type InvalidConfigError struct {
-	Errors []string `json:"errors"`
+	Errors           []string              `json:"errors"`
+	CredentialChecks []atc.CredentialCheck `json:"credential_checks"`
 }
 
 // Error lists the errors returned for the config.
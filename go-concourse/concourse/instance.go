@@ -0,0 +1,50 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// ForInstance returns the team, addressing the instances of its pipelines
+// with the given instance vars rather than the pipelines which aren't
+// instanced.
+func (t *team) ForInstance(instanceVars atc.InstanceVars) Team {
+	if len(instanceVars) == 0 {
+		return t
+	}
+
+	return &team{
+		name: t.name,
+		connection: instanceConnection{
+			Connection:   t.connection,
+			instanceVars: instanceVars,
+		},
+	}
+}
+
+// instanceConnection adds the instance vars to every request for a
+// pipeline, so that the API looks up the instance.
+type instanceConnection struct {
+	internal.Connection
+
+	instanceVars atc.InstanceVars
+}
+
+func (connection instanceConnection) Send(request internal.Request, response *internal.Response) error {
+	if _, found := request.Params["pipeline_name"]; found {
+		query := url.Values{}
+		for key, values := range request.Query {
+			query[key] = values
+		}
+
+		for key, values := range connection.instanceVars.QueryParams() {
+			query[key] = values
+		}
+
+		request.Query = query
+	}
+
+	return connection.Connection.Send(request, response)
+}
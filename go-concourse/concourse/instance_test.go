@@ -0,0 +1,77 @@
+package concourse_test
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Pipeline Instances", func() {
+	var instance concourse.Team
+
+	BeforeEach(func() {
+		instance = team.ForInstance(atc.InstanceVars{"branch": "1.2"})
+	})
+
+	It("is the team itself without any instance vars", func() {
+		Expect(team.ForInstance(nil)).To(BeIdenticalTo(team))
+	})
+
+	Describe("requests for a pipeline", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/release/pause", "vars="+url.QueryEscape(`{"branch":"1.2"}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("address the instance by its vars", func() {
+			found, err := instance.PausePipeline("release")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Describe("requests for a pipeline with their own query", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/release/checks"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.URL.Query().Get("limit")).To(Equal("10"))
+						Expect(r.URL.Query().Get("vars")).To(MatchJSON(`{"branch":"1.2"}`))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Check{}),
+				),
+			)
+		})
+
+		It("keep their query", func() {
+			_, err := instance.ListChecks("release", 10)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("requests for the team's pipelines", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines", ""),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Pipeline{}),
+				),
+			)
+		})
+
+		It("are not changed", func() {
+			_, err := instance.ListPipelines()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
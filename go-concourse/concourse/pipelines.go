@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -56,7 +57,7 @@ func (team *team) OrderingPipelines(pipelines []string) error {
 	}, &internal.Response{})
 }
 
-func (team *team) ListPipelines() ([]atc.Pipeline, error) {
+func (team *team) ListPipelines(labelSelectors ...string) ([]atc.Pipeline, error) {
 	params := rata.Params{
 		"team_name": team.name,
 	}
@@ -65,6 +66,7 @@ func (team *team) ListPipelines() ([]atc.Pipeline, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListPipelines,
 		Params:      params,
+		Query:       labelQuery(labelSelectors),
 	}, &internal.Response{
 		Result: &pipelines,
 	})
@@ -72,10 +74,11 @@ func (team *team) ListPipelines() ([]atc.Pipeline, error) {
 	return pipelines, err
 }
 
-func (client *client) ListPipelines() ([]atc.Pipeline, error) {
+func (client *client) ListPipelines(labelSelectors ...string) ([]atc.Pipeline, error) {
 	var pipelines []atc.Pipeline
 	err := client.connection.Send(internal.Request{
 		RequestName: atc.ListAllPipelines,
+		Query:       labelQuery(labelSelectors),
 	}, &internal.Response{
 		Result: &pipelines,
 	})
@@ -83,6 +86,15 @@ func (client *client) ListPipelines() ([]atc.Pipeline, error) {
 	return pipelines, err
 }
 
+func labelQuery(labelSelectors []string) url.Values {
+	query := url.Values{}
+	for _, selector := range labelSelectors {
+		query.Add("label", selector)
+	}
+
+	return query
+}
+
 func (team *team) CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error) {
 	var build atc.Build
 
@@ -149,6 +161,52 @@ func (team *team) managePipeline(pipelineName string, endpoint string) (bool, er
 	}
 }
 
+func (team *team) DeletePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error) {
+	return team.managePipelines(pipelineIDs, atc.DeletePipelines)
+}
+
+func (team *team) PausePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error) {
+	return team.managePipelines(pipelineIDs, atc.PausePipelines)
+}
+
+func (team *team) UnpausePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error) {
+	return team.managePipelines(pipelineIDs, atc.UnpausePipelines)
+}
+
+func (team *team) ExposePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error) {
+	return team.managePipelines(pipelineIDs, atc.ExposePipelines)
+}
+
+func (team *team) HidePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error) {
+	return team.managePipelines(pipelineIDs, atc.HidePipelines)
+}
+
+func (team *team) managePipelines(pipelineIDs []int, endpoint string) ([]atc.PipelineBatchResult, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(pipelineIDs)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal pipeline IDs: %s", err)
+	}
+
+	var results []atc.PipelineBatchResult
+	err = team.connection.Send(internal.Request{
+		RequestName: endpoint,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &results,
+	})
+
+	return results, err
+}
+
 func (team *team) RenamePipeline(pipelineName, name string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineName,
@@ -160,6 +160,71 @@ var _ = Describe("ATC Handler Pipelines", func() {
 		})
 	})
 
+	Describe("PausePipelines", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/pause"
+
+		Context("when the request succeeds", func() {
+			var expectedResults []atc.PipelineBatchResult
+
+			BeforeEach(func() {
+				expectedResults = []atc.PipelineBatchResult{
+					{ID: 1, Found: true},
+					{ID: 2, Found: false},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting([]int{1, 2}),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("returns the result for each pipeline", func() {
+				results, err := team.PausePipelines([]int{1, 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when the team doesn't exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns error", func() {
+				_, err := team.PausePipelines([]int{1})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DeletePipelines", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/pipelines"),
+					ghttp.VerifyJSONRepresenting([]int{1}),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineBatchResult{
+						{ID: 1, Found: true},
+					}),
+				),
+			)
+		})
+
+		It("returns the result for each pipeline", func() {
+			results, err := team.DeletePipelines([]int{1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]atc.PipelineBatchResult{{ID: 1, Found: true}}))
+		})
+	})
+
 	Describe("OrderingPipelines", func() {
 		Context("when the pipelines exists", func() {
 			BeforeEach(func() {
@@ -310,6 +375,21 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pipelines).To(Equal(expectedPipelines))
 		})
+
+		Context("when label selectors are given", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(0, ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines", "label=env%3Dprod&label=tier"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPipelines),
+				))
+			})
+
+			It("sends them as label query params", func() {
+				pipelines, err := team.ListPipelines("env=prod", "tier")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pipelines).To(Equal(expectedPipelines))
+			})
+		})
 	})
 
 	Describe("client.ListPipelines", func() {
@@ -356,6 +436,21 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pipelines).To(Equal(expectedPipelines))
 		})
+
+		Context("when label selectors are given", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(0, ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/pipelines", "label=env%3Dprod"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPipelines),
+				))
+			})
+
+			It("sends them as label query params", func() {
+				pipelines, err := client.ListPipelines("env=prod")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pipelines).To(Equal(expectedPipelines))
+			})
+		})
 	})
 
 	Describe("DeletePipeline", func() {
@@ -1,6 +1,10 @@
 package concourse
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
@@ -46,3 +50,31 @@ func (team *team) ListResources(pipelineName string) ([]atc.Resource, error) {
 
 	return resources, err
 }
+
+func (team *team) PinResource(pipelineName string, resourceName string, pin atc.PinResourceRequestBody) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(pin)
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.PinResource,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
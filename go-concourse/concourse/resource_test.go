@@ -108,4 +108,60 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 		})
 	})
+
+	Describe("team.PinResource", func() {
+		var (
+			expectedStatus int
+			pin            atc.PinResourceRequestBody
+		)
+
+		BeforeEach(func() {
+			pin = atc.PinResourceRequestBody{
+				Version:    atc.Version{"ref": "abc123"},
+				PinComment: "broken upstream",
+				ExpiresIn:  "24h",
+			}
+
+			expectedStatus = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/pin"),
+					ghttp.VerifyJSONRepresenting(pin),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		It("pins the resource", func() {
+			pinned, err := team.PinResource("some-pipeline", "some-resource", pin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pinned).To(BeTrue())
+		})
+
+		Context("when the resource or version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				pinned, err := team.PinResource("some-pipeline", "some-resource", pin)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+
+		Context("when pinning fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				_, err := team.PinResource("some-pipeline", "some-resource", pin)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
@@ -0,0 +1,31 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) SecretLookups(pipelineName string) ([]atc.SecretLookup, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var lookups []atc.SecretLookup
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListSecretLookups,
+		Params:      params,
+	}, &internal.Response{
+		Result: &lookups,
+	})
+
+	switch err.(type) {
+	case nil:
+		return lookups, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,64 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Secret Lookups", func() {
+	Describe("SecretLookups", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/secret-lookups"
+
+		Context("when the pipeline exists", func() {
+			var expectedLookups []atc.SecretLookup
+
+			BeforeEach(func() {
+				expectedLookups = []atc.SecretLookup{
+					{
+						UsedByType: "job",
+						UsedByName: "some-job",
+						Name:       "some-var",
+						Backend:    "vault",
+						Path:       "/concourse/some-team/some-var",
+						Found:      true,
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedLookups),
+					),
+				)
+			})
+
+			It("returns the secret lookups for the pipeline", func() {
+				lookups, found, err := team.SecretLookups("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(lookups).To(Equal(expectedLookups))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.SecretLookups("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -11,11 +11,15 @@ import (
 
 type Team interface {
 	Name() string
+	ForInstance(instanceVars atc.InstanceVars) Team
 
 	CreateOrUpdate(team atc.Team) (atc.Team, bool, bool, error)
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
 
+	ResourceTypes() (atc.ResourceTypes, error)
+	SetResourceTypes(resourceTypes atc.ResourceTypes) error
+
 	Pipeline(name string) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
 	DeletePipeline(pipelineName string) (bool, error)
@@ -23,10 +27,19 @@ type Team interface {
 	UnpausePipeline(pipelineName string) (bool, error)
 	ExposePipeline(pipelineName string) (bool, error)
 	HidePipeline(pipelineName string) (bool, error)
+	DeletePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error)
+	PausePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error)
+	UnpausePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error)
+	ExposePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error)
+	HidePipelines(pipelineIDs []int) ([]atc.PipelineBatchResult, error)
 	RenamePipeline(pipelineName, name string) (bool, error)
-	ListPipelines() ([]atc.Pipeline, error)
+	ListPipelines(labelSelectors ...string) ([]atc.Pipeline, error)
 	PipelineConfig(pipelineName string) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	ValidatePipelineConfig(pipelineName string, passedConfig []byte, checkCredentials bool) ([]ConfigWarning, []atc.CredentialCheck, error)
+	SecretLookups(pipelineName string) ([]atc.SecretLookup, bool, error)
+	PipelineConfigVersions(pipelineName string) ([]atc.PipelineConfigVersion, bool, error)
+	PipelineConfigAtVersion(pipelineName string, version int) (atc.Config, bool, error)
 
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
 
@@ -35,7 +48,11 @@ type Team interface {
 	Job(pipelineName, jobName string) (atc.Job, bool, error)
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
+	BuildInputDiff(pipelineName string, jobName string, fromBuildName string, toBuildName string) (atc.BuildInputDiff, bool, error)
+	BuildProvenance(pipelineName string, jobName string, buildName string) ([]atc.VersionProvenance, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
+	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, bool, error)
+	JobBuildStepPlan(pipelineName string, jobName string, buildName string, stepName string) (atc.BuildStepPlan, bool, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 
 	PauseJob(pipelineName string, jobName string) (bool, error)
@@ -45,10 +62,14 @@ type Team interface {
 
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
+	PinResource(pipelineName string, resourceName string, pin atc.PinResourceRequestBody) (bool, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error)
-	CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error)
-	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error)
+	CheckResource(pipelineName string, resourceName string, version atc.Version) (atc.Check, bool, error)
+	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (atc.Check, bool, error)
+	Check(checkID string) (atc.Check, bool, error)
+	ListChecks(pipelineName string, limit int) ([]atc.Check, error)
+	ResourceChecks(pipelineName string, resourceName string, limit int) ([]atc.Check, bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
 	EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
 
@@ -62,6 +83,8 @@ type Team interface {
 	Builds(page Page) ([]atc.Build, Pagination, error)
 	OrderingPipelines(pipelineNames []string) error
 
+	WebhookDeliveries(limit int) ([]atc.WebhookDelivery, error)
+
 	CreateArtifact(io.Reader) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
 }
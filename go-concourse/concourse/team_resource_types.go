@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ResourceTypes() (atc.ResourceTypes, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	var resourceTypes atc.ResourceTypes
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamResourceTypes,
+		Params:      params,
+	}, &internal.Response{
+		Result: &resourceTypes,
+	})
+
+	return resourceTypes, err
+}
+
+func (team *team) SetResourceTypes(resourceTypes atc.ResourceTypes) error {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	jsonBytes, err := json.Marshal(resourceTypes)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamResourceTypes,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
@@ -0,0 +1,78 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Team Resource Types", func() {
+	var resourceTypes atc.ResourceTypes
+
+	BeforeEach(func() {
+		resourceTypes = atc.ResourceTypes{
+			{
+				Name:     "some-type",
+				Type:     "registry-image",
+				Source:   atc.Source{"repository": "some-repository"},
+				Defaults: atc.Source{"some": "default"},
+			},
+		}
+	})
+
+	Describe("ResourceTypes", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/resource_types"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, resourceTypes),
+				),
+			)
+		})
+
+		It("returns the team's resource types", func() {
+			types, err := team.ResourceTypes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(types).To(Equal(resourceTypes))
+		})
+	})
+
+	Describe("SetResourceTypes", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/resource_types"),
+						ghttp.VerifyJSONRepresenting(resourceTypes),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("sends the resource types", func() {
+				err := team.SetResourceTypes(resourceTypes)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the resource types are invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/resource_types"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["invalid resource types"]}`),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				err := team.SetResourceTypes(resourceTypes)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) WebhookDeliveries(limit int) ([]atc.WebhookDelivery, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.WebhookDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListWebhookDeliveries,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	return deliveries, err
+}
@@ -0,0 +1,46 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Webhook Deliveries", func() {
+	Describe("WebhookDeliveries", func() {
+		expectedURL := "/api/v1/teams/some-team/webhooks/deliveries"
+
+		var expectedDeliveries []atc.WebhookDelivery
+
+		BeforeEach(func() {
+			expectedDeliveries = []atc.WebhookDelivery{
+				{
+					ID:         1,
+					Provider:   "github",
+					Event:      "push",
+					DeliveryID: "some-delivery",
+					Matched: []atc.WebhookMatch{
+						{PipelineName: "some-pipeline", ResourceName: "some-resource"},
+					},
+					ReceivedAt: 1,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+				),
+			)
+		})
+
+		It("returns the team's deliveries", func() {
+			deliveries, err := team.WebhookDeliveries(5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(Equal(expectedDeliveries))
+		})
+	})
+})